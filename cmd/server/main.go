@@ -5,8 +5,11 @@ import (
 	"flag"
 	"fmt"
 	"net/http"
+	"os/signal"
 	"strconv"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -15,6 +18,7 @@ import (
 	"github.com/openinfradev/tks-api/internal/database"
 	"github.com/openinfradev/tks-api/internal/keycloak"
 	"github.com/openinfradev/tks-api/internal/mail"
+	"github.com/openinfradev/tks-api/internal/middleware/audit"
 	"github.com/openinfradev/tks-api/internal/route"
 	argowf "github.com/openinfradev/tks-api/pkg/argo-client"
 	"github.com/openinfradev/tks-api/pkg/log"
@@ -69,6 +73,19 @@ func init() {
 	// alerts
 	flag.String("alert-slack", "", "slack url for LMA alert")
 
+	// network
+	flag.String("trusted-proxies", audit.DefaultTrustedProxies, "comma separated IPs or CIDRs of reverse proxies allowed to set X-Forwarded-For. defaults to private networks. if empty, X-Forwarded-For is ignored and the login throttle applies per proxy")
+
+	// policy
+	flag.String("constraint-template-allowed-hosts", "github.com,raw.githubusercontent.com,gitlab.com", "comma separated git hosts from which constraint templates can be imported")
+
+	// pricing
+	flag.String("pricing-catalog-dir", "", "directory of price files(*.json) for cost estimation. overrides the built-in catalog")
+	flag.String("project-usage-currency", "USD", "currency of project usage rates")
+	flag.Float64("project-usage-cpu-rate", 0.03, "project usage rate per cpu core hour")
+	flag.Float64("project-usage-memory-rate", 0.004, "project usage rate per memory GiB hour")
+	flag.Float64("project-usage-storage-rate", 0.00014, "project usage rate per storage GiB hour")
+
 	pflag.CommandLine.AddGoFlagSet(flag.CommandLine)
 	flag.Parse()
 
@@ -110,6 +127,13 @@ func main() {
 	}
 	log.Info(ctx, "****************** ")
 
+	if err := audit.SetTrustedProxies(viper.GetString("trusted-proxies")); err != nil {
+		log.Fatal(ctx, "invalid trusted-proxies : ", err)
+	}
+	if viper.GetString("trusted-proxies") == "" {
+		log.Warn(ctx, "trusted-proxies is empty. all requests through a reverse proxy share the proxy IP for audit logs and the login throttle")
+	}
+
 	// For web service
 	asset := route.NewAssetHandler(viper.GetString("web-root"))
 
@@ -155,11 +179,28 @@ func main() {
 		log.Fatal(ctx, "failed to initialize ses : ", err)
 	}
 
-	route := route.SetupRouter(db, argoClient, keycloak, asset)
+	// 종료 시그널을 받으면 주기 작업을 중단하고 처리 중인 요청을 마친 뒤 종료한다.
+	serverCtx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	route := route.SetupRouter(serverCtx, db, argoClient, keycloak, asset)
+
+	server := &http.Server{
+		Addr:    "0.0.0.0:" + strconv.Itoa(viper.GetInt("port")),
+		Handler: route,
+	}
+	go func() {
+		<-serverCtx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			log.Error(ctx, "failed to shutdown server: ", err)
+		}
+	}()
 
 	log.Info(ctx, "Starting server on ", viper.GetInt("port"))
-	err = http.ListenAndServe("0.0.0.0:"+strconv.Itoa(viper.GetInt("port")), route)
-	if err != nil {
+	err = server.ListenAndServe()
+	if err != nil && err != http.ErrServerClosed {
 		log.Fatal(ctx, err)
 	}
 }
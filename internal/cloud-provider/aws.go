@@ -0,0 +1,298 @@
+package cloudprovider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/kubernetes"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+const (
+	awsDefaultRegion = "ap-northeast-2"
+	awsAssumeRole    = "controllers.cluster-api-provider-aws.sigs.k8s.io"
+)
+
+var (
+	awsAccountIdRegexp = regexp.MustCompile(`^[0-9]{12}$`)
+	awsRegionRegexp    = regexp.MustCompile(`^[a-z]{2}(-gov|-iso[a-z]*)?-[a-z]+-[0-9]$`)
+)
+
+type awsProvider struct {
+	opts Options
+}
+
+func (p *awsProvider) CloudService() string {
+	return domain.CloudService_AWS
+}
+
+func (p *awsProvider) DefaultRegion() string {
+	return awsDefaultRegion
+}
+
+func (p *awsProvider) ValidateRegion(region string) error {
+	if !awsRegionRegexp.MatchString(region) {
+		return fmt.Errorf("invalid aws region '%s'", region)
+	}
+	return nil
+}
+
+func (p *awsProvider) AccountId(account model.CloudAccount) string {
+	if account.AwsAccountId != "" {
+		return account.AwsAccountId
+	}
+	return account.AccountId
+}
+
+func (p *awsProvider) ValidateAccount(account model.CloudAccount) error {
+	if !awsAccountIdRegexp.MatchString(p.AccountId(account)) {
+		return fmt.Errorf("awsAccountId must be 12 digits")
+	}
+	if account.AccessKeyId == "" || account.SecretAccessKey == "" {
+		return fmt.Errorf("accessKeyId and secretAccessKey are required")
+	}
+	return nil
+}
+
+func (p *awsProvider) ValidateCredential(ctx context.Context, account model.CloudAccount) error {
+	cfg, err := p.config(ctx, account.AccessKeyId, account.SecretAccessKey, account.SessionToken)
+	if err != nil {
+		return err
+	}
+
+	res, err := sts.NewFromConfig(cfg).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return err
+	}
+
+	if aws.ToString(res.Account) != p.AccountId(account) {
+		return fmt.Errorf("credential belongs to account %s, not %s", aws.ToString(res.Account), p.AccountId(account))
+	}
+	return nil
+}
+
+func (p *awsProvider) config(ctx context.Context, accessKeyId string, secretAccessKey string, sessionToken string) (aws.Config, error) {
+	cfg, err := config.LoadDefaultConfig(ctx,
+		config.WithRegion(awsDefaultRegion),
+		config.WithHTTPClient(p.opts.httpClient()),
+		config.WithCredentialsProvider(credentials.StaticCredentialsProvider{
+			Value: aws.Credentials{
+				AccessKeyID: accessKeyId, SecretAccessKey: secretAccessKey, SessionToken: sessionToken,
+			},
+		}))
+	if err != nil {
+		return cfg, err
+	}
+
+	if p.opts.Endpoint != "" {
+		cfg.BaseEndpoint = aws.String(p.opts.Endpoint)
+	}
+	return cfg, nil
+}
+
+// tksConfig 는 TKS 의 인증 정보로 계정의 IAM role 을 assume 한 설정을 반환한다.
+func (p *awsProvider) tksConfig(ctx context.Context, account model.CloudAccount, region string) (cfg aws.Config, err error) {
+	var awsAccessKeyId, awsSecretAccessKey string
+	if p.opts.Secret != nil {
+		secret, err := p.opts.Secret(ctx, "awsconfig-secret")
+		if err != nil {
+			return cfg, err
+		}
+		awsAccessKeyId, awsSecretAccessKey = secret["aws_access_key_id"], secret["aws_secret_access_key"]
+	} else {
+		awsAccessKeyId, awsSecretAccessKey, err = kubernetes.GetAwsSecret(ctx)
+	}
+	if err != nil || awsAccessKeyId == "" || awsSecretAccessKey == "" {
+		log.Error(ctx, err)
+		return cfg, fmt.Errorf("Invalid aws secret.")
+	}
+
+	cfg, err = p.config(ctx, awsAccessKeyId, awsSecretAccessKey, "")
+	if err != nil {
+		log.Error(ctx, err)
+	}
+
+	if !strings.Contains(account.Name, domain.CLOUD_ACCOUNT_INCLUSTER) {
+		log.Info(ctx, "Use assume role. awsAccountId : ", p.AccountId(account))
+		creds := stscreds.NewAssumeRoleProvider(sts.NewFromConfig(cfg), "arn:aws:iam::"+p.AccountId(account)+":role/"+awsAssumeRole)
+		cfg.Credentials = aws.NewCredentialsCache(creds)
+	}
+	cfg.Region = region
+	return cfg, nil
+}
+
+func (p *awsProvider) CheckHealth(ctx context.Context, account model.CloudAccount) error {
+	cfg, err := p.tksConfig(ctx, account, awsDefaultRegion)
+	if err != nil {
+		return err
+	}
+
+	res, err := sts.NewFromConfig(cfg).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return err
+	}
+
+	if aws.ToString(res.Account) != p.AccountId(account) {
+		return fmt.Errorf("assumed role belongs to account %s, not %s", aws.ToString(res.Account), p.AccountId(account))
+	}
+	return nil
+}
+
+func (p *awsProvider) GetResourceQuota(ctx context.Context, account model.CloudAccount, region string) (available bool, out domain.ResourceQuota, err error) {
+	cfg, err := p.tksConfig(ctx, account, region)
+	if err != nil {
+		return false, out, err
+	}
+
+	// current usage
+	type CurrentUsage struct {
+		NLB     int
+		CLB     int
+		IGW     int
+		Cluster int
+		EIP     int
+	}
+
+	// get current usage
+	currentUsage := CurrentUsage{}
+	{
+		c := elasticloadbalancingv2.NewFromConfig(cfg)
+		pageSize := int32(100)
+		res, err := c.DescribeLoadBalancers(ctx, &elasticloadbalancingv2.DescribeLoadBalancersInput{
+			PageSize: &pageSize,
+		})
+		if err != nil {
+			return false, out, err
+		}
+
+		for _, elb := range res.LoadBalancers {
+			switch elb.Type {
+			case "network":
+				currentUsage.NLB += 1
+			}
+		}
+	}
+
+	{
+		c := elasticloadbalancing.NewFromConfig(cfg)
+		pageSize := int32(100)
+		res, err := c.DescribeLoadBalancers(ctx, &elasticloadbalancing.DescribeLoadBalancersInput{
+			PageSize: &pageSize,
+		})
+		if err != nil {
+			return false, out, err
+		}
+		currentUsage.CLB = len(res.LoadBalancerDescriptions)
+	}
+
+	{
+		c := ec2.NewFromConfig(cfg)
+		res, err := c.DescribeInternetGateways(ctx, &ec2.DescribeInternetGatewaysInput{})
+		if err != nil {
+			return false, out, err
+		}
+		currentUsage.IGW = len(res.InternetGateways)
+	}
+
+	{
+		c := eks.NewFromConfig(cfg)
+		res, err := c.ListClusters(ctx, &eks.ListClustersInput{})
+		if err != nil {
+			return false, out, err
+		}
+		currentUsage.Cluster = len(res.Clusters)
+	}
+
+	{
+		c := ec2.NewFromConfig(cfg)
+		res, err := c.DescribeAddresses(ctx, &ec2.DescribeAddressesInput{})
+		if err != nil {
+			log.Error(ctx, err)
+			return false, out, err
+		}
+		currentUsage.EIP = len(res.Addresses)
+	}
+
+	// stack 1개 생성하는데 필요한 quota
+	// Classic 1
+	// Network 5
+	// IGW 1
+	// EIP 3
+	// Cluster 1
+	quotas := []struct {
+		quotaCode   string
+		serviceCode string
+		typ         string
+		usage       int
+		required    int
+	}{
+		{"L-69A177A2", "elasticloadbalancing", "NLB", currentUsage.NLB, 5},
+		{"L-E9E9831D", "elasticloadbalancing", "CLB", currentUsage.CLB, 1},
+		{"L-A4707A72", "vpc", "IGW", currentUsage.IGW, 1},
+		{"L-1194D53C", "eks", "EKS", currentUsage.Cluster, 1},
+		{"L-0263D0A3", "ec2", "EIP", currentUsage.EIP, 3},
+	}
+
+	client := servicequotas.NewFromConfig(cfg)
+	builder := newQuotaBuilder()
+	for _, quota := range quotas {
+		res, err := client.GetServiceQuota(ctx, &servicequotas.GetServiceQuotaInput{
+			QuotaCode:   &quota.quotaCode,
+			ServiceCode: &quota.serviceCode,
+		})
+		if err != nil {
+			return false, out, err
+		}
+		log.Debugf(ctx, "%s %s %v", aws.ToString(res.Quota.QuotaName), aws.ToString(res.Quota.QuotaCode), aws.ToFloat64(res.Quota.Value))
+
+		quotaValue := int(aws.ToFloat64(res.Quota.Value))
+		log.Infof(ctx, "%s : usage %d, quota %d", quota.typ, quota.usage, quotaValue)
+		builder.add(quota.typ, quota.usage, quotaValue, quota.required)
+	}
+
+	return builder.result()
+}
+
+func (p *awsProvider) BootstrapWorkflow(account model.CloudAccount, region string) Workflow {
+	return Workflow{
+		Template:   "tks-create-aws-cloud-account",
+		Parameters: p.workflowParameters(account, region),
+	}
+}
+
+func (p *awsProvider) CleanupWorkflow(account model.CloudAccount, region string) Workflow {
+	return Workflow{
+		Template:   "tks-delete-aws-cloud-account",
+		Parameters: p.workflowParameters(account, region),
+	}
+}
+
+func (p *awsProvider) workflowParameters(account model.CloudAccount, region string) []string {
+	return []string{
+		"aws_region=" + region,
+		"tks_cloud_account_id=" + account.ID.String(),
+		"aws_account_id=" + p.AccountId(account),
+		"aws_access_key_id=" + account.AccessKeyId,
+		"aws_secret_access_key=" + account.SecretAccessKey,
+		"aws_session_token=" + account.SessionToken,
+	}
+}
+
+func (p *awsProvider) ClusterWorkflowParameters(account model.CloudAccount) []string {
+	// AWS 클러스터 워크플로우는 cloud_account_id 로 어카운트 정보를 조회한다.
+	return nil
+}
@@ -0,0 +1,200 @@
+package cloudprovider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const (
+	azureDefaultRegion       = "koreacentral"
+	azureManagementEndpoint  = "https://management.azure.com"
+	azureLoginEndpoint       = "https://login.microsoftonline.com"
+	azureSubscriptionVersion = "2022-12-01"
+	azureComputeVersion      = "2023-07-01"
+	azureNetworkVersion      = "2023-09-01"
+)
+
+var (
+	uuidRegexp        = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	azureRegionRegexp = regexp.MustCompile(`^[a-z][a-z0-9]+$`)
+)
+
+type azureProvider struct {
+	opts Options
+}
+
+type azureUsage struct {
+	CurrentValue int `json:"currentValue"`
+	Limit        int `json:"limit"`
+	Name         struct {
+		Value string `json:"value"`
+	} `json:"name"`
+}
+
+func (p *azureProvider) CloudService() string {
+	return domain.CloudService_AZURE
+}
+
+func (p *azureProvider) DefaultRegion() string {
+	return azureDefaultRegion
+}
+
+func (p *azureProvider) ValidateRegion(region string) error {
+	if !azureRegionRegexp.MatchString(region) {
+		return fmt.Errorf("invalid azure region '%s'", region)
+	}
+	return nil
+}
+
+func (p *azureProvider) AccountId(account model.CloudAccount) string {
+	return account.AccountId
+}
+
+func (p *azureProvider) ValidateAccount(account model.CloudAccount) error {
+	if !uuidRegexp.MatchString(account.AccountId) {
+		return fmt.Errorf("accountId must be an azure subscription id")
+	}
+	if !uuidRegexp.MatchString(account.TenantId) {
+		return fmt.Errorf("tenantId must be an azure tenant id")
+	}
+	if account.ClientId == "" || account.ClientSecret == "" {
+		return fmt.Errorf("clientId and clientSecret are required")
+	}
+	return nil
+}
+
+func (p *azureProvider) ValidateCredential(ctx context.Context, account model.CloudAccount) error {
+	return p.checkSubscription(ctx, p.client(ctx, account.TenantId, account.ClientId, account.ClientSecret), account)
+}
+
+func (p *azureProvider) CheckHealth(ctx context.Context, account model.CloudAccount) error {
+	secret, err := p.opts.secret(ctx, "azureconfig-secret")
+	if err != nil {
+		return err
+	}
+	return p.checkSubscription(ctx, p.client(ctx, account.TenantId, secret["client_id"], secret["client_secret"]), account)
+}
+
+// checkSubscription 은 구독을 조회하여 활성 상태인지 확인한다.
+func (p *azureProvider) checkSubscription(ctx context.Context, client *http.Client, account model.CloudAccount) error {
+	var subscription struct {
+		SubscriptionId string `json:"subscriptionId"`
+		State          string `json:"state"`
+	}
+	if err := p.get(ctx, client, fmt.Sprintf("/subscriptions/%s?api-version=%s", account.AccountId, azureSubscriptionVersion), &subscription); err != nil {
+		return err
+	}
+
+	if subscription.State != "Enabled" {
+		return fmt.Errorf("subscription %s is %s", account.AccountId, subscription.State)
+	}
+	return nil
+}
+
+func (p *azureProvider) GetResourceQuota(ctx context.Context, account model.CloudAccount, region string) (available bool, out domain.ResourceQuota, err error) {
+	secret, err := p.opts.secret(ctx, "azureconfig-secret")
+	if err != nil {
+		return false, out, err
+	}
+	client := p.client(ctx, account.TenantId, secret["client_id"], secret["client_secret"])
+
+	usages := map[string]azureUsage{}
+	for _, path := range []string{
+		fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Compute/locations/%s/usages?api-version=%s", account.AccountId, region, azureComputeVersion),
+		fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Network/locations/%s/usages?api-version=%s", account.AccountId, region, azureNetworkVersion),
+	} {
+		var res struct {
+			Value []azureUsage `json:"value"`
+		}
+		if err := p.get(ctx, client, path, &res); err != nil {
+			return false, out, err
+		}
+		for _, usage := range res.Value {
+			usages[usage.Name.Value] = usage
+		}
+	}
+
+	// stack 1개 생성하는데 필요한 quota
+	// vCPU 24 (control plane 3, worker 3)
+	// Public IP 3
+	// Load balancer 2
+	builder := newQuotaBuilder()
+	for _, quota := range []struct {
+		name     string
+		typ      string
+		required int
+	}{
+		{"cores", "vCPU", 24},
+		{"PublicIPAddresses", "PublicIP", 3},
+		{"LoadBalancers", "LB", 2},
+	} {
+		usage, ok := usages[quota.name]
+		if !ok {
+			log.Warnf(ctx, "azure usage %s not found in %s", quota.name, region)
+			continue
+		}
+		builder.add(quota.typ, usage.CurrentValue, usage.Limit, quota.required)
+	}
+
+	return builder.result()
+}
+
+func (p *azureProvider) client(ctx context.Context, tenantId string, clientId string, clientSecret string) *http.Client {
+	cfg := clientcredentials.Config{
+		ClientID:     clientId,
+		ClientSecret: clientSecret,
+		TokenURL:     p.opts.authEndpoint(azureLoginEndpoint) + "/" + url.PathEscape(tenantId) + "/oauth2/v2.0/token",
+		Scopes:       []string{azureManagementEndpoint + "/.default"},
+	}
+	return cfg.Client(context.WithValue(ctx, oauth2.HTTPClient, p.opts.httpClient()))
+}
+
+func (p *azureProvider) get(ctx context.Context, client *http.Client, path string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.opts.endpoint(azureManagementEndpoint)+path, nil)
+	if err != nil {
+		return err
+	}
+	_, err = doJSON(client, req, out)
+	return err
+}
+
+func (p *azureProvider) BootstrapWorkflow(account model.CloudAccount, region string) Workflow {
+	return Workflow{
+		Template:   "tks-create-azure-cloud-account",
+		Parameters: p.workflowParameters(account, region),
+	}
+}
+
+func (p *azureProvider) CleanupWorkflow(account model.CloudAccount, region string) Workflow {
+	return Workflow{
+		Template:   "tks-delete-azure-cloud-account",
+		Parameters: p.workflowParameters(account, region),
+	}
+}
+
+func (p *azureProvider) workflowParameters(account model.CloudAccount, region string) []string {
+	return []string{
+		"azure_location=" + region,
+		"tks_cloud_account_id=" + account.ID.String(),
+		"azure_subscription_id=" + account.AccountId,
+		"azure_tenant_id=" + account.TenantId,
+		"azure_client_id=" + account.ClientId,
+		"azure_client_secret=" + account.ClientSecret,
+	}
+}
+
+func (p *azureProvider) ClusterWorkflowParameters(account model.CloudAccount) []string {
+	return []string{
+		"azure_subscription_id=" + account.AccountId,
+		"azure_tenant_id=" + account.TenantId,
+	}
+}
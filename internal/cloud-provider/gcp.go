@@ -0,0 +1,227 @@
+package cloudprovider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/jwt"
+)
+
+const (
+	gcpDefaultRegion   = "asia-northeast3"
+	gcpComputeEndpoint = "https://compute.googleapis.com"
+	gcpTokenEndpoint   = "https://oauth2.googleapis.com/token"
+	gcpComputeScope    = "https://www.googleapis.com/auth/compute.readonly"
+)
+
+var (
+	gcpProjectIdRegexp = regexp.MustCompile(`^[a-z][a-z0-9-]{4,28}[a-z0-9]$`)
+	gcpRegionRegexp    = regexp.MustCompile(`^[a-z]+-[a-z]+[0-9]+$`)
+)
+
+type gcpProvider struct {
+	opts Options
+}
+
+type gcpServiceAccountKey struct {
+	Type         string `json:"type"`
+	ProjectId    string `json:"project_id"`
+	PrivateKeyId string `json:"private_key_id"`
+	PrivateKey   string `json:"private_key"`
+	ClientEmail  string `json:"client_email"`
+}
+
+type gcpQuota struct {
+	Metric string  `json:"metric"`
+	Limit  float64 `json:"limit"`
+	Usage  float64 `json:"usage"`
+}
+
+func (p *gcpProvider) CloudService() string {
+	return domain.CloudService_GCP
+}
+
+func (p *gcpProvider) DefaultRegion() string {
+	return gcpDefaultRegion
+}
+
+func (p *gcpProvider) ValidateRegion(region string) error {
+	if !gcpRegionRegexp.MatchString(region) {
+		return fmt.Errorf("invalid gcp region '%s'", region)
+	}
+	return nil
+}
+
+func (p *gcpProvider) AccountId(account model.CloudAccount) string {
+	return account.AccountId
+}
+
+func (p *gcpProvider) ValidateAccount(account model.CloudAccount) error {
+	if !gcpProjectIdRegexp.MatchString(account.AccountId) {
+		return fmt.Errorf("accountId must be a gcp project id")
+	}
+	if _, err := parseGcpServiceAccountKey(account.ServiceAccountKey); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *gcpProvider) ValidateCredential(ctx context.Context, account model.CloudAccount) error {
+	key, err := parseGcpServiceAccountKey(account.ServiceAccountKey)
+	if err != nil {
+		return err
+	}
+
+	var project struct {
+		Name string `json:"name"`
+	}
+	return p.get(ctx, p.client(ctx, key), "/compute/v1/projects/"+account.AccountId, &project)
+}
+
+func (p *gcpProvider) CheckHealth(ctx context.Context, account model.CloudAccount) error {
+	secret, err := p.opts.secret(ctx, "gcpconfig-secret")
+	if err != nil {
+		return err
+	}
+	key, err := parseGcpServiceAccountKey(secret["key.json"])
+	if err != nil {
+		return err
+	}
+
+	var project struct {
+		Name string `json:"name"`
+	}
+	return p.get(ctx, p.client(ctx, key), "/compute/v1/projects/"+account.AccountId, &project)
+}
+
+func (p *gcpProvider) GetResourceQuota(ctx context.Context, account model.CloudAccount, region string) (available bool, out domain.ResourceQuota, err error) {
+	secret, err := p.opts.secret(ctx, "gcpconfig-secret")
+	if err != nil {
+		return false, out, err
+	}
+	key, err := parseGcpServiceAccountKey(secret["key.json"])
+	if err != nil {
+		return false, out, err
+	}
+	client := p.client(ctx, key)
+
+	quotas := map[string]gcpQuota{}
+	for _, path := range []string{
+		"/compute/v1/projects/" + account.AccountId,
+		"/compute/v1/projects/" + account.AccountId + "/regions/" + region,
+	} {
+		var res struct {
+			Quotas []gcpQuota `json:"quotas"`
+		}
+		if err := p.get(ctx, client, path, &res); err != nil {
+			return false, out, err
+		}
+		for _, quota := range res.Quotas {
+			quotas[quota.Metric] = quota
+		}
+	}
+
+	// stack 1개 생성하는데 필요한 quota
+	// vCPU 24 (control plane 3, worker 3)
+	// External IP 3
+	// VPC network 1
+	builder := newQuotaBuilder()
+	for _, required := range []struct {
+		metric   string
+		typ      string
+		required int
+	}{
+		{"CPUS", "vCPU", 24},
+		{"IN_USE_ADDRESSES", "ExternalIP", 3},
+		{"NETWORKS", "VPC", 1},
+	} {
+		quota, ok := quotas[required.metric]
+		if !ok {
+			log.Warnf(ctx, "gcp quota %s not found in %s", required.metric, region)
+			continue
+		}
+		builder.add(required.typ, int(quota.Usage), int(quota.Limit), required.required)
+	}
+
+	return builder.result()
+}
+
+// parseGcpServiceAccountKey 는 JSON 또는 base64 로 인코딩된 JSON 서비스 계정 키를 파싱한다.
+func parseGcpServiceAccountKey(raw string) (*gcpServiceAccountKey, error) {
+	data := []byte(raw)
+	if decoded, err := base64.StdEncoding.DecodeString(raw); err == nil {
+		data = decoded
+	}
+
+	var key gcpServiceAccountKey
+	if err := json.Unmarshal(data, &key); err != nil {
+		return nil, fmt.Errorf("invalid service account key: %w", err)
+	}
+	if key.Type != "service_account" || key.ClientEmail == "" || key.PrivateKey == "" {
+		return nil, fmt.Errorf("invalid service account key: type, client_email and private_key are required")
+	}
+	return &key, nil
+}
+
+func (p *gcpProvider) client(ctx context.Context, key *gcpServiceAccountKey) *http.Client {
+	cfg := jwt.Config{
+		Email:        key.ClientEmail,
+		PrivateKey:   []byte(key.PrivateKey),
+		PrivateKeyID: key.PrivateKeyId,
+		TokenURL:     p.opts.authEndpoint(gcpTokenEndpoint),
+		Scopes:       []string{gcpComputeScope},
+	}
+	return cfg.Client(context.WithValue(ctx, oauth2.HTTPClient, p.opts.httpClient()))
+}
+
+func (p *gcpProvider) get(ctx context.Context, client *http.Client, path string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.opts.endpoint(gcpComputeEndpoint)+path, nil)
+	if err != nil {
+		return err
+	}
+	_, err = doJSON(client, req, out)
+	return err
+}
+
+func (p *gcpProvider) BootstrapWorkflow(account model.CloudAccount, region string) Workflow {
+	return Workflow{
+		Template:   "tks-create-gcp-cloud-account",
+		Parameters: p.workflowParameters(account, region),
+	}
+}
+
+func (p *gcpProvider) CleanupWorkflow(account model.CloudAccount, region string) Workflow {
+	return Workflow{
+		Template:   "tks-delete-gcp-cloud-account",
+		Parameters: p.workflowParameters(account, region),
+	}
+}
+
+func (p *gcpProvider) workflowParameters(account model.CloudAccount, region string) []string {
+	// 서비스 계정 키는 여러 줄의 JSON 이므로 base64 로 인코딩하여 전달한다
+	serviceAccountKey := account.ServiceAccountKey
+	if _, err := base64.StdEncoding.DecodeString(serviceAccountKey); err != nil {
+		serviceAccountKey = base64.StdEncoding.EncodeToString([]byte(serviceAccountKey))
+	}
+
+	return []string{
+		"gcp_region=" + region,
+		"tks_cloud_account_id=" + account.ID.String(),
+		"gcp_project_id=" + account.AccountId,
+		"gcp_service_account_key=" + serviceAccountKey,
+	}
+}
+
+func (p *gcpProvider) ClusterWorkflowParameters(account model.CloudAccount) []string {
+	return []string{
+		"gcp_project_id=" + account.AccountId,
+	}
+}
@@ -0,0 +1,262 @@
+package cloudprovider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+const (
+	openstackDefaultRegion = "RegionOne"
+	openstackDefaultDomain = "Default"
+)
+
+type openstackProvider struct {
+	opts Options
+}
+
+// openstackSession 은 keystone 인증 결과인 토큰과 서비스 카탈로그이다.
+type openstackSession struct {
+	token   string
+	catalog []openstackService
+}
+
+type openstackService struct {
+	Type      string `json:"type"`
+	Endpoints []struct {
+		Interface string `json:"interface"`
+		RegionId  string `json:"region_id"`
+		URL       string `json:"url"`
+	} `json:"endpoints"`
+}
+
+type openstackQuotaDetail struct {
+	Used     int `json:"used"`
+	Limit    int `json:"limit"`
+	Reserved int `json:"reserved"`
+}
+
+func (p *openstackProvider) CloudService() string {
+	return domain.CloudService_OPENSTACK
+}
+
+func (p *openstackProvider) DefaultRegion() string {
+	return openstackDefaultRegion
+}
+
+func (p *openstackProvider) ValidateRegion(region string) error {
+	// OpenStack 리전 이름은 운영자가 임의로 정한다
+	if strings.TrimSpace(region) == "" || len(region) > 64 {
+		return fmt.Errorf("invalid openstack region '%s'", region)
+	}
+	return nil
+}
+
+func (p *openstackProvider) AccountId(account model.CloudAccount) string {
+	return account.AccountId
+}
+
+func (p *openstackProvider) ValidateAccount(account model.CloudAccount) error {
+	endpoint, err := url.Parse(account.Endpoint)
+	if err != nil || endpoint.Host == "" || (endpoint.Scheme != "https" && endpoint.Scheme != "http") {
+		return fmt.Errorf("endpoint must be a keystone url")
+	}
+	if account.AccountId == "" {
+		return fmt.Errorf("accountId must be an openstack project id")
+	}
+	if account.Username == "" || account.Password == "" {
+		return fmt.Errorf("username and password are required")
+	}
+	return nil
+}
+
+func (p *openstackProvider) ValidateCredential(ctx context.Context, account model.CloudAccount) error {
+	_, err := p.authenticate(ctx, account, account.Username, account.Password)
+	return err
+}
+
+func (p *openstackProvider) CheckHealth(ctx context.Context, account model.CloudAccount) error {
+	secret, err := p.opts.secret(ctx, "openstackconfig-secret")
+	if err != nil {
+		return err
+	}
+	_, err = p.authenticate(ctx, account, secret["username"], secret["password"])
+	return err
+}
+
+func (p *openstackProvider) GetResourceQuota(ctx context.Context, account model.CloudAccount, region string) (available bool, out domain.ResourceQuota, err error) {
+	secret, err := p.opts.secret(ctx, "openstackconfig-secret")
+	if err != nil {
+		return false, out, err
+	}
+
+	session, err := p.authenticate(ctx, account, secret["username"], secret["password"])
+	if err != nil {
+		return false, out, err
+	}
+
+	computeEndpoint, err := session.endpoint("compute", region)
+	if err != nil {
+		return false, out, err
+	}
+	networkEndpoint, err := session.endpoint("network", region)
+	if err != nil {
+		return false, out, err
+	}
+
+	var limits struct {
+		Limits struct {
+			Absolute struct {
+				MaxTotalCores      int `json:"maxTotalCores"`
+				TotalCoresUsed     int `json:"totalCoresUsed"`
+				MaxTotalInstances  int `json:"maxTotalInstances"`
+				TotalInstancesUsed int `json:"totalInstancesUsed"`
+			} `json:"absolute"`
+		} `json:"limits"`
+	}
+	if err := p.get(ctx, session, computeEndpoint+"/limits", &limits); err != nil {
+		return false, out, err
+	}
+
+	var quota struct {
+		Quota struct {
+			FloatingIP openstackQuotaDetail `json:"floatingip"`
+			Router     openstackQuotaDetail `json:"router"`
+		} `json:"quota"`
+	}
+	if err := p.get(ctx, session, networkEndpoint+"/v2.0/quotas/"+url.PathEscape(account.AccountId)+"/details.json", &quota); err != nil {
+		return false, out, err
+	}
+
+	// stack 1개 생성하는데 필요한 quota
+	// vCPU 24 (control plane 3, worker 3)
+	// Instance 6
+	// Floating IP 3
+	// Router 1
+	absolute := limits.Limits.Absolute
+	builder := newQuotaBuilder()
+	builder.add("vCPU", absolute.TotalCoresUsed, absolute.MaxTotalCores, 24)
+	builder.add("Instance", absolute.TotalInstancesUsed, absolute.MaxTotalInstances, 6)
+	builder.add("FloatingIP", quota.Quota.FloatingIP.Used+quota.Quota.FloatingIP.Reserved, quota.Quota.FloatingIP.Limit, 3)
+	builder.add("Router", quota.Quota.Router.Used+quota.Quota.Router.Reserved, quota.Quota.Router.Limit, 1)
+
+	return builder.result()
+}
+
+// authenticate 는 keystone v3 password 인증으로 프로젝트 범위의 토큰을 발급받는다.
+func (p *openstackProvider) authenticate(ctx context.Context, account model.CloudAccount, username string, password string) (*openstackSession, error) {
+	domainName := account.TenantId
+	if domainName == "" {
+		domainName = openstackDefaultDomain
+	}
+
+	body := map[string]interface{}{
+		"auth": map[string]interface{}{
+			"identity": map[string]interface{}{
+				"methods": []string{"password"},
+				"password": map[string]interface{}{
+					"user": map[string]interface{}{
+						"name":     username,
+						"domain":   map[string]string{"name": domainName},
+						"password": password,
+					},
+				},
+			},
+			"scope": map[string]interface{}{
+				"project": map[string]string{"id": account.AccountId},
+			},
+		},
+	}
+	jsonBytes, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := strings.TrimSuffix(p.opts.endpoint(account.Endpoint), "/")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint+"/v3/auth/tokens", bytes.NewReader(jsonBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Token struct {
+			Catalog []openstackService `json:"catalog"`
+		} `json:"token"`
+	}
+	httpRes, err := doJSON(p.opts.httpClient(), req, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	token := httpRes.Header.Get("X-Subject-Token")
+	if token == "" {
+		return nil, fmt.Errorf("keystone did not return a token")
+	}
+
+	return &openstackSession{token: token, catalog: res.Token.Catalog}, nil
+}
+
+func (s *openstackSession) endpoint(serviceType string, region string) (string, error) {
+	for _, service := range s.catalog {
+		if service.Type != serviceType {
+			continue
+		}
+		for _, endpoint := range service.Endpoints {
+			if endpoint.Interface == "public" && endpoint.RegionId == region {
+				return strings.TrimSuffix(endpoint.URL, "/"), nil
+			}
+		}
+	}
+	return "", fmt.Errorf("%s endpoint not found in region %s", serviceType, region)
+}
+
+func (p *openstackProvider) get(ctx context.Context, session *openstackSession, endpoint string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Auth-Token", session.token)
+
+	_, err = doJSON(p.opts.httpClient(), req, out)
+	return err
+}
+
+func (p *openstackProvider) BootstrapWorkflow(account model.CloudAccount, region string) Workflow {
+	return Workflow{
+		Template:   "tks-create-openstack-cloud-account",
+		Parameters: p.workflowParameters(account, region),
+	}
+}
+
+func (p *openstackProvider) CleanupWorkflow(account model.CloudAccount, region string) Workflow {
+	return Workflow{
+		Template:   "tks-delete-openstack-cloud-account",
+		Parameters: p.workflowParameters(account, region),
+	}
+}
+
+func (p *openstackProvider) workflowParameters(account model.CloudAccount, region string) []string {
+	return []string{
+		"openstack_region=" + region,
+		"tks_cloud_account_id=" + account.ID.String(),
+		"openstack_auth_url=" + account.Endpoint,
+		"openstack_project_id=" + account.AccountId,
+		"openstack_domain_name=" + account.TenantId,
+		"openstack_username=" + account.Username,
+		"openstack_password=" + account.Password,
+	}
+}
+
+func (p *openstackProvider) ClusterWorkflowParameters(account model.CloudAccount) []string {
+	return []string{
+		"openstack_auth_url=" + account.Endpoint,
+		"openstack_project_id=" + account.AccountId,
+	}
+}
@@ -0,0 +1,181 @@
+package cloudprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/kubernetes"
+)
+
+// Provider 는 클라우드 어카운트의 인증 정보 검증, 쿼터 조회, IAM 생성 워크플로우 등 클라우드별 로직을 추상화한다.
+type Provider interface {
+	// CloudService 는 domain.CloudService_* 값이다.
+	CloudService() string
+	DefaultRegion() string
+	// ValidateRegion 은 리전 이름이 클라우드의 리전 형식에 맞는지 확인한다.
+	ValidateRegion(region string) error
+
+	// AccountId 는 중복 확인에 사용하는 클라우드별 계정 식별자이다.
+	AccountId(account model.CloudAccount) string
+	// ValidateAccount 는 어카운트 생성 요청에 클라우드별 필수 항목이 있는지 확인한다.
+	ValidateAccount(account model.CloudAccount) error
+	// ValidateCredential 은 사용자가 입력한 인증 정보로 클라우드 API 를 호출하여 계정에 접근할 수 있는지 확인한다.
+	ValidateCredential(ctx context.Context, account model.CloudAccount) error
+	// CheckHealth 는 TKS 의 인증 정보로 계정에 생성한 IAM 을 사용할 수 있는지 확인한다.
+	CheckHealth(ctx context.Context, account model.CloudAccount) error
+	// GetResourceQuota 는 TKS 의 인증 정보로 스택 생성에 필요한 리소스의 사용량과 쿼터를 조회한다.
+	GetResourceQuota(ctx context.Context, account model.CloudAccount, region string) (available bool, out domain.ResourceQuota, err error)
+
+	// BootstrapWorkflow 는 계정에 TKS 가 사용할 IAM 을 생성하는 워크플로우이다.
+	BootstrapWorkflow(account model.CloudAccount, region string) Workflow
+	// CleanupWorkflow 는 BootstrapWorkflow 로 생성한 IAM 을 삭제하는 워크플로우이다.
+	CleanupWorkflow(account model.CloudAccount, region string) Workflow
+	// ClusterWorkflowParameters 는 클러스터 생성 워크플로우에 추가로 전달할 파라미터이다.
+	ClusterWorkflowParameters(account model.CloudAccount) []string
+}
+
+type Workflow struct {
+	Template   string
+	Parameters []string
+}
+
+// Options 는 클라우드 API 엔드포인트와 TKS 인증 정보 조회 방법을 지정한다.
+// 비어 있는 항목은 각 클라우드의 공개 엔드포인트와 관리 클러스터의 secret 을 사용하며, 테스트에서는 가짜 엔드포인트를 지정한다.
+type Options struct {
+	Endpoint     string
+	AuthEndpoint string
+	HTTPClient   *http.Client
+	Secret       func(ctx context.Context, name string) (map[string]string, error)
+}
+
+func (o Options) httpClient() *http.Client {
+	if o.HTTPClient != nil {
+		return o.HTTPClient
+	}
+	return &http.Client{Timeout: 30 * time.Second}
+}
+
+func (o Options) secret(ctx context.Context, name string) (map[string]string, error) {
+	if o.Secret != nil {
+		return o.Secret(ctx, name)
+	}
+	return kubernetes.GetCloudSecret(ctx, name)
+}
+
+func (o Options) endpoint(defaultEndpoint string) string {
+	if o.Endpoint != "" {
+		return o.Endpoint
+	}
+	return defaultEndpoint
+}
+
+func (o Options) authEndpoint(defaultEndpoint string) string {
+	if o.AuthEndpoint != "" {
+		return o.AuthEndpoint
+	}
+	return defaultEndpoint
+}
+
+// New 는 공개 엔드포인트를 사용하는 cloudService 의 Provider 를 반환한다.
+func New(cloudService string) (Provider, error) {
+	return NewWithOptions(cloudService, Options{})
+}
+
+func NewWithOptions(cloudService string, opts Options) (Provider, error) {
+	switch cloudService {
+	case domain.CloudService_AWS:
+		return &awsProvider{opts: opts}, nil
+	case domain.CloudService_AZURE:
+		return &azureProvider{opts: opts}, nil
+	case domain.CloudService_GCP:
+		return &gcpProvider{opts: opts}, nil
+	case domain.CloudService_OPENSTACK:
+		return &openstackProvider{opts: opts}, nil
+	}
+	return nil, fmt.Errorf("unsupported cloud service '%s'", cloudService)
+}
+
+// AllowedRegions 는 어카운트에 허용된 리전 목록이다. 리전을 지정하지 않은 어카운트는 기본 리전만 사용한다.
+func AllowedRegions(p Provider, account model.CloudAccount) []string {
+	if len(account.AllowedRegions) == 0 {
+		return []string{p.DefaultRegion()}
+	}
+	return account.AllowedRegions
+}
+
+// ResolveRegion 은 region 이 어카운트에 허용된 리전인지 확인한다. region 이 비어 있으면 첫번째 허용 리전을 반환한다.
+func ResolveRegion(p Provider, account model.CloudAccount, region string) (string, error) {
+	regions := AllowedRegions(p, account)
+	if region == "" {
+		return regions[0], nil
+	}
+	if !slices.Contains(regions, region) {
+		return "", fmt.Errorf("region '%s' is not allowed for cloud account. allowed regions : %s", region, strings.Join(regions, ","))
+	}
+	return region, nil
+}
+
+// quotaBuilder 는 리소스별 사용량과 쿼터를 모아 스택 생성 가능 여부를 판단한다.
+// 조회한 모든 리소스의 남은 쿼터가 필요량 이상이어야 스택을 생성할 수 있다.
+type quotaBuilder struct {
+	exceeded bool
+	out      domain.ResourceQuota
+}
+
+func newQuotaBuilder() *quotaBuilder {
+	return &quotaBuilder{out: domain.ResourceQuota{Quotas: make([]domain.ResourceQuotaAttr, 0)}}
+}
+
+func (b *quotaBuilder) add(resourceType string, usage int, quota int, required int) {
+	b.out.Quotas = append(b.out.Quotas, domain.ResourceQuotaAttr{
+		Type:     resourceType,
+		Usage:    usage,
+		Quota:    quota,
+		Required: required,
+	})
+	if quota < usage+required {
+		b.exceeded = true
+	}
+}
+
+func (b *quotaBuilder) result() (bool, domain.ResourceQuota, error) {
+	return len(b.out.Quotas) > 0 && !b.exceeded, b.out, nil
+}
+
+// doJSON 은 REST API 를 호출하고 응답 본문을 out 으로 decode 한다.
+func doJSON(client *http.Client, req *http.Request, out interface{}) (*http.Response, error) {
+	req.Header.Set("Accept", "application/json")
+	if req.Body != nil && req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, 4<<20))
+	if err != nil {
+		return res, err
+	}
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return res, fmt.Errorf("%s %s returned %d: %s", req.Method, req.URL.Path, res.StatusCode, string(body))
+	}
+
+	if out != nil && len(body) > 0 {
+		if err := json.Unmarshal(body, out); err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
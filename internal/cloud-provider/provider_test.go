@@ -0,0 +1,365 @@
+package cloudprovider
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+func fakeSecret(data map[string]string) func(ctx context.Context, name string) (map[string]string, error) {
+	return func(ctx context.Context, name string) (map[string]string, error) {
+		return data, nil
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func tokenHandler(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, map[string]interface{}{"access_token": "fake-token", "token_type": "Bearer", "expires_in": 3600})
+}
+
+func requireBearer(t *testing.T, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer fake-token" {
+		t.Errorf("unexpected authorization header %q for %s", r.Header.Get("Authorization"), r.URL.Path)
+	}
+}
+
+func assertQuota(t *testing.T, out domain.ResourceQuota, expected map[string][2]int) {
+	if len(out.Quotas) != len(expected) {
+		t.Fatalf("expected %d quotas, got %+v", len(expected), out.Quotas)
+	}
+	for _, quota := range out.Quotas {
+		values, ok := expected[quota.Type]
+		if !ok {
+			t.Errorf("unexpected quota type %s", quota.Type)
+			continue
+		}
+		if quota.Usage != values[0] || quota.Quota != values[1] {
+			t.Errorf("%s: expected usage %d quota %d, got usage %d quota %d", quota.Type, values[0], values[1], quota.Usage, quota.Quota)
+		}
+	}
+}
+
+func TestNewUnsupportedCloudService(t *testing.T) {
+	if _, err := New(domain.CloudService_BYOH); err == nil {
+		t.Error("expected error for BYOH")
+	}
+}
+
+func TestAwsProvider(t *testing.T) {
+	const accountId = "123456789012"
+
+	// 실행 환경의 aws 설정이 가짜 엔드포인트 호출에 영향을 주지 않도록 한다.
+	t.Setenv("AWS_CA_BUNDLE", "")
+	t.Setenv("AWS_PROFILE", "")
+	t.Setenv("AWS_CONFIG_FILE", t.TempDir()+"/config")
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", t.TempDir()+"/credentials")
+
+	quotas := map[string]int{"L-69A177A2": 50, "L-E9E9831D": 20, "L-A4707A72": 5, "L-1194D53C": 100, "L-0263D0A3": 5}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// 사용자 인증 정보는 GetCallerIdentity 에만 사용하고, 나머지는 assume role 로 받은 인증 정보를 사용해야 한다.
+		authorization := r.Header.Get("Authorization")
+		if target := r.Header.Get("X-Amz-Target"); target != "" {
+			if !strings.Contains(authorization, "Credential=ASSUMED/") {
+				t.Errorf("expected assumed role credential for %s, got %q", target, authorization)
+			}
+			var body struct {
+				QuotaCode string
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"Quota": map[string]interface{}{
+				"QuotaCode": body.QuotaCode, "Value": quotas[body.QuotaCode],
+			}})
+			return
+		}
+		if r.URL.Path == "/clusters" {
+			writeJSON(w, map[string]interface{}{"clusters": []string{"tks-1"}})
+			return
+		}
+
+		_ = r.ParseForm()
+		action := r.Form.Get("Action")
+		if action != "GetCallerIdentity" && action != "AssumeRole" && !strings.Contains(authorization, "Credential=ASSUMED/") {
+			t.Errorf("expected assumed role credential for %s, got %q", action, authorization)
+		}
+		w.Header().Set("Content-Type", "text/xml")
+		switch {
+		case action == "GetCallerIdentity":
+			if strings.Contains(authorization, "Credential=WRONG/") {
+				_, _ = w.Write([]byte(`<GetCallerIdentityResponse><GetCallerIdentityResult><Account>999999999999</Account></GetCallerIdentityResult></GetCallerIdentityResponse>`))
+				return
+			}
+			_, _ = w.Write([]byte(`<GetCallerIdentityResponse><GetCallerIdentityResult><Account>` + accountId + `</Account></GetCallerIdentityResult></GetCallerIdentityResponse>`))
+		case action == "AssumeRole":
+			if r.Form.Get("RoleArn") != "arn:aws:iam::"+accountId+":role/"+awsAssumeRole {
+				t.Errorf("unexpected role %s", r.Form.Get("RoleArn"))
+			}
+			_, _ = w.Write([]byte(`<AssumeRoleResponse><AssumeRoleResult><Credentials><AccessKeyId>ASSUMED</AccessKeyId><SecretAccessKey>secret</SecretAccessKey><SessionToken>token</SessionToken><Expiration>2100-01-01T00:00:00Z</Expiration></Credentials></AssumeRoleResult></AssumeRoleResponse>`))
+		case action == "DescribeLoadBalancers" && r.Form.Get("Version") == "2015-12-01":
+			_, _ = w.Write([]byte(`<DescribeLoadBalancersResponse><DescribeLoadBalancersResult><LoadBalancers><member><Type>network</Type></member><member><Type>network</Type></member><member><Type>application</Type></member></LoadBalancers></DescribeLoadBalancersResult></DescribeLoadBalancersResponse>`))
+		case action == "DescribeLoadBalancers":
+			_, _ = w.Write([]byte(`<DescribeLoadBalancersResponse><DescribeLoadBalancersResult><LoadBalancerDescriptions><member><LoadBalancerName>clb</LoadBalancerName></member></LoadBalancerDescriptions></DescribeLoadBalancersResult></DescribeLoadBalancersResponse>`))
+		case action == "DescribeInternetGateways":
+			_, _ = w.Write([]byte(`<DescribeInternetGatewaysResponse><internetGatewaySet><item><internetGatewayId>igw-1</internetGatewayId></item></internetGatewaySet></DescribeInternetGatewaysResponse>`))
+		case action == "DescribeAddresses":
+			_, _ = w.Write([]byte(`<DescribeAddressesResponse><addressesSet><item><publicIp>10.0.0.1</publicIp></item><item><publicIp>10.0.0.2</publicIp></item><item><publicIp>10.0.0.3</publicIp></item></addressesSet></DescribeAddressesResponse>`))
+		default:
+			t.Errorf("unexpected aws request %s %s", r.URL.Path, action)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	provider, _ := NewWithOptions(domain.CloudService_AWS, Options{
+		Endpoint: server.URL,
+		Secret:   fakeSecret(map[string]string{"aws_access_key_id": "TKS", "aws_secret_access_key": "tks-secret"}),
+	})
+	account := model.CloudAccount{Name: "tks-aws", AwsAccountId: accountId, AccessKeyId: "USER", SecretAccessKey: "secret"}
+
+	if err := provider.ValidateAccount(account); err != nil {
+		t.Fatal(err)
+	}
+	if err := provider.ValidateCredential(context.Background(), account); err != nil {
+		t.Fatal(err)
+	}
+	wrong := account
+	wrong.AccessKeyId = "WRONG"
+	if err := provider.ValidateCredential(context.Background(), wrong); err == nil {
+		t.Error("expected error for credential of another account")
+	}
+
+	available, out, err := provider.GetResourceQuota(context.Background(), account, provider.DefaultRegion())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// EIP 쿼터가 필요량(3)보다 적게 남았다.
+	if available {
+		t.Error("expected unavailable quota")
+	}
+	assertQuota(t, out, map[string][2]int{"NLB": {2, 50}, "CLB": {1, 20}, "IGW": {1, 5}, "EKS": {1, 100}, "EIP": {3, 5}})
+
+	quotas["L-0263D0A3"] = 10
+	available, _, err = provider.GetResourceQuota(context.Background(), account, provider.DefaultRegion())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !available {
+		t.Error("expected available quota")
+	}
+}
+
+func TestAzureProvider(t *testing.T) {
+	const subscriptionId = "00000000-0000-0000-0000-000000000001"
+	const tenantId = "00000000-0000-0000-0000-000000000002"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/"+tenantId+"/oauth2/v2.0/token", tokenHandler)
+	mux.HandleFunc("/subscriptions/"+subscriptionId, func(w http.ResponseWriter, r *http.Request) {
+		requireBearer(t, r)
+		writeJSON(w, map[string]string{"subscriptionId": subscriptionId, "state": "Enabled"})
+	})
+	mux.HandleFunc("/subscriptions/"+subscriptionId+"/providers/Microsoft.Compute/locations/koreacentral/usages", func(w http.ResponseWriter, r *http.Request) {
+		requireBearer(t, r)
+		writeJSON(w, map[string]interface{}{"value": []map[string]interface{}{
+			{"currentValue": 10, "limit": 100, "name": map[string]string{"value": "cores"}},
+		}})
+	})
+	mux.HandleFunc("/subscriptions/"+subscriptionId+"/providers/Microsoft.Network/locations/koreacentral/usages", func(w http.ResponseWriter, r *http.Request) {
+		requireBearer(t, r)
+		writeJSON(w, map[string]interface{}{"value": []map[string]interface{}{
+			{"currentValue": 1, "limit": 10, "name": map[string]string{"value": "PublicIPAddresses"}},
+			{"currentValue": 0, "limit": 1, "name": map[string]string{"value": "LoadBalancers"}},
+		}})
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	provider, _ := NewWithOptions(domain.CloudService_AZURE, Options{
+		Endpoint:     server.URL,
+		AuthEndpoint: server.URL,
+		Secret:       fakeSecret(map[string]string{"client_id": "tks", "client_secret": "tks-secret"}),
+	})
+	account := model.CloudAccount{AccountId: subscriptionId, TenantId: tenantId, ClientId: "client", ClientSecret: "secret"}
+
+	if err := provider.ValidateAccount(account); err != nil {
+		t.Fatal(err)
+	}
+	if err := provider.ValidateCredential(context.Background(), account); err != nil {
+		t.Fatal(err)
+	}
+
+	available, out, err := provider.GetResourceQuota(context.Background(), account, provider.DefaultRegion())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 로드밸런서 쿼터가 필요량(2)보다 적으므로 다른 쿼터가 충분해도 생성할 수 없다.
+	if available {
+		t.Error("expected unavailable quota")
+	}
+	assertQuota(t, out, map[string][2]int{"vCPU": {10, 100}, "PublicIP": {1, 10}, "LB": {0, 1}})
+}
+
+func TestGcpProvider(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+	serviceAccountKey, _ := json.Marshal(map[string]string{
+		"type":         "service_account",
+		"project_id":   "tks-project",
+		"private_key":  string(keyPem),
+		"client_email": "tks@tks-project.iam.gserviceaccount.com",
+	})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", tokenHandler)
+	mux.HandleFunc("/compute/v1/projects/tks-project", func(w http.ResponseWriter, r *http.Request) {
+		requireBearer(t, r)
+		writeJSON(w, map[string]interface{}{"name": "tks-project", "quotas": []map[string]interface{}{
+			{"metric": "NETWORKS", "limit": 5, "usage": 5},
+		}})
+	})
+	mux.HandleFunc("/compute/v1/projects/tks-project/regions/asia-northeast3", func(w http.ResponseWriter, r *http.Request) {
+		requireBearer(t, r)
+		writeJSON(w, map[string]interface{}{"quotas": []map[string]interface{}{
+			{"metric": "CPUS", "limit": 24, "usage": 8},
+			{"metric": "IN_USE_ADDRESSES", "limit": 8, "usage": 2},
+		}})
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	provider, _ := NewWithOptions(domain.CloudService_GCP, Options{
+		Endpoint:     server.URL,
+		AuthEndpoint: server.URL + "/token",
+		Secret:       fakeSecret(map[string]string{"key.json": string(serviceAccountKey)}),
+	})
+	account := model.CloudAccount{AccountId: "tks-project", ServiceAccountKey: string(serviceAccountKey)}
+
+	if err := provider.ValidateAccount(account); err != nil {
+		t.Fatal(err)
+	}
+	if err := provider.ValidateCredential(context.Background(), account); err != nil {
+		t.Fatal(err)
+	}
+
+	available, out, err := provider.GetResourceQuota(context.Background(), account, provider.DefaultRegion())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// vCPU 와 VPC 쿼터가 부족하다.
+	if available {
+		t.Error("expected unavailable quota")
+	}
+	assertQuota(t, out, map[string][2]int{"vCPU": {8, 24}, "ExternalIP": {2, 8}, "VPC": {5, 5}})
+}
+
+func TestOpenstackProvider(t *testing.T) {
+	var server *httptest.Server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Auth struct {
+				Identity struct {
+					Password struct {
+						User struct {
+							Name     string `json:"name"`
+							Password string `json:"password"`
+						} `json:"user"`
+					} `json:"password"`
+				} `json:"identity"`
+			} `json:"auth"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body.Auth.Identity.Password.User.Password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		w.Header().Set("X-Subject-Token", "fake-token")
+		w.WriteHeader(http.StatusCreated)
+		writeJSON(w, map[string]interface{}{"token": map[string]interface{}{"catalog": []map[string]interface{}{
+			{"type": "compute", "endpoints": []map[string]string{{"interface": "public", "region_id": "RegionOne", "url": server.URL + "/compute/v2.1/"}}},
+			{"type": "network", "endpoints": []map[string]string{{"interface": "public", "region_id": "RegionOne", "url": server.URL + "/network"}}},
+		}}})
+	})
+	mux.HandleFunc("/compute/v2.1/limits", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]interface{}{"limits": map[string]interface{}{"absolute": map[string]int{
+			"maxTotalCores": 40, "totalCoresUsed": 4, "maxTotalInstances": 10, "totalInstancesUsed": 2,
+		}}})
+	})
+	mux.HandleFunc("/network/v2.0/quotas/project-1/details.json", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Auth-Token") != "fake-token" {
+			t.Errorf("unexpected token %q", r.Header.Get("X-Auth-Token"))
+		}
+		writeJSON(w, map[string]interface{}{"quota": map[string]interface{}{
+			"floatingip": map[string]int{"used": 1, "limit": 10, "reserved": 1},
+			"router":     map[string]int{"used": 0, "limit": 5, "reserved": 0},
+		}})
+	})
+	server = httptest.NewServer(mux)
+	defer server.Close()
+
+	provider, _ := NewWithOptions(domain.CloudService_OPENSTACK, Options{
+		Secret: fakeSecret(map[string]string{"username": "tks", "password": "secret"}),
+	})
+	account := model.CloudAccount{Endpoint: server.URL, AccountId: "project-1", Username: "admin", Password: "secret"}
+
+	if err := provider.ValidateAccount(account); err != nil {
+		t.Fatal(err)
+	}
+	if err := provider.ValidateCredential(context.Background(), account); err != nil {
+		t.Fatal(err)
+	}
+	account.Password = "wrong"
+	if err := provider.ValidateCredential(context.Background(), account); err == nil || !strings.Contains(err.Error(), "401") {
+		t.Errorf("expected unauthorized error, got %v", err)
+	}
+
+	available, out, err := provider.GetResourceQuota(context.Background(), account, provider.DefaultRegion())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !available {
+		t.Error("expected available quota")
+	}
+	assertQuota(t, out, map[string][2]int{"vCPU": {4, 40}, "Instance": {2, 10}, "FloatingIP": {2, 10}, "Router": {0, 5}})
+}
+
+func TestResolveRegion(t *testing.T) {
+	provider, _ := New(domain.CloudService_AWS)
+
+	legacy := model.CloudAccount{}
+	if region, err := ResolveRegion(provider, legacy, ""); err != nil || region != awsDefaultRegion {
+		t.Errorf("expected default region, got %s %v", region, err)
+	}
+
+	account := model.CloudAccount{AllowedRegions: []string{"us-east-1", "ap-northeast-2"}}
+	if region, err := ResolveRegion(provider, account, ""); err != nil || region != "us-east-1" {
+		t.Errorf("expected first allowed region, got %s %v", region, err)
+	}
+	if region, err := ResolveRegion(provider, account, "ap-northeast-2"); err != nil || region != "ap-northeast-2" {
+		t.Errorf("expected requested region, got %s %v", region, err)
+	}
+	if _, err := ResolveRegion(provider, account, "eu-west-1"); err == nil {
+		t.Error("expected error for region not allowed")
+	}
+	if err := provider.ValidateRegion("koreacentral"); err == nil {
+		t.Error("expected error for non aws region")
+	}
+}
@@ -7,11 +7,69 @@ type ContextKey string
 const ContextKeyRequestID ContextKey = "REQUEST_ID"
 
 const (
-	PasswordExpiredDuration = 30 * 24 * time.Hour
-	EmailCodeExpireTime     = 5 * time.Minute
-	API_VERSION             = "/1.0"
-	API_PREFIX              = "/api"
-	ADMINAPI_PREFIX         = "/admin"
+	EmailCodeExpireTime = 5 * time.Minute
+	API_VERSION         = "/1.0"
+	API_PREFIX          = "/api"
+	ADMINAPI_PREFIX     = "/admin"
+
+	// 로그인 및 이메일 인증코드 요청에 대한 throttling 설정 (sliding window)
+	AuthAttemptWindow               = 10 * time.Minute
+	MaxLoginFailuresPerAccount      = 5
+	MaxLoginFailuresPerClientIp     = 20
+	AccountLockDuration             = 30 * time.Minute
+	MaxEmailCodeRequestsPerAccount  = 3
+	MaxEmailCodeRequestsPerClientIp = 10
+	MaxEmailCodeFailuresPerAccount  = 5
+	MaxEmailCodeFailuresPerClientIp = 20
+
+	// MFA
+	MfaIssuer            = "TKS"
+	MfaRecoveryCodeCount = 10
+	MfaStepUpDuration    = 10 * time.Minute
+	MaxMfaFailures       = 5
+	MfaLockDuration      = 15 * time.Minute
+
+	// 사용자 CSV 일괄 등록
+	MaxImportUsers        = 1000
+	MaxImportUsersCsvSize = 5 << 20
+
+	// 정책 예외
+	MaxPolicyExceptionDuration        = 90 * 24 * time.Hour
+	PolicyExceptionExpirationInterval = 5 * time.Minute
+
+	// 정책 위반 기록
+	PolicyViolationSyncInterval = 10 * time.Minute
+
+	// 정책 단계적 적용
+	PolicyRolloutInterval = 10 * time.Minute
+
+	// 정책 준수 보고서
+	ComplianceReportInterval = 1 * time.Hour
+
+	// 정책 변경 승인
+	PolicyChangeRequestExpirationInterval = 5 * time.Minute
+
+	// 클라우드 계정 인증 정보 점검
+	CloudAccountCredentialCheckInterval = 1 * time.Hour
+
+	// 프로젝트 사용량 집계
+	ProjectUsageRollupInterval = 1 * time.Hour
+	ProjectUsageBackfillDays   = 7
+	MaxProjectUsagePeriodDays  = 366
+
+	// Thanos range query 결과 캐시
+	ThanosQueryCacheTTL = 1 * time.Minute
+
+	// 알림 설정 미리보기
+	DefaultSystemNotificationPreviewHours = 6
+	MaxSystemNotificationPreviewPoints    = 1440
+
+	// 스택 점검 시간
+	DefaultMaintenanceWindowTimezone = "Asia/Seoul"
+
+	// 알림 에스컬레이션
+	SystemNotificationEscalationInterval = 1 * time.Minute
+	MaxSystemNotificationEscalationAge   = 24 * time.Hour
 
 	SYSTEM_API_VERSION = "/1.0"
 	SYSTEM_API_PREFIX  = "/system-api"
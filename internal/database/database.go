@@ -62,6 +62,7 @@ func InitDB() (*gorm.DB, error) {
 func migrateSchema(db *gorm.DB) error {
 	if err := db.AutoMigrate(&model.CacheEmailCode{},
 		&model.ExpiredTokenTime{},
+		&model.AuthAttempt{},
 		&model.Role{},
 		&model.CloudAccount{},
 		&model.StackTemplate{},
@@ -90,6 +91,31 @@ func migrateSchema(db *gorm.DB) error {
 		&model.PolicyTemplate{},
 		&model.Policy{},
 		&model.Dashboard{},
+		&model.ServiceAccount{},
+		&model.AccessToken{},
+		&model.UserMfa{},
+		&model.MfaStepUp{},
+		&model.MfaPolicy{},
+		&model.PasswordHistory{},
+		&model.PolicyException{},
+		&model.PolicyViolation{},
+		&model.StackPolicyTemplateVersion{},
+		&model.StackPolicyTemplateHistory{},
+		&model.PolicyBundle{},
+		&model.PolicyBundleItem{},
+		&model.PolicyRollout{},
+		&model.PolicyRolloutStack{},
+		&model.ComplianceReport{},
+		&model.MutationPolicy{},
+		&model.MutationPolicyTargetCluster{},
+		&model.PolicyChangeRequest{},
+		&model.ProjectUsage{},
+		&model.DashboardWidget{},
+		&model.SystemNotificationSilence{},
+		&model.StackMaintenanceWindow{},
+		&model.OnCallSchedule{},
+		&model.SystemNotificationEscalationPolicy{},
+		&model.JobLease{},
 	); err != nil {
 		return err
 	}
@@ -28,8 +28,11 @@ const (
 	UpdateUsers
 	UpdateUser
 	ResetPassword
+	UnlockUser
 	CheckId
 	CheckEmail
+	ImportUsers
+	ExportUsers
 	GetPermissionsByAccountId
 
 	// MyProfile
@@ -38,6 +41,28 @@ const (
 	UpdateMyPassword
 	RenewPasswordExpiredDate
 	DeleteMyProfile
+	CreateMyAccessToken
+	GetMyAccessTokens
+	RevokeMyAccessToken
+	GetMyMfa
+	EnrollMfa
+	ConfirmMfa
+	DisableMfa
+	RegenerateMfaRecoveryCodes
+	VerifyMfa
+
+	// MfaPolicy
+	GetMfaPolicy
+	UpdateMfaPolicy
+
+	// ServiceAccount
+	CreateServiceAccount
+	GetServiceAccounts
+	GetServiceAccount
+	DeleteServiceAccount
+	CreateServiceAccountKey
+	GetServiceAccountKeys
+	RevokeServiceAccountKey
 
 	// Organization
 	Admin_CreateOrganization
@@ -47,6 +72,8 @@ const (
 	CheckOrganizationName
 	UpdateOrganization
 	UpdatePrimaryCluster
+	GetPasswordPolicy
+	UpdatePasswordPolicy
 
 	// Cluster
 	CreateCluster
@@ -94,6 +121,8 @@ const (
 	DeleteCloudAccount
 	DeleteForceCloudAccount
 	GetResourceQuota
+	RotateCloudAccountCredential
+	CheckCloudAccountCredential
 
 	// StackTemplate
 	Admin_GetStackTemplates
@@ -126,6 +155,21 @@ const (
 	GetPolicyStatisticsDashboard
 	GetWorkloadDashboard
 	GetPolicyViolationTop5Dashboard
+	GetCostDashboard       // 대시보드/대시보드/조회
+	ResetDashboard         // 대시보드/대시보드/수정
+	ExportDashboard        // 대시보드/대시보드/조회
+	ImportDashboard        // 대시보드/대시보드/수정
+	GetDefaultDashboard    // 대시보드/대시보드/조회
+	SetDefaultDashboard    // 설정/일반/수정
+	DeleteDefaultDashboard // 설정/일반/수정
+
+	// DashboardWidget
+	CreateDashboardWidget  // 대시보드/대시보드/수정
+	ListDashboardWidgets   // 대시보드/대시보드/조회
+	GetDashboardWidget     // 대시보드/대시보드/조회
+	UpdateDashboardWidget  // 대시보드/대시보드/수정
+	DeleteDashboardWidget  // 대시보드/대시보드/수정
+	GetDashboardWidgetData // 대시보드/대시보드/조회
 
 	// SystemNotificationTemplate
 	Admin_CreateSystemNotificationTemplate
@@ -147,6 +191,27 @@ const (
 	DeleteSystemNotificationRule
 	UpdateSystemNotificationRule
 	MakeDefaultSystemNotificationRules
+	PreviewSystemNotificationRule
+
+	// SystemNotificationSilence
+	CreateSystemNotificationSilence // 설정/시스템 알림/생성
+	GetSystemNotificationSilences   // 설정/시스템 알림/조회
+	GetSystemNotificationSilence    // 설정/시스템 알림/조회
+	ExpireSystemNotificationSilence // 설정/시스템 알림/삭제
+	CreateStackMaintenanceWindow    // 설정/시스템 알림/생성
+	GetStackMaintenanceWindows      // 설정/시스템 알림/조회
+	UpdateStackMaintenanceWindow    // 설정/시스템 알림/수정
+	DeleteStackMaintenanceWindow    // 설정/시스템 알림/삭제
+
+	// SystemNotificationEscalation
+	CreateOnCallSchedule                     // 설정/시스템 알림/생성
+	GetOnCallSchedules                       // 설정/시스템 알림/조회
+	GetOnCallSchedule                        // 설정/시스템 알림/조회
+	UpdateOnCallSchedule                     // 설정/시스템 알림/수정
+	DeleteOnCallSchedule                     // 설정/시스템 알림/삭제
+	GetSystemNotificationEscalationPolicy    // 설정/시스템 알림/조회
+	SetSystemNotificationEscalationPolicy    // 설정/시스템 알림/수정
+	DeleteSystemNotificationEscalationPolicy // 설정/시스템 알림/삭제
 
 	// SystemNotification
 	CreateSystemNotification
@@ -172,6 +237,8 @@ const (
 	SetFavoriteStack    // 스택관리/조회
 	DeleteFavoriteStack // 스택관리/조회
 	InstallStack        // 스택관리 / 조회
+	EstimateStackCost   // 스택관리/생성
+	GetStackCost        // 스택관리/조회
 
 	// Project
 	CreateProject           // 프로젝트 관리/프로젝트/생성
@@ -198,6 +265,9 @@ const (
 	GetProjectKubeconfig
 	GetProjectNamespaceK8sResources
 	GetProjectNamespaceKubeconfig
+	GetProjectCost     // 프로젝트 관리/프로젝트/조회
+	GetProjectUsage    // 프로젝트 관리/프로젝트/조회
+	ExportProjectUsage // 프로젝트 관리/프로젝트/조회
 
 	// Audit
 	GetAudits
@@ -251,11 +321,14 @@ const (
 	Admin_ExtractParameters
 	Admin_AddPermittedPolicyTemplatesForOrganization
 	Admin_DeletePermittedPolicyTemplatesForOrganization
+	Admin_ImportPolicyTemplate
+	Admin_ExportPolicyTemplate
 
 	// StackPolicyStatus
 	ListStackPolicyStatus
 	GetStackPolicyTemplateStatus
 	UpdateStackPolicyTemplateStatus
+	ListStackPolicyTemplateHistories
 
 	// Policy
 	GetMandatoryPolicies
@@ -273,6 +346,51 @@ const (
 	AddPoliciesForStack
 	DeletePoliciesForStack
 	StackPolicyStatistics
+	CreatePolicyException
+	ListPolicyExceptions
+	DeletePolicyException
+	StartPolicyRollout
+	GetPolicyRollout
+	CancelPolicyRollout
+
+	// PolicyViolation
+	ListPolicyViolations
+	ExportPolicyViolations
+	GetPolicyViolation
+	UpdatePolicyViolationStatus
+
+	// PolicyBundle
+	CreatePolicyBundle
+	ListPolicyBundles
+	GetPolicyBundle
+	UpdatePolicyBundle
+	DeletePolicyBundle
+	PreviewPolicyBundleUpdate
+	AssignPolicyBundleToStack
+	UnassignPolicyBundleFromStack
+
+	// ComplianceReport
+	CreateComplianceReport
+	ListComplianceReports
+	GetComplianceReport
+	DeleteComplianceReport
+	DownloadComplianceReport
+
+	// MutationPolicy
+	ListMutationPolicyTemplates
+	CreateMutationPolicy
+	ListMutationPolicies
+	GetMutationPolicy
+	UpdateMutationPolicy
+	DeleteMutationPolicy
+
+	// PolicyChangeRequest
+	GetPolicyApprovalSetting
+	UpdatePolicyApprovalSetting
+	ListPolicyChangeRequests
+	GetPolicyChangeRequest
+	ApprovePolicyChangeRequest
+	RejectPolicyChangeRequest
 
 	// OrganizationPolicyTemplate
 	ListPolicyTemplate
@@ -289,6 +407,8 @@ const (
 	ExistsPolicyTemplateKind
 	ExistsPolicyTemplateName
 	ExtractParameters
+	ImportPolicyTemplate
+	ExportPolicyTemplate
 
 	// PolicyTemplateExample
 	ListPolicyTemplateExample
@@ -63,6 +63,10 @@ var ApiMap = map[Endpoint]EndpointInfo{
 		Name: "ResetPassword", 
 		Group: "User",
 	},
+    UnlockUser: {
+		Name: "UnlockUser", 
+		Group: "User",
+	},
     CheckId: {
 		Name: "CheckId", 
 		Group: "User",
@@ -71,6 +75,14 @@ var ApiMap = map[Endpoint]EndpointInfo{
 		Name: "CheckEmail", 
 		Group: "User",
 	},
+    ImportUsers: {
+		Name: "ImportUsers", 
+		Group: "User",
+	},
+    ExportUsers: {
+		Name: "ExportUsers", 
+		Group: "User",
+	},
     GetPermissionsByAccountId: {
 		Name: "GetPermissionsByAccountId", 
 		Group: "User",
@@ -95,6 +107,78 @@ var ApiMap = map[Endpoint]EndpointInfo{
 		Name: "DeleteMyProfile", 
 		Group: "MyProfile",
 	},
+    CreateMyAccessToken: {
+		Name: "CreateMyAccessToken", 
+		Group: "MyProfile",
+	},
+    GetMyAccessTokens: {
+		Name: "GetMyAccessTokens", 
+		Group: "MyProfile",
+	},
+    RevokeMyAccessToken: {
+		Name: "RevokeMyAccessToken", 
+		Group: "MyProfile",
+	},
+    GetMyMfa: {
+		Name: "GetMyMfa", 
+		Group: "MyProfile",
+	},
+    EnrollMfa: {
+		Name: "EnrollMfa", 
+		Group: "MyProfile",
+	},
+    ConfirmMfa: {
+		Name: "ConfirmMfa", 
+		Group: "MyProfile",
+	},
+    DisableMfa: {
+		Name: "DisableMfa", 
+		Group: "MyProfile",
+	},
+    RegenerateMfaRecoveryCodes: {
+		Name: "RegenerateMfaRecoveryCodes", 
+		Group: "MyProfile",
+	},
+    VerifyMfa: {
+		Name: "VerifyMfa", 
+		Group: "MyProfile",
+	},
+    GetMfaPolicy: {
+		Name: "GetMfaPolicy", 
+		Group: "MfaPolicy",
+	},
+    UpdateMfaPolicy: {
+		Name: "UpdateMfaPolicy", 
+		Group: "MfaPolicy",
+	},
+    CreateServiceAccount: {
+		Name: "CreateServiceAccount", 
+		Group: "ServiceAccount",
+	},
+    GetServiceAccounts: {
+		Name: "GetServiceAccounts", 
+		Group: "ServiceAccount",
+	},
+    GetServiceAccount: {
+		Name: "GetServiceAccount", 
+		Group: "ServiceAccount",
+	},
+    DeleteServiceAccount: {
+		Name: "DeleteServiceAccount", 
+		Group: "ServiceAccount",
+	},
+    CreateServiceAccountKey: {
+		Name: "CreateServiceAccountKey", 
+		Group: "ServiceAccount",
+	},
+    GetServiceAccountKeys: {
+		Name: "GetServiceAccountKeys", 
+		Group: "ServiceAccount",
+	},
+    RevokeServiceAccountKey: {
+		Name: "RevokeServiceAccountKey", 
+		Group: "ServiceAccount",
+	},
     Admin_CreateOrganization: {
 		Name: "Admin_CreateOrganization", 
 		Group: "Organization",
@@ -123,6 +207,14 @@ var ApiMap = map[Endpoint]EndpointInfo{
 		Name: "UpdatePrimaryCluster", 
 		Group: "Organization",
 	},
+    GetPasswordPolicy: {
+		Name: "GetPasswordPolicy", 
+		Group: "Organization",
+	},
+    UpdatePasswordPolicy: {
+		Name: "UpdatePasswordPolicy", 
+		Group: "Organization",
+	},
     CreateCluster: {
 		Name: "CreateCluster", 
 		Group: "Cluster",
@@ -279,6 +371,14 @@ var ApiMap = map[Endpoint]EndpointInfo{
 		Name: "GetResourceQuota", 
 		Group: "CloudAccount",
 	},
+    RotateCloudAccountCredential: {
+		Name: "RotateCloudAccountCredential", 
+		Group: "CloudAccount",
+	},
+    CheckCloudAccountCredential: {
+		Name: "CheckCloudAccountCredential", 
+		Group: "CloudAccount",
+	},
     Admin_GetStackTemplates: {
 		Name: "Admin_GetStackTemplates", 
 		Group: "StackTemplate",
@@ -391,6 +491,58 @@ var ApiMap = map[Endpoint]EndpointInfo{
 		Name: "GetPolicyViolationTop5Dashboard", 
 		Group: "Dashboard",
 	},
+    GetCostDashboard: {
+		Name: "GetCostDashboard", 
+		Group: "Dashboard",
+	},
+    ResetDashboard: {
+		Name: "ResetDashboard", 
+		Group: "Dashboard",
+	},
+    ExportDashboard: {
+		Name: "ExportDashboard", 
+		Group: "Dashboard",
+	},
+    ImportDashboard: {
+		Name: "ImportDashboard", 
+		Group: "Dashboard",
+	},
+    GetDefaultDashboard: {
+		Name: "GetDefaultDashboard", 
+		Group: "Dashboard",
+	},
+    SetDefaultDashboard: {
+		Name: "SetDefaultDashboard", 
+		Group: "Dashboard",
+	},
+    DeleteDefaultDashboard: {
+		Name: "DeleteDefaultDashboard", 
+		Group: "Dashboard",
+	},
+    CreateDashboardWidget: {
+		Name: "CreateDashboardWidget", 
+		Group: "DashboardWidget",
+	},
+    ListDashboardWidgets: {
+		Name: "ListDashboardWidgets", 
+		Group: "DashboardWidget",
+	},
+    GetDashboardWidget: {
+		Name: "GetDashboardWidget", 
+		Group: "DashboardWidget",
+	},
+    UpdateDashboardWidget: {
+		Name: "UpdateDashboardWidget", 
+		Group: "DashboardWidget",
+	},
+    DeleteDashboardWidget: {
+		Name: "DeleteDashboardWidget", 
+		Group: "DashboardWidget",
+	},
+    GetDashboardWidgetData: {
+		Name: "GetDashboardWidgetData", 
+		Group: "DashboardWidget",
+	},
     Admin_CreateSystemNotificationTemplate: {
 		Name: "Admin_CreateSystemNotificationTemplate", 
 		Group: "SystemNotificationTemplate",
@@ -459,6 +611,74 @@ var ApiMap = map[Endpoint]EndpointInfo{
 		Name: "MakeDefaultSystemNotificationRules", 
 		Group: "SystemNotificationRule",
 	},
+    PreviewSystemNotificationRule: {
+		Name: "PreviewSystemNotificationRule", 
+		Group: "SystemNotificationRule",
+	},
+    CreateSystemNotificationSilence: {
+		Name: "CreateSystemNotificationSilence", 
+		Group: "SystemNotificationSilence",
+	},
+    GetSystemNotificationSilences: {
+		Name: "GetSystemNotificationSilences", 
+		Group: "SystemNotificationSilence",
+	},
+    GetSystemNotificationSilence: {
+		Name: "GetSystemNotificationSilence", 
+		Group: "SystemNotificationSilence",
+	},
+    ExpireSystemNotificationSilence: {
+		Name: "ExpireSystemNotificationSilence", 
+		Group: "SystemNotificationSilence",
+	},
+    CreateStackMaintenanceWindow: {
+		Name: "CreateStackMaintenanceWindow", 
+		Group: "SystemNotificationSilence",
+	},
+    GetStackMaintenanceWindows: {
+		Name: "GetStackMaintenanceWindows", 
+		Group: "SystemNotificationSilence",
+	},
+    UpdateStackMaintenanceWindow: {
+		Name: "UpdateStackMaintenanceWindow", 
+		Group: "SystemNotificationSilence",
+	},
+    DeleteStackMaintenanceWindow: {
+		Name: "DeleteStackMaintenanceWindow", 
+		Group: "SystemNotificationSilence",
+	},
+    CreateOnCallSchedule: {
+		Name: "CreateOnCallSchedule", 
+		Group: "SystemNotificationEscalation",
+	},
+    GetOnCallSchedules: {
+		Name: "GetOnCallSchedules", 
+		Group: "SystemNotificationEscalation",
+	},
+    GetOnCallSchedule: {
+		Name: "GetOnCallSchedule", 
+		Group: "SystemNotificationEscalation",
+	},
+    UpdateOnCallSchedule: {
+		Name: "UpdateOnCallSchedule", 
+		Group: "SystemNotificationEscalation",
+	},
+    DeleteOnCallSchedule: {
+		Name: "DeleteOnCallSchedule", 
+		Group: "SystemNotificationEscalation",
+	},
+    GetSystemNotificationEscalationPolicy: {
+		Name: "GetSystemNotificationEscalationPolicy", 
+		Group: "SystemNotificationEscalation",
+	},
+    SetSystemNotificationEscalationPolicy: {
+		Name: "SetSystemNotificationEscalationPolicy", 
+		Group: "SystemNotificationEscalation",
+	},
+    DeleteSystemNotificationEscalationPolicy: {
+		Name: "DeleteSystemNotificationEscalationPolicy", 
+		Group: "SystemNotificationEscalation",
+	},
     CreateSystemNotification: {
 		Name: "CreateSystemNotification", 
 		Group: "SystemNotification",
@@ -535,6 +755,14 @@ var ApiMap = map[Endpoint]EndpointInfo{
 		Name: "InstallStack", 
 		Group: "Stack",
 	},
+    EstimateStackCost: {
+		Name: "EstimateStackCost", 
+		Group: "Stack",
+	},
+    GetStackCost: {
+		Name: "GetStackCost", 
+		Group: "Stack",
+	},
     CreateProject: {
 		Name: "CreateProject", 
 		Group: "Project",
@@ -631,6 +859,18 @@ var ApiMap = map[Endpoint]EndpointInfo{
 		Name: "GetProjectNamespaceKubeconfig", 
 		Group: "Project",
 	},
+    GetProjectCost: {
+		Name: "GetProjectCost", 
+		Group: "Project",
+	},
+    GetProjectUsage: {
+		Name: "GetProjectUsage", 
+		Group: "Project",
+	},
+    ExportProjectUsage: {
+		Name: "ExportProjectUsage", 
+		Group: "Project",
+	},
     GetAudits: {
 		Name: "GetAudits", 
 		Group: "Audit",
@@ -787,6 +1027,14 @@ var ApiMap = map[Endpoint]EndpointInfo{
 		Name: "Admin_DeletePermittedPolicyTemplatesForOrganization", 
 		Group: "PolicyTemplate",
 	},
+    Admin_ImportPolicyTemplate: {
+		Name: "Admin_ImportPolicyTemplate", 
+		Group: "PolicyTemplate",
+	},
+    Admin_ExportPolicyTemplate: {
+		Name: "Admin_ExportPolicyTemplate", 
+		Group: "PolicyTemplate",
+	},
     ListStackPolicyStatus: {
 		Name: "ListStackPolicyStatus", 
 		Group: "StackPolicyStatus",
@@ -799,6 +1047,10 @@ var ApiMap = map[Endpoint]EndpointInfo{
 		Name: "UpdateStackPolicyTemplateStatus", 
 		Group: "StackPolicyStatus",
 	},
+    ListStackPolicyTemplateHistories: {
+		Name: "ListStackPolicyTemplateHistories", 
+		Group: "StackPolicyStatus",
+	},
     GetMandatoryPolicies: {
 		Name: "GetMandatoryPolicies", 
 		Group: "Policy",
@@ -859,6 +1111,146 @@ var ApiMap = map[Endpoint]EndpointInfo{
 		Name: "StackPolicyStatistics", 
 		Group: "Policy",
 	},
+    CreatePolicyException: {
+		Name: "CreatePolicyException", 
+		Group: "Policy",
+	},
+    ListPolicyExceptions: {
+		Name: "ListPolicyExceptions", 
+		Group: "Policy",
+	},
+    DeletePolicyException: {
+		Name: "DeletePolicyException", 
+		Group: "Policy",
+	},
+    StartPolicyRollout: {
+		Name: "StartPolicyRollout", 
+		Group: "Policy",
+	},
+    GetPolicyRollout: {
+		Name: "GetPolicyRollout", 
+		Group: "Policy",
+	},
+    CancelPolicyRollout: {
+		Name: "CancelPolicyRollout", 
+		Group: "Policy",
+	},
+    ListPolicyViolations: {
+		Name: "ListPolicyViolations", 
+		Group: "PolicyViolation",
+	},
+    ExportPolicyViolations: {
+		Name: "ExportPolicyViolations", 
+		Group: "PolicyViolation",
+	},
+    GetPolicyViolation: {
+		Name: "GetPolicyViolation", 
+		Group: "PolicyViolation",
+	},
+    UpdatePolicyViolationStatus: {
+		Name: "UpdatePolicyViolationStatus", 
+		Group: "PolicyViolation",
+	},
+    CreatePolicyBundle: {
+		Name: "CreatePolicyBundle", 
+		Group: "PolicyBundle",
+	},
+    ListPolicyBundles: {
+		Name: "ListPolicyBundles", 
+		Group: "PolicyBundle",
+	},
+    GetPolicyBundle: {
+		Name: "GetPolicyBundle", 
+		Group: "PolicyBundle",
+	},
+    UpdatePolicyBundle: {
+		Name: "UpdatePolicyBundle", 
+		Group: "PolicyBundle",
+	},
+    DeletePolicyBundle: {
+		Name: "DeletePolicyBundle", 
+		Group: "PolicyBundle",
+	},
+    PreviewPolicyBundleUpdate: {
+		Name: "PreviewPolicyBundleUpdate", 
+		Group: "PolicyBundle",
+	},
+    AssignPolicyBundleToStack: {
+		Name: "AssignPolicyBundleToStack", 
+		Group: "PolicyBundle",
+	},
+    UnassignPolicyBundleFromStack: {
+		Name: "UnassignPolicyBundleFromStack", 
+		Group: "PolicyBundle",
+	},
+    CreateComplianceReport: {
+		Name: "CreateComplianceReport", 
+		Group: "ComplianceReport",
+	},
+    ListComplianceReports: {
+		Name: "ListComplianceReports", 
+		Group: "ComplianceReport",
+	},
+    GetComplianceReport: {
+		Name: "GetComplianceReport", 
+		Group: "ComplianceReport",
+	},
+    DeleteComplianceReport: {
+		Name: "DeleteComplianceReport", 
+		Group: "ComplianceReport",
+	},
+    DownloadComplianceReport: {
+		Name: "DownloadComplianceReport", 
+		Group: "ComplianceReport",
+	},
+    ListMutationPolicyTemplates: {
+		Name: "ListMutationPolicyTemplates", 
+		Group: "MutationPolicy",
+	},
+    CreateMutationPolicy: {
+		Name: "CreateMutationPolicy", 
+		Group: "MutationPolicy",
+	},
+    ListMutationPolicies: {
+		Name: "ListMutationPolicies", 
+		Group: "MutationPolicy",
+	},
+    GetMutationPolicy: {
+		Name: "GetMutationPolicy", 
+		Group: "MutationPolicy",
+	},
+    UpdateMutationPolicy: {
+		Name: "UpdateMutationPolicy", 
+		Group: "MutationPolicy",
+	},
+    DeleteMutationPolicy: {
+		Name: "DeleteMutationPolicy", 
+		Group: "MutationPolicy",
+	},
+    GetPolicyApprovalSetting: {
+		Name: "GetPolicyApprovalSetting", 
+		Group: "PolicyChangeRequest",
+	},
+    UpdatePolicyApprovalSetting: {
+		Name: "UpdatePolicyApprovalSetting", 
+		Group: "PolicyChangeRequest",
+	},
+    ListPolicyChangeRequests: {
+		Name: "ListPolicyChangeRequests", 
+		Group: "PolicyChangeRequest",
+	},
+    GetPolicyChangeRequest: {
+		Name: "GetPolicyChangeRequest", 
+		Group: "PolicyChangeRequest",
+	},
+    ApprovePolicyChangeRequest: {
+		Name: "ApprovePolicyChangeRequest", 
+		Group: "PolicyChangeRequest",
+	},
+    RejectPolicyChangeRequest: {
+		Name: "RejectPolicyChangeRequest", 
+		Group: "PolicyChangeRequest",
+	},
     ListPolicyTemplate: {
 		Name: "ListPolicyTemplate", 
 		Group: "OrganizationPolicyTemplate",
@@ -915,6 +1307,14 @@ var ApiMap = map[Endpoint]EndpointInfo{
 		Name: "ExtractParameters", 
 		Group: "OrganizationPolicyTemplate",
 	},
+    ImportPolicyTemplate: {
+		Name: "ImportPolicyTemplate", 
+		Group: "OrganizationPolicyTemplate",
+	},
+    ExportPolicyTemplate: {
+		Name: "ExportPolicyTemplate", 
+		Group: "OrganizationPolicyTemplate",
+	},
     ListPolicyTemplateExample: {
 		Name: "ListPolicyTemplateExample", 
 		Group: "PolicyTemplateExample",
@@ -968,10 +1368,16 @@ func (e Endpoint) String() string {
 		return "UpdateUser"
 	case ResetPassword:
 		return "ResetPassword"
+	case UnlockUser:
+		return "UnlockUser"
 	case CheckId:
 		return "CheckId"
 	case CheckEmail:
 		return "CheckEmail"
+	case ImportUsers:
+		return "ImportUsers"
+	case ExportUsers:
+		return "ExportUsers"
 	case GetPermissionsByAccountId:
 		return "GetPermissionsByAccountId"
 	case GetMyProfile:
@@ -984,6 +1390,42 @@ func (e Endpoint) String() string {
 		return "RenewPasswordExpiredDate"
 	case DeleteMyProfile:
 		return "DeleteMyProfile"
+	case CreateMyAccessToken:
+		return "CreateMyAccessToken"
+	case GetMyAccessTokens:
+		return "GetMyAccessTokens"
+	case RevokeMyAccessToken:
+		return "RevokeMyAccessToken"
+	case GetMyMfa:
+		return "GetMyMfa"
+	case EnrollMfa:
+		return "EnrollMfa"
+	case ConfirmMfa:
+		return "ConfirmMfa"
+	case DisableMfa:
+		return "DisableMfa"
+	case RegenerateMfaRecoveryCodes:
+		return "RegenerateMfaRecoveryCodes"
+	case VerifyMfa:
+		return "VerifyMfa"
+	case GetMfaPolicy:
+		return "GetMfaPolicy"
+	case UpdateMfaPolicy:
+		return "UpdateMfaPolicy"
+	case CreateServiceAccount:
+		return "CreateServiceAccount"
+	case GetServiceAccounts:
+		return "GetServiceAccounts"
+	case GetServiceAccount:
+		return "GetServiceAccount"
+	case DeleteServiceAccount:
+		return "DeleteServiceAccount"
+	case CreateServiceAccountKey:
+		return "CreateServiceAccountKey"
+	case GetServiceAccountKeys:
+		return "GetServiceAccountKeys"
+	case RevokeServiceAccountKey:
+		return "RevokeServiceAccountKey"
 	case Admin_CreateOrganization:
 		return "Admin_CreateOrganization"
 	case Admin_DeleteOrganization:
@@ -998,6 +1440,10 @@ func (e Endpoint) String() string {
 		return "UpdateOrganization"
 	case UpdatePrimaryCluster:
 		return "UpdatePrimaryCluster"
+	case GetPasswordPolicy:
+		return "GetPasswordPolicy"
+	case UpdatePasswordPolicy:
+		return "UpdatePasswordPolicy"
 	case CreateCluster:
 		return "CreateCluster"
 	case GetClusters:
@@ -1076,6 +1522,10 @@ func (e Endpoint) String() string {
 		return "DeleteForceCloudAccount"
 	case GetResourceQuota:
 		return "GetResourceQuota"
+	case RotateCloudAccountCredential:
+		return "RotateCloudAccountCredential"
+	case CheckCloudAccountCredential:
+		return "CheckCloudAccountCredential"
 	case Admin_GetStackTemplates:
 		return "Admin_GetStackTemplates"
 	case Admin_GetStackTemplate:
@@ -1132,6 +1582,32 @@ func (e Endpoint) String() string {
 		return "GetWorkloadDashboard"
 	case GetPolicyViolationTop5Dashboard:
 		return "GetPolicyViolationTop5Dashboard"
+	case GetCostDashboard:
+		return "GetCostDashboard"
+	case ResetDashboard:
+		return "ResetDashboard"
+	case ExportDashboard:
+		return "ExportDashboard"
+	case ImportDashboard:
+		return "ImportDashboard"
+	case GetDefaultDashboard:
+		return "GetDefaultDashboard"
+	case SetDefaultDashboard:
+		return "SetDefaultDashboard"
+	case DeleteDefaultDashboard:
+		return "DeleteDefaultDashboard"
+	case CreateDashboardWidget:
+		return "CreateDashboardWidget"
+	case ListDashboardWidgets:
+		return "ListDashboardWidgets"
+	case GetDashboardWidget:
+		return "GetDashboardWidget"
+	case UpdateDashboardWidget:
+		return "UpdateDashboardWidget"
+	case DeleteDashboardWidget:
+		return "DeleteDashboardWidget"
+	case GetDashboardWidgetData:
+		return "GetDashboardWidgetData"
 	case Admin_CreateSystemNotificationTemplate:
 		return "Admin_CreateSystemNotificationTemplate"
 	case Admin_UpdateSystemNotificationTemplate:
@@ -1166,6 +1642,40 @@ func (e Endpoint) String() string {
 		return "UpdateSystemNotificationRule"
 	case MakeDefaultSystemNotificationRules:
 		return "MakeDefaultSystemNotificationRules"
+	case PreviewSystemNotificationRule:
+		return "PreviewSystemNotificationRule"
+	case CreateSystemNotificationSilence:
+		return "CreateSystemNotificationSilence"
+	case GetSystemNotificationSilences:
+		return "GetSystemNotificationSilences"
+	case GetSystemNotificationSilence:
+		return "GetSystemNotificationSilence"
+	case ExpireSystemNotificationSilence:
+		return "ExpireSystemNotificationSilence"
+	case CreateStackMaintenanceWindow:
+		return "CreateStackMaintenanceWindow"
+	case GetStackMaintenanceWindows:
+		return "GetStackMaintenanceWindows"
+	case UpdateStackMaintenanceWindow:
+		return "UpdateStackMaintenanceWindow"
+	case DeleteStackMaintenanceWindow:
+		return "DeleteStackMaintenanceWindow"
+	case CreateOnCallSchedule:
+		return "CreateOnCallSchedule"
+	case GetOnCallSchedules:
+		return "GetOnCallSchedules"
+	case GetOnCallSchedule:
+		return "GetOnCallSchedule"
+	case UpdateOnCallSchedule:
+		return "UpdateOnCallSchedule"
+	case DeleteOnCallSchedule:
+		return "DeleteOnCallSchedule"
+	case GetSystemNotificationEscalationPolicy:
+		return "GetSystemNotificationEscalationPolicy"
+	case SetSystemNotificationEscalationPolicy:
+		return "SetSystemNotificationEscalationPolicy"
+	case DeleteSystemNotificationEscalationPolicy:
+		return "DeleteSystemNotificationEscalationPolicy"
 	case CreateSystemNotification:
 		return "CreateSystemNotification"
 	case GetSystemNotifications:
@@ -1204,6 +1714,10 @@ func (e Endpoint) String() string {
 		return "DeleteFavoriteStack"
 	case InstallStack:
 		return "InstallStack"
+	case EstimateStackCost:
+		return "EstimateStackCost"
+	case GetStackCost:
+		return "GetStackCost"
 	case CreateProject:
 		return "CreateProject"
 	case GetProjectRoles:
@@ -1252,6 +1766,12 @@ func (e Endpoint) String() string {
 		return "GetProjectNamespaceK8sResources"
 	case GetProjectNamespaceKubeconfig:
 		return "GetProjectNamespaceKubeconfig"
+	case GetProjectCost:
+		return "GetProjectCost"
+	case GetProjectUsage:
+		return "GetProjectUsage"
+	case ExportProjectUsage:
+		return "ExportProjectUsage"
 	case GetAudits:
 		return "GetAudits"
 	case GetAudit:
@@ -1330,12 +1850,18 @@ func (e Endpoint) String() string {
 		return "Admin_AddPermittedPolicyTemplatesForOrganization"
 	case Admin_DeletePermittedPolicyTemplatesForOrganization:
 		return "Admin_DeletePermittedPolicyTemplatesForOrganization"
+	case Admin_ImportPolicyTemplate:
+		return "Admin_ImportPolicyTemplate"
+	case Admin_ExportPolicyTemplate:
+		return "Admin_ExportPolicyTemplate"
 	case ListStackPolicyStatus:
 		return "ListStackPolicyStatus"
 	case GetStackPolicyTemplateStatus:
 		return "GetStackPolicyTemplateStatus"
 	case UpdateStackPolicyTemplateStatus:
 		return "UpdateStackPolicyTemplateStatus"
+	case ListStackPolicyTemplateHistories:
+		return "ListStackPolicyTemplateHistories"
 	case GetMandatoryPolicies:
 		return "GetMandatoryPolicies"
 	case SetMandatoryPolicies:
@@ -1366,6 +1892,76 @@ func (e Endpoint) String() string {
 		return "DeletePoliciesForStack"
 	case StackPolicyStatistics:
 		return "StackPolicyStatistics"
+	case CreatePolicyException:
+		return "CreatePolicyException"
+	case ListPolicyExceptions:
+		return "ListPolicyExceptions"
+	case DeletePolicyException:
+		return "DeletePolicyException"
+	case StartPolicyRollout:
+		return "StartPolicyRollout"
+	case GetPolicyRollout:
+		return "GetPolicyRollout"
+	case CancelPolicyRollout:
+		return "CancelPolicyRollout"
+	case ListPolicyViolations:
+		return "ListPolicyViolations"
+	case ExportPolicyViolations:
+		return "ExportPolicyViolations"
+	case GetPolicyViolation:
+		return "GetPolicyViolation"
+	case UpdatePolicyViolationStatus:
+		return "UpdatePolicyViolationStatus"
+	case CreatePolicyBundle:
+		return "CreatePolicyBundle"
+	case ListPolicyBundles:
+		return "ListPolicyBundles"
+	case GetPolicyBundle:
+		return "GetPolicyBundle"
+	case UpdatePolicyBundle:
+		return "UpdatePolicyBundle"
+	case DeletePolicyBundle:
+		return "DeletePolicyBundle"
+	case PreviewPolicyBundleUpdate:
+		return "PreviewPolicyBundleUpdate"
+	case AssignPolicyBundleToStack:
+		return "AssignPolicyBundleToStack"
+	case UnassignPolicyBundleFromStack:
+		return "UnassignPolicyBundleFromStack"
+	case CreateComplianceReport:
+		return "CreateComplianceReport"
+	case ListComplianceReports:
+		return "ListComplianceReports"
+	case GetComplianceReport:
+		return "GetComplianceReport"
+	case DeleteComplianceReport:
+		return "DeleteComplianceReport"
+	case DownloadComplianceReport:
+		return "DownloadComplianceReport"
+	case ListMutationPolicyTemplates:
+		return "ListMutationPolicyTemplates"
+	case CreateMutationPolicy:
+		return "CreateMutationPolicy"
+	case ListMutationPolicies:
+		return "ListMutationPolicies"
+	case GetMutationPolicy:
+		return "GetMutationPolicy"
+	case UpdateMutationPolicy:
+		return "UpdateMutationPolicy"
+	case DeleteMutationPolicy:
+		return "DeleteMutationPolicy"
+	case GetPolicyApprovalSetting:
+		return "GetPolicyApprovalSetting"
+	case UpdatePolicyApprovalSetting:
+		return "UpdatePolicyApprovalSetting"
+	case ListPolicyChangeRequests:
+		return "ListPolicyChangeRequests"
+	case GetPolicyChangeRequest:
+		return "GetPolicyChangeRequest"
+	case ApprovePolicyChangeRequest:
+		return "ApprovePolicyChangeRequest"
+	case RejectPolicyChangeRequest:
+		return "RejectPolicyChangeRequest"
 	case ListPolicyTemplate:
 		return "ListPolicyTemplate"
 	case CreatePolicyTemplate:
@@ -1394,6 +1990,10 @@ func (e Endpoint) String() string {
 		return "ExistsPolicyTemplateName"
 	case ExtractParameters:
 		return "ExtractParameters"
+	case ImportPolicyTemplate:
+		return "ImportPolicyTemplate"
+	case ExportPolicyTemplate:
+		return "ExportPolicyTemplate"
 	case ListPolicyTemplateExample:
 		return "ListPolicyTemplateExample"
 	case GetPolicyTemplateExample:
@@ -1440,10 +2040,16 @@ func GetEndpoint(name string) Endpoint {
 		return UpdateUser
 	case "ResetPassword":
 		return ResetPassword
+	case "UnlockUser":
+		return UnlockUser
 	case "CheckId":
 		return CheckId
 	case "CheckEmail":
 		return CheckEmail
+	case "ImportUsers":
+		return ImportUsers
+	case "ExportUsers":
+		return ExportUsers
 	case "GetPermissionsByAccountId":
 		return GetPermissionsByAccountId
 	case "GetMyProfile":
@@ -1456,6 +2062,42 @@ func GetEndpoint(name string) Endpoint {
 		return RenewPasswordExpiredDate
 	case "DeleteMyProfile":
 		return DeleteMyProfile
+	case "CreateMyAccessToken":
+		return CreateMyAccessToken
+	case "GetMyAccessTokens":
+		return GetMyAccessTokens
+	case "RevokeMyAccessToken":
+		return RevokeMyAccessToken
+	case "GetMyMfa":
+		return GetMyMfa
+	case "EnrollMfa":
+		return EnrollMfa
+	case "ConfirmMfa":
+		return ConfirmMfa
+	case "DisableMfa":
+		return DisableMfa
+	case "RegenerateMfaRecoveryCodes":
+		return RegenerateMfaRecoveryCodes
+	case "VerifyMfa":
+		return VerifyMfa
+	case "GetMfaPolicy":
+		return GetMfaPolicy
+	case "UpdateMfaPolicy":
+		return UpdateMfaPolicy
+	case "CreateServiceAccount":
+		return CreateServiceAccount
+	case "GetServiceAccounts":
+		return GetServiceAccounts
+	case "GetServiceAccount":
+		return GetServiceAccount
+	case "DeleteServiceAccount":
+		return DeleteServiceAccount
+	case "CreateServiceAccountKey":
+		return CreateServiceAccountKey
+	case "GetServiceAccountKeys":
+		return GetServiceAccountKeys
+	case "RevokeServiceAccountKey":
+		return RevokeServiceAccountKey
 	case "Admin_CreateOrganization":
 		return Admin_CreateOrganization
 	case "Admin_DeleteOrganization":
@@ -1470,6 +2112,10 @@ func GetEndpoint(name string) Endpoint {
 		return UpdateOrganization
 	case "UpdatePrimaryCluster":
 		return UpdatePrimaryCluster
+	case "GetPasswordPolicy":
+		return GetPasswordPolicy
+	case "UpdatePasswordPolicy":
+		return UpdatePasswordPolicy
 	case "CreateCluster":
 		return CreateCluster
 	case "GetClusters":
@@ -1548,6 +2194,10 @@ func GetEndpoint(name string) Endpoint {
 		return DeleteForceCloudAccount
 	case "GetResourceQuota":
 		return GetResourceQuota
+	case "RotateCloudAccountCredential":
+		return RotateCloudAccountCredential
+	case "CheckCloudAccountCredential":
+		return CheckCloudAccountCredential
 	case "Admin_GetStackTemplates":
 		return Admin_GetStackTemplates
 	case "Admin_GetStackTemplate":
@@ -1604,6 +2254,32 @@ func GetEndpoint(name string) Endpoint {
 		return GetWorkloadDashboard
 	case "GetPolicyViolationTop5Dashboard":
 		return GetPolicyViolationTop5Dashboard
+	case "GetCostDashboard":
+		return GetCostDashboard
+	case "ResetDashboard":
+		return ResetDashboard
+	case "ExportDashboard":
+		return ExportDashboard
+	case "ImportDashboard":
+		return ImportDashboard
+	case "GetDefaultDashboard":
+		return GetDefaultDashboard
+	case "SetDefaultDashboard":
+		return SetDefaultDashboard
+	case "DeleteDefaultDashboard":
+		return DeleteDefaultDashboard
+	case "CreateDashboardWidget":
+		return CreateDashboardWidget
+	case "ListDashboardWidgets":
+		return ListDashboardWidgets
+	case "GetDashboardWidget":
+		return GetDashboardWidget
+	case "UpdateDashboardWidget":
+		return UpdateDashboardWidget
+	case "DeleteDashboardWidget":
+		return DeleteDashboardWidget
+	case "GetDashboardWidgetData":
+		return GetDashboardWidgetData
 	case "Admin_CreateSystemNotificationTemplate":
 		return Admin_CreateSystemNotificationTemplate
 	case "Admin_UpdateSystemNotificationTemplate":
@@ -1638,6 +2314,40 @@ func GetEndpoint(name string) Endpoint {
 		return UpdateSystemNotificationRule
 	case "MakeDefaultSystemNotificationRules":
 		return MakeDefaultSystemNotificationRules
+	case "PreviewSystemNotificationRule":
+		return PreviewSystemNotificationRule
+	case "CreateSystemNotificationSilence":
+		return CreateSystemNotificationSilence
+	case "GetSystemNotificationSilences":
+		return GetSystemNotificationSilences
+	case "GetSystemNotificationSilence":
+		return GetSystemNotificationSilence
+	case "ExpireSystemNotificationSilence":
+		return ExpireSystemNotificationSilence
+	case "CreateStackMaintenanceWindow":
+		return CreateStackMaintenanceWindow
+	case "GetStackMaintenanceWindows":
+		return GetStackMaintenanceWindows
+	case "UpdateStackMaintenanceWindow":
+		return UpdateStackMaintenanceWindow
+	case "DeleteStackMaintenanceWindow":
+		return DeleteStackMaintenanceWindow
+	case "CreateOnCallSchedule":
+		return CreateOnCallSchedule
+	case "GetOnCallSchedules":
+		return GetOnCallSchedules
+	case "GetOnCallSchedule":
+		return GetOnCallSchedule
+	case "UpdateOnCallSchedule":
+		return UpdateOnCallSchedule
+	case "DeleteOnCallSchedule":
+		return DeleteOnCallSchedule
+	case "GetSystemNotificationEscalationPolicy":
+		return GetSystemNotificationEscalationPolicy
+	case "SetSystemNotificationEscalationPolicy":
+		return SetSystemNotificationEscalationPolicy
+	case "DeleteSystemNotificationEscalationPolicy":
+		return DeleteSystemNotificationEscalationPolicy
 	case "CreateSystemNotification":
 		return CreateSystemNotification
 	case "GetSystemNotifications":
@@ -1676,6 +2386,10 @@ func GetEndpoint(name string) Endpoint {
 		return DeleteFavoriteStack
 	case "InstallStack":
 		return InstallStack
+	case "EstimateStackCost":
+		return EstimateStackCost
+	case "GetStackCost":
+		return GetStackCost
 	case "CreateProject":
 		return CreateProject
 	case "GetProjectRoles":
@@ -1724,6 +2438,12 @@ func GetEndpoint(name string) Endpoint {
 		return GetProjectNamespaceK8sResources
 	case "GetProjectNamespaceKubeconfig":
 		return GetProjectNamespaceKubeconfig
+	case "GetProjectCost":
+		return GetProjectCost
+	case "GetProjectUsage":
+		return GetProjectUsage
+	case "ExportProjectUsage":
+		return ExportProjectUsage
 	case "GetAudits":
 		return GetAudits
 	case "GetAudit":
@@ -1802,12 +2522,18 @@ func GetEndpoint(name string) Endpoint {
 		return Admin_AddPermittedPolicyTemplatesForOrganization
 	case "Admin_DeletePermittedPolicyTemplatesForOrganization":
 		return Admin_DeletePermittedPolicyTemplatesForOrganization
+	case "Admin_ImportPolicyTemplate":
+		return Admin_ImportPolicyTemplate
+	case "Admin_ExportPolicyTemplate":
+		return Admin_ExportPolicyTemplate
 	case "ListStackPolicyStatus":
 		return ListStackPolicyStatus
 	case "GetStackPolicyTemplateStatus":
 		return GetStackPolicyTemplateStatus
 	case "UpdateStackPolicyTemplateStatus":
 		return UpdateStackPolicyTemplateStatus
+	case "ListStackPolicyTemplateHistories":
+		return ListStackPolicyTemplateHistories
 	case "GetMandatoryPolicies":
 		return GetMandatoryPolicies
 	case "SetMandatoryPolicies":
@@ -1838,6 +2564,76 @@ func GetEndpoint(name string) Endpoint {
 		return DeletePoliciesForStack
 	case "StackPolicyStatistics":
 		return StackPolicyStatistics
+	case "CreatePolicyException":
+		return CreatePolicyException
+	case "ListPolicyExceptions":
+		return ListPolicyExceptions
+	case "DeletePolicyException":
+		return DeletePolicyException
+	case "StartPolicyRollout":
+		return StartPolicyRollout
+	case "GetPolicyRollout":
+		return GetPolicyRollout
+	case "CancelPolicyRollout":
+		return CancelPolicyRollout
+	case "ListPolicyViolations":
+		return ListPolicyViolations
+	case "ExportPolicyViolations":
+		return ExportPolicyViolations
+	case "GetPolicyViolation":
+		return GetPolicyViolation
+	case "UpdatePolicyViolationStatus":
+		return UpdatePolicyViolationStatus
+	case "CreatePolicyBundle":
+		return CreatePolicyBundle
+	case "ListPolicyBundles":
+		return ListPolicyBundles
+	case "GetPolicyBundle":
+		return GetPolicyBundle
+	case "UpdatePolicyBundle":
+		return UpdatePolicyBundle
+	case "DeletePolicyBundle":
+		return DeletePolicyBundle
+	case "PreviewPolicyBundleUpdate":
+		return PreviewPolicyBundleUpdate
+	case "AssignPolicyBundleToStack":
+		return AssignPolicyBundleToStack
+	case "UnassignPolicyBundleFromStack":
+		return UnassignPolicyBundleFromStack
+	case "CreateComplianceReport":
+		return CreateComplianceReport
+	case "ListComplianceReports":
+		return ListComplianceReports
+	case "GetComplianceReport":
+		return GetComplianceReport
+	case "DeleteComplianceReport":
+		return DeleteComplianceReport
+	case "DownloadComplianceReport":
+		return DownloadComplianceReport
+	case "ListMutationPolicyTemplates":
+		return ListMutationPolicyTemplates
+	case "CreateMutationPolicy":
+		return CreateMutationPolicy
+	case "ListMutationPolicies":
+		return ListMutationPolicies
+	case "GetMutationPolicy":
+		return GetMutationPolicy
+	case "UpdateMutationPolicy":
+		return UpdateMutationPolicy
+	case "DeleteMutationPolicy":
+		return DeleteMutationPolicy
+	case "GetPolicyApprovalSetting":
+		return GetPolicyApprovalSetting
+	case "UpdatePolicyApprovalSetting":
+		return UpdatePolicyApprovalSetting
+	case "ListPolicyChangeRequests":
+		return ListPolicyChangeRequests
+	case "GetPolicyChangeRequest":
+		return GetPolicyChangeRequest
+	case "ApprovePolicyChangeRequest":
+		return ApprovePolicyChangeRequest
+	case "RejectPolicyChangeRequest":
+		return RejectPolicyChangeRequest
 	case "ListPolicyTemplate":
 		return ListPolicyTemplate
 	case "CreatePolicyTemplate":
@@ -1866,6 +2662,10 @@ func GetEndpoint(name string) Endpoint {
 		return ExistsPolicyTemplateName
 	case "ExtractParameters":
 		return ExtractParameters
+	case "ImportPolicyTemplate":
+		return ImportPolicyTemplate
+	case "ExportPolicyTemplate":
+		return ExportPolicyTemplate
 	case "ListPolicyTemplateExample":
 		return ListPolicyTemplateExample
 	case "GetPolicyTemplateExample":
@@ -0,0 +1,491 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+)
+
+type AccessTokenHandler struct {
+	usecase usecase.IAccessTokenUsecase
+}
+
+func NewAccessTokenHandler(h usecase.Usecase) *AccessTokenHandler {
+	return &AccessTokenHandler{
+		usecase: h.AccessToken,
+	}
+}
+
+// CreateMyAccessToken godoc
+//
+//	@Tags			My-profile
+//	@Summary		Create personal access token
+//	@Description	Create personal access token. The token is returned only once.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string							true	"organizationId"
+//	@Param			body			body		domain.CreateAccessTokenRequest	true	"create access token request"
+//	@Success		200				{object}	domain.CreateAccessTokenResponse
+//	@Router			/organizations/{organizationId}/my-profile/access-tokens [post]
+//	@Security		JWT
+func (h *AccessTokenHandler) CreateMyAccessToken(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.CreateAccessTokenRequest{}
+	err := UnmarshalRequestInput(r, &input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.AccessToken
+	if err = serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.OrganizationId = organizationId
+
+	token, accessToken, err := h.usecase.CreatePersonalAccessToken(r.Context(), dto, input.ExpiresIn)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	out := domain.CreateAccessTokenResponse{
+		ID:        accessToken.ID.String(),
+		Token:     token,
+		ExpiredAt: accessToken.ExpiredAt,
+	}
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetMyAccessTokens godoc
+//
+//	@Tags			My-profile
+//	@Summary		Get personal access tokens
+//	@Description	Get personal access tokens
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"organizationId"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			soertColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Param			filters			query		[]string	false	"filters"
+//	@Success		200				{object}	domain.GetAccessTokensResponse
+//	@Router			/organizations/{organizationId}/my-profile/access-tokens [get]
+//	@Security		JWT
+func (h *AccessTokenHandler) GetMyAccessTokens(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+
+	accessTokens, err := h.usecase.FetchPersonalAccessTokens(r.Context(), organizationId, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetAccessTokensResponse
+	out.AccessTokens = make([]domain.AccessTokenResponse, len(accessTokens))
+	for i, accessToken := range accessTokens {
+		if err := serializer.Map(r.Context(), accessToken, &out.AccessTokens[i]); err != nil {
+			log.Info(r.Context(), err)
+		}
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// RevokeMyAccessToken godoc
+//
+//	@Tags			My-profile
+//	@Summary		Revoke personal access token
+//	@Description	Revoke personal access token
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			accessTokenId	path		string	true	"accessTokenId"
+//	@Success		200				{object}	nil
+//	@Router			/organizations/{organizationId}/my-profile/access-tokens/{accessTokenId} [delete]
+//	@Security		JWT
+func (h *AccessTokenHandler) RevokeMyAccessToken(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+	accessTokenId, err := parseAccessTokenId(vars)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err = h.usecase.RevokePersonalAccessToken(r.Context(), organizationId, accessTokenId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// CreateServiceAccount godoc
+//
+//	@Tags			ServiceAccounts
+//	@Summary		Create service account
+//	@Description	Create service account
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string								true	"organizationId"
+//	@Param			body			body		domain.CreateServiceAccountRequest	true	"create service account request"
+//	@Success		200				{object}	domain.CreateServiceAccountResponse
+//	@Router			/organizations/{organizationId}/service-accounts [post]
+//	@Security		JWT
+func (h *AccessTokenHandler) CreateServiceAccount(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.CreateServiceAccountRequest{}
+	err := UnmarshalRequestInput(r, &input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.ServiceAccount
+	if err = serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.OrganizationId = organizationId
+
+	id, err := h.usecase.CreateServiceAccount(r.Context(), dto)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	out := domain.CreateServiceAccountResponse{
+		ID: id.String(),
+	}
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetServiceAccounts godoc
+//
+//	@Tags			ServiceAccounts
+//	@Summary		Get service accounts
+//	@Description	Get service accounts
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"organizationId"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			soertColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Param			filters			query		[]string	false	"filters"
+//	@Success		200				{object}	domain.GetServiceAccountsResponse
+//	@Router			/organizations/{organizationId}/service-accounts [get]
+//	@Security		JWT
+func (h *AccessTokenHandler) GetServiceAccounts(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+
+	serviceAccounts, err := h.usecase.FetchServiceAccounts(r.Context(), organizationId, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetServiceAccountsResponse
+	out.ServiceAccounts = make([]domain.ServiceAccountResponse, len(serviceAccounts))
+	for i, serviceAccount := range serviceAccounts {
+		if err := serializer.Map(r.Context(), serviceAccount, &out.ServiceAccounts[i]); err != nil {
+			log.Info(r.Context(), err)
+		}
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetServiceAccount godoc
+//
+//	@Tags			ServiceAccounts
+//	@Summary		Get service account
+//	@Description	Get service account
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId		path		string	true	"organizationId"
+//	@Param			serviceAccountId	path		string	true	"serviceAccountId"
+//	@Success		200					{object}	domain.GetServiceAccountResponse
+//	@Router			/organizations/{organizationId}/service-accounts/{serviceAccountId} [get]
+//	@Security		JWT
+func (h *AccessTokenHandler) GetServiceAccount(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+	serviceAccountId, err := parseServiceAccountId(vars)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	serviceAccount, err := h.usecase.GetServiceAccount(r.Context(), organizationId, serviceAccountId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetServiceAccountResponse
+	if err := serializer.Map(r.Context(), serviceAccount, &out.ServiceAccount); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// DeleteServiceAccount godoc
+//
+//	@Tags			ServiceAccounts
+//	@Summary		Delete service account
+//	@Description	Delete service account. All keys of the service account are revoked.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId		path		string	true	"organizationId"
+//	@Param			serviceAccountId	path		string	true	"serviceAccountId"
+//	@Success		200					{object}	nil
+//	@Router			/organizations/{organizationId}/service-accounts/{serviceAccountId} [delete]
+//	@Security		JWT
+func (h *AccessTokenHandler) DeleteServiceAccount(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+	serviceAccountId, err := parseServiceAccountId(vars)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err = h.usecase.DeleteServiceAccount(r.Context(), organizationId, serviceAccountId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// CreateServiceAccountKey godoc
+//
+//	@Tags			ServiceAccounts
+//	@Summary		Create service account key
+//	@Description	Create API key for service account. The key is returned only once.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId		path		string							true	"organizationId"
+//	@Param			serviceAccountId	path		string							true	"serviceAccountId"
+//	@Param			body				body		domain.CreateAccessTokenRequest	true	"create service account key request"
+//	@Success		200					{object}	domain.CreateAccessTokenResponse
+//	@Router			/organizations/{organizationId}/service-accounts/{serviceAccountId}/keys [post]
+//	@Security		JWT
+func (h *AccessTokenHandler) CreateServiceAccountKey(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+	serviceAccountId, err := parseServiceAccountId(vars)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	input := domain.CreateAccessTokenRequest{}
+	err = UnmarshalRequestInput(r, &input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.AccessToken
+	if err = serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.OrganizationId = organizationId
+	dto.ServiceAccountId = &serviceAccountId
+
+	token, accessToken, err := h.usecase.CreateServiceAccountKey(r.Context(), dto, input.ExpiresIn)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	out := domain.CreateAccessTokenResponse{
+		ID:        accessToken.ID.String(),
+		Token:     token,
+		ExpiredAt: accessToken.ExpiredAt,
+	}
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetServiceAccountKeys godoc
+//
+//	@Tags			ServiceAccounts
+//	@Summary		Get service account keys
+//	@Description	Get service account keys
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId		path		string		true	"organizationId"
+//	@Param			serviceAccountId	path		string		true	"serviceAccountId"
+//	@Param			pageSize			query		string		false	"pageSize"
+//	@Param			pageNumber			query		string		false	"pageNumber"
+//	@Param			soertColumn			query		string		false	"sortColumn"
+//	@Param			sortOrder			query		string		false	"sortOrder"
+//	@Param			filters				query		[]string	false	"filters"
+//	@Success		200					{object}	domain.GetAccessTokensResponse
+//	@Router			/organizations/{organizationId}/service-accounts/{serviceAccountId}/keys [get]
+//	@Security		JWT
+func (h *AccessTokenHandler) GetServiceAccountKeys(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+	serviceAccountId, err := parseServiceAccountId(vars)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+
+	accessTokens, err := h.usecase.FetchServiceAccountKeys(r.Context(), organizationId, serviceAccountId, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetAccessTokensResponse
+	out.AccessTokens = make([]domain.AccessTokenResponse, len(accessTokens))
+	for i, accessToken := range accessTokens {
+		if err := serializer.Map(r.Context(), accessToken, &out.AccessTokens[i]); err != nil {
+			log.Info(r.Context(), err)
+		}
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// RevokeServiceAccountKey godoc
+//
+//	@Tags			ServiceAccounts
+//	@Summary		Revoke service account key
+//	@Description	Revoke service account key
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId		path		string	true	"organizationId"
+//	@Param			serviceAccountId	path		string	true	"serviceAccountId"
+//	@Param			accessTokenId		path		string	true	"accessTokenId"
+//	@Success		200					{object}	nil
+//	@Router			/organizations/{organizationId}/service-accounts/{serviceAccountId}/keys/{accessTokenId} [delete]
+//	@Security		JWT
+func (h *AccessTokenHandler) RevokeServiceAccountKey(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+	serviceAccountId, err := parseServiceAccountId(vars)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+	accessTokenId, err := parseAccessTokenId(vars)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err = h.usecase.RevokeServiceAccountKey(r.Context(), organizationId, serviceAccountId, accessTokenId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+func parseServiceAccountId(vars map[string]string) (uuid.UUID, error) {
+	strId, ok := vars["serviceAccountId"]
+	if !ok {
+		return uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("invalid serviceAccountId"), "C_INVALID_SERVICE_ACCOUNT_ID", "")
+	}
+	serviceAccountId, err := uuid.Parse(strId)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewBadRequestError(errors.Wrap(err, "Failed to parse uuid %s"), "C_INVALID_SERVICE_ACCOUNT_ID", "")
+	}
+	return serviceAccountId, nil
+}
+
+func parseAccessTokenId(vars map[string]string) (uuid.UUID, error) {
+	strId, ok := vars["accessTokenId"]
+	if !ok {
+		return uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("invalid accessTokenId"), "C_INVALID_ACCESS_TOKEN_ID", "")
+	}
+	accessTokenId, err := uuid.Parse(strId)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewBadRequestError(errors.Wrap(err, "Failed to parse uuid %s"), "C_INVALID_ACCESS_TOKEN_ID", "")
+	}
+	return accessTokenId, nil
+}
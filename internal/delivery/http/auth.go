@@ -59,18 +59,9 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	user, err := h.usecase.Login(r.Context(), input.AccountId, input.Password, input.OrganizationId)
+	user, err := h.usecase.Login(r.Context(), input.AccountId, input.Password, input.OrganizationId, input.OtpCode, audit.GetClientIpAddress(w, r))
 	if err != nil {
-		errorResponse, _ := httpErrors.ErrorResponse(err)
-		_, _ = h.auditUsecase.Create(r.Context(), model.Audit{
-			OrganizationId: input.OrganizationId,
-			Group:          "Auth",
-			Message:        fmt.Sprintf("[%s]님이 로그인에 실패하였습니다.", input.AccountId),
-			Description:    errorResponse.Text(),
-			ClientIP:       audit.GetClientIpAddress(w, r),
-			UserId:         nil,
-			UserAccountId:  input.AccountId,
-		})
+		h.createFailureAudit(w, r, input.OrganizationId, input.AccountId, fmt.Sprintf("[%s]님이 로그인에 실패하였습니다.", input.AccountId), err)
 		log.Errorf(r.Context(), "error is :%s(%T)", err.Error(), err)
 		ErrorJSON(w, r, err)
 		return
@@ -190,9 +181,10 @@ func (h *AuthHandler) FindId(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	accountId, err := h.usecase.FindId(r.Context(), input.Code, input.Email, input.UserName, input.OrganizationId)
+	accountId, err := h.usecase.FindId(r.Context(), input.Code, input.Email, input.UserName, input.OrganizationId, audit.GetClientIpAddress(w, r))
 	if err != nil {
 		log.Errorf(r.Context(), "error is :%s(%T)", err.Error(), err)
+		h.createFailureAudit(w, r, input.OrganizationId, "", fmt.Sprintf("[%s]님의 아이디 찾기 인증에 실패하였습니다.", input.Email), err)
 
 		ErrorJSON(w, r, err)
 		return
@@ -222,9 +214,10 @@ func (h *AuthHandler) FindPassword(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = h.usecase.FindPassword(r.Context(), input.Code, input.AccountId, input.Email, input.UserName, input.OrganizationId)
+	err = h.usecase.FindPassword(r.Context(), input.Code, input.AccountId, input.Email, input.UserName, input.OrganizationId, audit.GetClientIpAddress(w, r))
 	if err != nil {
 		log.Errorf(r.Context(), "error is :%s(%T)", err.Error(), err)
+		h.createFailureAudit(w, r, input.OrganizationId, input.AccountId, fmt.Sprintf("[%s]님의 비밀번호 찾기 인증에 실패하였습니다.", input.AccountId), err)
 		ErrorJSON(w, r, err)
 		return
 	}
@@ -251,7 +244,7 @@ func (h *AuthHandler) VerifyIdentityForLostId(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	err = h.usecase.VerifyIdentity(r.Context(), "", input.Email, input.UserName, input.OrganizationId)
+	err = h.usecase.VerifyIdentity(r.Context(), "", input.Email, input.UserName, input.OrganizationId, audit.GetClientIpAddress(w, r))
 	if err != nil {
 		log.Errorf(r.Context(), "error is :%s(%T)", err.Error(), err)
 		ErrorJSON(w, r, err)
@@ -282,7 +275,7 @@ func (h *AuthHandler) VerifyIdentityForLostPassword(w http.ResponseWriter, r *ht
 		return
 	}
 
-	err = h.usecase.VerifyIdentity(r.Context(), input.AccountId, input.Email, input.UserName, input.OrganizationId)
+	err = h.usecase.VerifyIdentity(r.Context(), input.AccountId, input.Email, input.UserName, input.OrganizationId, audit.GetClientIpAddress(w, r))
 	if err != nil {
 		log.Errorf(r.Context(), "error is :%s(%T)", err.Error(), err)
 		ErrorJSON(w, r, err)
@@ -324,3 +317,16 @@ func (h *AuthHandler) VerifyToken(w http.ResponseWriter, r *http.Request) {
 
 	ResponseJSON(w, r, http.StatusOK, nil)
 }
+
+func (h *AuthHandler) createFailureAudit(w http.ResponseWriter, r *http.Request, organizationId string, accountId string, message string, err error) {
+	errorResponse, _ := httpErrors.ErrorResponse(err)
+	_, _ = h.auditUsecase.Create(r.Context(), model.Audit{
+		OrganizationId: organizationId,
+		Group:          "Auth",
+		Message:        message,
+		Description:    errorResponse.Text(),
+		ClientIP:       audit.GetClientIpAddress(w, r),
+		UserId:         nil,
+		UserAccountId:  accountId,
+	})
+}
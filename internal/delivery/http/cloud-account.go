@@ -319,6 +319,103 @@ func (h *CloudAccountHandler) DeleteForceCloudAccount(w http.ResponseWriter, r *
 	ResponseJSON(w, r, http.StatusOK, out)
 }
 
+// RotateCloudAccountCredential godoc
+//
+//	@Tags			CloudAccounts
+//	@Summary		Rotate CloudAccount credential
+//	@Description	새 인증 정보로 클라우드 계정의 IAM 생성 워크플로우를 다시 실행한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string										true	"organizationId"
+//	@Param			cloudAccountId	path		string										true	"cloudAccountId"
+//	@Param			body			body		domain.RotateCloudAccountCredentialRequest	true	"Rotate credential request"
+//	@Success		200				{object}	nil
+//	@Router			/organizations/{organizationId}/cloud-accounts/{cloudAccountId}/credentials [put]
+//	@Security		JWT
+func (h *CloudAccountHandler) RotateCloudAccountCredential(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+	strId, ok := vars["cloudAccountId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid cloudAccountId"), "C_INVALID_CLOUD_ACCOUNT_ID", ""))
+		return
+	}
+	cloudAccountId, err := uuid.Parse(strId)
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(errors.Wrap(err, "Failed to parse uuid"), "C_INVALID_CLOUD_ACCOUNT_ID", ""))
+		return
+	}
+
+	input := domain.RotateCloudAccountCredentialRequest{}
+	err = UnmarshalRequestInput(r, &input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.CloudAccount
+	if err = serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.ID = cloudAccountId
+	dto.OrganizationId = organizationId
+
+	if err = h.usecase.RotateCredential(r.Context(), dto); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// CheckCloudAccountCredential godoc
+//
+//	@Tags			CloudAccounts
+//	@Summary		Check CloudAccount credential
+//	@Description	TKS 의 인증 정보로 클라우드 계정의 IAM 을 사용할 수 있는지 즉시 확인한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			cloudAccountId	path		string	true	"cloudAccountId"
+//	@Success		200				{object}	domain.CloudAccountCredentialStatusResponse
+//	@Router			/organizations/{organizationId}/cloud-accounts/{cloudAccountId}/credentials/check [post]
+//	@Security		JWT
+func (h *CloudAccountHandler) CheckCloudAccountCredential(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+	strId, ok := vars["cloudAccountId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid cloudAccountId"), "C_INVALID_CLOUD_ACCOUNT_ID", ""))
+		return
+	}
+	cloudAccountId, err := uuid.Parse(strId)
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(errors.Wrap(err, "Failed to parse uuid"), "C_INVALID_CLOUD_ACCOUNT_ID", ""))
+		return
+	}
+
+	cloudAccount, err := h.usecase.CheckCredential(r.Context(), organizationId, cloudAccountId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.CloudAccountCredentialStatusResponse
+	if err := serializer.Map(r.Context(), cloudAccount, &out); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
 // CheckCloudAccountName godoc
 //
 //	@Tags			CloudAccounts
@@ -425,17 +522,20 @@ func (h *CloudAccountHandler) GetResourceQuota(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	available, resourceQuota, err := h.usecase.GetResourceQuota(r.Context(), cloudAccountId)
+	regions, err := h.usecase.GetResourceQuota(r.Context(), cloudAccountId)
 	if err != nil {
 		ErrorJSON(w, r, err)
 		return
 	}
 
 	var out domain.GetCloudAccountResourceQuotaResponse
-	if err := serializer.Map(r.Context(), resourceQuota, &out.ResourceQuota); err != nil {
-		log.Info(r.Context(), err)
+	out.Regions = regions
+	out.ResourceQuota = regions[0].ResourceQuota
+	for _, region := range regions {
+		if region.Available {
+			out.Available = true
+		}
 	}
-	out.Available = available
 
 	ResponseJSON(w, r, http.StatusOK, out)
 }
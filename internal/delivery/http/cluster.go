@@ -353,8 +353,8 @@ func (h *ClusterHandler) GetBootstrapKubeconfig(w http.ResponseWriter, r *http.R
 // GetNodes godoc
 //
 //	@Tags			Clusters
-//	@Summary		Get nodes information for BYOH
-//	@Description	Get nodes information for BYOH
+//	@Summary		Get nodes information
+//	@Description	BYOH 스택은 등록된 호스트를, 그 외의 스택은 클러스터의 노드를 역할별로 조회한다.
 //	@Accept			json
 //	@Produce		json
 //	@Param			clusterId	path		string	true	"clusterId"
@@ -0,0 +1,317 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+var complianceReportHtmlTemplate = template.Must(template.New("compliance-report").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Compliance Report - {{.OrganizationName}}</title>
+<style>
+body { font-family: sans-serif; font-size: 13px; }
+table { border-collapse: collapse; width: 100%; margin-bottom: 24px; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; vertical-align: top; }
+th { background: #f0f0f0; }
+</style>
+</head>
+<body>
+<h1>Compliance Report</h1>
+<p>Organization: {{.OrganizationName}} ({{.OrganizationId}})<br>
+Period: {{.PeriodStart.Format "2006-01-02 15:04 MST"}} ~ {{.PeriodEnd.Format "2006-01-02 15:04 MST"}}<br>
+Generated: {{.GeneratedAt.Format "2006-01-02 15:04 MST"}}</p>
+{{range .Stacks}}
+<h2>{{.StackName}} ({{.StackId}})</h2>
+<p>Violations: {{.ViolationCount}}, Open exceptions: {{.OpenExceptionCount}}</p>
+<table>
+<tr><th>Policy</th><th>Mandatory</th><th>Template</th><th>Kind</th><th>Version</th><th>Enforcement</th><th>Violations</th><th>Open exceptions</th></tr>
+{{range .Policies}}
+<tr>
+<td>{{.PolicyName}}</td>
+<td>{{if .Mandatory}}Y{{else}}N{{end}}</td>
+<td>{{.TemplateName}}</td>
+<td>{{.TemplateKind}}</td>
+<td>{{.TemplateVersion}}{{if .VersionPinned}} (pinned){{end}}</td>
+<td>{{.EnforcementAction}}</td>
+<td>{{.ViolationCount}}</td>
+<td>{{range .OpenExceptions}}{{.Namespace}} until {{.ExpiredAt.Format "2006-01-02"}}: {{.Justification}}<br>{{end}}</td>
+</tr>
+{{end}}
+</table>
+{{end}}
+</body>
+</html>
+`))
+
+type ComplianceReportHandler struct {
+	usecase usecase.IComplianceReportUsecase
+}
+
+type IComplianceReportHandler interface {
+	CreateComplianceReport(w http.ResponseWriter, r *http.Request)
+	ListComplianceReports(w http.ResponseWriter, r *http.Request)
+	GetComplianceReport(w http.ResponseWriter, r *http.Request)
+	DeleteComplianceReport(w http.ResponseWriter, r *http.Request)
+	DownloadComplianceReport(w http.ResponseWriter, r *http.Request)
+}
+
+func NewComplianceReportHandler(u usecase.Usecase) IComplianceReportHandler {
+	return &ComplianceReportHandler{
+		usecase: u.ComplianceReport,
+	}
+}
+
+// CreateComplianceReport godoc
+//
+//	@Tags			ComplianceReport
+//	@Summary		[CreateComplianceReport] 정책 준수 보고서 생성
+//	@Description	스택별 적용 정책, 템플릿 버전, 적용 액션, 기간 내 위반 수, 유효한 정책 예외를 스냅샷으로 기록한 보고서를 생성한다. stackId 를 지정하지 않으면 조직의 모든 스택을 대상으로 한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string								true	"조직 식별자(o로 시작)"
+//	@Param			body			body		domain.CreateComplianceReportRequest	true	"create compliance report request"
+//	@Success		200				{object}	domain.CreateComplianceReportResponse
+//	@Router			/organizations/{organizationId}/compliance-reports [post]
+//	@Security		JWT
+func (h *ComplianceReportHandler) CreateComplianceReport(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.CreateComplianceReportRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	dto := model.ComplianceReport{
+		OrganizationId: organizationId,
+		ClusterId:      domain.ClusterId(input.StackId),
+		PeriodStart:    input.PeriodStart,
+		PeriodEnd:      input.PeriodEnd,
+	}
+
+	reportId, err := h.usecase.Create(r.Context(), dto)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.CreateComplianceReportResponse{ID: reportId.String()})
+}
+
+// ListComplianceReports godoc
+//
+//	@Tags			ComplianceReport
+//	@Summary		[ListComplianceReports] 정책 준수 보고서 목록 조회
+//	@Description	수동 생성 및 월간 자동 생성된 정책 준수 보고서 목록을 조회한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"조직 식별자(o로 시작)"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			sortColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Param			filters			query		[]string	false	"filters"
+//	@Success		200				{object}	domain.ListComplianceReportsResponse
+//	@Router			/organizations/{organizationId}/compliance-reports [get]
+//	@Security		JWT
+func (h *ComplianceReportHandler) ListComplianceReports(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+
+	reports, err := h.usecase.Fetch(r.Context(), organizationId, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.ListComplianceReportsResponse
+	out.ComplianceReports = make([]domain.ComplianceReportResponse, len(reports))
+	for i, report := range reports {
+		out.ComplianceReports[i] = convertComplianceReportToResponse(r.Context(), report)
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetComplianceReport godoc
+//
+//	@Tags			ComplianceReport
+//	@Summary		[GetComplianceReport] 정책 준수 보고서 조회
+//	@Description	정책 준수 보고서와 보고서 내용을 조회한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"조직 식별자(o로 시작)"
+//	@Param			reportId		path		string	true	"준수 보고서 식별자(uuid)"
+//	@Success		200				{object}	domain.GetComplianceReportResponse
+//	@Router			/organizations/{organizationId}/compliance-reports/{reportId} [get]
+//	@Security		JWT
+func (h *ComplianceReportHandler) GetComplianceReport(w http.ResponseWriter, r *http.Request) {
+	organizationId, reportId, err := complianceReportPathParams(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	report, err := h.usecase.Get(r.Context(), organizationId, reportId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetComplianceReportResponse
+	out.ComplianceReport = convertComplianceReportToResponse(r.Context(), report)
+	if report.ContentData != nil {
+		out.Content = *report.ContentData
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// DeleteComplianceReport godoc
+//
+//	@Tags			ComplianceReport
+//	@Summary		[DeleteComplianceReport] 정책 준수 보고서 삭제
+//	@Description	정책 준수 보고서를 삭제한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"조직 식별자(o로 시작)"
+//	@Param			reportId		path		string	true	"준수 보고서 식별자(uuid)"
+//	@Success		200				{object}	nil
+//	@Router			/organizations/{organizationId}/compliance-reports/{reportId} [delete]
+//	@Security		JWT
+func (h *ComplianceReportHandler) DeleteComplianceReport(w http.ResponseWriter, r *http.Request) {
+	organizationId, reportId, err := complianceReportPathParams(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err := h.usecase.Delete(r.Context(), organizationId, reportId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// DownloadComplianceReport godoc
+//
+//	@Tags			ComplianceReport
+//	@Summary		[DownloadComplianceReport] 정책 준수 보고서 다운로드
+//	@Description	정책 준수 보고서 내용을 JSON 또는 HTML 파일로 내려받는다. PDF 는 지원하지 않으며 HTML 을 브라우저에서 인쇄하여 사용한다.
+//	@Produce		json
+//	@Produce		html
+//	@Param			organizationId	path		string	true	"조직 식별자(o로 시작)"
+//	@Param			reportId		path		string	true	"준수 보고서 식별자(uuid)"
+//	@Param			format			query		string	false	"json(기본값) 또는 html"
+//	@Success		200				{object}	domain.ComplianceReportContent
+//	@Router			/organizations/{organizationId}/compliance-reports/{reportId}/download [get]
+//	@Security		JWT
+func (h *ComplianceReportHandler) DownloadComplianceReport(w http.ResponseWriter, r *http.Request) {
+	organizationId, reportId, err := complianceReportPathParams(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	format := domain.ComplianceReportFormat(r.URL.Query().Get("format"))
+	if format == "" {
+		format = domain.ComplianceReportFormatJson
+	}
+	if format != domain.ComplianceReportFormatJson && format != domain.ComplianceReportFormatHtml {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("unsupported format %s", format), "CR_UNSUPPORTED_FORMAT", ""))
+		return
+	}
+
+	report, err := h.usecase.Get(r.Context(), organizationId, reportId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	content := domain.ComplianceReportContent{}
+	if report.ContentData != nil {
+		content = *report.ContentData
+	}
+
+	fileName := fmt.Sprintf("compliance-report-%s-%s.%s", organizationId, report.PeriodStart.Format("20060102"), format)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", fileName))
+
+	switch format {
+	case domain.ComplianceReportFormatHtml:
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		if err := complianceReportHtmlTemplate.Execute(w, content); err != nil {
+			log.Error(r.Context(), err)
+		}
+	default:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(content); err != nil {
+			log.Error(r.Context(), err)
+		}
+	}
+}
+
+func complianceReportPathParams(r *http.Request) (organizationId string, reportId uuid.UUID, err error) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+	}
+
+	reportId, err = uuid.Parse(vars["reportId"])
+	if err != nil {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("invalid reportId"), "CR_INVALID_COMPLIANCE_REPORT_ID", "")
+	}
+	return organizationId, reportId, nil
+}
+
+func convertComplianceReportToResponse(ctx context.Context, report model.ComplianceReport) (out domain.ComplianceReportResponse) {
+	out.ID = report.ID.String()
+	out.StackId = report.ClusterId.String()
+	out.PeriodStart = report.PeriodStart
+	out.PeriodEnd = report.PeriodEnd
+	out.Scheduled = report.Scheduled
+	out.CreatedAt = report.CreatedAt
+
+	if err := serializer.Map(ctx, report.Creator, &out.Creator); err != nil {
+		log.Error(ctx, err)
+	}
+	return
+}
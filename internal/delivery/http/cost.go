@@ -0,0 +1,142 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type CostHandler struct {
+	usecase usecase.ICostUsecase
+}
+
+func NewCostHandler(h usecase.Usecase) *CostHandler {
+	return &CostHandler{
+		usecase: h.Cost,
+	}
+}
+
+// EstimateStackCost godoc
+//
+//	@Tags			Stacks
+//	@Summary		Estimate stack cost
+//	@Description	Estimate monthly cost of a stack before creation
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string							true	"organizationId"
+//	@Param			body			body		domain.EstimateStackCostRequest	true	"stack node configuration"
+//	@Success		200				{object}	domain.EstimateStackCostResponse
+//	@Router			/organizations/{organizationId}/stacks/cost-estimate [post]
+//	@Security		JWT
+func (h *CostHandler) EstimateStackCost(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.EstimateStackCostRequest{}
+	err := UnmarshalRequestInput(r, &input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.Stack
+	if err = serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	if err = serializer.Map(r.Context(), input, &dto.Conf); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	estimate, err := h.usecase.EstimateStack(r.Context(), organizationId, dto)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.EstimateStackCostResponse
+	out.Estimate = estimate
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetStackCost godoc
+//
+//	@Tags			Stacks
+//	@Summary		Get stack cost
+//	@Description	Get monthly cost of a stack
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			stackId			path		string	true	"stackId"
+//	@Success		200				{object}	domain.GetStackCostResponse
+//	@Router			/organizations/{organizationId}/stacks/{stackId}/cost [get]
+//	@Security		JWT
+func (h *CostHandler) GetStackCost(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+	stackId, ok := vars["stackId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid stackId"), "C_INVALID_STACK_ID", ""))
+		return
+	}
+
+	cost, err := h.usecase.GetStackCost(r.Context(), organizationId, domain.StackId(stackId))
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetStackCostResponse
+	out.Cost = cost
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetProjectCost godoc
+//
+//	@Tags			Projects
+//	@Summary		Get project cost
+//	@Description	Get monthly cost of the stacks used by a project. Shared stacks are split evenly among projects.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			projectId		path		string	true	"projectId"
+//	@Success		200				{object}	domain.GetProjectCostResponse
+//	@Router			/organizations/{organizationId}/projects/{projectId}/cost [get]
+//	@Security		JWT
+func (h *CostHandler) GetProjectCost(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+	projectId, ok := vars["projectId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid projectId"), "C_INVALID_PROJECT_ID", ""))
+		return
+	}
+
+	cost, err := h.usecase.GetProjectCost(r.Context(), organizationId, projectId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetProjectCostResponse
+	out.Cost = cost
+	ResponseJSON(w, r, http.StatusOK, out)
+}
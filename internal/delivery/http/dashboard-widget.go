@@ -0,0 +1,299 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type DashboardWidgetHandler struct {
+	usecase usecase.IDashboardWidgetUsecase
+}
+
+type IDashboardWidgetHandler interface {
+	CreateDashboardWidget(w http.ResponseWriter, r *http.Request)
+	ListDashboardWidgets(w http.ResponseWriter, r *http.Request)
+	GetDashboardWidget(w http.ResponseWriter, r *http.Request)
+	UpdateDashboardWidget(w http.ResponseWriter, r *http.Request)
+	DeleteDashboardWidget(w http.ResponseWriter, r *http.Request)
+	GetDashboardWidgetData(w http.ResponseWriter, r *http.Request)
+}
+
+func NewDashboardWidgetHandler(u usecase.Usecase) IDashboardWidgetHandler {
+	return &DashboardWidgetHandler{
+		usecase: u.DashboardWidget,
+	}
+}
+
+// CreateDashboardWidget godoc
+//
+//	@Tags			Dashboard Widgets
+//	@Summary		[CreateDashboardWidget] 사용자 정의 위젯 생성
+//	@Description	PromQL 로 정의한 사용자 정의 대시보드 위젯을 생성한다. shared 가 true 이면 조직 내 다른 사용자도 조회할 수 있다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string								true	"조직 식별자(o로 시작)"
+//	@Param			body			body		domain.CreateDashboardWidgetRequest	true	"create dashboard widget request"
+//	@Success		200				{object}	domain.CreateDashboardWidgetResponse
+//	@Router			/organizations/{organizationId}/dashboards/widgets/custom [post]
+//	@Security		JWT
+func (h *DashboardWidgetHandler) CreateDashboardWidget(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.CreateDashboardWidgetRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	dto := model.DashboardWidget{
+		Name:           input.Name,
+		Description:    input.Description,
+		Query:          input.Query,
+		ChartType:      domain.DashboardWidgetChartType(input.ChartType),
+		Unit:           input.Unit,
+		ThresholdsData: input.Thresholds,
+		Shared:         input.Shared,
+	}
+
+	dashboardWidgetId, err := h.usecase.Create(r.Context(), organizationId, dto)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.CreateDashboardWidgetResponse{ID: dashboardWidgetId.String()})
+}
+
+// ListDashboardWidgets godoc
+//
+//	@Tags			Dashboard Widgets
+//	@Summary		[ListDashboardWidgets] 사용자 정의 위젯 목록 조회
+//	@Description	내가 생성한 위젯과 조직에 공유된 위젯 목록을 조회한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"조직 식별자(o로 시작)"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			sortColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Param			filters			query		[]string	false	"filters"
+//	@Success		200				{object}	domain.ListDashboardWidgetResponse
+//	@Router			/organizations/{organizationId}/dashboards/widgets/custom [get]
+//	@Security		JWT
+func (h *DashboardWidgetHandler) ListDashboardWidgets(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+
+	dashboardWidgets, err := h.usecase.Fetch(r.Context(), organizationId, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.ListDashboardWidgetResponse
+	out.DashboardWidgets = make([]domain.DashboardWidgetResponse, len(*dashboardWidgets))
+	for i, dashboardWidget := range *dashboardWidgets {
+		out.DashboardWidgets[i] = convertDashboardWidgetToResponse(r.Context(), dashboardWidget)
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetDashboardWidget godoc
+//
+//	@Tags			Dashboard Widgets
+//	@Summary		[GetDashboardWidget] 사용자 정의 위젯 조회
+//	@Description	사용자 정의 위젯 정보를 조회한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId		path		string	true	"조직 식별자(o로 시작)"
+//	@Param			dashboardWidgetId	path		string	true	"위젯 식별자(uuid)"
+//	@Success		200					{object}	domain.GetDashboardWidgetResponse
+//	@Router			/organizations/{organizationId}/dashboards/widgets/custom/{dashboardWidgetId} [get]
+//	@Security		JWT
+func (h *DashboardWidgetHandler) GetDashboardWidget(w http.ResponseWriter, r *http.Request) {
+	organizationId, dashboardWidgetId, err := dashboardWidgetPathParams(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	dashboardWidget, err := h.usecase.Get(r.Context(), organizationId, dashboardWidgetId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	out := domain.GetDashboardWidgetResponse{
+		DashboardWidget: convertDashboardWidgetToResponse(r.Context(), *dashboardWidget),
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// UpdateDashboardWidget godoc
+//
+//	@Tags			Dashboard Widgets
+//	@Summary		[UpdateDashboardWidget] 사용자 정의 위젯 수정
+//	@Description	사용자 정의 위젯을 수정한다. 위젯을 생성한 사용자만 수정할 수 있다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId		path		string								true	"조직 식별자(o로 시작)"
+//	@Param			dashboardWidgetId	path		string								true	"위젯 식별자(uuid)"
+//	@Param			body				body		domain.UpdateDashboardWidgetRequest	true	"update dashboard widget request"
+//	@Success		200					{object}	nil
+//	@Router			/organizations/{organizationId}/dashboards/widgets/custom/{dashboardWidgetId} [put]
+//	@Security		JWT
+func (h *DashboardWidgetHandler) UpdateDashboardWidget(w http.ResponseWriter, r *http.Request) {
+	organizationId, dashboardWidgetId, err := dashboardWidgetPathParams(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	input := domain.UpdateDashboardWidgetRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err := h.usecase.Update(r.Context(), organizationId, dashboardWidgetId, input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// DeleteDashboardWidget godoc
+//
+//	@Tags			Dashboard Widgets
+//	@Summary		[DeleteDashboardWidget] 사용자 정의 위젯 삭제
+//	@Description	사용자 정의 위젯을 삭제한다. 위젯을 생성한 사용자만 삭제할 수 있다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId		path		string	true	"조직 식별자(o로 시작)"
+//	@Param			dashboardWidgetId	path		string	true	"위젯 식별자(uuid)"
+//	@Success		200					{object}	nil
+//	@Router			/organizations/{organizationId}/dashboards/widgets/custom/{dashboardWidgetId} [delete]
+//	@Security		JWT
+func (h *DashboardWidgetHandler) DeleteDashboardWidget(w http.ResponseWriter, r *http.Request) {
+	organizationId, dashboardWidgetId, err := dashboardWidgetPathParams(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err := h.usecase.Delete(r.Context(), organizationId, dashboardWidgetId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// GetDashboardWidgetData godoc
+//
+//	@Tags			Dashboard Widgets
+//	@Summary		[GetDashboardWidgetData] 사용자 정의 위젯 데이터 조회
+//	@Description	위젯의 PromQL 을 조직의 클러스터(taco_cluster)로 범위를 제한하여 조회한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId		path		string	true	"조직 식별자(o로 시작)"
+//	@Param			dashboardWidgetId	path		string	true	"위젯 식별자(uuid)"
+//	@Param			duration			query		string	false	"duration"
+//	@Param			interval			query		string	false	"interval"
+//	@Success		200					{object}	domain.GetDashboardWidgetDataResponse
+//	@Router			/organizations/{organizationId}/dashboards/widgets/custom/{dashboardWidgetId}/data [get]
+//	@Security		JWT
+func (h *DashboardWidgetHandler) GetDashboardWidgetData(w http.ResponseWriter, r *http.Request) {
+	organizationId, dashboardWidgetId, err := dashboardWidgetPathParams(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	query := r.URL.Query()
+	duration := query.Get("duration")
+	if duration == "" {
+		duration = "1d" // default
+	}
+	interval := query.Get("interval")
+	if interval == "" {
+		interval = "1h" // default
+	}
+
+	dashboardWidget, data, err := h.usecase.Query(r.Context(), organizationId, dashboardWidgetId, duration, interval)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	out := domain.GetDashboardWidgetDataResponse{
+		DashboardWidget: convertDashboardWidgetToResponse(r.Context(), *dashboardWidget),
+		Data:            data,
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+func dashboardWidgetPathParams(r *http.Request) (organizationId string, dashboardWidgetId uuid.UUID, err error) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+	}
+
+	dashboardWidgetId, err = uuid.Parse(vars["dashboardWidgetId"])
+	if err != nil {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("invalid dashboardWidgetId"), "DW_INVALID_DASHBOARD_WIDGET_ID", "")
+	}
+	return organizationId, dashboardWidgetId, nil
+}
+
+func convertDashboardWidgetToResponse(ctx context.Context, dashboardWidget model.DashboardWidget) (out domain.DashboardWidgetResponse) {
+	out.ID = dashboardWidget.ID.String()
+	out.Name = dashboardWidget.Name
+	out.Description = dashboardWidget.Description
+	out.Query = dashboardWidget.Query
+	out.ChartType = string(dashboardWidget.ChartType)
+	out.Unit = dashboardWidget.Unit
+	out.Thresholds = dashboardWidget.ThresholdsData
+	out.Shared = dashboardWidget.Shared
+	out.CreatedAt = dashboardWidget.CreatedAt
+	out.UpdatedAt = dashboardWidget.UpdatedAt
+
+	if err := serializer.Map(ctx, dashboardWidget.Creator, &out.Creator); err != nil {
+		log.Error(ctx, err)
+	}
+	return
+}
@@ -22,6 +22,12 @@ type IDashboardHandler interface {
 	CreateDashboard(w http.ResponseWriter, r *http.Request)
 	GetDashboard(w http.ResponseWriter, r *http.Request)
 	UpdateDashboard(w http.ResponseWriter, r *http.Request)
+	ResetDashboard(w http.ResponseWriter, r *http.Request)
+	ExportDashboard(w http.ResponseWriter, r *http.Request)
+	ImportDashboard(w http.ResponseWriter, r *http.Request)
+	GetDefaultDashboard(w http.ResponseWriter, r *http.Request)
+	SetDefaultDashboard(w http.ResponseWriter, r *http.Request)
+	DeleteDefaultDashboard(w http.ResponseWriter, r *http.Request)
 	GetCharts(w http.ResponseWriter, r *http.Request)
 	GetChart(w http.ResponseWriter, r *http.Request)
 	GetStacks(w http.ResponseWriter, r *http.Request)
@@ -34,6 +40,7 @@ type IDashboardHandler interface {
 	GetPolicyStatistics(w http.ResponseWriter, r *http.Request)
 	GetWorkload(w http.ResponseWriter, r *http.Request)
 	GetPolicyViolationTop5(w http.ResponseWriter, r *http.Request)
+	GetCost(w http.ResponseWriter, r *http.Request)
 }
 
 type DashboardHandler struct {
@@ -41,6 +48,7 @@ type DashboardHandler struct {
 	organizationUsecase       usecase.IOrganizationUsecase
 	policyUsecase             usecase.IPolicyUsecase
 	systemNotificationUsecase usecase.ISystemNotificationUsecase
+	costUsecase               usecase.ICostUsecase
 }
 
 func NewDashboardHandler(h usecase.Usecase) IDashboardHandler {
@@ -49,6 +57,7 @@ func NewDashboardHandler(h usecase.Usecase) IDashboardHandler {
 		organizationUsecase:       h.Organization,
 		policyUsecase:             h.Policy,
 		systemNotificationUsecase: h.SystemNotification,
+		costUsecase:               h.Cost,
 	}
 }
 
@@ -121,7 +130,7 @@ func (h *DashboardHandler) CreateDashboard(w http.ResponseWriter, r *http.Reques
 //
 //	@Tags			Dashboards
 //	@Summary		Get dashboard
-//	@Description	Get dashboard
+//	@Description	Get dashboard. If the user has no dashboard, the organization default dashboard is returned.
 //	@Accept			json
 //	@Produce		json
 //	@Param			organizationId	path	string	true	"Organization ID"
@@ -157,6 +166,14 @@ func (h *DashboardHandler) GetDashboard(w http.ResponseWriter, r *http.Request)
 		ErrorJSON(w, r, err)
 		return
 	}
+	if dashboard == nil {
+		// 자신의 대시보드가 없는 사용자는 조직의 기본 대시보드를 사용
+		dashboard, err = h.usecase.GetDefaultDashboard(r.Context(), organizationId, dashboardKey)
+		if err != nil {
+			ErrorJSON(w, r, err)
+			return
+		}
+	}
 	if dashboard == nil {
 		ResponseJSON(w, r, http.StatusOK, nil)
 		return
@@ -233,6 +250,256 @@ func (h *DashboardHandler) UpdateDashboard(w http.ResponseWriter, r *http.Reques
 	ResponseJSON(w, r, http.StatusOK, domain.CommonDashboardResponse{Result: "OK"})
 }
 
+// ResetDashboard godoc
+//
+//	@Tags			Dashboards
+//	@Summary		Reset dashboard
+//	@Description	Reset user dashboard to the organization default dashboard. If the user has no dashboard, the default dashboard is copied.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path	string	true	"Organization ID"
+//	@Param			dashboardKey	path	string	true	"Dashboard Key"
+//	@Success		200				{array}	domain.GetDashboardResponse
+//	@Router			/organizations/{organizationId}/dashboards/{dashboardKey}/reset [post]
+//	@Security		JWT
+func (h *DashboardHandler) ResetDashboard(w http.ResponseWriter, r *http.Request) {
+	organizationId, dashboardKey, err := dashboardPathParams(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	requestUserInfo, ok := request.UserFrom(r.Context())
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewUnauthorizedError(fmt.Errorf("failed to retrieve user info from request"), "A_INVALID_TOKEN", ""))
+		return
+	}
+
+	dashboard, err := h.usecase.ResetDashboard(r.Context(), organizationId, requestUserInfo.GetUserId(), dashboardKey)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dashboardRes []domain.GetDashboardResponse
+	if err := UnmarshalFromString(r.Context(), dashboard.Content, &dashboardRes); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+	ResponseJSON(w, r, http.StatusOK, dashboardRes)
+}
+
+// ExportDashboard godoc
+//
+//	@Tags			Dashboards
+//	@Summary		Export dashboard layout
+//	@Description	Export user dashboard layout as JSON file. If the user has no dashboard, the organization default dashboard is exported.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"Organization ID"
+//	@Param			dashboardKey	path		string	true	"Dashboard Key"
+//	@Success		200				{object}	domain.DashboardLayout
+//	@Router			/organizations/{organizationId}/dashboards/{dashboardKey}/export [get]
+//	@Security		JWT
+func (h *DashboardHandler) ExportDashboard(w http.ResponseWriter, r *http.Request) {
+	organizationId, dashboardKey, err := dashboardPathParams(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	requestUserInfo, ok := request.UserFrom(r.Context())
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewUnauthorizedError(fmt.Errorf("failed to retrieve user info from request"), "A_INVALID_TOKEN", ""))
+		return
+	}
+
+	dashboard, err := h.usecase.GetDashboard(r.Context(), organizationId, requestUserInfo.GetUserId().String(), dashboardKey)
+	if err == nil && dashboard == nil {
+		dashboard, err = h.usecase.GetDefaultDashboard(r.Context(), organizationId, dashboardKey)
+	}
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	out := domain.DashboardLayout{
+		Version:      domain.DashboardLayoutVersion,
+		DashboardKey: dashboardKey,
+		Contents:     []domain.DashboardContents{},
+		ExportedAt:   time.Now(),
+	}
+	if dashboard != nil && len(dashboard.Content) > 0 {
+		if err := UnmarshalFromString(r.Context(), dashboard.Content, &out.Contents); err != nil {
+			ErrorJSON(w, r, err)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"dashboard-%s.json\"", dashboardKey))
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// ImportDashboard godoc
+//
+//	@Tags			Dashboards
+//	@Summary		Import dashboard layout
+//	@Description	Import dashboard layout exported by ExportDashboard into user dashboard. The existing layout is replaced.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string					true	"Organization ID"
+//	@Param			dashboardKey	path		string					true	"Dashboard Key"
+//	@Param			request			body		domain.DashboardLayout	true	"Exported dashboard layout"
+//	@Success		200				{object}	domain.CommonDashboardResponse
+//	@Router			/organizations/{organizationId}/dashboards/{dashboardKey}/import [post]
+//	@Security		JWT
+func (h *DashboardHandler) ImportDashboard(w http.ResponseWriter, r *http.Request) {
+	organizationId, dashboardKey, err := dashboardPathParams(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var layout domain.DashboardLayout
+	if err := UnmarshalRequestInput(r, &layout); err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(err, "D_INVALID_DASHBOARD_LAYOUT", ""))
+		return
+	}
+	content, err := MarshalToString(r.Context(), layout.Contents)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	requestUserInfo, ok := request.UserFrom(r.Context())
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewUnauthorizedError(fmt.Errorf("failed to retrieve user info from request"), "A_INVALID_TOKEN", ""))
+		return
+	}
+
+	if err := h.usecase.SaveDashboard(r.Context(), organizationId, requestUserInfo.GetUserId(), dashboardKey, content); err != nil {
+		ErrorJSON(w, r, httpErrors.NewInternalServerError(err, "", ""))
+		return
+	}
+	ResponseJSON(w, r, http.StatusOK, domain.CommonDashboardResponse{Result: "OK"})
+}
+
+// GetDefaultDashboard godoc
+//
+//	@Tags			Dashboards
+//	@Summary		Get organization default dashboard
+//	@Description	Get organization default dashboard
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path	string	true	"Organization ID"
+//	@Param			dashboardKey	path	string	true	"Dashboard Key"
+//	@Success		200				{array}	domain.GetDashboardResponse
+//	@Router			/organizations/{organizationId}/dashboards/{dashboardKey}/default [get]
+//	@Security		JWT
+func (h *DashboardHandler) GetDefaultDashboard(w http.ResponseWriter, r *http.Request) {
+	organizationId, dashboardKey, err := dashboardPathParams(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	dashboard, err := h.usecase.GetDefaultDashboard(r.Context(), organizationId, dashboardKey)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+	if dashboard == nil {
+		ErrorJSON(w, r, httpErrors.NewNotFoundError(fmt.Errorf("not found default dashboard"), "D_NOT_FOUND_DEFAULT_DASHBOARD", ""))
+		return
+	}
+
+	dashboardRes := []domain.GetDashboardResponse{}
+	if len(dashboard.Content) > 0 {
+		if err := UnmarshalFromString(r.Context(), dashboard.Content, &dashboardRes); err != nil {
+			ErrorJSON(w, r, err)
+			return
+		}
+	}
+	ResponseJSON(w, r, http.StatusOK, dashboardRes)
+}
+
+// SetDefaultDashboard godoc
+//
+//	@Tags			Dashboards
+//	@Summary		Set organization default dashboard
+//	@Description	Publish organization default dashboard. Users without their own dashboard see the default dashboard.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string								true	"Organization ID"
+//	@Param			dashboardKey	path		string								true	"Dashboard Key"
+//	@Param			request			body		domain.SetDefaultDashboardRequest	true	"Request body to set default dashboard"
+//	@Success		200				{object}	domain.CommonDashboardResponse
+//	@Router			/organizations/{organizationId}/dashboards/{dashboardKey}/default [put]
+//	@Security		JWT
+func (h *DashboardHandler) SetDefaultDashboard(w http.ResponseWriter, r *http.Request) {
+	organizationId, dashboardKey, err := dashboardPathParams(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var input domain.SetDefaultDashboardRequest
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+	content, err := MarshalToString(r.Context(), input.Contents)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err := h.usecase.SetDefaultDashboard(r.Context(), organizationId, dashboardKey, content); err != nil {
+		ErrorJSON(w, r, httpErrors.NewInternalServerError(err, "", ""))
+		return
+	}
+	ResponseJSON(w, r, http.StatusOK, domain.CommonDashboardResponse{Result: "OK"})
+}
+
+// DeleteDefaultDashboard godoc
+//
+//	@Tags			Dashboards
+//	@Summary		Delete organization default dashboard
+//	@Description	Delete organization default dashboard. Dashboards already forked by users are kept.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"Organization ID"
+//	@Param			dashboardKey	path		string	true	"Dashboard Key"
+//	@Success		200				{object}	domain.CommonDashboardResponse
+//	@Router			/organizations/{organizationId}/dashboards/{dashboardKey}/default [delete]
+//	@Security		JWT
+func (h *DashboardHandler) DeleteDefaultDashboard(w http.ResponseWriter, r *http.Request) {
+	organizationId, dashboardKey, err := dashboardPathParams(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err := h.usecase.DeleteDefaultDashboard(r.Context(), organizationId, dashboardKey); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+	ResponseJSON(w, r, http.StatusOK, domain.CommonDashboardResponse{Result: "OK"})
+}
+
+func dashboardPathParams(r *http.Request) (organizationId string, dashboardKey string, err error) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		return "", "", httpErrors.NewBadRequestError(fmt.Errorf("%s: invalid organizationId", organizationId), "C_INVALID_ORGANIZATION_ID", "")
+	}
+	dashboardKey, ok = vars["dashboardKey"]
+	if !ok {
+		return "", "", httpErrors.NewBadRequestError(fmt.Errorf("%s: invalid dashboardKey", dashboardKey), "", "")
+	}
+	return organizationId, dashboardKey, nil
+}
+
 // GetCharts godoc
 //
 //	@Tags			Dashboard Widgets
@@ -811,3 +1078,33 @@ func (h *DashboardHandler) GetPolicyViolationTop5(w http.ResponseWriter, r *http
 	out.UpdatedAt = time.Now()
 	ResponseJSON(w, r, http.StatusOK, out)
 }
+
+// GetCost godoc
+//
+//	@Tags			Dashboard Widgets
+//	@Summary		Get monthly cost
+//	@Description	Get monthly cost of stacks and projects
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"Organization ID"
+//	@Success		200				{object}	domain.GetDashboardCostResponse
+//	@Router			/organizations/{organizationId}/dashboards/widgets/cost [get]
+//	@Security		JWT
+func (h *DashboardHandler) GetCost(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	cost, err := h.costUsecase.GetDashboardCost(r.Context(), organizationId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetDashboardCostResponse
+	out.Cost = cost
+	ResponseJSON(w, r, http.StatusOK, out)
+}
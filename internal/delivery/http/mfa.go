@@ -0,0 +1,272 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type MfaHandler struct {
+	usecase usecase.IMfaUsecase
+}
+
+func NewMfaHandler(h usecase.Usecase) *MfaHandler {
+	return &MfaHandler{
+		usecase: h.Mfa,
+	}
+}
+
+// GetMyMfa godoc
+//
+//	@Tags			My-profile
+//	@Summary		Get my MFA status
+//	@Description	Get my MFA status
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Success		200				{object}	domain.GetMyMfaResponse
+//	@Router			/organizations/{organizationId}/my-profile/mfa [get]
+//	@Security		JWT
+func (h *MfaHandler) GetMyMfa(w http.ResponseWriter, r *http.Request) {
+	mfa, err := h.usecase.GetMyMfa(r.Context())
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	out := domain.GetMyMfaResponse{
+		Enabled:               mfa.Enabled,
+		EnabledAt:             mfa.EnabledAt,
+		RemainingRecoveryCode: len(mfa.RecoveryCodeHashs),
+	}
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// EnrollMfa godoc
+//
+//	@Tags			My-profile
+//	@Summary		Enroll TOTP authenticator
+//	@Description	Issue new TOTP secret. MFA is enabled after confirming a code generated by the authenticator.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Success		200				{object}	domain.EnrollMfaResponse
+//	@Router			/organizations/{organizationId}/my-profile/mfa/enroll [post]
+//	@Security		JWT
+func (h *MfaHandler) EnrollMfa(w http.ResponseWriter, r *http.Request) {
+	secret, provisioningUri, err := h.usecase.Enroll(r.Context())
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	out := domain.EnrollMfaResponse{
+		Secret:          secret,
+		ProvisioningUri: provisioningUri,
+	}
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// ConfirmMfa godoc
+//
+//	@Tags			My-profile
+//	@Summary		Confirm TOTP authenticator
+//	@Description	Enable MFA and return recovery codes. The recovery codes are returned only once.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string					true	"organizationId"
+//	@Param			body			body		domain.MfaCodeRequest	true	"TOTP code"
+//	@Success		200				{object}	domain.MfaRecoveryCodesResponse
+//	@Router			/organizations/{organizationId}/my-profile/mfa/confirm [post]
+//	@Security		JWT
+func (h *MfaHandler) ConfirmMfa(w http.ResponseWriter, r *http.Request) {
+	input := domain.MfaCodeRequest{}
+	err := UnmarshalRequestInput(r, &input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	recoveryCodes, err := h.usecase.Confirm(r.Context(), input.Code)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	out := domain.MfaRecoveryCodesResponse{
+		RecoveryCodes: recoveryCodes,
+	}
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// DisableMfa godoc
+//
+//	@Tags			My-profile
+//	@Summary		Disable MFA
+//	@Description	Disable MFA with TOTP code or recovery code
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string					true	"organizationId"
+//	@Param			body			body		domain.MfaCodeRequest	true	"TOTP code or recovery code"
+//	@Success		200				{object}	nil
+//	@Router			/organizations/{organizationId}/my-profile/mfa/disable [post]
+//	@Security		JWT
+func (h *MfaHandler) DisableMfa(w http.ResponseWriter, r *http.Request) {
+	input := domain.MfaCodeRequest{}
+	err := UnmarshalRequestInput(r, &input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err = h.usecase.Disable(r.Context(), input.Code); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// RegenerateMfaRecoveryCodes godoc
+//
+//	@Tags			My-profile
+//	@Summary		Regenerate MFA recovery codes
+//	@Description	Regenerate MFA recovery codes. Previous recovery codes are invalidated.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string					true	"organizationId"
+//	@Param			body			body		domain.MfaCodeRequest	true	"TOTP code"
+//	@Success		200				{object}	domain.MfaRecoveryCodesResponse
+//	@Router			/organizations/{organizationId}/my-profile/mfa/recovery-codes [post]
+//	@Security		JWT
+func (h *MfaHandler) RegenerateMfaRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	input := domain.MfaCodeRequest{}
+	err := UnmarshalRequestInput(r, &input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	recoveryCodes, err := h.usecase.RegenerateRecoveryCodes(r.Context(), input.Code)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	out := domain.MfaRecoveryCodesResponse{
+		RecoveryCodes: recoveryCodes,
+	}
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// VerifyMfa godoc
+//
+//	@Tags			My-profile
+//	@Summary		Step-up verification
+//	@Description	Verify TOTP code or recovery code for the current session. Required before calling sensitive APIs.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string					true	"organizationId"
+//	@Param			body			body		domain.MfaCodeRequest	true	"TOTP code or recovery code"
+//	@Success		200				{object}	domain.VerifyMfaResponse
+//	@Router			/organizations/{organizationId}/my-profile/mfa/verify [post]
+//	@Security		JWT
+func (h *MfaHandler) VerifyMfa(w http.ResponseWriter, r *http.Request) {
+	input := domain.MfaCodeRequest{}
+	err := UnmarshalRequestInput(r, &input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	expiredAt, err := h.usecase.VerifyStepUp(r.Context(), input.Code)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	out := domain.VerifyMfaResponse{
+		ExpiredAt: expiredAt,
+	}
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetMfaPolicy godoc
+//
+//	@Tags			MfaPolicy
+//	@Summary		Get MFA policy of organization
+//	@Description	Get MFA policy of organization
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Success		200				{object}	domain.GetMfaPolicyResponse
+//	@Router			/organizations/{organizationId}/mfa-policy [get]
+//	@Security		JWT
+func (h *MfaHandler) GetMfaPolicy(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	policy, err := h.usecase.GetPolicy(r.Context(), organizationId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetMfaPolicyResponse
+	if err := serializer.Map(r.Context(), policy, &out.MfaPolicy); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// UpdateMfaPolicy godoc
+//
+//	@Tags			MfaPolicy
+//	@Summary		Update MFA policy of organization
+//	@Description	Require MFA for all users or for users having specific roles
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string							true	"organizationId"
+//	@Param			body			body		domain.UpdateMfaPolicyRequest	true	"update MFA policy request"
+//	@Success		200				{object}	nil
+//	@Router			/organizations/{organizationId}/mfa-policy [put]
+//	@Security		JWT
+func (h *MfaHandler) UpdateMfaPolicy(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.UpdateMfaPolicyRequest{}
+	err := UnmarshalRequestInput(r, &input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	dto := model.MfaPolicy{
+		OrganizationId: organizationId,
+		Required:       input.Required,
+		RoleIds:        input.RoleIds,
+	}
+	if err = h.usecase.UpdatePolicy(r.Context(), dto); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
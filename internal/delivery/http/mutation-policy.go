@@ -0,0 +1,302 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type MutationPolicyHandler struct {
+	usecase usecase.IMutationPolicyUsecase
+}
+
+type IMutationPolicyHandler interface {
+	ListMutationPolicyTemplates(w http.ResponseWriter, r *http.Request)
+	CreateMutationPolicy(w http.ResponseWriter, r *http.Request)
+	ListMutationPolicies(w http.ResponseWriter, r *http.Request)
+	GetMutationPolicy(w http.ResponseWriter, r *http.Request)
+	UpdateMutationPolicy(w http.ResponseWriter, r *http.Request)
+	DeleteMutationPolicy(w http.ResponseWriter, r *http.Request)
+}
+
+func NewMutationPolicyHandler(u usecase.Usecase) IMutationPolicyHandler {
+	return &MutationPolicyHandler{
+		usecase: u.MutationPolicy,
+	}
+}
+
+// ListMutationPolicyTemplates godoc
+//
+//	@Tags			MutationPolicy
+//	@Summary		[ListMutationPolicyTemplates] 뮤테이션 정책 템플릿 목록 조회
+//	@Description	뮤테이션 정책 유형별 설명과 파라미터 스키마, 생성되는 Gatekeeper 뮤테이션 리소스 종류를 조회한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"조직 식별자(o로 시작)"
+//	@Success		200				{object}	domain.ListMutationPolicyTemplatesResponse
+//	@Router			/organizations/{organizationId}/mutation-policy-templates [get]
+//	@Security		JWT
+func (h *MutationPolicyHandler) ListMutationPolicyTemplates(w http.ResponseWriter, r *http.Request) {
+	templates := h.usecase.ListTemplates(r.Context())
+
+	var out domain.ListMutationPolicyTemplatesResponse
+	out.MutationPolicyTemplates = make([]domain.MutationPolicyTemplateResponse, len(templates))
+	for i, template := range templates {
+		out.MutationPolicyTemplates[i] = domain.MutationPolicyTemplateResponse{
+			Kind:             string(template.Kind),
+			Name:             template.Name,
+			Description:      template.Description,
+			MutatorKinds:     template.MutatorKinds,
+			ParametersSchema: template.ParametersSchema,
+		}
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// CreateMutationPolicy godoc
+//
+//	@Tags			MutationPolicy
+//	@Summary		[CreateMutationPolicy] 뮤테이션 정책 생성
+//	@Description	뮤테이션 정책을 생성하고 대상 클러스터에 Gatekeeper Assign/AssignMetadata 리소스로 적용한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string								true	"조직 식별자(o로 시작)"
+//	@Param			body			body		domain.CreateMutationPolicyRequest	true	"create mutation policy request"
+//	@Success		200				{object}	domain.CreateMutationPolicyResponse
+//	@Router			/organizations/{organizationId}/mutation-policies [post]
+//	@Security		JWT
+func (h *MutationPolicyHandler) CreateMutationPolicy(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.CreateMutationPolicyRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	dto := model.MutationPolicy{
+		PolicyName:         input.PolicyName,
+		PolicyResourceName: input.PolicyResourceName,
+		Description:        input.Description,
+		Kind:               domain.MutationPolicyKind(input.Kind),
+		TargetClusterIds:   input.TargetClusterIds,
+		Parameters:         input.Parameters,
+		Match:              input.Match,
+	}
+
+	mutationPolicyId, err := h.usecase.Create(r.Context(), organizationId, dto)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.CreateMutationPolicyResponse{ID: mutationPolicyId.String()})
+}
+
+// ListMutationPolicies godoc
+//
+//	@Tags			MutationPolicy
+//	@Summary		[ListMutationPolicies] 뮤테이션 정책 목록 조회
+//	@Description	뮤테이션 정책 목록을 조회한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"조직 식별자(o로 시작)"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			sortColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Param			filters			query		[]string	false	"filters"
+//	@Success		200				{object}	domain.ListMutationPolicyResponse
+//	@Router			/organizations/{organizationId}/mutation-policies [get]
+//	@Security		JWT
+func (h *MutationPolicyHandler) ListMutationPolicies(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+
+	mutationPolicies, err := h.usecase.Fetch(r.Context(), organizationId, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.ListMutationPolicyResponse
+	out.MutationPolicies = make([]domain.MutationPolicyResponse, len(*mutationPolicies))
+	for i, mutationPolicy := range *mutationPolicies {
+		out.MutationPolicies[i] = convertMutationPolicyToResponse(r.Context(), mutationPolicy)
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetMutationPolicy godoc
+//
+//	@Tags			MutationPolicy
+//	@Summary		[GetMutationPolicy] 뮤테이션 정책 조회
+//	@Description	뮤테이션 정책 정보를 조회한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId		path		string	true	"조직 식별자(o로 시작)"
+//	@Param			mutationPolicyId	path		string	true	"뮤테이션 정책 식별자(uuid)"
+//	@Success		200					{object}	domain.GetMutationPolicyResponse
+//	@Router			/organizations/{organizationId}/mutation-policies/{mutationPolicyId} [get]
+//	@Security		JWT
+func (h *MutationPolicyHandler) GetMutationPolicy(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	mutationPolicyId, err := uuid.Parse(vars["mutationPolicyId"])
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid mutationPolicyId"), "MP_INVALID_MUTATION_POLICY_ID", ""))
+		return
+	}
+
+	mutationPolicy, err := h.usecase.Get(r.Context(), organizationId, mutationPolicyId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	out := domain.GetMutationPolicyResponse{
+		MutationPolicy: convertMutationPolicyToResponse(r.Context(), *mutationPolicy),
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// UpdateMutationPolicy godoc
+//
+//	@Tags			MutationPolicy
+//	@Summary		[UpdateMutationPolicy] 뮤테이션 정책 수정
+//	@Description	뮤테이션 정책을 수정하고 대상 클러스터의 뮤테이션 리소스에 반영한다. 대상에서 제외된 클러스터의 리소스는 삭제된다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId		path		string								true	"조직 식별자(o로 시작)"
+//	@Param			mutationPolicyId	path		string								true	"뮤테이션 정책 식별자(uuid)"
+//	@Param			body				body		domain.UpdateMutationPolicyRequest	true	"update mutation policy request"
+//	@Success		200					{object}	nil
+//	@Router			/organizations/{organizationId}/mutation-policies/{mutationPolicyId} [put]
+//	@Security		JWT
+func (h *MutationPolicyHandler) UpdateMutationPolicy(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	mutationPolicyId, err := uuid.Parse(vars["mutationPolicyId"])
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid mutationPolicyId"), "MP_INVALID_MUTATION_POLICY_ID", ""))
+		return
+	}
+
+	input := domain.UpdateMutationPolicyRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err := h.usecase.Update(r.Context(), organizationId, mutationPolicyId, input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// DeleteMutationPolicy godoc
+//
+//	@Tags			MutationPolicy
+//	@Summary		[DeleteMutationPolicy] 뮤테이션 정책 삭제
+//	@Description	뮤테이션 정책과 대상 클러스터의 뮤테이션 리소스를 삭제한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId		path		string	true	"조직 식별자(o로 시작)"
+//	@Param			mutationPolicyId	path		string	true	"뮤테이션 정책 식별자(uuid)"
+//	@Success		200					{object}	nil
+//	@Router			/organizations/{organizationId}/mutation-policies/{mutationPolicyId} [delete]
+//	@Security		JWT
+func (h *MutationPolicyHandler) DeleteMutationPolicy(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	mutationPolicyId, err := uuid.Parse(vars["mutationPolicyId"])
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid mutationPolicyId"), "MP_INVALID_MUTATION_POLICY_ID", ""))
+		return
+	}
+
+	if err := h.usecase.Delete(r.Context(), organizationId, mutationPolicyId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+func convertMutationPolicyToResponse(ctx context.Context, mutationPolicy model.MutationPolicy) (out domain.MutationPolicyResponse) {
+	out.ID = mutationPolicy.ID.String()
+	out.CreatedAt = mutationPolicy.CreatedAt
+	out.UpdatedAt = mutationPolicy.UpdatedAt
+	out.PolicyName = mutationPolicy.PolicyName
+	out.PolicyResourceName = mutationPolicy.PolicyResourceName
+	out.Description = mutationPolicy.Description
+	out.Kind = string(mutationPolicy.Kind)
+	out.Parameters = mutationPolicy.Parameters
+	out.Match = mutationPolicy.Match
+
+	out.TargetClusters = make([]domain.SimpleClusterResponse, len(mutationPolicy.TargetClusters))
+	for i, targetCluster := range mutationPolicy.TargetClusters {
+		if err := serializer.Map(ctx, targetCluster, &out.TargetClusters[i]); err != nil {
+			log.Error(ctx, err)
+		}
+	}
+
+	if err := serializer.Map(ctx, mutationPolicy.Creator, &out.Creator); err != nil {
+		log.Error(ctx, err)
+	}
+	if err := serializer.Map(ctx, mutationPolicy.Updator, &out.Updator); err != nil {
+		log.Error(ctx, err)
+	}
+	return
+}
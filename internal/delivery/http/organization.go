@@ -416,6 +416,91 @@ func (h *OrganizationHandler) UpdatePrimaryCluster(w http.ResponseWriter, r *htt
 	ResponseJSON(w, r, http.StatusOK, nil)
 }
 
+// GetPasswordPolicy godoc
+//
+//	@Tags			Organizations
+//	@Summary		Get password policy of organization
+//	@Description	Get password policy of organization
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Success		200				{object}	domain.GetPasswordPolicyResponse
+//	@Router			/organizations/{organizationId}/password-policy [get]
+//	@Security		JWT
+func (h *OrganizationHandler) GetPasswordPolicy(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	policy, err := h.usecase.GetPasswordPolicy(r.Context(), organizationId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetPasswordPolicyResponse
+	out.PasswordPolicy = domain.PasswordPolicyResponse{
+		MinLength:        policy.MinLength,
+		RequireUppercase: policy.RequireUppercase,
+		RequireLowercase: policy.RequireLowercase,
+		RequireDigit:     policy.RequireDigit,
+		RequireSpecial:   policy.RequireSpecial,
+		HistoryDepth:     policy.HistoryDepth,
+		ExpiryDays:       policy.ExpiryDays,
+		ReuseBanned:      policy.ReuseBanned,
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// UpdatePasswordPolicy godoc
+//
+//	@Tags			Organizations
+//	@Summary		Update password policy of organization
+//	@Description	Update password policy of organization. The policy is also applied to the keycloak realm.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string								true	"organizationId"
+//	@Param			body			body		domain.UpdatePasswordPolicyRequest	true	"update password policy request"
+//	@Success		200				{object}	nil
+//	@Router			/organizations/{organizationId}/password-policy [put]
+//	@Security		JWT
+func (h *OrganizationHandler) UpdatePasswordPolicy(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.UpdatePasswordPolicyRequest{}
+	err := UnmarshalRequestInput(r, &input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	policy := model.PasswordPolicy{
+		MinLength:        input.MinLength,
+		RequireUppercase: input.RequireUppercase,
+		RequireLowercase: input.RequireLowercase,
+		RequireDigit:     input.RequireDigit,
+		RequireSpecial:   input.RequireSpecial,
+		HistoryDepth:     input.HistoryDepth,
+		ExpiryDays:       input.ExpiryDays,
+		ReuseBanned:      input.ReuseBanned,
+	}
+	if err = h.usecase.UpdatePasswordPolicy(r.Context(), organizationId, policy); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
 // CheckOrganizationName godoc
 //
 //	@Tags			Organizations
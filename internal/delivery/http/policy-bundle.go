@@ -0,0 +1,430 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type PolicyBundleHandler struct {
+	usecase usecase.IPolicyBundleUsecase
+}
+
+type IPolicyBundleHandler interface {
+	CreatePolicyBundle(w http.ResponseWriter, r *http.Request)
+	ListPolicyBundles(w http.ResponseWriter, r *http.Request)
+	GetPolicyBundle(w http.ResponseWriter, r *http.Request)
+	UpdatePolicyBundle(w http.ResponseWriter, r *http.Request)
+	DeletePolicyBundle(w http.ResponseWriter, r *http.Request)
+	PreviewPolicyBundleUpdate(w http.ResponseWriter, r *http.Request)
+	AssignPolicyBundleToStack(w http.ResponseWriter, r *http.Request)
+	UnassignPolicyBundleFromStack(w http.ResponseWriter, r *http.Request)
+}
+
+func NewPolicyBundleHandler(u usecase.Usecase) IPolicyBundleHandler {
+	return &PolicyBundleHandler{
+		usecase: u.PolicyBundle,
+	}
+}
+
+// CreatePolicyBundle godoc
+//
+//	@Tags			PolicyBundle
+//	@Summary		[CreatePolicyBundle] 정책 번들 생성
+//	@Description	정책과 정책별 파라미터, 적용 액션 프리셋으로 구성된 정책 번들을 생성한다. 프리셋은 생성 시 정책에 반영된다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string								true	"조직 식별자(o로 시작)"
+//	@Param			body			body		domain.CreatePolicyBundleRequest	true	"create policy bundle request"
+//	@Success		200				{object}	domain.CreatePolicyBundleResponse
+//	@Router			/organizations/{organizationId}/policy-bundles [post]
+//	@Security		JWT
+func (h *PolicyBundleHandler) CreatePolicyBundle(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.CreatePolicyBundleRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	items, err := convertPolicyBundleItemRequests(input.Items)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	dto := model.PolicyBundle{
+		Name:        input.Name,
+		Description: input.Description,
+		Items:       items,
+	}
+
+	policyBundleId, err := h.usecase.Create(r.Context(), organizationId, dto)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.CreatePolicyBundleResponse{ID: policyBundleId.String()})
+}
+
+// ListPolicyBundles godoc
+//
+//	@Tags			PolicyBundle
+//	@Summary		[ListPolicyBundles] 정책 번들 목록 조회
+//	@Description	조직의 정책 번들 목록을 조회한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"조직 식별자(o로 시작)"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			sortColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Param			filters			query		[]string	false	"filters"
+//	@Success		200				{object}	domain.ListPolicyBundleResponse
+//	@Router			/organizations/{organizationId}/policy-bundles [get]
+//	@Security		JWT
+func (h *PolicyBundleHandler) ListPolicyBundles(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+
+	policyBundles, err := h.usecase.Fetch(r.Context(), organizationId, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.ListPolicyBundleResponse
+	out.PolicyBundles = make([]domain.PolicyBundleResponse, len(*policyBundles))
+	for i, policyBundle := range *policyBundles {
+		out.PolicyBundles[i] = convertPolicyBundleToResponse(r.Context(), policyBundle)
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetPolicyBundle godoc
+//
+//	@Tags			PolicyBundle
+//	@Summary		[GetPolicyBundle] 정책 번들 조회
+//	@Description	정책 번들의 항목과 번들이 할당된 스택을 조회한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"조직 식별자(o로 시작)"
+//	@Param			policyBundleId	path		string	true	"정책 번들 식별자(uuid)"
+//	@Success		200				{object}	domain.GetPolicyBundleResponse
+//	@Router			/organizations/{organizationId}/policy-bundles/{policyBundleId} [get]
+//	@Security		JWT
+func (h *PolicyBundleHandler) GetPolicyBundle(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	policyBundleId, err := uuid.Parse(vars["policyBundleId"])
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid policyBundleId"), "PB_INVALID_POLICY_BUNDLE_ID", ""))
+		return
+	}
+
+	policyBundle, err := h.usecase.Get(r.Context(), organizationId, policyBundleId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	out := domain.GetPolicyBundleResponse{
+		PolicyBundle: convertPolicyBundleToResponse(r.Context(), *policyBundle),
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// UpdatePolicyBundle godoc
+//
+//	@Tags			PolicyBundle
+//	@Summary		[UpdatePolicyBundle] 정책 번들 수정
+//	@Description	정책 번들을 수정하고 추가, 제거된 정책과 변경된 프리셋을 번들이 할당된 모든 스택에 반영한다. 반영 대상은 PreviewPolicyBundleUpdate 로 미리 확인할 수 있다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string								true	"조직 식별자(o로 시작)"
+//	@Param			policyBundleId	path		string								true	"정책 번들 식별자(uuid)"
+//	@Param			body			body		domain.UpdatePolicyBundleRequest	true	"update policy bundle request"
+//	@Success		200				{object}	nil
+//	@Router			/organizations/{organizationId}/policy-bundles/{policyBundleId} [put]
+//	@Security		JWT
+func (h *PolicyBundleHandler) UpdatePolicyBundle(w http.ResponseWriter, r *http.Request) {
+	organizationId, dto, err := h.parseUpdatePolicyBundleRequest(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err := h.usecase.Update(r.Context(), organizationId, dto); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// DeletePolicyBundle godoc
+//
+//	@Tags			PolicyBundle
+//	@Summary		[DeletePolicyBundle] 정책 번들 삭제
+//	@Description	정책 번들을 삭제한다. 스택에 할당된 번들은 할당을 해제한 후 삭제할 수 있다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"조직 식별자(o로 시작)"
+//	@Param			policyBundleId	path		string	true	"정책 번들 식별자(uuid)"
+//	@Success		200				{object}	nil
+//	@Router			/organizations/{organizationId}/policy-bundles/{policyBundleId} [delete]
+//	@Security		JWT
+func (h *PolicyBundleHandler) DeletePolicyBundle(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	policyBundleId, err := uuid.Parse(vars["policyBundleId"])
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid policyBundleId"), "PB_INVALID_POLICY_BUNDLE_ID", ""))
+		return
+	}
+
+	if err := h.usecase.Delete(r.Context(), organizationId, policyBundleId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// PreviewPolicyBundleUpdate godoc
+//
+//	@Tags			PolicyBundle
+//	@Summary		[PreviewPolicyBundleUpdate] 정책 번들 수정 영향 미리보기
+//	@Description	정책 번들을 수정하지 않고, 수정 요청을 반영했을 때 영향을 받는 클러스터와 클러스터별로 추가, 제거, 변경되는 정책을 조회한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string								true	"조직 식별자(o로 시작)"
+//	@Param			policyBundleId	path		string								true	"정책 번들 식별자(uuid)"
+//	@Param			body			body		domain.UpdatePolicyBundleRequest	true	"update policy bundle request"
+//	@Success		200				{object}	domain.PreviewPolicyBundleUpdateResponse
+//	@Router			/organizations/{organizationId}/policy-bundles/{policyBundleId}/preview [post]
+//	@Security		JWT
+func (h *PolicyBundleHandler) PreviewPolicyBundleUpdate(w http.ResponseWriter, r *http.Request) {
+	organizationId, dto, err := h.parseUpdatePolicyBundleRequest(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	affectedClusters, err := h.usecase.PreviewUpdate(r.Context(), organizationId, dto)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.PreviewPolicyBundleUpdateResponse{AffectedClusters: affectedClusters})
+}
+
+// AssignPolicyBundleToStack godoc
+//
+//	@Tags			PolicyBundle
+//	@Summary		[AssignPolicyBundleToStack] 스택에 정책 번들 할당
+//	@Description	정책 번들의 정책을 스택에 적용하고 이후 번들 변경 사항이 스택에 반영되도록 할당한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"조직 식별자(o로 시작)"
+//	@Param			stackId			path		string	true	"스택 식별자"
+//	@Param			policyBundleId	path		string	true	"정책 번들 식별자(uuid)"
+//	@Success		200				{object}	nil
+//	@Router			/organizations/{organizationId}/stacks/{stackId}/policy-bundles/{policyBundleId} [post]
+//	@Security		JWT
+func (h *PolicyBundleHandler) AssignPolicyBundleToStack(w http.ResponseWriter, r *http.Request) {
+	organizationId, stackId, policyBundleId, err := parseStackPolicyBundlePath(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err := h.usecase.AssignToStack(r.Context(), organizationId, policyBundleId, domain.ClusterId(stackId)); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// UnassignPolicyBundleFromStack godoc
+//
+//	@Tags			PolicyBundle
+//	@Summary		[UnassignPolicyBundleFromStack] 스택의 정책 번들 할당 해제
+//	@Description	스택의 정책 번들 할당을 해제하고 번들의 정책을 스택에서 제거한다. 스택에 할당된 다른 번들에 포함된 정책은 유지된다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"조직 식별자(o로 시작)"
+//	@Param			stackId			path		string	true	"스택 식별자"
+//	@Param			policyBundleId	path		string	true	"정책 번들 식별자(uuid)"
+//	@Success		200				{object}	nil
+//	@Router			/organizations/{organizationId}/stacks/{stackId}/policy-bundles/{policyBundleId} [delete]
+//	@Security		JWT
+func (h *PolicyBundleHandler) UnassignPolicyBundleFromStack(w http.ResponseWriter, r *http.Request) {
+	organizationId, stackId, policyBundleId, err := parseStackPolicyBundlePath(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err := h.usecase.UnassignFromStack(r.Context(), organizationId, policyBundleId, domain.ClusterId(stackId)); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+func (h *PolicyBundleHandler) parseUpdatePolicyBundleRequest(r *http.Request) (organizationId string, dto model.PolicyBundle, err error) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		return "", dto, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+	}
+
+	policyBundleId, err := uuid.Parse(vars["policyBundleId"])
+	if err != nil {
+		return "", dto, httpErrors.NewBadRequestError(fmt.Errorf("invalid policyBundleId"), "PB_INVALID_POLICY_BUNDLE_ID", "")
+	}
+
+	input := domain.UpdatePolicyBundleRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		return "", dto, err
+	}
+
+	items, err := convertPolicyBundleItemRequests(input.Items)
+	if err != nil {
+		return "", dto, err
+	}
+
+	dto = model.PolicyBundle{
+		ID:          policyBundleId,
+		Name:        input.Name,
+		Description: input.Description,
+		Items:       items,
+	}
+	return organizationId, dto, nil
+}
+
+func parseStackPolicyBundlePath(r *http.Request) (organizationId string, stackId string, policyBundleId uuid.UUID, err error) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		return "", "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+	}
+
+	stackId, ok = vars["stackId"]
+	if !ok {
+		return "", "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("invalid stackId"), "C_INVALID_STACK_ID", "")
+	}
+
+	policyBundleId, err = uuid.Parse(vars["policyBundleId"])
+	if err != nil {
+		return "", "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("invalid policyBundleId"), "PB_INVALID_POLICY_BUNDLE_ID", "")
+	}
+	return organizationId, stackId, policyBundleId, nil
+}
+
+func convertPolicyBundleItemRequests(items []domain.PolicyBundleItemRequest) ([]model.PolicyBundleItem, error) {
+	out := make([]model.PolicyBundleItem, len(items))
+	for i, item := range items {
+		policyId, err := uuid.Parse(item.PolicyId)
+		if err != nil {
+			return nil, httpErrors.NewBadRequestError(err, "C_INVALID_POLICY_ID", "")
+		}
+		out[i] = model.PolicyBundleItem{
+			PolicyId:          policyId,
+			EnforcementAction: item.EnforcementAction,
+			Parameters:        item.Parameters,
+		}
+	}
+	return out, nil
+}
+
+// 번들 항목의 적용 액션, 파라미터는 프리셋이 없으면 정책에 설정된 값을 응답한다.
+func convertPolicyBundleToResponse(ctx context.Context, policyBundle model.PolicyBundle) (out domain.PolicyBundleResponse) {
+	out.ID = policyBundle.ID.String()
+	out.Name = policyBundle.Name
+	out.Description = policyBundle.Description
+	out.CreatedAt = policyBundle.CreatedAt
+	out.UpdatedAt = policyBundle.UpdatedAt
+
+	out.Items = make([]domain.PolicyBundleItemResponse, len(policyBundle.Items))
+	for i, item := range policyBundle.Items {
+		out.Items[i] = domain.PolicyBundleItemResponse{
+			PolicyId:          item.PolicyId.String(),
+			PolicyName:        item.Policy.PolicyName,
+			TemplateName:      item.Policy.TemplateName,
+			EnforcementAction: item.Policy.EnforcementAction,
+			Parameters:        item.Policy.Parameters,
+		}
+		if item.EnforcementAction != "" {
+			out.Items[i].EnforcementAction = item.EnforcementAction
+		}
+		if item.Parameters != "" {
+			out.Items[i].Parameters = item.Parameters
+		}
+	}
+
+	out.Stacks = make([]domain.SimpleClusterResponse, len(policyBundle.Clusters))
+	for i, cluster := range policyBundle.Clusters {
+		out.Stacks[i] = domain.SimpleClusterResponse{
+			ID:             cluster.ID,
+			OrganizationId: cluster.OrganizationId,
+			Name:           cluster.Name,
+		}
+	}
+
+	if err := serializer.Map(ctx, policyBundle.Creator, &out.Creator); err != nil {
+		log.Error(ctx, err)
+	}
+	if err := serializer.Map(ctx, policyBundle.Updator, &out.Updator); err != nil {
+		log.Error(ctx, err)
+	}
+	return
+}
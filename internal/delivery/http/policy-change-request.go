@@ -0,0 +1,298 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type PolicyChangeRequestHandler struct {
+	usecase usecase.IPolicyChangeRequestUsecase
+}
+
+type IPolicyChangeRequestHandler interface {
+	GetPolicyApprovalSetting(w http.ResponseWriter, r *http.Request)
+	UpdatePolicyApprovalSetting(w http.ResponseWriter, r *http.Request)
+	ListPolicyChangeRequests(w http.ResponseWriter, r *http.Request)
+	GetPolicyChangeRequest(w http.ResponseWriter, r *http.Request)
+	ApprovePolicyChangeRequest(w http.ResponseWriter, r *http.Request)
+	RejectPolicyChangeRequest(w http.ResponseWriter, r *http.Request)
+}
+
+func NewPolicyChangeRequestHandler(u usecase.Usecase) IPolicyChangeRequestHandler {
+	return &PolicyChangeRequestHandler{
+		usecase: u.PolicyChangeRequest,
+	}
+}
+
+// GetPolicyApprovalSetting godoc
+//
+//	@Tags			PolicyChangeRequest
+//	@Summary		[GetPolicyApprovalSetting] 정책 변경 승인 모드 조회
+//	@Description	조직의 정책 변경 승인 모드 활성화 여부와 변경 요청 만료 시간을 조회한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"조직 식별자(o로 시작)"
+//	@Success		200				{object}	domain.GetPolicyApprovalSettingResponse
+//	@Router			/organizations/{organizationId}/policy-approval-setting [get]
+//	@Security		JWT
+func (h *PolicyChangeRequestHandler) GetPolicyApprovalSetting(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	setting, err := h.usecase.GetApprovalSetting(r.Context(), organizationId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetPolicyApprovalSettingResponse
+	out.PolicyApprovalSetting = domain.PolicyApprovalSettingResponse{
+		Enabled:     setting.Enabled,
+		ExpiryHours: setting.ExpiryHours,
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// UpdatePolicyApprovalSetting godoc
+//
+//	@Tags			PolicyChangeRequest
+//	@Summary		[UpdatePolicyApprovalSetting] 정책 변경 승인 모드 수정
+//	@Description	조직의 정책 변경 승인 모드를 수정한다. 활성화되면 정책 수정, 삭제, 대상 클러스터 변경은 변경 요청으로 생성되며 다른 사용자의 승인 후에 적용된다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string										true	"조직 식별자(o로 시작)"
+//	@Param			body			body		domain.UpdatePolicyApprovalSettingRequest	true	"update policy approval setting request"
+//	@Success		200				{object}	nil
+//	@Router			/organizations/{organizationId}/policy-approval-setting [put]
+//	@Security		JWT
+func (h *PolicyChangeRequestHandler) UpdatePolicyApprovalSetting(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.UpdatePolicyApprovalSettingRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	setting := model.PolicyApprovalSetting{
+		Enabled:     input.Enabled,
+		ExpiryHours: input.ExpiryHours,
+	}
+	if err := h.usecase.UpdateApprovalSetting(r.Context(), organizationId, setting); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// ListPolicyChangeRequests godoc
+//
+//	@Tags			PolicyChangeRequest
+//	@Summary		[ListPolicyChangeRequests] 정책 변경 요청 목록 조회
+//	@Description	정책 변경 요청 목록을 조회한다. filters 로 status 를 지정하면 승인 대기 요청만 조회할 수 있다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"조직 식별자(o로 시작)"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			sortColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Param			filters			query		[]string	false	"filters"
+//	@Success		200				{object}	domain.ListPolicyChangeRequestResponse
+//	@Router			/organizations/{organizationId}/policy-change-requests [get]
+//	@Security		JWT
+func (h *PolicyChangeRequestHandler) ListPolicyChangeRequests(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+
+	changeRequests, err := h.usecase.Fetch(r.Context(), organizationId, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.ListPolicyChangeRequestResponse
+	out.PolicyChangeRequests = make([]domain.PolicyChangeRequestResponse, len(*changeRequests))
+	for i, changeRequest := range *changeRequests {
+		out.PolicyChangeRequests[i] = convertPolicyChangeRequestToResponse(r.Context(), changeRequest)
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetPolicyChangeRequest godoc
+//
+//	@Tags			PolicyChangeRequest
+//	@Summary		[GetPolicyChangeRequest] 정책 변경 요청 조회
+//	@Description	정책 변경 요청과 변경 전후 값을 조회한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"조직 식별자(o로 시작)"
+//	@Param			changeRequestId	path		string	true	"정책 변경 요청 식별자(uuid)"
+//	@Success		200				{object}	domain.GetPolicyChangeRequestResponse
+//	@Router			/organizations/{organizationId}/policy-change-requests/{changeRequestId} [get]
+//	@Security		JWT
+func (h *PolicyChangeRequestHandler) GetPolicyChangeRequest(w http.ResponseWriter, r *http.Request) {
+	organizationId, changeRequestId, err := parsePolicyChangeRequestPath(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	changeRequest, err := h.usecase.Get(r.Context(), organizationId, changeRequestId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	out := domain.GetPolicyChangeRequestResponse{
+		PolicyChangeRequest: convertPolicyChangeRequestToResponse(r.Context(), *changeRequest),
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// ApprovePolicyChangeRequest godoc
+//
+//	@Tags			PolicyChangeRequest
+//	@Summary		[ApprovePolicyChangeRequest] 정책 변경 요청 승인
+//	@Description	정책 변경 요청을 승인하고 변경 내용을 정책과 TKSPolicy CR 에 적용한다. 요청자 본인은 승인할 수 없다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string										true	"조직 식별자(o로 시작)"
+//	@Param			changeRequestId	path		string										true	"정책 변경 요청 식별자(uuid)"
+//	@Param			body			body		domain.ApprovePolicyChangeRequestRequest	false	"approve policy change request"
+//	@Success		200				{object}	nil
+//	@Router			/organizations/{organizationId}/policy-change-requests/{changeRequestId}/approve [post]
+//	@Security		JWT
+func (h *PolicyChangeRequestHandler) ApprovePolicyChangeRequest(w http.ResponseWriter, r *http.Request) {
+	organizationId, changeRequestId, err := parsePolicyChangeRequestPath(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	// 승인 의견은 선택 사항이므로 본문이 없어도 승인한다
+	input := domain.ApprovePolicyChangeRequestRequest{}
+	if r.ContentLength != 0 {
+		if err := UnmarshalRequestInput(r, &input); err != nil {
+			ErrorJSON(w, r, err)
+			return
+		}
+	}
+
+	if err := h.usecase.Approve(r.Context(), organizationId, changeRequestId, input.Comment); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// RejectPolicyChangeRequest godoc
+//
+//	@Tags			PolicyChangeRequest
+//	@Summary		[RejectPolicyChangeRequest] 정책 변경 요청 반려
+//	@Description	정책 변경 요청을 사유와 함께 반려한다. 요청자 본인은 반려할 수 없다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string									true	"조직 식별자(o로 시작)"
+//	@Param			changeRequestId	path		string									true	"정책 변경 요청 식별자(uuid)"
+//	@Param			body			body		domain.RejectPolicyChangeRequestRequest	true	"reject policy change request"
+//	@Success		200				{object}	nil
+//	@Router			/organizations/{organizationId}/policy-change-requests/{changeRequestId}/reject [post]
+//	@Security		JWT
+func (h *PolicyChangeRequestHandler) RejectPolicyChangeRequest(w http.ResponseWriter, r *http.Request) {
+	organizationId, changeRequestId, err := parsePolicyChangeRequestPath(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	input := domain.RejectPolicyChangeRequestRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err := h.usecase.Reject(r.Context(), organizationId, changeRequestId, input.Comment); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+func parsePolicyChangeRequestPath(r *http.Request) (organizationId string, changeRequestId uuid.UUID, err error) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+	}
+
+	changeRequestId, err = uuid.Parse(vars["changeRequestId"])
+	if err != nil {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("invalid changeRequestId"), "PCR_INVALID_POLICY_CHANGE_REQUEST_ID", "")
+	}
+
+	return organizationId, changeRequestId, nil
+}
+
+func convertPolicyChangeRequestToResponse(ctx context.Context, changeRequest model.PolicyChangeRequest) (out domain.PolicyChangeRequestResponse) {
+	out.ID = changeRequest.ID.String()
+	out.PolicyId = changeRequest.PolicyId.String()
+	out.PolicyName = changeRequest.PolicyName
+	out.ChangeType = string(changeRequest.ChangeType)
+	out.Diff = changeRequest.DiffItems
+	out.Status = string(changeRequest.Status)
+	out.ReviewComment = changeRequest.ReviewComment
+	out.ReviewedAt = changeRequest.ReviewedAt
+	out.ExpiresAt = changeRequest.ExpiresAt
+	out.CreatedAt = changeRequest.CreatedAt
+
+	if err := serializer.Map(ctx, changeRequest.Requester, &out.Requester); err != nil {
+		log.Error(ctx, err)
+	}
+	if changeRequest.ReviewerId != nil {
+		if err := serializer.Map(ctx, changeRequest.Reviewer, &out.Reviewer); err != nil {
+			log.Error(ctx, err)
+		}
+	}
+	return
+}
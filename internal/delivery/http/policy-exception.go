@@ -0,0 +1,192 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type PolicyExceptionHandler struct {
+	usecase usecase.IPolicyExceptionUsecase
+}
+
+type IPolicyExceptionHandler interface {
+	CreatePolicyException(w http.ResponseWriter, r *http.Request)
+	ListPolicyExceptions(w http.ResponseWriter, r *http.Request)
+	DeletePolicyException(w http.ResponseWriter, r *http.Request)
+}
+
+func NewPolicyExceptionHandler(u usecase.Usecase) IPolicyExceptionHandler {
+	return &PolicyExceptionHandler{
+		usecase: u.PolicyException,
+	}
+}
+
+// CreatePolicyException godoc
+//
+//	@Tags			Policy
+//	@Summary		[CreatePolicyException] 정책 예외 생성
+//	@Description	특정 클러스터의 네임스페이스에 대해 만료 시각이 있는 정책 예외를 생성한다. 승인자는 요청자와 다른 같은 조직의 사용자여야 한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string								true	"조직 식별자(o로 시작)"
+//	@Param			policyId		path		string								true	"정책 식별자(uuid)"
+//	@Param			body			body		domain.CreatePolicyExceptionRequest	true	"create policy exception request"
+//	@Success		200				{object}	domain.CreatePolicyExceptionResponse
+//	@Router			/organizations/{organizationId}/policies/{policyId}/exceptions [post]
+//	@Security		JWT
+func (h *PolicyExceptionHandler) CreatePolicyException(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	policyId, err := uuid.Parse(vars["policyId"])
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid policyId"), "C_INVALID_POLICY_ID", ""))
+		return
+	}
+
+	input := domain.CreatePolicyExceptionRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	approverId, err := uuid.Parse(input.ApproverId)
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid approverId"), "PE_INVALID_APPROVER", ""))
+		return
+	}
+
+	dto := model.PolicyException{
+		ClusterId:     domain.ClusterId(input.ClusterId),
+		Namespace:     input.Namespace,
+		Justification: input.Justification,
+		ApproverId:    &approverId,
+		ExpiredAt:     input.ExpiredAt,
+	}
+
+	exceptionId, err := h.usecase.Create(r.Context(), organizationId, policyId, dto)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.CreatePolicyExceptionResponse{ID: exceptionId.String()})
+}
+
+// ListPolicyExceptions godoc
+//
+//	@Tags			Policy
+//	@Summary		[ListPolicyExceptions] 정책 예외 목록 조회
+//	@Description	정책에 등록된 예외 목록을 조회한다. 만료된 예외도 expired 플래그와 함께 조회된다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"조직 식별자(o로 시작)"
+//	@Param			policyId		path		string	true	"정책 식별자(uuid)"
+//	@Success		200				{object}	domain.ListPolicyExceptionResponse
+//	@Router			/organizations/{organizationId}/policies/{policyId}/exceptions [get]
+//	@Security		JWT
+func (h *PolicyExceptionHandler) ListPolicyExceptions(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	policyId, err := uuid.Parse(vars["policyId"])
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid policyId"), "C_INVALID_POLICY_ID", ""))
+		return
+	}
+
+	exceptions, err := h.usecase.List(r.Context(), organizationId, policyId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.ListPolicyExceptionResponse
+	out.Exceptions = make([]domain.PolicyExceptionResponse, len(exceptions))
+	for i, exception := range exceptions {
+		out.Exceptions[i] = convertPolicyExceptionToResponse(r.Context(), exception)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// DeletePolicyException godoc
+//
+//	@Tags			Policy
+//	@Summary		[DeletePolicyException] 정책 예외 삭제
+//	@Description	정책 예외를 삭제하고 클러스터의 정책에서 제거한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path	string	true	"조직 식별자(o로 시작)"
+//	@Param			policyId		path	string	true	"정책 식별자(uuid)"
+//	@Param			exceptionId		path	string	true	"정책 예외 식별자(uuid)"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/policies/{policyId}/exceptions/{exceptionId} [delete]
+//	@Security		JWT
+func (h *PolicyExceptionHandler) DeletePolicyException(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	policyId, err := uuid.Parse(vars["policyId"])
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid policyId"), "C_INVALID_POLICY_ID", ""))
+		return
+	}
+
+	exceptionId, err := uuid.Parse(vars["exceptionId"])
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid exceptionId"), "PE_INVALID_POLICY_EXCEPTION_ID", ""))
+		return
+	}
+
+	if err := h.usecase.Delete(r.Context(), organizationId, policyId, exceptionId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, "")
+}
+
+func convertPolicyExceptionToResponse(ctx context.Context, exception model.PolicyException) (out domain.PolicyExceptionResponse) {
+	out.ID = exception.ID.String()
+	out.PolicyId = exception.PolicyId.String()
+	out.ClusterId = exception.ClusterId.String()
+	out.Namespace = exception.Namespace
+	out.Justification = exception.Justification
+	out.ExpiredAt = exception.ExpiredAt
+	out.Expired = exception.Expired
+	out.CreatedAt = exception.CreatedAt
+
+	if err := serializer.Map(ctx, exception.Approver, &out.Approver); err != nil {
+		log.Error(ctx, err)
+	}
+	if err := serializer.Map(ctx, exception.Creator, &out.Creator); err != nil {
+		log.Error(ctx, err)
+	}
+	return
+}
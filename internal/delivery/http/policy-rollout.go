@@ -0,0 +1,184 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type PolicyRolloutHandler struct {
+	usecase usecase.IPolicyRolloutUsecase
+}
+
+type IPolicyRolloutHandler interface {
+	StartPolicyRollout(w http.ResponseWriter, r *http.Request)
+	GetPolicyRollout(w http.ResponseWriter, r *http.Request)
+	CancelPolicyRollout(w http.ResponseWriter, r *http.Request)
+}
+
+func NewPolicyRolloutHandler(u usecase.Usecase) IPolicyRolloutHandler {
+	return &PolicyRolloutHandler{
+		usecase: u.PolicyRollout,
+	}
+}
+
+// StartPolicyRollout godoc
+//
+//	@Tags			Policy
+//	@Summary		[StartPolicyRollout] 정책 단계적 적용 시작
+//	@Description	정책이 적용된 스택에 dryrun 또는 warn 을 먼저 적용하고, 관찰 기간 동안 위반 수가 임계치 이하인 스택부터 자동으로 deny 로 승격한다. 단계 전환마다 시스템 알림과 감사 로그가 기록된다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string								true	"조직 식별자(o로 시작)"
+//	@Param			policyId		path		string								true	"정책 식별자(uuid)"
+//	@Param			body			body		domain.StartPolicyRolloutRequest	true	"start policy rollout request"
+//	@Success		200				{object}	domain.StartPolicyRolloutResponse
+//	@Router			/organizations/{organizationId}/policies/{policyId}/rollout [post]
+//	@Security		JWT
+func (h *PolicyRolloutHandler) StartPolicyRollout(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	policyId, err := uuid.Parse(vars["policyId"])
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid policyId"), "C_INVALID_POLICY_ID", ""))
+		return
+	}
+
+	input := domain.StartPolicyRolloutRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	dto := model.PolicyRollout{
+		InitialEnforcementAction: input.InitialEnforcementAction,
+		ObservationHours:         input.ObservationHours,
+		ViolationThreshold:       input.ViolationThreshold,
+	}
+
+	rolloutId, err := h.usecase.Start(r.Context(), organizationId, policyId, dto)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.StartPolicyRolloutResponse{ID: rolloutId.String()})
+}
+
+// GetPolicyRollout godoc
+//
+//	@Tags			Policy
+//	@Summary		[GetPolicyRollout] 정책 단계적 적용 조회
+//	@Description	정책의 가장 최근 단계적 적용과 스택별 적용 단계를 조회한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"조직 식별자(o로 시작)"
+//	@Param			policyId		path		string	true	"정책 식별자(uuid)"
+//	@Success		200				{object}	domain.GetPolicyRolloutResponse
+//	@Router			/organizations/{organizationId}/policies/{policyId}/rollout [get]
+//	@Security		JWT
+func (h *PolicyRolloutHandler) GetPolicyRollout(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	policyId, err := uuid.Parse(vars["policyId"])
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid policyId"), "C_INVALID_POLICY_ID", ""))
+		return
+	}
+
+	rollout, err := h.usecase.Get(r.Context(), organizationId, policyId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	out := domain.GetPolicyRolloutResponse{
+		PolicyRollout: convertPolicyRolloutToResponse(r.Context(), rollout),
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// CancelPolicyRollout godoc
+//
+//	@Tags			Policy
+//	@Summary		[CancelPolicyRollout] 정책 단계적 적용 취소
+//	@Description	진행 중인 단계적 적용을 취소하고 모든 스택을 초기 적용 액션으로 되돌린다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"조직 식별자(o로 시작)"
+//	@Param			policyId		path		string	true	"정책 식별자(uuid)"
+//	@Success		200				{object}	nil
+//	@Router			/organizations/{organizationId}/policies/{policyId}/rollout [delete]
+//	@Security		JWT
+func (h *PolicyRolloutHandler) CancelPolicyRollout(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	policyId, err := uuid.Parse(vars["policyId"])
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid policyId"), "C_INVALID_POLICY_ID", ""))
+		return
+	}
+
+	if err := h.usecase.Cancel(r.Context(), organizationId, policyId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+func convertPolicyRolloutToResponse(ctx context.Context, rollout model.PolicyRollout) (out domain.PolicyRolloutResponse) {
+	out.ID = rollout.ID.String()
+	out.PolicyId = rollout.PolicyId.String()
+	out.InitialEnforcementAction = rollout.InitialEnforcementAction
+	out.BaseEnforcementAction = rollout.BaseEnforcementAction
+	out.ObservationHours = rollout.ObservationHours
+	out.ViolationThreshold = rollout.ViolationThreshold
+	out.Status = string(rollout.Status)
+	out.CreatedAt = rollout.CreatedAt
+	out.CompletedAt = rollout.CompletedAt
+
+	out.Stacks = make([]domain.PolicyRolloutStackResponse, len(rollout.Stacks))
+	for i, stack := range rollout.Stacks {
+		out.Stacks[i] = domain.PolicyRolloutStackResponse{
+			ClusterId:          stack.ClusterId.String(),
+			EnforcementAction:  stack.EnforcementAction,
+			StageStartedAt:     stack.StageStartedAt,
+			LastViolationCount: stack.LastViolationCount,
+			PromotedAt:         stack.PromotedAt,
+		}
+	}
+
+	if err := serializer.Map(ctx, rollout.Creator, &out.Creator); err != nil {
+		log.Error(ctx, err)
+	}
+	return
+}
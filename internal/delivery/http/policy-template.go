@@ -43,6 +43,8 @@ type IPolicyTemplateHandler interface {
 	Admin_AddPermittedPolicyTemplatesForOrganization(w http.ResponseWriter, r *http.Request)
 	Admin_UpdatePermittedPolicyTemplatesForOrganization(w http.ResponseWriter, r *http.Request)
 	Admin_DeletePermittedPolicyTemplatesForOrganization(w http.ResponseWriter, r *http.Request)
+	Admin_ImportPolicyTemplate(w http.ResponseWriter, r *http.Request)
+	Admin_ExportPolicyTemplate(w http.ResponseWriter, r *http.Request)
 
 	CreatePolicyTemplate(w http.ResponseWriter, r *http.Request)
 	UpdatePolicyTemplate(w http.ResponseWriter, r *http.Request)
@@ -58,6 +60,8 @@ type IPolicyTemplateHandler interface {
 	DeletePolicyTemplateVersion(w http.ResponseWriter, r *http.Request)
 	ListPolicyTemplateVersions(w http.ResponseWriter, r *http.Request)
 	ExtractParameters(w http.ResponseWriter, r *http.Request)
+	ImportPolicyTemplate(w http.ResponseWriter, r *http.Request)
+	ExportPolicyTemplate(w http.ResponseWriter, r *http.Request)
 
 	RegoCompile(w http.ResponseWriter, r *http.Request)
 }
@@ -1769,3 +1773,132 @@ func (h *PolicyTemplateHandler) ExtractParameters(w http.ResponseWriter, r *http
 
 	ResponseJSON(w, r, http.StatusCreated, response)
 }
+
+// Admin_ImportPolicyTemplate godoc
+//
+//	@Tags			PolicyTemplate
+//	@Summary		[Admin_ImportPolicyTemplate] Gatekeeper ConstraintTemplate 가져오기
+//	@Description	ConstraintTemplate YAML 또는 git 저장소 경로로부터 TKS 정책 템플릿을 생성한다. policyTemplateId 를 지정하면 해당 템플릿의 새 버전으로 추가한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		domain.ImportPolicyTemplateRequest	true	"import policy template request"
+//	@Success		200		{object}	domain.ImportPolicyTemplateResponse
+//	@Router			/admin/policy-templates/import [post]
+//	@Security		JWT
+func (h *PolicyTemplateHandler) Admin_ImportPolicyTemplate(w http.ResponseWriter, r *http.Request) {
+	h.importPolicyTemplate(w, r, nil)
+}
+
+// Admin_ExportPolicyTemplate godoc
+//
+//	@Tags			PolicyTemplate
+//	@Summary		[Admin_ExportPolicyTemplate] 정책 템플릿을 Gatekeeper ConstraintTemplate 으로 내보내기
+//	@Description	정책 템플릿의 특정 버전을 ConstraintTemplate YAML 로 내보낸다. 내보낸 YAML 은 다시 가져오기 할 수 있다.
+//	@Produce		application/yaml
+//	@Param			policyTemplateId	path		string	true	"정책 템플릿 식별자(uuid)"
+//	@Param			version				path		string	true	"내보낼 버전(v0.0.0 형식)"
+//	@Success		200					{string}	string	"ConstraintTemplate YAML"
+//	@Router			/admin/policy-templates/{policyTemplateId}/versions/{version}/export [get]
+//	@Security		JWT
+func (h *PolicyTemplateHandler) Admin_ExportPolicyTemplate(w http.ResponseWriter, r *http.Request) {
+	h.exportPolicyTemplate(w, r, nil)
+}
+
+// ImportPolicyTemplate godoc
+//
+//	@Tags			PolicyTemplate
+//	@Summary		[ImportPolicyTemplate] Gatekeeper ConstraintTemplate 가져오기
+//	@Description	ConstraintTemplate YAML 또는 git 저장소 경로로부터 조직 정책 템플릿을 생성한다. policyTemplateId 를 지정하면 해당 템플릿의 새 버전으로 추가한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string								true	"조직 식별자(o로 시작)"
+//	@Param			body			body		domain.ImportPolicyTemplateRequest	true	"import policy template request"
+//	@Success		200				{object}	domain.ImportPolicyTemplateResponse
+//	@Router			/organizations/{organizationId}/policy-templates/import [post]
+//	@Security		JWT
+func (h *PolicyTemplateHandler) ImportPolicyTemplate(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	h.importPolicyTemplate(w, r, &organizationId)
+}
+
+// ExportPolicyTemplate godoc
+//
+//	@Tags			PolicyTemplate
+//	@Summary		[ExportPolicyTemplate] 정책 템플릿을 Gatekeeper ConstraintTemplate 으로 내보내기
+//	@Description	정책 템플릿의 특정 버전을 ConstraintTemplate YAML 로 내보낸다. 내보낸 YAML 은 다시 가져오기 할 수 있다.
+//	@Produce		application/yaml
+//	@Param			organizationId		path		string	true	"조직 식별자(o로 시작)"
+//	@Param			policyTemplateId	path		string	true	"정책 템플릿 식별자(uuid)"
+//	@Param			version				path		string	true	"내보낼 버전(v0.0.0 형식)"
+//	@Success		200					{string}	string	"ConstraintTemplate YAML"
+//	@Router			/organizations/{organizationId}/policy-templates/{policyTemplateId}/versions/{version}/export [get]
+//	@Security		JWT
+func (h *PolicyTemplateHandler) ExportPolicyTemplate(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	h.exportPolicyTemplate(w, r, &organizationId)
+}
+
+func (h *PolicyTemplateHandler) importPolicyTemplate(w http.ResponseWriter, r *http.Request, organizationId *string) {
+	input := domain.ImportPolicyTemplateRequest{}
+
+	err := UnmarshalRequestInput(r, &input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	policyTemplateId, version, err := h.usecase.ImportConstraintTemplate(r.Context(), organizationId, input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.ImportPolicyTemplateResponse
+	out.ID = policyTemplateId.String()
+	out.Version = version
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+func (h *PolicyTemplateHandler) exportPolicyTemplate(w http.ResponseWriter, r *http.Request, organizationId *string) {
+	vars := mux.Vars(r)
+
+	id, err := uuid.Parse(vars["policyTemplateId"])
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid policyTemplateId"), "C_INVALID_POLICY_TEMPLATE_ID", ""))
+		return
+	}
+
+	version, ok := vars["version"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid version"), "PT_INVALID_POLICY_TEMPLATE_VERSION", ""))
+		return
+	}
+
+	constraintTemplate, err := h.usecase.ExportConstraintTemplate(r.Context(), organizationId, id, version)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/yaml; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s-%s.yaml\"", id, version))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write([]byte(constraintTemplate)); err != nil {
+		log.Error(r.Context(), err)
+	}
+}
@@ -0,0 +1,238 @@
+package http
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+var policyViolationCsvHeader = []string{"clusterId", "policyName", "policyTemplateKind", "namespace", "kind", "name",
+	"message", "enforcementAction", "status", "firstSeenAt", "lastSeenAt"}
+
+type PolicyViolationHandler struct {
+	usecase usecase.IPolicyViolationUsecase
+}
+
+type IPolicyViolationHandler interface {
+	ListPolicyViolations(w http.ResponseWriter, r *http.Request)
+	ExportPolicyViolations(w http.ResponseWriter, r *http.Request)
+	GetPolicyViolation(w http.ResponseWriter, r *http.Request)
+	UpdatePolicyViolationStatus(w http.ResponseWriter, r *http.Request)
+}
+
+func NewPolicyViolationHandler(u usecase.Usecase) IPolicyViolationHandler {
+	return &PolicyViolationHandler{
+		usecase: u.PolicyViolation,
+	}
+}
+
+// ListPolicyViolations godoc
+//
+//	@Tags			PolicyViolation
+//	@Summary		[ListPolicyViolations] 정책 위반 목록 조회
+//	@Description	자원 단위의 정책 위반 기록을 조회한다. clusterId, namespace, kind, name, policyName, message, status 등 모든 필드로 검색할 수 있다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"조직 식별자(o로 시작)"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			sortColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Param			filters			query		[]string	false	"filters"
+//	@Success		200				{object}	domain.ListPolicyViolationResponse
+//	@Router			/organizations/{organizationId}/policy-violations [get]
+//	@Security		JWT
+func (h *PolicyViolationHandler) ListPolicyViolations(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+
+	violations, err := h.usecase.Fetch(r.Context(), organizationId, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.ListPolicyViolationResponse
+	out.PolicyViolations = make([]domain.PolicyViolationResponse, len(violations))
+	for i, violation := range violations {
+		out.PolicyViolations[i] = convertPolicyViolationToResponse(r.Context(), violation)
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// ExportPolicyViolations godoc
+//
+//	@Tags			PolicyViolation
+//	@Summary		[ExportPolicyViolations] 정책 위반 목록 CSV 내보내기
+//	@Description	검색 조건에 해당하는 정책 위반 기록 전체를 CSV 로 내보낸다. 페이지 설정은 무시된다.
+//	@Produce		text/csv
+//	@Param			organizationId	path		string		true	"조직 식별자(o로 시작)"
+//	@Param			sortColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Param			filters			query		[]string	false	"filters"
+//	@Success		200				{string}	string		"policy violation CSV"
+//	@Router			/organizations/{organizationId}/policy-violations/export [get]
+//	@Security		JWT
+func (h *PolicyViolationHandler) ExportPolicyViolations(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+	pg.Page = 1
+	pg.Limit = pagination.DEFAULT_LIMIT
+	pg.MakePaginationRequest()
+
+	violations, err := h.usecase.Fetch(r.Context(), organizationId, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"policy-violations-%s.csv\"", organizationId))
+	w.WriteHeader(http.StatusOK)
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(policyViolationCsvHeader); err != nil {
+		log.Error(r.Context(), err)
+		return
+	}
+	for _, violation := range violations {
+		record := []string{violation.ClusterId.String(), violation.PolicyName, violation.PolicyTemplateKind,
+			violation.Namespace, violation.Kind, violation.Name, violation.Message, violation.EnforcementAction,
+			string(violation.Status), violation.FirstSeenAt.Format(time.RFC3339), violation.LastSeenAt.Format(time.RFC3339)}
+		if err := writer.Write(record); err != nil {
+			log.Error(r.Context(), err)
+			return
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		log.Error(r.Context(), err)
+	}
+}
+
+// GetPolicyViolation godoc
+//
+//	@Tags			PolicyViolation
+//	@Summary		[GetPolicyViolation] 정책 위반 조회
+//	@Description	정책 위반 기록을 조회한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"조직 식별자(o로 시작)"
+//	@Param			violationId		path		string	true	"정책 위반 식별자(uuid)"
+//	@Success		200				{object}	domain.GetPolicyViolationResponse
+//	@Router			/organizations/{organizationId}/policy-violations/{violationId} [get]
+//	@Security		JWT
+func (h *PolicyViolationHandler) GetPolicyViolation(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	violationId, err := uuid.Parse(vars["violationId"])
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid violationId"), "PV_INVALID_POLICY_VIOLATION_ID", ""))
+		return
+	}
+
+	violation, err := h.usecase.Get(r.Context(), organizationId, violationId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetPolicyViolationResponse
+	out.PolicyViolation = convertPolicyViolationToResponse(r.Context(), violation)
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// UpdatePolicyViolationStatus godoc
+//
+//	@Tags			PolicyViolation
+//	@Summary		[UpdatePolicyViolationStatus] 정책 위반 상태 변경
+//	@Description	정책 위반 기록의 처리 상태(open, acknowledged, fixed, excepted)를 변경한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path	string										true	"조직 식별자(o로 시작)"
+//	@Param			violationId		path	string										true	"정책 위반 식별자(uuid)"
+//	@Param			body			body	domain.UpdatePolicyViolationStatusRequest	true	"update policy violation status request"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/policy-violations/{violationId}/status [patch]
+//	@Security		JWT
+func (h *PolicyViolationHandler) UpdatePolicyViolationStatus(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	violationId, err := uuid.Parse(vars["violationId"])
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid violationId"), "PV_INVALID_POLICY_VIOLATION_ID", ""))
+		return
+	}
+
+	input := domain.UpdatePolicyViolationStatusRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err := h.usecase.UpdateStatus(r.Context(), organizationId, violationId, domain.PolicyViolationStatus(input.Status)); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, "")
+}
+
+func convertPolicyViolationToResponse(ctx context.Context, violation model.PolicyViolation) (out domain.PolicyViolationResponse) {
+	if err := serializer.Map(ctx, violation, &out); err != nil {
+		log.Error(ctx, err)
+	}
+	out.ID = violation.ID.String()
+	out.ClusterId = violation.ClusterId.String()
+	if violation.PolicyId != nil {
+		out.PolicyId = violation.PolicyId.String()
+	}
+	out.Status = string(violation.Status)
+	return
+}
@@ -20,7 +20,8 @@ import (
 )
 
 type PolicyHandler struct {
-	usecase usecase.IPolicyUsecase
+	usecase              usecase.IPolicyUsecase
+	changeRequestUsecase usecase.IPolicyChangeRequestUsecase
 }
 
 type IPolicyHandler interface {
@@ -37,6 +38,7 @@ type IPolicyHandler interface {
 	ListStackPolicyStatus(w http.ResponseWriter, r *http.Request)
 	GetStackPolicyTemplateStatus(w http.ResponseWriter, r *http.Request)
 	UpdateStackPolicyTemplateStatus(w http.ResponseWriter, r *http.Request)
+	ListStackPolicyTemplateHistories(w http.ResponseWriter, r *http.Request)
 	GetPolicyEdit(w http.ResponseWriter, r *http.Request)
 	GetPolicyStatistics(w http.ResponseWriter, r *http.Request)
 	AddPoliciesForStack(w http.ResponseWriter, r *http.Request)
@@ -47,7 +49,8 @@ type IPolicyHandler interface {
 
 func NewPolicyHandler(u usecase.Usecase) IPolicyHandler {
 	return &PolicyHandler{
-		usecase: u.Policy,
+		usecase:              u.Policy,
+		changeRequestUsecase: u.PolicyChangeRequest,
 	}
 }
 
@@ -148,6 +151,7 @@ func (h *PolicyHandler) CreatePolicy(w http.ResponseWriter, r *http.Request) {
 //	@Param			policyId		path		string						true	"정책 식별자(uuid)"
 //	@Param			body			body		domain.UpdatePolicyRequest	true	"update policy set request"
 //	@Success		200				{object}	nil
+//	@Success		202				{object}	domain.CreatePolicyChangeRequestResponse	"조직의 정책 변경 승인 모드가 활성화된 경우 생성된 변경 요청"
 //	@Router			/organizations/{organizationId}/policies/{policyId} [patch]
 //	@Security		JWT
 func (h *PolicyHandler) UpdatePolicy(w http.ResponseWriter, r *http.Request) {
@@ -223,6 +227,10 @@ func (h *PolicyHandler) UpdatePolicy(w http.ResponseWriter, r *http.Request) {
 		templateId = &tuuid
 	}
 
+	if h.requestChangeIfRequired(w, r, organizationId, id, domain.PolicyChangeTypeUpdate, input) {
+		return
+	}
+
 	err = h.usecase.Update(r.Context(), organizationId, id,
 		input.Mandatory, input.PolicyName, input.Description, templateId, input.EnforcementAction,
 		input.Parameters, input.Match, input.MatchYaml, input.TargetClusterIds)
@@ -251,6 +259,7 @@ func (h *PolicyHandler) UpdatePolicy(w http.ResponseWriter, r *http.Request) {
 //	@Param			organizationId	path		string	true	"조직 식별자(o로 시작)"
 //	@Param			policyId		path		string	true	"정책 식별자(uuid)"
 //	@Success		200				{object}	nil
+//	@Success		202				{object}	domain.CreatePolicyChangeRequestResponse	"조직의 정책 변경 승인 모드가 활성화된 경우 생성된 변경 요청"
 //	@Router			/organizations/{organizationId}/policies/{policyId} [delete]
 //	@Security		JWT
 func (h *PolicyHandler) DeletePolicy(w http.ResponseWriter, r *http.Request) {
@@ -275,6 +284,10 @@ func (h *PolicyHandler) DeletePolicy(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if h.requestChangeIfRequired(w, r, organizationId, id, domain.PolicyChangeTypeDelete, nil) {
+		return
+	}
+
 	err = h.usecase.Delete(r.Context(), organizationId, id)
 
 	if err != nil {
@@ -360,6 +373,11 @@ func (h *PolicyHandler) GetPolicy(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	out.Policy.Exceptions = make([]domain.PolicyExceptionResponse, len(policy.Exceptions))
+	for i, exception := range policy.Exceptions {
+		out.Policy.Exceptions[i] = convertPolicyExceptionToResponse(r.Context(), exception)
+	}
+
 	ResponseJSON(w, r, http.StatusOK, out)
 }
 
@@ -458,6 +476,7 @@ func (h *PolicyHandler) ListPolicy(w http.ResponseWriter, r *http.Request) {
 //	@Param			policyId		path		string								true	"정책 식별자(uuid)"
 //	@Param			body			body		domain.UpdatePolicyClustersRequest	true	"update policy set request"
 //	@Success		200				{object}	nil
+//	@Success		202				{object}	domain.CreatePolicyChangeRequestResponse	"조직의 정책 변경 승인 모드가 활성화된 경우 생성된 변경 요청"
 //	@Router			/organizations/{organizationId}/policies/{policyId}/clusters [patch]
 //	@Security		JWT
 func (h *PolicyHandler) UpdatePolicyTargetClusters(w http.ResponseWriter, r *http.Request) {
@@ -497,6 +516,10 @@ func (h *PolicyHandler) UpdatePolicyTargetClusters(w http.ResponseWriter, r *htt
 		return
 	}
 
+	if h.requestChangeIfRequired(w, r, organizationId, id, domain.PolicyChangeTypeUpdateTargetClusters, input) {
+		return
+	}
+
 	err = h.usecase.UpdatePolicyTargetClusters(r.Context(), organizationId, id,
 		input.CurrentTargetClusterIds, input.NewTargetClusterIds)
 
@@ -836,7 +859,7 @@ func (h *PolicyHandler) GetStackPolicyTemplateStatus(w http.ResponseWriter, r *h
 //
 //	@Tags			StackPolicyStatus
 //	@Summary		[UpdateStackPolicyTemplateStatus] 템플릿 버전 업데이트
-//	@Description	해당 템플릿의 버전 업데이트 및 연관된 정책의 새 기본값을 설정한다.
+//	@Description	해당 템플릿의 버전을 최신 버전으로 업데이트하고 연관된 정책의 새 기본값을 설정한다. pinned 를 지정하면 버전 고정 여부를 변경하며, 고정된 스택은 고정을 해제하기 전까지 업데이트되지 않는다.
 //	@Accept			json
 //	@Produce		json
 //	@Param			organizationId		path		string											true	"조직 식별자(o로 시작)"
@@ -879,7 +902,7 @@ func (h *PolicyHandler) UpdateStackPolicyTemplateStatus(w http.ResponseWriter, r
 	}
 
 	err = h.usecase.UpdateStackPolicyTemplateStatus(r.Context(), stackId, id,
-		input.TemplateCurrentVersion, input.TemplateTargetVerson)
+		input.TemplateCurrentVersion, input.TemplateTargetVerson, input.Pinned)
 
 	if err != nil {
 		log.Errorf(r.Context(), "error is :%s(%T)", err.Error(), err)
@@ -895,6 +918,53 @@ func (h *PolicyHandler) UpdateStackPolicyTemplateStatus(w http.ResponseWriter, r
 	ResponseJSON(w, r, http.StatusOK, nil)
 }
 
+// ListStackPolicyTemplateHistories godoc
+//
+//	@Tags			StackPolicyStatus
+//	@Summary		[ListStackPolicyTemplateHistories] 템플릿 버전 변경 이력 조회
+//	@Description	스택의 템플릿 버전 변경 이력을 최신순으로 조회한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId		path		string	true	"조직 식별자(o로 시작)"
+//	@Param			stackId				path		string	true	"스택 식별자"
+//	@Param			policyTemplateId	path		string	true	"정책 템플릿 식별자(uuid)"
+//	@Success		200					{object}	domain.ListStackPolicyTemplateHistoryResponse
+//	@Router			/organizations/{organizationId}/stacks/{stackId}/policy-templates/{policyTemplateId}/histories [get]
+//	@Security		JWT
+func (h *PolicyHandler) ListStackPolicyTemplateHistories(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	stackId, ok := vars["stackId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid clusterId"),
+			"C_INVALID_STACK_ID", ""))
+		return
+	}
+
+	id, err := uuid.Parse(vars["policyTemplateId"])
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid policyTemplateId"), "C_INVALID_POLICY_TEMPLATE_ID", ""))
+		return
+	}
+
+	histories, err := h.usecase.ListStackPolicyTemplateHistories(r.Context(), stackId, id)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.ListStackPolicyTemplateHistoryResponse
+	out.Histories = make([]domain.StackPolicyTemplateHistoryResponse, len(histories))
+	for i, history := range histories {
+		if err := serializer.Map(r.Context(), history, &out.Histories[i]); err != nil {
+			log.Error(r.Context(), err)
+		}
+		out.Histories[i].ID = history.ID.String()
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
 // GetPolicyEdit godoc
 //
 //	@Tags			Policy
@@ -1187,3 +1257,22 @@ func (h *PolicyHandler) DeletePoliciesForStack(w http.ResponseWriter, r *http.Re
 
 	ResponseJSON(w, r, http.StatusOK, nil)
 }
+
+// requestChangeIfRequired 는 조직의 정책 변경 승인 모드가 활성화된 경우 변경 요청을 생성하고 202 로 응답한다.
+// 응답을 보냈으면 true 를 반환하며, 호출자는 변경을 적용하지 않고 종료해야 한다.
+func (h *PolicyHandler) requestChangeIfRequired(w http.ResponseWriter, r *http.Request, organizationId string, policyId uuid.UUID,
+	changeType domain.PolicyChangeType, payload interface{}) bool {
+	changeRequestId, err := h.changeRequestUsecase.RequestIfRequired(r.Context(), organizationId, policyId, changeType, payload)
+	if err != nil {
+		log.Errorf(r.Context(), "error is :%s(%T)", err.Error(), err)
+		ErrorJSON(w, r, err)
+		return true
+	}
+
+	if changeRequestId == uuid.Nil {
+		return false
+	}
+
+	ResponseJSON(w, r, http.StatusAccepted, domain.CreatePolicyChangeRequestResponse{ID: changeRequestId.String()})
+	return true
+}
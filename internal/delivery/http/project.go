@@ -1,8 +1,10 @@
 package http
 
 import (
+	"encoding/csv"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -57,19 +59,24 @@ type IProjectHandler interface {
 	GetProjectNamespaceK8sResources(w http.ResponseWriter, r *http.Request)
 	GetProjectNamespaceResourcesUsage(w http.ResponseWriter, r *http.Request)
 	GetProjectNamespaceKubeconfig(w http.ResponseWriter, r *http.Request)
+
+	GetProjectUsage(w http.ResponseWriter, r *http.Request)
+	ExportProjectUsage(w http.ResponseWriter, r *http.Request)
 }
 
 type ProjectHandler struct {
-	usecase          usecase.IProjectUsecase
-	authUsecase      usecase.IAuthUsecase
-	dashboardUsecase usecase.IDashboardUsecase
+	usecase             usecase.IProjectUsecase
+	authUsecase         usecase.IAuthUsecase
+	dashboardUsecase    usecase.IDashboardUsecase
+	projectUsageUsecase usecase.IProjectUsageUsecase
 }
 
 func NewProjectHandler(u usecase.Usecase) IProjectHandler {
 	return &ProjectHandler{
-		usecase:          u.Project,
-		authUsecase:      u.Auth,
-		dashboardUsecase: u.Dashboard,
+		usecase:             u.Project,
+		authUsecase:         u.Auth,
+		dashboardUsecase:    u.Dashboard,
+		projectUsageUsecase: u.ProjectUsage,
 	}
 }
 
@@ -1904,3 +1911,106 @@ func (p ProjectHandler) GetProjectNamespaceKubeconfig(w http.ResponseWriter, r *
 
 	ResponseJSON(w, r, http.StatusOK, out)
 }
+
+var projectUsageCsvHeader = []string{"date", "stackId", "namespace", "cpuCoreHours", "memoryGibHours", "storageGibHours", "cost", "currency"}
+
+// GetProjectUsage godoc
+//
+//	@Tags			Projects
+//	@Summary		Get project resource usage
+//	@Description	Get daily cpu, memory and storage usage of the project namespaces on all stacks and the cost from unit rates
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"Organization ID"
+//	@Param			projectId		path		string	true	"Project ID"
+//	@Param			from			query		string	false	"from date (YYYY-MM-DD). default is the first day of this month"
+//	@Param			to				query		string	false	"to date (YYYY-MM-DD). default is today"
+//	@Success		200				{object}	domain.GetProjectUsageResponse
+//	@Router			/organizations/{organizationId}/projects/{projectId}/usage [get]
+//	@Security		JWT
+func (p ProjectHandler) GetProjectUsage(w http.ResponseWriter, r *http.Request) {
+	out, err := p.getProjectUsage(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// ExportProjectUsage godoc
+//
+//	@Tags			Projects
+//	@Summary		Export project resource usage as CSV
+//	@Description	Export daily cpu, memory and storage usage of the project namespaces and the cost as CSV
+//	@Produce		text/csv
+//	@Param			organizationId	path		string	true	"Organization ID"
+//	@Param			projectId		path		string	true	"Project ID"
+//	@Param			from			query		string	false	"from date (YYYY-MM-DD). default is the first day of this month"
+//	@Param			to				query		string	false	"to date (YYYY-MM-DD). default is today"
+//	@Success		200				{string}	string	"project usage CSV"
+//	@Router			/organizations/{organizationId}/projects/{projectId}/usage/export [get]
+//	@Security		JWT
+func (p ProjectHandler) ExportProjectUsage(w http.ResponseWriter, r *http.Request) {
+	out, err := p.getProjectUsage(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"project-usage-%s-%s-%s.csv\"", out.ProjectId, out.From, out.To))
+	w.WriteHeader(http.StatusOK)
+
+	formatFloat := func(v float64) string {
+		return strconv.FormatFloat(v, 'f', 4, 64)
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(projectUsageCsvHeader); err != nil {
+		log.Error(r.Context(), err)
+		return
+	}
+	for _, usage := range out.Usages {
+		record := []string{usage.Date, usage.StackId, usage.Namespace, formatFloat(usage.CpuCoreHours),
+			formatFloat(usage.MemoryGibHours), formatFloat(usage.StorageGibHours), formatFloat(usage.Cost), out.Rates.Currency}
+		if err := writer.Write(record); err != nil {
+			log.Error(r.Context(), err)
+			return
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		log.Error(r.Context(), err)
+	}
+}
+
+func (p ProjectHandler) getProjectUsage(r *http.Request) (out domain.GetProjectUsageResponse, err error) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		return out, httpErrors.NewBadRequestError(fmt.Errorf("organizationId not found in path"), "C_INVALID_ORGANIZATION_ID", "")
+	}
+	projectId, ok := vars["projectId"]
+	if !ok {
+		return out, httpErrors.NewBadRequestError(fmt.Errorf("projectId not found in path"), "C_INVALID_PROJECT_ID", "")
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	from := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC)
+	to := today
+
+	query := r.URL.Query()
+	if v := query.Get("from"); v != "" {
+		if from, err = time.Parse("2006-01-02", v); err != nil {
+			return out, httpErrors.NewBadRequestError(err, "PU_INVALID_PERIOD", "")
+		}
+	}
+	if v := query.Get("to"); v != "" {
+		if to, err = time.Parse("2006-01-02", v); err != nil {
+			return out, httpErrors.NewBadRequestError(err, "PU_INVALID_PERIOD", "")
+		}
+	}
+
+	return p.projectUsageUsecase.Get(r.Context(), organizationId, projectId, from, to)
+}
@@ -338,7 +338,7 @@ func (h *StackHandler) DeleteStack(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if policyIds != nil && len(*policyIds) > 0 {
-		err = h.usecasePolicy.DeletePoliciesForClusterID(r.Context(), organizationId, domain.ClusterId(dto.ID), *policyIds)
+		err = h.usecasePolicy.DeletePoliciesForDeletedCluster(r.Context(), organizationId, domain.ClusterId(dto.ID), *policyIds)
 		if err != nil {
 			ErrorJSON(w, r, httpErrors.NewBadRequestError(err, "S_FAILED_DELETE_POLICIES", ""))
 			return
@@ -0,0 +1,391 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type SystemNotificationEscalationHandler struct {
+	usecase usecase.ISystemNotificationEscalationUsecase
+}
+
+type ISystemNotificationEscalationHandler interface {
+	CreateOnCallSchedule(w http.ResponseWriter, r *http.Request)
+	GetOnCallSchedules(w http.ResponseWriter, r *http.Request)
+	GetOnCallSchedule(w http.ResponseWriter, r *http.Request)
+	UpdateOnCallSchedule(w http.ResponseWriter, r *http.Request)
+	DeleteOnCallSchedule(w http.ResponseWriter, r *http.Request)
+	GetSystemNotificationEscalationPolicy(w http.ResponseWriter, r *http.Request)
+	SetSystemNotificationEscalationPolicy(w http.ResponseWriter, r *http.Request)
+	DeleteSystemNotificationEscalationPolicy(w http.ResponseWriter, r *http.Request)
+}
+
+func NewSystemNotificationEscalationHandler(u usecase.Usecase) ISystemNotificationEscalationHandler {
+	return &SystemNotificationEscalationHandler{
+		usecase: u.SystemNotificationEscalation,
+	}
+}
+
+// CreateOnCallSchedule godoc
+//
+//	@Tags			SystemNotificationEscalations
+//	@Summary		[CreateOnCallSchedule] 당직 순번 생성
+//	@Description	당직 순번을 생성한다. rotationStartAt 부터 rotationHour 시간마다 참여자 순서대로 당직이 인계된다. 현재 당직자가 1차, 다음 순번이 2차 당직자이다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string								true	"조직 식별자(o로 시작)"
+//	@Param			body			body		domain.CreateOnCallScheduleRequest	true	"create on-call schedule request"
+//	@Success		200				{object}	domain.CreateOnCallScheduleResponse
+//	@Router			/organizations/{organizationId}/on-call-schedules [post]
+//	@Security		JWT
+func (h *SystemNotificationEscalationHandler) CreateOnCallSchedule(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.CreateOnCallScheduleRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	dto := model.OnCallSchedule{
+		Name:            input.Name,
+		Description:     input.Description,
+		RotationStartAt: input.RotationStartAt,
+		RotationHour:    input.RotationHour,
+	}
+	for _, id := range input.ParticipantIds {
+		participantId, err := uuid.Parse(id)
+		if err != nil {
+			ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid participantId %s", id), "SNE_INVALID_ON_CALL_SCHEDULE", ""))
+			return
+		}
+		dto.ParticipantIds = append(dto.ParticipantIds, participantId)
+	}
+
+	onCallScheduleId, err := h.usecase.CreateOnCallSchedule(r.Context(), organizationId, dto)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.CreateOnCallScheduleResponse{ID: onCallScheduleId.String()})
+}
+
+// GetOnCallSchedules godoc
+//
+//	@Tags			SystemNotificationEscalations
+//	@Summary		[GetOnCallSchedules] 당직 순번 목록 조회
+//	@Description	조직의 당직 순번 목록을 현재 1차, 2차 당직자와 다음 인계 시각과 함께 조회한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"조직 식별자(o로 시작)"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			sortColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Param			filters			query		[]string	false	"filters"
+//	@Success		200				{object}	domain.GetOnCallSchedulesResponse
+//	@Router			/organizations/{organizationId}/on-call-schedules [get]
+//	@Security		JWT
+func (h *SystemNotificationEscalationHandler) GetOnCallSchedules(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+	schedules, err := h.usecase.FetchOnCallSchedules(r.Context(), organizationId, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetOnCallSchedulesResponse
+	out.OnCallSchedules = make([]domain.OnCallScheduleResponse, len(schedules))
+	now := time.Now()
+	for i, schedule := range schedules {
+		out.OnCallSchedules[i] = convertOnCallScheduleToResponse(r.Context(), schedule, now)
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetOnCallSchedule godoc
+//
+//	@Tags			SystemNotificationEscalations
+//	@Summary		[GetOnCallSchedule] 당직 순번 조회
+//	@Description	당직 순번을 현재 1차, 2차 당직자와 다음 인계 시각과 함께 조회한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId		path		string	true	"조직 식별자(o로 시작)"
+//	@Param			onCallScheduleId	path		string	true	"당직 순번 식별자(uuid)"
+//	@Success		200					{object}	domain.OnCallScheduleResponse
+//	@Router			/organizations/{organizationId}/on-call-schedules/{onCallScheduleId} [get]
+//	@Security		JWT
+func (h *SystemNotificationEscalationHandler) GetOnCallSchedule(w http.ResponseWriter, r *http.Request) {
+	organizationId, onCallScheduleId, err := onCallSchedulePathParams(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	schedule, err := h.usecase.GetOnCallSchedule(r.Context(), organizationId, onCallScheduleId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, convertOnCallScheduleToResponse(r.Context(), schedule, time.Now()))
+}
+
+// UpdateOnCallSchedule godoc
+//
+//	@Tags			SystemNotificationEscalations
+//	@Summary		[UpdateOnCallSchedule] 당직 순번 수정
+//	@Description	당직 순번의 참여자, 인계 주기를 수정한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId		path	string								true	"조직 식별자(o로 시작)"
+//	@Param			onCallScheduleId	path	string								true	"당직 순번 식별자(uuid)"
+//	@Param			body				body	domain.UpdateOnCallScheduleRequest	true	"update on-call schedule request"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/on-call-schedules/{onCallScheduleId} [put]
+//	@Security		JWT
+func (h *SystemNotificationEscalationHandler) UpdateOnCallSchedule(w http.ResponseWriter, r *http.Request) {
+	organizationId, onCallScheduleId, err := onCallSchedulePathParams(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	input := domain.UpdateOnCallScheduleRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err := h.usecase.UpdateOnCallSchedule(r.Context(), organizationId, onCallScheduleId, input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, "")
+}
+
+// DeleteOnCallSchedule godoc
+//
+//	@Tags			SystemNotificationEscalations
+//	@Summary		[DeleteOnCallSchedule] 당직 순번 삭제
+//	@Description	당직 순번을 삭제한다. 에스컬레이션 정책에서 사용 중인 당직 순번은 삭제할 수 없다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId		path	string	true	"조직 식별자(o로 시작)"
+//	@Param			onCallScheduleId	path	string	true	"당직 순번 식별자(uuid)"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/on-call-schedules/{onCallScheduleId} [delete]
+//	@Security		JWT
+func (h *SystemNotificationEscalationHandler) DeleteOnCallSchedule(w http.ResponseWriter, r *http.Request) {
+	organizationId, onCallScheduleId, err := onCallSchedulePathParams(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err := h.usecase.DeleteOnCallSchedule(r.Context(), organizationId, onCallScheduleId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, "")
+}
+
+// GetSystemNotificationEscalationPolicy godoc
+//
+//	@Tags			SystemNotificationEscalations
+//	@Summary		[GetSystemNotificationEscalationPolicy] 알림 설정 에스컬레이션 정책 조회
+//	@Description	알림 설정의 에스컬레이션 정책을 조회한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId				path		string	true	"조직 식별자(o로 시작)"
+//	@Param			systemNotificationRuleId	path		string	true	"알림 설정 식별자(uuid)"
+//	@Success		200							{object}	domain.SystemNotificationEscalationPolicyResponse
+//	@Router			/organizations/{organizationId}/system-notification-rules/{systemNotificationRuleId}/escalation-policy [get]
+//	@Security		JWT
+func (h *SystemNotificationEscalationHandler) GetSystemNotificationEscalationPolicy(w http.ResponseWriter, r *http.Request) {
+	organizationId, systemNotificationRuleId, err := escalationPolicyPathParams(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	policy, err := h.usecase.GetPolicy(r.Context(), organizationId, systemNotificationRuleId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.SystemNotificationEscalationPolicyResponse
+	out.ID = policy.ID.String()
+	out.SystemNotificationRuleId = policy.SystemNotificationRuleId.String()
+	out.Steps = policy.StepsData
+	out.Enabled = policy.Enabled
+	out.CreatedAt = policy.CreatedAt
+	out.UpdatedAt = policy.UpdatedAt
+	if err := serializer.Map(r.Context(), policy.Creator, &out.Creator); err != nil {
+		log.Error(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// SetSystemNotificationEscalationPolicy godoc
+//
+//	@Tags			SystemNotificationEscalations
+//	@Summary		[SetSystemNotificationEscalationPolicy] 알림 설정 에스컬레이션 정책 설정
+//	@Description	알림 설정의 에스컬레이션 정책을 생성하거나 교체한다. 알림이 발생한 뒤 조치 없이 단계의 delayMinute 이 지나면 해당 단계의 대상(1차/2차 당직자, 사용자, 조직 관리자)에게 메일을 발송한다. 단계는 delayMinute 순서여야 한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId				path	string												true	"조직 식별자(o로 시작)"
+//	@Param			systemNotificationRuleId	path	string												true	"알림 설정 식별자(uuid)"
+//	@Param			body						body	domain.SetSystemNotificationEscalationPolicyRequest	true	"set escalation policy request"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/system-notification-rules/{systemNotificationRuleId}/escalation-policy [put]
+//	@Security		JWT
+func (h *SystemNotificationEscalationHandler) SetSystemNotificationEscalationPolicy(w http.ResponseWriter, r *http.Request) {
+	organizationId, systemNotificationRuleId, err := escalationPolicyPathParams(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	input := domain.SetSystemNotificationEscalationPolicyRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err := h.usecase.SetPolicy(r.Context(), organizationId, systemNotificationRuleId, input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, "")
+}
+
+// DeleteSystemNotificationEscalationPolicy godoc
+//
+//	@Tags			SystemNotificationEscalations
+//	@Summary		[DeleteSystemNotificationEscalationPolicy] 알림 설정 에스컬레이션 정책 삭제
+//	@Description	알림 설정의 에스컬레이션 정책을 삭제한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId				path	string	true	"조직 식별자(o로 시작)"
+//	@Param			systemNotificationRuleId	path	string	true	"알림 설정 식별자(uuid)"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/system-notification-rules/{systemNotificationRuleId}/escalation-policy [delete]
+//	@Security		JWT
+func (h *SystemNotificationEscalationHandler) DeleteSystemNotificationEscalationPolicy(w http.ResponseWriter, r *http.Request) {
+	organizationId, systemNotificationRuleId, err := escalationPolicyPathParams(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err := h.usecase.DeletePolicy(r.Context(), organizationId, systemNotificationRuleId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, "")
+}
+
+func onCallSchedulePathParams(r *http.Request) (organizationId string, onCallScheduleId uuid.UUID, err error) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+	}
+	onCallScheduleId, err = uuid.Parse(vars["onCallScheduleId"])
+	if err != nil {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("invalid onCallScheduleId"), "SNE_INVALID_ON_CALL_SCHEDULE_ID", "")
+	}
+	return organizationId, onCallScheduleId, nil
+}
+
+func escalationPolicyPathParams(r *http.Request) (organizationId string, systemNotificationRuleId uuid.UUID, err error) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+	}
+	systemNotificationRuleId, err = uuid.Parse(vars["systemNotificationRuleId"])
+	if err != nil {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("invalid systemNotificationRuleId"), "C_INVALID_SYSTEM_NOTIFICATION_RULE_ID", "")
+	}
+	return organizationId, systemNotificationRuleId, nil
+}
+
+func convertOnCallScheduleToResponse(ctx context.Context, schedule model.OnCallSchedule, now time.Time) (out domain.OnCallScheduleResponse) {
+	out.ID = schedule.ID.String()
+	out.OrganizationId = schedule.OrganizationId
+	out.Name = schedule.Name
+	out.Description = schedule.Description
+	out.RotationStartAt = schedule.RotationStartAt
+	out.RotationHour = schedule.RotationHour
+	out.NextHandoffAt = schedule.NextHandoffAt(now)
+	out.CreatedAt = schedule.CreatedAt
+	out.UpdatedAt = schedule.UpdatedAt
+
+	out.Participants = make([]domain.SimpleUserResponse, len(schedule.ParticipantUsers))
+	for i, user := range schedule.ParticipantUsers {
+		if err := serializer.Map(ctx, user, &out.Participants[i]); err != nil {
+			log.Error(ctx, err)
+		}
+	}
+	if primaryId, ok := schedule.OnCall(now, 0); ok {
+		out.Primary = findParticipant(out.Participants, primaryId)
+	}
+	if secondaryId, ok := schedule.OnCall(now, 1); ok {
+		out.Secondary = findParticipant(out.Participants, secondaryId)
+	}
+
+	if err := serializer.Map(ctx, schedule.Creator, &out.Creator); err != nil {
+		log.Error(ctx, err)
+	}
+	return
+}
+
+func findParticipant(participants []domain.SimpleUserResponse, userId uuid.UUID) *domain.SimpleUserResponse {
+	for i := range participants {
+		if participants[i].ID == userId.String() {
+			return &participants[i]
+		}
+	}
+	return nil
+}
@@ -359,3 +359,38 @@ func (h *SystemNotificationRuleHandler) MakeDefaultSystemNotificationRules(w htt
 
 	ResponseJSON(w, r, http.StatusOK, nil)
 }
+
+// PreviewSystemNotificationRule godoc
+//
+//	@Tags			SystemNotificationRules
+//	@Summary		Preview SystemNotificationRule
+//	@Description	Run the rendered rule expression against Thanos for the last N hours and show when the rule would have fired
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string										true	"organizationId"
+//	@Param			body			body		domain.PreviewSystemNotificationRuleRequest	true	"preview systemNotificationRule request"
+//	@Success		200				{object}	domain.PreviewSystemNotificationRuleResponse
+//	@Router			/organizations/{organizationId}/system-notification-rules/preview [post]
+//	@Security		JWT
+func (h *SystemNotificationRuleHandler) PreviewSystemNotificationRule(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.PreviewSystemNotificationRuleRequest{}
+	err := UnmarshalRequestInput(r, &input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	out, err := h.usecase.Preview(r.Context(), organizationId, input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+	ResponseJSON(w, r, http.StatusOK, out)
+}
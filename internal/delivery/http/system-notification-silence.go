@@ -0,0 +1,414 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type SystemNotificationSilenceHandler struct {
+	usecase usecase.ISystemNotificationSilenceUsecase
+}
+
+type ISystemNotificationSilenceHandler interface {
+	CreateSystemNotificationSilence(w http.ResponseWriter, r *http.Request)
+	GetSystemNotificationSilences(w http.ResponseWriter, r *http.Request)
+	GetSystemNotificationSilence(w http.ResponseWriter, r *http.Request)
+	ExpireSystemNotificationSilence(w http.ResponseWriter, r *http.Request)
+	CreateStackMaintenanceWindow(w http.ResponseWriter, r *http.Request)
+	GetStackMaintenanceWindows(w http.ResponseWriter, r *http.Request)
+	UpdateStackMaintenanceWindow(w http.ResponseWriter, r *http.Request)
+	DeleteStackMaintenanceWindow(w http.ResponseWriter, r *http.Request)
+}
+
+func NewSystemNotificationSilenceHandler(u usecase.Usecase) ISystemNotificationSilenceHandler {
+	return &SystemNotificationSilenceHandler{
+		usecase: u.SystemNotificationSilence,
+	}
+}
+
+// CreateSystemNotificationSilence godoc
+//
+//	@Tags			SystemNotificationSilences
+//	@Summary		[CreateSystemNotificationSilence] 알림 사일런스 생성
+//	@Description	지정한 기간 동안 조건(클러스터, 노드, 알림 이름, 알림 설정)에 맞는 알림을 억제한다. 비어 있는 조건은 모든 값에 해당한다. 억제된 알림은 저장되지만 발송되지 않는다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string											true	"조직 식별자(o로 시작)"
+//	@Param			body			body		domain.CreateSystemNotificationSilenceRequest	true	"create system notification silence request"
+//	@Success		200				{object}	domain.CreateSystemNotificationSilenceResponse
+//	@Router			/organizations/{organizationId}/system-notification-silences [post]
+//	@Security		JWT
+func (h *SystemNotificationSilenceHandler) CreateSystemNotificationSilence(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.CreateSystemNotificationSilenceRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	dto := model.SystemNotificationSilence{
+		ClusterId: domain.ClusterId(input.ClusterId),
+		Node:      input.Node,
+		AlertName: input.AlertName,
+		StartsAt:  input.StartsAt,
+		EndsAt:    input.EndsAt,
+		Comment:   input.Comment,
+	}
+	if input.SystemNotificationRuleId != "" {
+		ruleId, err := uuid.Parse(input.SystemNotificationRuleId)
+		if err != nil {
+			ErrorJSON(w, r, httpErrors.NewBadRequestError(err, "SNR_NOT_EXISTED_SYSTEM_NOTIFICATION_RULE", ""))
+			return
+		}
+		dto.SystemNotificationRuleId = &ruleId
+	}
+
+	silenceId, err := h.usecase.Create(r.Context(), organizationId, dto)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.CreateSystemNotificationSilenceResponse{ID: silenceId.String()})
+}
+
+// GetSystemNotificationSilences godoc
+//
+//	@Tags			SystemNotificationSilences
+//	@Summary		[GetSystemNotificationSilences] 알림 사일런스 목록 조회
+//	@Description	조직의 알림 사일런스 목록을 조회한다. 종료된 사일런스도 active 플래그와 함께 조회된다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"조직 식별자(o로 시작)"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			sortColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Param			filters			query		[]string	false	"filters"
+//	@Success		200				{object}	domain.GetSystemNotificationSilencesResponse
+//	@Router			/organizations/{organizationId}/system-notification-silences [get]
+//	@Security		JWT
+func (h *SystemNotificationSilenceHandler) GetSystemNotificationSilences(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+	silences, err := h.usecase.Fetch(r.Context(), organizationId, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetSystemNotificationSilencesResponse
+	out.SystemNotificationSilences = make([]domain.SystemNotificationSilenceResponse, len(silences))
+	now := time.Now()
+	for i, silence := range silences {
+		out.SystemNotificationSilences[i] = convertSystemNotificationSilenceToResponse(r.Context(), silence, now)
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetSystemNotificationSilence godoc
+//
+//	@Tags			SystemNotificationSilences
+//	@Summary		[GetSystemNotificationSilence] 알림 사일런스 조회
+//	@Description	알림 사일런스를 조회한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId				path		string	true	"조직 식별자(o로 시작)"
+//	@Param			systemNotificationSilenceId	path		string	true	"알림 사일런스 식별자(uuid)"
+//	@Success		200							{object}	domain.SystemNotificationSilenceResponse
+//	@Router			/organizations/{organizationId}/system-notification-silences/{systemNotificationSilenceId} [get]
+//	@Security		JWT
+func (h *SystemNotificationSilenceHandler) GetSystemNotificationSilence(w http.ResponseWriter, r *http.Request) {
+	organizationId, silenceId, err := systemNotificationSilencePathParams(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	silence, err := h.usecase.Get(r.Context(), organizationId, silenceId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, convertSystemNotificationSilenceToResponse(r.Context(), silence, time.Now()))
+}
+
+// ExpireSystemNotificationSilence godoc
+//
+//	@Tags			SystemNotificationSilences
+//	@Summary		[ExpireSystemNotificationSilence] 알림 사일런스 종료
+//	@Description	알림 사일런스를 즉시 종료한다. 진행 중인 사일런스는 이력으로 남고, 시작 전인 사일런스는 삭제된다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId				path	string	true	"조직 식별자(o로 시작)"
+//	@Param			systemNotificationSilenceId	path	string	true	"알림 사일런스 식별자(uuid)"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/system-notification-silences/{systemNotificationSilenceId} [delete]
+//	@Security		JWT
+func (h *SystemNotificationSilenceHandler) ExpireSystemNotificationSilence(w http.ResponseWriter, r *http.Request) {
+	organizationId, silenceId, err := systemNotificationSilencePathParams(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err := h.usecase.Expire(r.Context(), organizationId, silenceId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, "")
+}
+
+// CreateStackMaintenanceWindow godoc
+//
+//	@Tags			SystemNotificationSilences
+//	@Summary		[CreateStackMaintenanceWindow] 스택 점검 시간 생성
+//	@Description	스택의 정기 점검 시간을 생성한다. 지정한 요일(0=일요일)의 시작 시각부터 지속 시간 동안 스택에서 발생한 알림은 억제된다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string										true	"조직 식별자(o로 시작)"
+//	@Param			stackId			path		string										true	"스택 식별자"
+//	@Param			body			body		domain.CreateStackMaintenanceWindowRequest	true	"create stack maintenance window request"
+//	@Success		200				{object}	domain.CreateStackMaintenanceWindowResponse
+//	@Router			/organizations/{organizationId}/stacks/{stackId}/maintenance-windows [post]
+//	@Security		JWT
+func (h *SystemNotificationSilenceHandler) CreateStackMaintenanceWindow(w http.ResponseWriter, r *http.Request) {
+	organizationId, stackId, err := stackMaintenanceWindowPathParams(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	input := domain.CreateStackMaintenanceWindowRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	dto := model.StackMaintenanceWindow{
+		StackId:        stackId,
+		Name:           input.Name,
+		Description:    input.Description,
+		WeekdaysData:   input.Weekdays,
+		StartTime:      input.StartTime,
+		DurationMinute: input.DurationMinute,
+		Timezone:       input.Timezone,
+	}
+
+	maintenanceWindowId, err := h.usecase.CreateMaintenanceWindow(r.Context(), organizationId, dto)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.CreateStackMaintenanceWindowResponse{ID: maintenanceWindowId.String()})
+}
+
+// GetStackMaintenanceWindows godoc
+//
+//	@Tags			SystemNotificationSilences
+//	@Summary		[GetStackMaintenanceWindows] 스택 점검 시간 목록 조회
+//	@Description	스택의 정기 점검 시간 목록을 조회한다. 현재 점검 중인 경우 active 가 true 이다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"조직 식별자(o로 시작)"
+//	@Param			stackId			path		string	true	"스택 식별자"
+//	@Success		200				{object}	domain.GetStackMaintenanceWindowsResponse
+//	@Router			/organizations/{organizationId}/stacks/{stackId}/maintenance-windows [get]
+//	@Security		JWT
+func (h *SystemNotificationSilenceHandler) GetStackMaintenanceWindows(w http.ResponseWriter, r *http.Request) {
+	organizationId, stackId, err := stackMaintenanceWindowPathParams(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	windows, err := h.usecase.ListMaintenanceWindows(r.Context(), organizationId, stackId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetStackMaintenanceWindowsResponse
+	out.StackMaintenanceWindows = make([]domain.StackMaintenanceWindowResponse, len(windows))
+	now := time.Now()
+	for i, window := range windows {
+		out.StackMaintenanceWindows[i] = convertStackMaintenanceWindowToResponse(r.Context(), window, now)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// UpdateStackMaintenanceWindow godoc
+//
+//	@Tags			SystemNotificationSilences
+//	@Summary		[UpdateStackMaintenanceWindow] 스택 점검 시간 수정
+//	@Description	스택의 정기 점검 시간을 수정한다. enabled 를 false 로 설정하면 점검 시간을 삭제하지 않고 중지할 수 있다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId		path	string										true	"조직 식별자(o로 시작)"
+//	@Param			stackId				path	string										true	"스택 식별자"
+//	@Param			maintenanceWindowId	path	string										true	"점검 시간 식별자(uuid)"
+//	@Param			body				body	domain.UpdateStackMaintenanceWindowRequest	true	"update stack maintenance window request"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/stacks/{stackId}/maintenance-windows/{maintenanceWindowId} [put]
+//	@Security		JWT
+func (h *SystemNotificationSilenceHandler) UpdateStackMaintenanceWindow(w http.ResponseWriter, r *http.Request) {
+	organizationId, stackId, err := stackMaintenanceWindowPathParams(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+	maintenanceWindowId, err := uuid.Parse(mux.Vars(r)["maintenanceWindowId"])
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid maintenanceWindowId"), "SNS_INVALID_MAINTENANCE_WINDOW_ID", ""))
+		return
+	}
+
+	input := domain.UpdateStackMaintenanceWindowRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err := h.usecase.UpdateMaintenanceWindow(r.Context(), organizationId, stackId, maintenanceWindowId, input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, "")
+}
+
+// DeleteStackMaintenanceWindow godoc
+//
+//	@Tags			SystemNotificationSilences
+//	@Summary		[DeleteStackMaintenanceWindow] 스택 점검 시간 삭제
+//	@Description	스택의 정기 점검 시간을 삭제한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId		path	string	true	"조직 식별자(o로 시작)"
+//	@Param			stackId				path	string	true	"스택 식별자"
+//	@Param			maintenanceWindowId	path	string	true	"점검 시간 식별자(uuid)"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/stacks/{stackId}/maintenance-windows/{maintenanceWindowId} [delete]
+//	@Security		JWT
+func (h *SystemNotificationSilenceHandler) DeleteStackMaintenanceWindow(w http.ResponseWriter, r *http.Request) {
+	organizationId, stackId, err := stackMaintenanceWindowPathParams(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+	maintenanceWindowId, err := uuid.Parse(mux.Vars(r)["maintenanceWindowId"])
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid maintenanceWindowId"), "SNS_INVALID_MAINTENANCE_WINDOW_ID", ""))
+		return
+	}
+
+	if err := h.usecase.DeleteMaintenanceWindow(r.Context(), organizationId, stackId, maintenanceWindowId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, "")
+}
+
+func systemNotificationSilencePathParams(r *http.Request) (organizationId string, silenceId uuid.UUID, err error) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+	}
+	silenceId, err = uuid.Parse(vars["systemNotificationSilenceId"])
+	if err != nil {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("invalid systemNotificationSilenceId"), "SNS_INVALID_SILENCE_ID", "")
+	}
+	return organizationId, silenceId, nil
+}
+
+func stackMaintenanceWindowPathParams(r *http.Request) (organizationId string, stackId domain.StackId, err error) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		return "", "", httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+	}
+	stackId = domain.StackId(vars["stackId"])
+	if !stackId.Validate() {
+		return "", "", httpErrors.NewBadRequestError(fmt.Errorf("invalid stackId"), "C_INVALID_STACK_ID", "")
+	}
+	return organizationId, stackId, nil
+}
+
+func convertSystemNotificationSilenceToResponse(ctx context.Context, silence model.SystemNotificationSilence, now time.Time) (out domain.SystemNotificationSilenceResponse) {
+	out.ID = silence.ID.String()
+	out.OrganizationId = silence.OrganizationId
+	out.ClusterId = silence.ClusterId.String()
+	out.Node = silence.Node
+	out.AlertName = silence.AlertName
+	if silence.SystemNotificationRuleId != nil {
+		out.SystemNotificationRuleId = silence.SystemNotificationRuleId.String()
+	}
+	out.StartsAt = silence.StartsAt
+	out.EndsAt = silence.EndsAt
+	out.Comment = silence.Comment
+	out.Active = silence.IsActive(now)
+	out.CreatedAt = silence.CreatedAt
+
+	if err := serializer.Map(ctx, silence.Creator, &out.Creator); err != nil {
+		log.Error(ctx, err)
+	}
+	return
+}
+
+func convertStackMaintenanceWindowToResponse(ctx context.Context, window model.StackMaintenanceWindow, now time.Time) (out domain.StackMaintenanceWindowResponse) {
+	out.ID = window.ID.String()
+	out.StackId = window.StackId.String()
+	out.Name = window.Name
+	out.Description = window.Description
+	out.Weekdays = window.WeekdaysData
+	out.StartTime = window.StartTime
+	out.DurationMinute = window.DurationMinute
+	out.Timezone = window.Timezone
+	out.Enabled = window.Enabled
+	out.Active = window.IsActive(now)
+	out.CreatedAt = window.CreatedAt
+	out.UpdatedAt = window.UpdatedAt
+
+	if err := serializer.Map(ctx, window.Creator, &out.Creator); err != nil {
+		log.Error(ctx, err)
+	}
+	return
+}
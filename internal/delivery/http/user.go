@@ -2,11 +2,15 @@ package http
 
 import (
 	"context"
+	"encoding/csv"
 	"fmt"
+	"io"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal"
 	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
 	"github.com/openinfradev/tks-api/internal/model"
 	"github.com/openinfradev/tks-api/internal/pagination"
@@ -25,6 +29,7 @@ type IUserHandler interface {
 	Update(w http.ResponseWriter, r *http.Request)
 	UpdateUsers(w http.ResponseWriter, r *http.Request)
 	ResetPassword(w http.ResponseWriter, r *http.Request)
+	Unlock(w http.ResponseWriter, r *http.Request)
 
 	GetMyProfile(w http.ResponseWriter, r *http.Request)
 	UpdateMyProfile(w http.ResponseWriter, r *http.Request)
@@ -34,6 +39,8 @@ type IUserHandler interface {
 
 	CheckId(w http.ResponseWriter, r *http.Request)
 	CheckEmail(w http.ResponseWriter, r *http.Request)
+	ImportUsers(w http.ResponseWriter, r *http.Request)
+	ExportUsers(w http.ResponseWriter, r *http.Request)
 	GetPermissionsByAccountId(w http.ResponseWriter, r *http.Request)
 
 	// Admin
@@ -495,6 +502,40 @@ func (u UserHandler) ResetPassword(w http.ResponseWriter, r *http.Request) {
 	ResponseJSON(w, r, http.StatusOK, nil)
 }
 
+// Unlock godoc
+//
+//	@Tags			Users
+//	@Summary		Unlock user locked by login failures
+//	@Description	Unlock user locked by login failures and reset failure history
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path	string	true	"organizationId"
+//	@Param			accountId		path	string	true	"accountId"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/users/{accountId}/unlock [put]
+//	@Security		JWT
+func (u UserHandler) Unlock(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	accountId, ok := vars["accountId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("accountId not found in path"), "C_INVALID_ACCOUNT_ID", ""))
+		return
+	}
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("organizationId not found in path"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	err := u.usecase.UnlockByAccountId(r.Context(), accountId, organizationId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
 // GetMyProfile godoc
 //
 //	@Tags			My-profile
@@ -791,6 +832,201 @@ func (u UserHandler) CheckEmail(w http.ResponseWriter, r *http.Request) {
 	ResponseJSON(w, r, http.StatusOK, out)
 }
 
+var userCsvHeader = []string{"accountId", "name", "email", "department", "role"}
+
+// ImportUsers godoc
+//
+//	@Tags			Users
+//	@Summary		Import users from CSV
+//	@Description	Create users from CSV (accountId, name, email, department, role). Multiple roles are separated by ';'.
+//	@Description	Users are created only when all rows are valid. With dryRun, only the validation result is returned.
+//	@Description	If creating a user or syncing the cluster admin permission fails, all users created by the import are deleted.
+//	@Accept			text/csv
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			dryRun			query		bool	false	"validate only"
+//	@Param			sendEmail		query		bool	false	"send temporary password emails"
+//	@Param			body			body		string	true	"user CSV"
+//	@Success		200				{object}	domain.ImportUsersResponse
+//	@Router			/organizations/{organizationId}/users/import [post]
+//	@Security		JWT
+func (u UserHandler) ImportUsers(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("organizationId not found in path"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	dryRun, _ := strconv.ParseBool(urlParams.Get("dryRun"))
+	sendEmail, _ := strconv.ParseBool(urlParams.Get("sendEmail"))
+
+	rows, err := readUserCsv(w, r)
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(err, "U_INVALID_CSV", ""))
+		return
+	}
+
+	out, created, err := u.usecase.ImportUsers(r.Context(), organizationId, rows, dryRun)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if len(created) > 0 {
+		// Sync ClusterAdmin Permission to Keycloak
+		// 권한을 동기화하지 못하면 권한 없는 사용자가 남지 않도록 생성한 사용자를 모두 삭제한다.
+		stacks, err := u.stackUsecase.Fetch(r.Context(), organizationId, nil)
+		if err != nil {
+			u.usecase.RollbackImportedUsers(r.Context(), organizationId, created)
+			ErrorJSON(w, r, err)
+			return
+		}
+		stackIds := make([]string, 0)
+		for _, stack := range stacks {
+			stackIds = append(stackIds, stack.ID.String())
+		}
+		if err = u.syncKeycloakWithClusterAdminPermission(r.Context(), organizationId, stackIds, created); err != nil {
+			u.usecase.RollbackImportedUsers(r.Context(), organizationId, created)
+			ErrorJSON(w, r, err)
+			return
+		}
+
+		if sendEmail {
+			u.usecase.SendImportedUserPasswords(r.Context(), organizationId, &out, created)
+		}
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// ExportUsers godoc
+//
+//	@Tags			Users
+//	@Summary		Export users to CSV
+//	@Description	Export users of organization as CSV which can be imported again
+//	@Produce		text/csv
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Success		200				{string}	string	"user CSV"
+//	@Router			/organizations/{organizationId}/users/export [get]
+//	@Security		JWT
+func (u UserHandler) ExportUsers(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("organizationId not found in path"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	users, err := u.usecase.List(r.Context(), organizationId)
+	if err != nil {
+		if _, status := httpErrors.ErrorResponse(err); status != http.StatusNotFound {
+			ErrorJSON(w, r, err)
+			return
+		}
+		users = &[]model.User{}
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"users-%s.csv\"", organizationId))
+	w.WriteHeader(http.StatusOK)
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(userCsvHeader); err != nil {
+		log.Error(r.Context(), err)
+		return
+	}
+	for _, user := range *users {
+		roleNames := make([]string, len(user.Roles))
+		for i, role := range user.Roles {
+			roleNames[i] = role.Name
+		}
+		record := []string{user.AccountId, user.Name, user.Email, user.Department, strings.Join(roleNames, ";")}
+		if err := writer.Write(record); err != nil {
+			log.Error(r.Context(), err)
+			return
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		log.Error(r.Context(), err)
+	}
+}
+
+// readUserCsv reads the user CSV from the request body or the multipart "file" field.
+// The first record must be a header and the columns may be in any order.
+func readUserCsv(w http.ResponseWriter, r *http.Request) ([]domain.ImportUserRow, error) {
+	r.Body = http.MaxBytesReader(w, r.Body, internal.MaxImportUsersCsvSize)
+
+	var body io.Reader = r.Body
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		body = file
+	}
+
+	reader := csv.NewReader(body)
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	for _, name := range userCsvHeader {
+		if _, ok := columns[strings.ToLower(name)]; !ok && name != "department" {
+			return nil, fmt.Errorf("column '%s' is required", name)
+		}
+	}
+	column := func(record []string, name string) string {
+		i, ok := columns[strings.ToLower(name)]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	rows := make([]domain.ImportUserRow, 0)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) >= internal.MaxImportUsers {
+			return nil, fmt.Errorf("CSV must have at most %d users", internal.MaxImportUsers)
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := domain.ImportUserRow{
+			Line:       line,
+			AccountId:  column(record, "accountId"),
+			Name:       column(record, "name"),
+			Email:      column(record, "email"),
+			Department: column(record, "department"),
+			Roles:      make([]string, 0),
+		}
+		for _, role := range strings.Split(column(record, "role"), ";") {
+			if role = strings.TrimSpace(role); role != "" {
+				row.Roles = append(row.Roles, role)
+			}
+		}
+		rows = append(rows, row)
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("CSV has no users")
+	}
+	return rows, nil
+}
+
 // GetPermissionsByAccountId godoc
 //
 //	@Tags			Users
@@ -936,7 +1172,7 @@ func (u UserHandler) Admin_Create(w http.ResponseWriter, r *http.Request) {
 		ID: organizationId,
 	}
 
-	user.Password = u.usecase.GenerateRandomPassword(r.Context())
+	user.Password = u.usecase.GenerateRandomPassword(r.Context(), organizationId)
 
 	resUser, err := u.usecase.Create(r.Context(), &user)
 	if err != nil {
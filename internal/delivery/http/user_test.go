@@ -0,0 +1,71 @@
+package http
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/openinfradev/tks-api/internal"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+func TestReadUserCsv(t *testing.T) {
+	// BOM 이 붙은 헤더, 순서가 바뀐 컬럼, 여러 역할과 빈 department 컬럼
+	body := "\ufeffemail,accountId,name,role\n" +
+		"alice@example.com,alice,Alice,admin; user\n" +
+		" bob@example.com , bob,Bob,\n"
+
+	r := httptest.NewRequest("POST", "/", strings.NewReader(body))
+	r.Header.Set("Content-Type", "text/csv")
+	rows, err := readUserCsv(httptest.NewRecorder(), r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []domain.ImportUserRow{
+		{Line: 2, AccountId: "alice", Name: "Alice", Email: "alice@example.com", Roles: []string{"admin", "user"}},
+		{Line: 3, AccountId: "bob", Name: "Bob", Email: "bob@example.com", Roles: []string{}},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("readUserCsv()\n got: %+v\nwant: %+v", rows, want)
+	}
+}
+
+func TestReadUserCsvMultipart(t *testing.T) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	file, _ := writer.CreateFormFile("file", "users.csv")
+	_, _ = file.Write([]byte("accountId,name,email,department,role\nalice,Alice,alice@example.com,dev,user\n"))
+	_ = writer.Close()
+
+	r := httptest.NewRequest("POST", "/", &body)
+	r.Header.Set("Content-Type", writer.FormDataContentType())
+	rows, err := readUserCsv(httptest.NewRecorder(), r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 || rows[0].AccountId != "alice" || rows[0].Department != "dev" {
+		t.Errorf("unexpected rows: %+v", rows)
+	}
+}
+
+func TestReadUserCsvInvalid(t *testing.T) {
+	tooMany := "accountId,name,email,role\n" + strings.Repeat("a,A,a@example.com,user\n", internal.MaxImportUsers+1)
+
+	for name, body := range map[string]string{
+		"empty":          "",
+		"missing column": "accountId,name,role\nalice,Alice,user\n",
+		"no users":       "accountId,name,email,role\n",
+		"broken quote":   "accountId,name,email,role\n\"alice,Alice,alice@example.com,user\n",
+		"too many users": tooMany,
+	} {
+		r := httptest.NewRequest("POST", "/", strings.NewReader(body))
+		r.Header.Set("Content-Type", "text/csv")
+		if _, err := readUserCsv(httptest.NewRecorder(), r); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package helper
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+)
+
+const (
+	AccessTokenPrefix       = "tks_"
+	accessTokenLookupLength = 8
+	accessTokenSecretLength = 40
+)
+
+// GenerateAccessToken returns a new plain access token and its lookup prefix.
+// The token has the form tks_<lookup>_<secret>; only its hash is meant to be stored.
+func GenerateAccessToken() (token string, lookup string) {
+	lookup = GenerateRandomString(accessTokenLookupLength)
+	token = AccessTokenPrefix + lookup + "_" + GenerateRandomString(accessTokenSecretLength)
+	return token, lookup
+}
+
+func IsAccessToken(token string) bool {
+	return strings.HasPrefix(token, AccessTokenPrefix)
+}
+
+// ParseAccessTokenLookup extracts the lookup prefix from a plain access token.
+func ParseAccessTokenLookup(token string) (string, bool) {
+	if !IsAccessToken(token) {
+		return "", false
+	}
+	parts := strings.Split(strings.TrimPrefix(token, AccessTokenPrefix), "_")
+	if len(parts) != 2 || len(parts[0]) != accessTokenLookupLength || len(parts[1]) != accessTokenSecretLength {
+		return "", false
+	}
+	return parts[0], true
+}
+
+func HashAccessToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func CheckAccessTokenHash(hashVal, token string) bool {
+	return subtle.ConstantTimeCompare([]byte(hashVal), []byte(HashAccessToken(token))) == 1
+}
@@ -0,0 +1,46 @@
+package helper_test
+
+import (
+	"testing"
+
+	"github.com/openinfradev/tks-api/internal/helper"
+)
+
+func TestAccessTokenGeneration(t *testing.T) {
+	token, lookup := helper.GenerateAccessToken()
+	if !helper.IsAccessToken(token) {
+		t.Errorf("IsAccessToken() = false, token %s", token)
+		return
+	}
+
+	parsed, ok := helper.ParseAccessTokenLookup(token)
+	if !ok || parsed != lookup {
+		t.Errorf("ParseAccessTokenLookup() = %s, %v, want %s", parsed, ok, lookup)
+		return
+	}
+
+	hashed := helper.HashAccessToken(token)
+	if !helper.CheckAccessTokenHash(hashed, token) {
+		t.Errorf("CheckAccessTokenHash() error")
+		return
+	}
+
+	other, _ := helper.GenerateAccessToken()
+	if helper.CheckAccessTokenHash(hashed, other) {
+		t.Errorf("CheckAccessTokenHash() accepted a different token")
+	}
+}
+
+func TestParseAccessTokenLookup(t *testing.T) {
+	tests := []string{
+		"",
+		"eyJhbGciOiJSUzI1NiJ9.eyJzdWIiOiIxIn0.sig",
+		"tks_short_secret",
+		"tks_abcdefgh",
+	}
+	for _, token := range tests {
+		if _, ok := helper.ParseAccessTokenLookup(token); ok {
+			t.Errorf("ParseAccessTokenLookup(%q) accepted an invalid token", token)
+		}
+	}
+}
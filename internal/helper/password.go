@@ -24,15 +24,26 @@ func GenerateRandomString(length int) string {
 	var letters = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789")
 	b := make([]rune, length)
 	for i := range b {
-		b[i] = randomRune(letters)
+		b[i] = RandomRune(letters)
 	}
 	return string(b)
 }
 
-func randomRune(chars []rune) rune {
+func RandomRune(chars []rune) rune {
 	n, err := rand.Int(rand.Reader, big.NewInt(int64(len(chars))))
 	if err != nil {
 		panic(err)
 	}
 	return chars[n.Int64()]
 }
+
+func ShuffleRunes(runes []rune) {
+	for i := len(runes) - 1; i > 0; i-- {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			panic(err)
+		}
+		j := n.Int64()
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+}
@@ -0,0 +1,120 @@
+package helper
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// TOTP (RFC 6238) parameters. Google Authenticator 등 일반적인 앱의 기본값을 따른다.
+const (
+	totpSecretSize = 20
+	totpDigits     = 6
+	totpPeriod     = 30
+	totpSkew       = 1
+
+	recoveryCodeLength = 10
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func GenerateTotpSecret() (string, error) {
+	secret := make([]byte, totpSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return totpEncoding.EncodeToString(secret), nil
+}
+
+// TotpProvisioningUri returns otpauth uri which can be rendered as QR code for authenticator apps.
+func TotpProvisioningUri(issuer string, accountName string, secret string) string {
+	v := url.Values{}
+	v.Set("secret", secret)
+	v.Set("issuer", issuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprintf("%d", totpDigits))
+	v.Set("period", fmt.Sprintf("%d", totpPeriod))
+
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + accountName,
+		RawQuery: v.Encode(),
+	}
+	return u.String()
+}
+
+func GenerateTotpCode(secret string, t time.Time) (string, error) {
+	return totpCode(secret, uint64(t.Unix()/totpPeriod))
+}
+
+// ValidateTotpCode checks the code against the current time step and its neighbours to tolerate clock drift.
+func ValidateTotpCode(secret string, code string, t time.Time) bool {
+	_, ok := MatchTotpCode(secret, code, t)
+	return ok
+}
+
+// MatchTotpCode is ValidateTotpCode which also returns the time step of the matched code,
+// so that the caller can refuse a code which has already been accepted.
+func MatchTotpCode(secret string, code string, t time.Time) (step int64, ok bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != totpDigits {
+		return 0, false
+	}
+
+	counter := t.Unix() / totpPeriod
+	for i := -totpSkew; i <= totpSkew; i++ {
+		expected, err := totpCode(secret, uint64(counter+int64(i)))
+		if err != nil {
+			return 0, false
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return counter + int64(i), true
+		}
+	}
+	return 0, false
+}
+
+func totpCode(secret string, counter uint64) (string, error) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(strings.TrimSpace(secret)))
+	if err != nil {
+		return "", err
+	}
+
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(buf)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", totpDigits, value%mod), nil
+}
+
+// GenerateRecoveryCodes returns one-time codes used when the authenticator is not available.
+// Only hashes of the codes are meant to be stored.
+func GenerateRecoveryCodes(count int) []string {
+	codes := make([]string, count)
+	for i := range codes {
+		codes[i] = strings.ToLower(GenerateRandomString(recoveryCodeLength))
+	}
+	return codes
+}
+
+func HashRecoveryCode(code string) string {
+	return HashAccessToken(strings.ToLower(strings.TrimSpace(code)))
+}
@@ -0,0 +1,71 @@
+package helper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openinfradev/tks-api/internal/helper"
+)
+
+// RFC 6238 test vectors (SHA1), truncated to 6 digits.
+func TestGenerateTotpCode(t *testing.T) {
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+
+	for _, tt := range tests {
+		got, err := helper.GenerateTotpCode(secret, time.Unix(tt.unix, 0))
+		if err != nil {
+			t.Errorf("GenerateTotpCode() error = %v", err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("GenerateTotpCode(%d) = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestValidateTotpCode(t *testing.T) {
+	secret, err := helper.GenerateTotpSecret()
+	if err != nil {
+		t.Errorf("GenerateTotpSecret() error = %v", err)
+		return
+	}
+
+	now := time.Now()
+	code, _ := helper.GenerateTotpCode(secret, now.Add(-30*time.Second))
+	if !helper.ValidateTotpCode(secret, code, now) {
+		t.Errorf("ValidateTotpCode() rejected code of previous time step")
+	}
+
+	code, _ = helper.GenerateTotpCode(secret, now.Add(-5*time.Minute))
+	if helper.ValidateTotpCode(secret, code, now) {
+		t.Errorf("ValidateTotpCode() accepted stale code")
+	}
+}
+
+func TestMatchTotpCode(t *testing.T) {
+	secret, err := helper.GenerateTotpSecret()
+	if err != nil {
+		t.Errorf("GenerateTotpSecret() error = %v", err)
+		return
+	}
+
+	now := time.Unix(1700000015, 0)
+	code, _ := helper.GenerateTotpCode(secret, now.Add(-30*time.Second))
+	step, ok := helper.MatchTotpCode(secret, code, now)
+	if !ok || step != now.Unix()/30-1 {
+		t.Errorf("MatchTotpCode() = %d, %v, want %d, true", step, ok, now.Unix()/30-1)
+	}
+
+	if _, ok := helper.MatchTotpCode(secret, "12345", now); ok {
+		t.Errorf("MatchTotpCode() accepted code of wrong length")
+	}
+}
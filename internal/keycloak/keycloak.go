@@ -5,6 +5,7 @@ import (
 	"crypto/tls"
 	"fmt"
 	"github.com/spf13/viper"
+	"strings"
 
 	"time"
 
@@ -26,6 +27,7 @@ type IKeycloak interface {
 	GetRealms(ctx context.Context) ([]*model.Organization, error)
 	DeleteRealm(ctx context.Context, organizationId string) error
 	UpdateRealm(ctx context.Context, organizationId string, organizationConfig model.Organization) error
+	UpdateRealmPasswordPolicy(ctx context.Context, organizationId string, policy model.PasswordPolicy) error
 
 	CreateClient(ctx context.Context, organizationId string, clientName string, clientSecret string, redirectURIs *[]string) (string, error)
 	CreateClientProtocolMapper(ctx context.Context, realm string, clientId string, mapper gocloak.ProtocolMapperRepresentation) (string, error)
@@ -338,6 +340,21 @@ func (k *Keycloak) UpdateRealm(ctx context.Context, organizationId string, organ
 	return nil
 }
 
+func (k *Keycloak) UpdateRealmPasswordPolicy(ctx context.Context, organizationId string, policy model.PasswordPolicy) error {
+	token := k.adminCliToken
+	realm, err := k.client.GetRealm(context.Background(), token.AccessToken, organizationId)
+	if err != nil {
+		return err
+	}
+
+	realm.PasswordPolicy = gocloak.StringP(realmPasswordPolicy(policy))
+	err = k.client.UpdateRealm(context.Background(), token.AccessToken, *realm)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func (k *Keycloak) CreateClient(ctx context.Context, organizationId string, clientName string, clientSecret string, redirectURIs *[]string) (string, error) {
 	token := k.adminCliToken
 	clientUUID, err := k.createDefaultClient(ctx, token.AccessToken, organizationId, clientName, clientSecret, redirectURIs)
@@ -1006,6 +1023,28 @@ func defaultRealmSetting(realmId string) gocloak.RealmRepresentation {
 	}
 }
 
+// realmPasswordPolicy 는 keycloak realm 의 password policy 문자열을 만든다.
+// 비밀번호 만료는 TKS 에서 직접 처리하므로 forceExpiredPasswordChange 는 설정하지 않는다.
+func realmPasswordPolicy(policy model.PasswordPolicy) string {
+	rules := []string{fmt.Sprintf("length(%d)", policy.MinLength)}
+	if policy.RequireUppercase {
+		rules = append(rules, "upperCase(1)")
+	}
+	if policy.RequireLowercase {
+		rules = append(rules, "lowerCase(1)")
+	}
+	if policy.RequireDigit {
+		rules = append(rules, "digits(1)")
+	}
+	if policy.RequireSpecial {
+		rules = append(rules, "specialChars(1)")
+	}
+	if policy.ReuseBanned && policy.HistoryDepth > 0 {
+		rules = append(rules, fmt.Sprintf("passwordHistory(%d)", policy.HistoryDepth))
+	}
+	return strings.Join(rules, " and ")
+}
+
 func getRefreshTokenExpiredDuration(token *gocloak.JWT) time.Duration {
 	return time.Duration(token.RefreshExpiresIn) * time.Second
 }
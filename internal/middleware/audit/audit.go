@@ -2,9 +2,11 @@ package audit
 
 import (
 	"bytes"
+	"fmt"
 	"io"
 	"net"
 	"net/http"
+	"strings"
 
 	"github.com/gorilla/mux"
 	internalApi "github.com/openinfradev/tks-api/internal/delivery/api"
@@ -61,6 +63,24 @@ func (a *defaultAudit) WithAudit(endpoint internalApi.Endpoint, handler http.Han
 				message, description = fn(r.Context(), lrw.GetBody().Bytes(), body, statusCode)
 				r.Body = io.NopCloser(bytes.NewBuffer(body))
 
+				// service account is not a user of keycloak, so it is recorded with its own name
+				if accessToken, ok := request.AccessTokenFrom(r.Context()); ok && accessToken.IsServiceAccount() {
+					dto := model.Audit{
+						OrganizationId: organizationId,
+						Group:          internalApi.ApiMap[endpoint].Group,
+						Message:        message,
+						Description:    description,
+						ClientIP:       GetClientIpAddress(w, r),
+						UserAccountId:  user.GetAccountId(),
+						UserName:       accessToken.Name,
+						UserRoles:      user.GetRoleOrganizationMapping()[user.GetOrganizationId()],
+					}
+					if _, err := a.repo.Create(r.Context(), dto); err != nil {
+						log.Error(r.Context(), err)
+					}
+					return
+				}
+
 				u, err := a.userRepo.GetByUuid(r.Context(), userId)
 				if err != nil {
 					log.Error(r.Context(), err)
@@ -98,15 +118,90 @@ func (a *defaultAudit) WithAudit(endpoint internalApi.Endpoint, handler http.Han
 
 var X_FORWARDED_FOR = "X-Forwarded-For"
 
-func GetClientIpAddress(w http.ResponseWriter, r *http.Request) string {
-	xforward := r.Header.Get(X_FORWARDED_FOR)
-	if xforward != "" {
-		return xforward
+// DefaultTrustedProxies 는 클러스터 안의 ingress 가 사용하는 사설 대역이다.
+// 사설 대역에서 직접 접속하는 클라이언트는 X-Forwarded-For 로 IP 를 바꿀 수 있으므로, 프록시 주소만 지정하는 것이 좋다.
+const DefaultTrustedProxies = "10.0.0.0/8,172.16.0.0/12,192.168.0.0/16,127.0.0.0/8,::1/128,fc00::/7"
+
+// 서버 시작 시 SetTrustedProxies 로 한 번만 파싱한다.
+var trustedProxies []*net.IPNet
+
+// SetTrustedProxies 는 X-Forwarded-For 를 설정할 수 있는 프록시의 IP 또는 CIDR 목록을 설정한다.
+// 목록이 비어 있으면 모든 요청이 프록시의 IP 로 기록되고, IP 별 로그인 제한도 프록시 단위로 적용된다.
+func SetTrustedProxies(value string) error {
+	out, err := parseTrustedProxies(value)
+	if err != nil {
+		return err
 	}
+	trustedProxies = out
+	return nil
+}
 
+// GetClientIpAddress 는 요청한 클라이언트의 IP 를 반환한다.
+// X-Forwarded-For 의 왼쪽 값은 클라이언트가 임의로 넣을 수 있으므로, 신뢰하는 프록시(trusted-proxies)를 거친 요청에 한해
+// 오른쪽부터 신뢰하는 프록시가 아닌 첫 번째 주소를 사용한다. 신뢰하는 프록시가 설정되지 않았다면 RemoteAddr 를 사용한다.
+func GetClientIpAddress(w http.ResponseWriter, r *http.Request) string {
 	clientAddr, _, err := net.SplitHostPort(r.RemoteAddr)
 	if err != nil {
+		clientAddr = r.RemoteAddr
+	}
+
+	if !isTrustedProxy(trustedProxies, clientAddr) {
+		return clientAddr
+	}
+
+	xforward := r.Header.Get(X_FORWARDED_FOR)
+	if xforward == "" {
 		return clientAddr
 	}
-	return ""
+	addrs := strings.Split(xforward, ",")
+	for i := len(addrs) - 1; i >= 0; i-- {
+		addr := strings.TrimSpace(addrs[i])
+		if net.ParseIP(addr) == nil {
+			break
+		}
+		clientAddr = addr
+		if !isTrustedProxy(trustedProxies, addr) {
+			break
+		}
+	}
+	return clientAddr
+}
+
+func parseTrustedProxies(value string) (out []*net.IPNet, err error) {
+	for _, s := range strings.Split(value, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		if !strings.Contains(s, "/") {
+			ip := net.ParseIP(s)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy '%s'", s)
+			}
+			if ip.To4() != nil {
+				s += "/32"
+			} else {
+				s += "/128"
+			}
+		}
+		_, ipNet, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy '%s': %w", s, err)
+		}
+		out = append(out, ipNet)
+	}
+	return out, nil
+}
+
+func isTrustedProxy(trustedProxies []*net.IPNet, addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, ipNet := range trustedProxies {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,50 @@
+package audit
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetClientIpAddress(t *testing.T) {
+	if err := SetTrustedProxies("10.0.0.0/8,192.168.0.1"); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = SetTrustedProxies(DefaultTrustedProxies) }()
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		xff        string
+		want       string
+	}{
+		{"direct client", "203.0.113.7:4000", "", "203.0.113.7"},
+		{"untrusted peer cannot spoof", "203.0.113.7:4000", "198.51.100.1", "203.0.113.7"},
+		{"trusted proxy", "10.1.2.3:4000", "198.51.100.1", "198.51.100.1"},
+		{"spoofed left value is ignored", "10.1.2.3:4000", "1.1.1.1, 198.51.100.1", "198.51.100.1"},
+		{"chain of trusted proxies", "10.1.2.3:4000", "198.51.100.1, 192.168.0.1", "198.51.100.1"},
+		{"trusted proxy without header", "10.1.2.3:4000", "", "10.1.2.3"},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest("GET", "/", nil)
+		r.RemoteAddr = tt.remoteAddr
+		if tt.xff != "" {
+			r.Header.Set(X_FORWARDED_FOR, tt.xff)
+		}
+		if got := GetClientIpAddress(nil, r); got != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSetTrustedProxies(t *testing.T) {
+	defer func() { _ = SetTrustedProxies(DefaultTrustedProxies) }()
+
+	if err := SetTrustedProxies(DefaultTrustedProxies); err != nil {
+		t.Errorf("default trusted proxies must be valid: %v", err)
+	}
+	for _, value := range []string{"10.0.0.0/33", "proxy.local", "10.0.0.1,not-an-ip"} {
+		if err := SetTrustedProxies(value); err == nil {
+			t.Errorf("SetTrustedProxies(%q) expected error", value)
+		}
+	}
+}
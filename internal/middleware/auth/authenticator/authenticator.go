@@ -2,8 +2,11 @@ package authenticator
 
 import (
 	"fmt"
-	"github.com/openinfradev/tks-api/internal/repository"
 	"net/http"
+	"strings"
+
+	"github.com/openinfradev/tks-api/internal/helper"
+	"github.com/openinfradev/tks-api/internal/repository"
 
 	internalHttp "github.com/openinfradev/tks-api/internal/delivery/http"
 	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
@@ -22,34 +25,50 @@ type Request interface {
 type defaultAuthenticator struct {
 	kcAuth     Request
 	customAuth Request
+	tokenAuth  Request
 	repo       repository.Repository
 }
 
-func NewAuthenticator(kc Request, repo repository.Repository, c Request) *defaultAuthenticator {
+func NewAuthenticator(kc Request, repo repository.Repository, c Request, t Request) *defaultAuthenticator {
 	return &defaultAuthenticator{
 		kcAuth:     kc,
 		repo:       repo,
 		customAuth: c,
+		tokenAuth:  t,
 	}
 }
 
 func (a *defaultAuthenticator) WithAuthentication(handler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp, ok, err := a.kcAuth.AuthenticateRequest(r)
-		if !ok {
-			log.Error(r.Context(), err)
-			internalHttp.ErrorJSON(w, r, err)
-			return
-		}
-		if err != nil {
-			internalHttp.ErrorJSON(w, r, err)
-			return
-		}
+		var resp *Response
+		var ok bool
+		var err error
 
-		_, ok, err = a.customAuth.AuthenticateRequest(r)
-		if !ok {
-			internalHttp.ErrorJSON(w, r, err)
-			return
+		if isAccessTokenRequest(r) {
+			// personal access token or service account key
+			resp, ok, err = a.tokenAuth.AuthenticateRequest(r)
+			if !ok {
+				log.Error(r.Context(), err)
+				internalHttp.ErrorJSON(w, r, err)
+				return
+			}
+		} else {
+			resp, ok, err = a.kcAuth.AuthenticateRequest(r)
+			if !ok {
+				log.Error(r.Context(), err)
+				internalHttp.ErrorJSON(w, r, err)
+				return
+			}
+			if err != nil {
+				internalHttp.ErrorJSON(w, r, err)
+				return
+			}
+
+			_, ok, err = a.customAuth.AuthenticateRequest(r)
+			if !ok {
+				internalHttp.ErrorJSON(w, r, err)
+				return
+			}
 		}
 
 		r = r.WithContext(request.WithUser(r.Context(), resp.User))
@@ -73,6 +92,14 @@ func (a *defaultAuthenticator) WithAuthentication(handler http.Handler) http.Han
 	})
 }
 
+func isAccessTokenRequest(r *http.Request) bool {
+	parts := strings.SplitN(strings.TrimSpace(r.Header.Get("Authorization")), " ", 3)
+	if len(parts) < 2 || strings.ToLower(parts[0]) != "bearer" {
+		return false
+	}
+	return helper.IsAccessToken(parts[1])
+}
+
 type Response struct {
 	User user.Info
 }
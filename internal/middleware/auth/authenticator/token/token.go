@@ -0,0 +1,93 @@
+package token
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/openinfradev/tks-api/internal/helper"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/authenticator"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/user"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+// accessTokenAuthenticator authenticates personal access tokens and service account keys
+// issued by tks-api. Only the hash of the token is stored, so the lookup prefix is used to find the record.
+type accessTokenAuthenticator struct {
+	repo repository.Repository
+}
+
+func NewAccessTokenAuthenticator(repo repository.Repository) *accessTokenAuthenticator {
+	return &accessTokenAuthenticator{
+		repo: repo,
+	}
+}
+
+func (a *accessTokenAuthenticator) AuthenticateRequest(r *http.Request) (*authenticator.Response, bool, error) {
+	authHeader := strings.TrimSpace(r.Header.Get("Authorization"))
+	if authHeader == "" {
+		return nil, false, fmt.Errorf("authorizer header is invalid")
+	}
+	parts := strings.SplitN(authHeader, " ", 3)
+	if len(parts) < 2 || strings.ToLower(parts[0]) != "bearer" {
+		return nil, false, fmt.Errorf("authorizer header is invalid")
+	}
+	token := parts[1]
+
+	lookup, ok := helper.ParseAccessTokenLookup(token)
+	if !ok {
+		return nil, false, httpErrors.NewUnauthorizedError(fmt.Errorf("invalid access token format"), "A_INVALID_TOKEN", "토큰이 유효하지 않습니다.")
+	}
+
+	accessToken, err := a.repo.AccessToken.GetByTokenPrefix(r.Context(), lookup)
+	if err != nil {
+		return nil, false, httpErrors.NewUnauthorizedError(err, "A_INVALID_TOKEN", "토큰이 유효하지 않습니다.")
+	}
+	if !helper.CheckAccessTokenHash(accessToken.TokenHash, token) {
+		return nil, false, httpErrors.NewUnauthorizedError(fmt.Errorf("mismatch access token"), "A_INVALID_TOKEN", "토큰이 유효하지 않습니다.")
+	}
+	if accessToken.IsRevoked() {
+		return nil, false, httpErrors.NewUnauthorizedError(fmt.Errorf("access token is revoked"), "A_UNUSABLE_TOKEN", "토큰이 폐기되었습니다.")
+	}
+	if accessToken.IsExpired() {
+		return nil, false, httpErrors.NewUnauthorizedError(fmt.Errorf("access token is expired"), "A_EXPIRED_TOKEN", "토큰이 만료되었습니다.")
+	}
+
+	userInfo := &user.DefaultInfo{
+		OrganizationId:          accessToken.OrganizationId,
+		ProjectIds:              make([]string, 0),
+		RoleOrganizationMapping: make(map[string]string),
+		RoleProjectMapping:      make(map[string]string),
+	}
+	if accessToken.ServiceAccount != nil {
+		userInfo.UserId = accessToken.ServiceAccount.ID
+		userInfo.AccountId = accessToken.ServiceAccount.Name
+		userInfo.RoleOrganizationMapping[accessToken.OrganizationId] = accessToken.ServiceAccount.Role.Name
+	} else if accessToken.User != nil {
+		userInfo.UserId = accessToken.User.ID
+		userInfo.AccountId = accessToken.User.AccountId
+		for _, role := range accessToken.User.Roles {
+			userInfo.RoleOrganizationMapping[accessToken.OrganizationId] = role.Name
+		}
+	} else {
+		return nil, false, httpErrors.NewUnauthorizedError(fmt.Errorf("owner of access token is not found"), "A_INVALID_TOKEN", "토큰이 유효하지 않습니다.")
+	}
+
+	if err := a.repo.AccessToken.UpdateLastUsedAt(r.Context(), accessToken.ID); err != nil {
+		log.Error(r.Context(), err)
+	}
+
+	*r = *(r.WithContext(request.WithToken(r.Context(), token)))
+	*r = *(r.WithContext(request.WithSession(r.Context(), accessToken.ID.String())))
+	*r = *(r.WithContext(request.WithAccessToken(r.Context(), user.AccessTokenInfo{
+		TokenId:          accessToken.ID,
+		Name:             accessToken.Name,
+		ServiceAccountId: accessToken.ServiceAccountId,
+		Scopes:           accessToken.Scopes,
+	})))
+
+	return &authenticator.Response{User: userInfo}, true, nil
+}
@@ -0,0 +1,40 @@
+package authorizer
+
+import (
+	"fmt"
+	"net/http"
+
+	internalApi "github.com/openinfradev/tks-api/internal/delivery/api"
+	internalHttp "github.com/openinfradev/tks-api/internal/delivery/http"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+)
+
+// AccessTokenScopeFilter limits requests authenticated by a personal access token or a service account key
+// to the endpoints (or endpoint groups) listed in the token scopes.
+func AccessTokenScopeFilter(handler http.Handler, repo repository.Repository) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accessToken, ok := request.AccessTokenFrom(r.Context())
+		if !ok {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		endpointInfo, ok := request.EndpointFrom(r.Context())
+		if !ok {
+			internalHttp.ErrorJSON(w, r, httpErrors.NewInternalServerError(fmt.Errorf("endpoint not found"), "", ""))
+			return
+		}
+
+		endpoint := internalApi.ApiMap[endpointInfo]
+		for _, scope := range accessToken.Scopes {
+			if scope == endpoint.Name || scope == endpoint.Group {
+				handler.ServeHTTP(w, r)
+				return
+			}
+		}
+
+		internalHttp.ErrorJSON(w, r, httpErrors.NewForbiddenError(fmt.Errorf("endpoint %s is out of access token scope", endpoint.Name), "A_OUT_OF_SCOPE", ""))
+	})
+}
@@ -22,6 +22,8 @@ func NewDefaultAuthorization(repo repository.Repository) *defaultAuthorization {
 	//d.addFilters(RBACFilter)
 	//d.addFilters(RBACFilterWithEndpoint)
 	d.addFilters(AdminApiFilter)
+	d.addFilters(AccessTokenScopeFilter)
+	d.addFilters(MfaFilter)
 
 	return d
 }
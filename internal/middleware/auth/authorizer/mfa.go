@@ -0,0 +1,98 @@
+package authorizer
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/openinfradev/tks-api/internal"
+	internalApi "github.com/openinfradev/tks-api/internal/delivery/api"
+	internalHttp "github.com/openinfradev/tks-api/internal/delivery/http"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+)
+
+// 최근 2차 인증(step-up)이 필요한 민감한 API
+var stepUpEndpoints = map[internalApi.Endpoint]bool{
+	internalApi.DeleteStack:             true,
+	internalApi.GetStackKubeConfig:      true,
+	internalApi.DeleteCloudAccount:      true,
+	internalApi.DeleteForceCloudAccount: true,
+	internalApi.CreateMyAccessToken:     true,
+	internalApi.CreateServiceAccountKey: true,
+}
+
+// MFA 등록이 필요한 사용자도 호출할 수 있는 API
+var mfaEnrollmentEndpoints = map[internalApi.Endpoint]bool{
+	internalApi.Logout:                   true,
+	internalApi.VerifyToken:              true,
+	internalApi.GetMyProfile:             true,
+	internalApi.UpdateMyPassword:         true,
+	internalApi.RenewPasswordExpiredDate: true,
+	internalApi.GetMyMfa:                 true,
+	internalApi.EnrollMfa:                true,
+	internalApi.ConfirmMfa:               true,
+}
+
+// MfaFilter 는 MFA 등록이 필요한 사용자의 요청을 제한하고, 민감한 API 는 최근 2차 인증(step-up)을 요구한다.
+//
+// personal access token, service account key 로 인증한 요청은 step-up 을 검사하지 않는다.
+// 토큰에는 step-up 을 기록할 세션이 없고, 대신 다음과 같이 제한된다.
+//   - 토큰 발급(CreateMyAccessToken, CreateServiceAccountKey)은 step-up 이 필요한 API 이다.
+//   - 토큰으로 다른 토큰을 발급할 수 없다. (AT_NOT_PERMITTED_ON_ACCESS_TOKEN)
+//   - 토큰은 발급 시 지정한 scope 의 API 만 호출할 수 있다. (AccessTokenScopeFilter)
+//
+// 따라서 stepUpEndpoints 의 API 를 토큰으로 호출하려면 발급 시 해당 API 를 scope 에 명시해야 한다.
+func MfaFilter(handler http.Handler, repo repository.Repository) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// access token 은 발급 시점에 step-up 검증을 거치므로 검사하지 않음.
+		if _, ok := request.AccessTokenFrom(r.Context()); ok {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		requestUserInfo, ok := request.UserFrom(r.Context())
+		if !ok {
+			internalHttp.ErrorJSON(w, r, httpErrors.NewInternalServerError(fmt.Errorf("user not found"), "", ""))
+			return
+		}
+		endpoint, ok := request.EndpointFrom(r.Context())
+		if !ok {
+			internalHttp.ErrorJSON(w, r, httpErrors.NewInternalServerError(fmt.Errorf("endpoint not found"), "", ""))
+			return
+		}
+
+		mfa, err := repo.Mfa.GetUserMfa(r.Context(), requestUserInfo.GetUserId())
+		if err != nil || !mfa.Enabled {
+			storedUser, err := repo.User.GetByUuid(r.Context(), requestUserInfo.GetUserId())
+			if err != nil {
+				internalHttp.ErrorJSON(w, r, err)
+				return
+			}
+			//TODO: TKS control plane 동작을 위해, master 조직의 admin 계정은 MFA 정책을 무시하도록 함.
+			if storedUser.Organization.ID == "master" && storedUser.AccountId == "admin" {
+				handler.ServeHTTP(w, r)
+				return
+			}
+			policy, err := repo.Mfa.GetPolicy(r.Context(), storedUser.OrganizationId)
+			if err == nil && policy.IsRequiredFor(storedUser.Roles) && !mfaEnrollmentEndpoints[endpoint] {
+				internalHttp.ErrorJSON(w, r, httpErrors.NewForbiddenError(fmt.Errorf("mfa enrollment required"), "A_MFA_ENROLLMENT_REQUIRED", ""))
+				return
+			}
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		if stepUpEndpoints[endpoint] {
+			sessionId, _ := request.SessionFrom(r.Context())
+			stepUp, err := repo.Mfa.GetLatestStepUp(r.Context(), requestUserInfo.GetUserId(), sessionId)
+			if err != nil || time.Since(stepUp.VerifiedAt) > internal.MfaStepUpDuration {
+				internalHttp.ErrorJSON(w, r, httpErrors.NewForbiddenError(fmt.Errorf("mfa step-up verification required"), "A_MFA_STEP_UP_REQUIRED", ""))
+				return
+			}
+		}
+
+		handler.ServeHTTP(w, r)
+	})
+}
@@ -6,7 +6,6 @@ import (
 
 	"github.com/openinfradev/tks-api/internal"
 	internalHttp "github.com/openinfradev/tks-api/internal/delivery/http"
-	"github.com/openinfradev/tks-api/internal/helper"
 	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
 	"github.com/openinfradev/tks-api/internal/repository"
 	"github.com/openinfradev/tks-api/pkg/httpErrors"
@@ -20,6 +19,12 @@ func PasswordFilter(handler http.Handler, repo repository.Repository) http.Handl
 			return
 		}
 
+		// access token 은 비밀번호와 무관하게 발급/폐기되므로 비밀번호 만료 검사를 하지 않음.
+		if _, ok := request.AccessTokenFrom(r.Context()); ok {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
 		storedUser, err := repo.User.GetByUuid(r.Context(), requestUserInfo.GetUserId())
 		if err != nil {
 			internalHttp.ErrorJSON(w, r, err)
@@ -30,7 +35,7 @@ func PasswordFilter(handler http.Handler, repo repository.Repository) http.Handl
 			handler.ServeHTTP(w, r)
 			return
 		}
-		if helper.IsDurationExpired(storedUser.PasswordUpdatedAt, internal.PasswordExpiredDuration) {
+		if storedUser.Organization.PasswordPolicy.IsExpired(storedUser.PasswordUpdatedAt) {
 			allowedUrl := []string{
 				internal.API_PREFIX + internal.API_VERSION + "/organizations/" + requestUserInfo.GetOrganizationId() + "/my-profile" + "/password",
 				internal.API_PREFIX + internal.API_VERSION + "/organizations/" + requestUserInfo.GetOrganizationId() + "/my-profile" + "/next-password-change",
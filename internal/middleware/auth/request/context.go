@@ -15,6 +15,7 @@ const (
 	sessionKey
 	endpointKey
 	auditKey
+	accessTokenKey
 )
 
 func WithValue(parent context.Context, key, val interface{}) context.Context {
@@ -65,3 +66,13 @@ func AuditFrom(ctx context.Context) (string, bool) {
 	audit, ok := ctx.Value(auditKey).(string)
 	return audit, ok
 }
+
+func WithAccessToken(parent context.Context, accessToken user.AccessTokenInfo) context.Context {
+	return WithValue(parent, accessTokenKey, accessToken)
+}
+
+// AccessTokenFrom function to retrieve the access token used for the request. If the request is authenticated by keycloak, it returns false
+func AccessTokenFrom(ctx context.Context) (user.AccessTokenInfo, bool) {
+	accessToken, ok := ctx.Value(accessTokenKey).(user.AccessTokenInfo)
+	return accessToken, ok
+}
@@ -48,6 +48,19 @@ func (i *DefaultInfo) GetRoleOrganizationMapping() map[string]string {
 	return i.RoleOrganizationMapping
 }
 
+// AccessTokenInfo describes a personal access token or a service account key
+// which has been used to authenticate the request instead of a keycloak token.
+type AccessTokenInfo struct {
+	TokenId          uuid.UUID
+	Name             string
+	ServiceAccountId *uuid.UUID
+	Scopes           []string
+}
+
+func (i *AccessTokenInfo) IsServiceAccount() bool {
+	return i.ServiceAccountId != nil
+}
+
 // well-known user and group names
 const (
 	TksAdminRole  = "tks_admin"
@@ -0,0 +1,79 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"gorm.io/gorm"
+)
+
+// Models
+type ServiceAccount struct {
+	gorm.Model
+
+	ID             uuid.UUID    `gorm:"primarykey;type:uuid"`
+	OrganizationId string       `gorm:"index:idx_service_account_org_id_name,unique;not null"`
+	Organization   Organization `gorm:"foreignKey:OrganizationId"`
+	Name           string       `gorm:"index:idx_service_account_org_id_name,unique;not null"`
+	Description    string
+	RoleId         string
+	Role           Role       `gorm:"foreignKey:RoleId"`
+	CreatorId      *uuid.UUID `gorm:"type:uuid"`
+	Creator        *User      `gorm:"foreignKey:CreatorId"`
+}
+
+func (m *ServiceAccount) BeforeCreate(tx *gorm.DB) (err error) {
+	if m.ID == uuid.Nil {
+		m.ID = uuid.New()
+	}
+	return nil
+}
+
+type AccessToken struct {
+	gorm.Model
+
+	ID               uuid.UUID `gorm:"primarykey;type:uuid"`
+	OrganizationId   string
+	Name             string
+	Description      string
+	TokenType        domain.AccessTokenType
+	UserId           *uuid.UUID      `gorm:"type:uuid"`
+	User             *User           `gorm:"foreignKey:UserId"`
+	ServiceAccountId *uuid.UUID      `gorm:"type:uuid"`
+	ServiceAccount   *ServiceAccount `gorm:"foreignKey:ServiceAccountId"`
+	TokenPrefix      string          `gorm:"index;not null"`
+	TokenHash        string          `gorm:"not null"`
+	Scope            string          `gorm:"type:text"`
+	Scopes           []string        `gorm:"-:all"`
+	ExpiredAt        *time.Time
+	LastUsedAt       *time.Time
+	RevokedAt        *time.Time
+	CreatorId        *uuid.UUID `gorm:"type:uuid"`
+	Creator          *User      `gorm:"foreignKey:CreatorId"`
+}
+
+func (m *AccessToken) BeforeCreate(tx *gorm.DB) (err error) {
+	if m.ID == uuid.Nil {
+		m.ID = uuid.New()
+	}
+	m.Scope = strings.Join(m.Scopes, ",")
+	return nil
+}
+
+func (m *AccessToken) AfterFind(tx *gorm.DB) (err error) {
+	m.Scopes = make([]string, 0)
+	if m.Scope != "" {
+		m.Scopes = strings.Split(m.Scope, ",")
+	}
+	return nil
+}
+
+func (m *AccessToken) IsRevoked() bool {
+	return m.RevokedAt != nil
+}
+
+func (m *AccessToken) IsExpired() bool {
+	return m.ExpiredAt != nil && time.Now().After(*m.ExpiredAt)
+}
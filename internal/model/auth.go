@@ -15,6 +15,17 @@ type ExpiredTokenTime struct {
 	ExpiredTime    time.Time
 }
 
+// AuthAttempt 는 로그인, 인증코드 요청/확인 시도를 기록하며 throttling 및 계정 잠금 판단에 사용된다.
+type AuthAttempt struct {
+	gorm.Model
+
+	AttemptType    string `gorm:"index:idx_auth_attempt_account;index:idx_auth_attempt_client_ip;not null"`
+	OrganizationId string `gorm:"index:idx_auth_attempt_account"`
+	AccountId      string `gorm:"index:idx_auth_attempt_account"`
+	ClientIp       string `gorm:"index:idx_auth_attempt_client_ip"`
+	Succeeded      bool
+}
+
 type CacheEmailCode struct {
 	gorm.Model
 
@@ -1,6 +1,9 @@
 package model
 
 import (
+	"strings"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/openinfradev/tks-api/pkg/domain"
 	"gorm.io/gorm"
@@ -10,24 +13,54 @@ import (
 type CloudAccount struct {
 	gorm.Model
 
-	ID              uuid.UUID `gorm:"primarykey"`
-	OrganizationId  string
-	Organization    Organization `gorm:"foreignKey:OrganizationId"`
-	Name            string       `gorm:"index"`
-	Description     string       `gorm:"index"`
-	Resource        string
-	CloudService    string
-	WorkflowId      string
-	Status          domain.CloudAccountStatus
-	StatusDesc      string
-	AwsAccountId    string
-	AccessKeyId     string `gorm:"-:all"`
-	SecretAccessKey string `gorm:"-:all"`
-	SessionToken    string `gorm:"-:all"`
-	Clusters        int    `gorm:"-:all"`
-	CreatedIAM      bool
-	CreatorId       *uuid.UUID `gorm:"type:uuid"`
-	Creator         User       `gorm:"foreignKey:CreatorId"`
-	UpdatorId       *uuid.UUID `gorm:"type:uuid"`
-	Updator         User       `gorm:"foreignKey:UpdatorId"`
+	ID             uuid.UUID `gorm:"primarykey"`
+	OrganizationId string
+	Organization   Organization `gorm:"foreignKey:OrganizationId"`
+	Name           string       `gorm:"index"`
+	Description    string       `gorm:"index"`
+	Resource       string
+	CloudService   string
+	WorkflowId     string
+	Status         domain.CloudAccountStatus
+	StatusDesc     string
+	AwsAccountId   string
+	AccountId      string   `gorm:"index"` // AWS account id, Azure subscription id, GCP project id, OpenStack project id
+	TenantId       string   // Azure tenant id, OpenStack domain name
+	Endpoint       string   // OpenStack keystone 주소
+	AllowedRegion  string   `gorm:"type:text"`
+	AllowedRegions []string `gorm:"-:all"`
+
+	// 인증 정보는 저장하지 않고 검증 및 워크플로우 파라미터로만 사용한다.
+	AccessKeyId       string `gorm:"-:all"`
+	SecretAccessKey   string `gorm:"-:all"`
+	SessionToken      string `gorm:"-:all"`
+	ClientId          string `gorm:"-:all"`
+	ClientSecret      string `gorm:"-:all"`
+	ServiceAccountKey string `gorm:"-:all"`
+	Username          string `gorm:"-:all"`
+	Password          string `gorm:"-:all"`
+
+	CredentialStatus    domain.CloudAccountCredentialStatus `gorm:"default:UNKNOWN"`
+	CredentialCheckedAt *time.Time
+	CredentialError     string
+
+	Clusters   int `gorm:"-:all"`
+	CreatedIAM bool
+	CreatorId  *uuid.UUID `gorm:"type:uuid"`
+	Creator    User       `gorm:"foreignKey:CreatorId"`
+	UpdatorId  *uuid.UUID `gorm:"type:uuid"`
+	Updator    User       `gorm:"foreignKey:UpdatorId"`
+}
+
+func (m *CloudAccount) BeforeCreate(tx *gorm.DB) (err error) {
+	m.AllowedRegion = strings.Join(m.AllowedRegions, ",")
+	return nil
+}
+
+func (m *CloudAccount) AfterFind(tx *gorm.DB) (err error) {
+	m.AllowedRegions = make([]string, 0)
+	if m.AllowedRegion != "" {
+		m.AllowedRegions = strings.Split(m.AllowedRegion, ",")
+	}
+	return nil
 }
@@ -37,6 +37,7 @@ type Cluster struct {
 	TksUserNode            int
 	TksUserNodeMax         int
 	TksUserNodeType        string
+	ClusterRegion          string
 	Kubeconfig             []byte     `gorm:"-:all"`
 	PolicyIds              []string   `gorm:"-:all"`
 	CreatorId              *uuid.UUID `gorm:"type:uuid"`
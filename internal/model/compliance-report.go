@@ -0,0 +1,54 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"gorm.io/gorm"
+)
+
+// ComplianceReport 는 조직 또는 스택의 정책 준수 현황을 특정 시점에 기록한 보고서이다.
+// 보고서 내용은 생성 시점의 스냅샷이며, 이후 정책이 변경되어도 바뀌지 않는다.
+type ComplianceReport struct {
+	gorm.Model
+
+	ID             uuid.UUID `gorm:"primarykey;type:varchar(36);not null"`
+	OrganizationId string    `gorm:"index;uniqueIndex:idx_compliance_reports_scheduled_period,where:scheduled = true AND deleted_at IS NULL"`
+	// 비어 있으면 조직 내 모든 스택을 대상으로 한다.
+	ClusterId   domain.ClusterId
+	PeriodStart time.Time `gorm:"uniqueIndex:idx_compliance_reports_scheduled_period"`
+	PeriodEnd   time.Time
+	// 월간 자동 생성된 보고서인 경우 true. 조직별 기간마다 하나만 생성된다.
+	Scheduled   bool
+	Content     string                          `gorm:"type:text"`
+	ContentData *domain.ComplianceReportContent `gorm:"-:all"`
+
+	CreatorId *uuid.UUID `gorm:"type:uuid"`
+	Creator   User       `gorm:"foreignKey:CreatorId"`
+}
+
+func (c *ComplianceReport) BeforeCreate(tx *gorm.DB) (err error) {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+
+	if c.ContentData != nil {
+		jsonBytes, err := json.Marshal(c.ContentData)
+		if err != nil {
+			return err
+		}
+		c.Content = string(jsonBytes)
+	}
+
+	return nil
+}
+
+func (c *ComplianceReport) AfterFind(tx *gorm.DB) (err error) {
+	if len(c.Content) > 0 {
+		c.ContentData = &domain.ComplianceReportContent{}
+		return json.Unmarshal([]byte(c.Content), c.ContentData)
+	}
+	return nil
+}
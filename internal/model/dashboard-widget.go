@@ -0,0 +1,55 @@
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"gorm.io/gorm"
+)
+
+// DashboardWidget 은 사용자가 PromQL 로 정의한 대시보드 위젯이다.
+// Shared 가 true 이면 같은 조직의 다른 사용자도 조회할 수 있으며, 수정과 삭제는 생성자만 할 수 있다.
+type DashboardWidget struct {
+	gorm.Model
+
+	ID             uuid.UUID `gorm:"primarykey;type:varchar(36);not null"`
+	OrganizationId string    `gorm:"type:varchar(36);index"`
+
+	Name        string
+	Description string
+	Query       string `gorm:"type:text"`
+	ChartType   domain.DashboardWidgetChartType
+	Unit        string
+	Shared      bool `gorm:"default:false"`
+
+	Thresholds     string                            `gorm:"type:text"`
+	ThresholdsData []domain.DashboardWidgetThreshold `gorm:"-:all"`
+
+	CreatorId *uuid.UUID `gorm:"type:uuid"`
+	Creator   User       `gorm:"foreignKey:CreatorId"`
+}
+
+func (w *DashboardWidget) BeforeCreate(tx *gorm.DB) (err error) {
+	if w.ID == uuid.Nil {
+		w.ID = uuid.New()
+	}
+
+	if w.ThresholdsData != nil {
+		jsonBytes, err := json.Marshal(w.ThresholdsData)
+		if err != nil {
+			return err
+		}
+		w.Thresholds = string(jsonBytes)
+	}
+
+	return nil
+}
+
+func (w *DashboardWidget) AfterFind(tx *gorm.DB) (err error) {
+	if len(w.Thresholds) > 0 {
+		// 목록 조회 시 에러가 발생해서 전체 조회가 실패하는 것을 방지하기 위해서 에러는 무시
+		_ = json.Unmarshal([]byte(w.Thresholds), &w.ThresholdsData)
+	}
+	return
+}
@@ -0,0 +1,13 @@
+package model
+
+import (
+	"time"
+)
+
+// JobLease 는 주기 작업의 실행 권한이다. 여러 replica 중 lease 를 가진 하나의 서버만 작업을 실행한다.
+type JobLease struct {
+	Name      string `gorm:"primarykey"`
+	Holder    string
+	ExpiresAt time.Time
+	UpdatedAt time.Time
+}
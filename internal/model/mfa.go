@@ -0,0 +1,78 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Models
+type UserMfa struct {
+	gorm.Model
+
+	UserId            uuid.UUID `gorm:"type:uuid;uniqueIndex;not null"`
+	Secret            string    `gorm:"not null"`
+	Enabled           bool
+	EnabledAt         *time.Time
+	RecoveryCode      string   `gorm:"type:text"`
+	RecoveryCodeHashs []string `gorm:"-:all"`
+	// 무차별 대입을 막기 위한 연속 실패 횟수와 잠금 시각, 재사용을 막기 위한 마지막으로 사용한 TOTP time step
+	FailedCount  int
+	LockedUntil  *time.Time
+	LastUsedStep int64
+}
+
+// IsLocked returns whether the code verification is locked out by repeated failures.
+func (m *UserMfa) IsLocked() bool {
+	return m.LockedUntil != nil && time.Now().Before(*m.LockedUntil)
+}
+
+func (m *UserMfa) BeforeSave(tx *gorm.DB) (err error) {
+	m.RecoveryCode = strings.Join(m.RecoveryCodeHashs, ",")
+	return nil
+}
+
+func (m *UserMfa) AfterFind(tx *gorm.DB) (err error) {
+	m.RecoveryCodeHashs = make([]string, 0)
+	if m.RecoveryCode != "" {
+		m.RecoveryCodeHashs = strings.Split(m.RecoveryCode, ",")
+	}
+	return nil
+}
+
+// MfaStepUp 는 세션 단위로 최근 2차 인증 시각을 기록한다. 민감한 API 호출 시 확인한다.
+type MfaStepUp struct {
+	gorm.Model
+
+	UserId     uuid.UUID `gorm:"type:uuid;index:idx_mfa_step_up_user_session;not null"`
+	SessionId  string    `gorm:"index:idx_mfa_step_up_user_session;not null"`
+	VerifiedAt time.Time
+}
+
+type MfaPolicy struct {
+	OrganizationId string `gorm:"primarykey;type:varchar(36);not null"`
+	Required       bool
+	Roles          []Role     `gorm:"many2many:mfa_policy_roles;"`
+	RoleIds        []string   `gorm:"-:all"`
+	UpdatorId      *uuid.UUID `gorm:"type:uuid"`
+	Updator        *User      `gorm:"foreignKey:UpdatorId"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// IsRequiredFor returns whether the policy forces MFA to a user having the given roles.
+func (m *MfaPolicy) IsRequiredFor(roles []Role) bool {
+	if m.Required {
+		return true
+	}
+	for _, required := range m.Roles {
+		for _, role := range roles {
+			if required.ID == role.ID {
+				return true
+			}
+		}
+	}
+	return false
+}
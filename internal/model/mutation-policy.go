@@ -0,0 +1,77 @@
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"gorm.io/gorm"
+)
+
+// MutationPolicy 는 대상 클러스터의 리소스를 생성 시점에 변경하는 정책이다.
+// 템플릿 유형(Kind)과 파라미터로부터 Gatekeeper Assign/AssignMetadata 리소스를 생성하여 클러스터에 적용한다.
+type MutationPolicy struct {
+	gorm.Model
+
+	ID             uuid.UUID `gorm:"primarykey;type:varchar(36);not null"`
+	OrganizationId string
+
+	PolicyName         string
+	PolicyResourceName string
+	Description        string
+	Kind               domain.MutationPolicyKind
+
+	TargetClusterIds []string  `gorm:"-:all"`
+	TargetClusters   []Cluster `gorm:"many2many:mutation_policy_target_clusters"`
+
+	Parameters  string        `gorm:"type:text"`
+	PolicyMatch string        `gorm:"type:text"`
+	Match       *domain.Match `gorm:"-:all"`
+
+	CreatorId *uuid.UUID `gorm:"type:uuid"`
+	Creator   User       `gorm:"foreignKey:CreatorId"`
+	UpdatorId *uuid.UUID `gorm:"type:uuid"`
+	Updator   User       `gorm:"foreignKey:UpdatorId"`
+}
+
+func (p *MutationPolicy) BeforeCreate(tx *gorm.DB) (err error) {
+	if p.ID == uuid.Nil {
+		p.ID = uuid.New()
+	}
+
+	if p.Match != nil {
+		jsonBytes, err := json.Marshal(p.Match)
+		if err != nil {
+			return err
+		}
+		p.PolicyMatch = string(jsonBytes)
+	}
+
+	return nil
+}
+
+func (p *MutationPolicy) AfterFind(tx *gorm.DB) (err error) {
+	if len(p.PolicyMatch) > 0 {
+		// 목록 조회 시 에러가 발생해서 전체 조회가 실패하는 것을 방지하기 위해서 에러는 무시
+		var match domain.Match
+		_ = json.Unmarshal([]byte(p.PolicyMatch), &match)
+		p.Match = &match
+	}
+
+	p.TargetClusterIds = make([]string, len(p.TargetClusters))
+	for i, cluster := range p.TargetClusters {
+		p.TargetClusterIds[i] = cluster.ID.String()
+	}
+
+	return
+}
+
+type MutationPolicyTargetCluster struct {
+	MutationPolicyId uuid.UUID        `gorm:"primarykey"`
+	ClusterId        domain.ClusterId `gorm:"primarykey"`
+}
+
+type MutationPolicyCount struct {
+	Kind  domain.MutationPolicyKind
+	Count int64
+}
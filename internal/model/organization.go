@@ -27,5 +27,7 @@ type Organization struct {
 	SystemNotificationTemplateIds []uuid.UUID                  `gorm:"-:all"`
 	ClusterCount                  int                          `gorm:"-:all"`
 	AdminId                       *uuid.UUID
-	Admin                         *User `gorm:"-:all"`
+	Admin                         *User                 `gorm:"-:all"`
+	PasswordPolicy                PasswordPolicy        `gorm:"embedded;embeddedPrefix:password_policy_"`
+	PolicyApproval                PolicyApprovalSetting `gorm:"embedded;embeddedPrefix:policy_approval_"`
 }
@@ -0,0 +1,145 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/helper"
+)
+
+// PasswordPolicy 는 조직별 비밀번호 정책이며 Organization 에 embedded 된다.
+// 기본값은 기존 전역 정책(8자 이상, 30일 만료)과 동일하다.
+type PasswordPolicy struct {
+	MinLength        int  `gorm:"default:8"`
+	RequireUppercase bool `gorm:"default:false"`
+	RequireLowercase bool `gorm:"default:false"`
+	RequireDigit     bool `gorm:"default:false"`
+	RequireSpecial   bool `gorm:"default:false"`
+	HistoryDepth     int  `gorm:"default:0"`
+	ExpiryDays       int  `gorm:"default:30"`
+	ReuseBanned      bool `gorm:"default:false"`
+}
+
+const passwordSpecialCharacters = "!@#$%^&*()-_=+[]{};:,.?/~"
+
+const (
+	PasswordPolicyMinLength       = 8
+	PasswordPolicyMaxLength       = 128
+	PasswordPolicyMaxHistoryDepth = 24
+	PasswordPolicyMaxExpiryDays   = 365
+)
+
+func DefaultPasswordPolicy() PasswordPolicy {
+	return PasswordPolicy{
+		MinLength:  8,
+		ExpiryDays: 30,
+	}
+}
+
+// ExpiredDuration returns zero when the password never expires.
+func (p PasswordPolicy) ExpiredDuration() time.Duration {
+	return time.Duration(p.ExpiryDays) * 24 * time.Hour
+}
+
+func (p PasswordPolicy) IsExpired(passwordUpdatedAt time.Time) bool {
+	if p.ExpiryDays <= 0 {
+		return false
+	}
+	return helper.IsDurationExpired(passwordUpdatedAt, p.ExpiredDuration())
+}
+
+// Validate returns an error when a value of the policy is out of range.
+// HistoryDepth 와 ExpiryDays 의 0 은 각각 이력 미보관, 만료 없음을 뜻한다.
+func (p PasswordPolicy) Validate() error {
+	if p.MinLength < PasswordPolicyMinLength || p.MinLength > PasswordPolicyMaxLength {
+		return fmt.Errorf("minLength must be between %d and %d", PasswordPolicyMinLength, PasswordPolicyMaxLength)
+	}
+	if p.HistoryDepth < 0 || p.HistoryDepth > PasswordPolicyMaxHistoryDepth {
+		return fmt.Errorf("historyDepth must be between 0 and %d", PasswordPolicyMaxHistoryDepth)
+	}
+	if p.ExpiryDays < 0 || p.ExpiryDays > PasswordPolicyMaxExpiryDays {
+		return fmt.Errorf("expiryDays must be between 0 and %d", PasswordPolicyMaxExpiryDays)
+	}
+	if p.ReuseBanned && p.HistoryDepth == 0 {
+		return fmt.Errorf("historyDepth must be greater than 0 when reuse is banned")
+	}
+	return nil
+}
+
+// Check returns an error describing the first rule which the password violates.
+func (p PasswordPolicy) Check(password string) error {
+	// 한글 등 멀티바이트 문자도 한 글자로 센다.
+	if utf8.RuneCountInString(password) < p.MinLength {
+		return fmt.Errorf("password must be at least %d characters", p.MinLength)
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSpecial bool
+	for _, c := range password {
+		switch {
+		case unicode.IsUpper(c):
+			hasUpper = true
+		case unicode.IsLower(c):
+			hasLower = true
+		case unicode.IsDigit(c):
+			hasDigit = true
+		case strings.ContainsRune(passwordSpecialCharacters, c) || unicode.IsPunct(c) || unicode.IsSymbol(c):
+			hasSpecial = true
+		}
+	}
+	if p.RequireUppercase && !hasUpper {
+		return fmt.Errorf("password must contain an uppercase letter")
+	}
+	if p.RequireLowercase && !hasLower {
+		return fmt.Errorf("password must contain a lowercase letter")
+	}
+	if p.RequireDigit && !hasDigit {
+		return fmt.Errorf("password must contain a digit")
+	}
+	if p.RequireSpecial && !hasSpecial {
+		return fmt.Errorf("password must contain a special character")
+	}
+	return nil
+}
+
+// GenerateRandomPassword returns a temporary password which satisfies the policy.
+func (p PasswordPolicy) GenerateRandomPassword() string {
+	length := p.MinLength
+	if length < 8 {
+		length = 8
+	}
+
+	password := []rune(helper.GenerateRandomString(length))
+	required := make([]string, 0)
+	if p.RequireUppercase {
+		required = append(required, "ABCDEFGHIJKLMNOPQRSTUVWXYZ")
+	}
+	if p.RequireLowercase {
+		required = append(required, "abcdefghijklmnopqrstuvwxyz")
+	}
+	if p.RequireDigit {
+		required = append(required, "0123456789")
+	}
+	if p.RequireSpecial {
+		required = append(required, passwordSpecialCharacters)
+	}
+	for i, chars := range required {
+		password[i] = helper.RandomRune([]rune(chars))
+	}
+	helper.ShuffleRunes(password)
+
+	return string(password)
+}
+
+// PasswordHistory 는 비밀번호 재사용 금지 정책을 위해 이전 비밀번호의 hash 를 보관한다.
+type PasswordHistory struct {
+	gorm.Model
+
+	UserId       uuid.UUID `gorm:"type:uuid;index;not null"`
+	PasswordHash string    `gorm:"not null"`
+}
@@ -0,0 +1,98 @@
+package model
+
+import (
+	"strings"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+)
+
+func TestPasswordPolicyValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  PasswordPolicy
+		wantErr bool
+	}{
+		{"default", DefaultPasswordPolicy(), false},
+		{"max values", PasswordPolicy{MinLength: 128, HistoryDepth: 24, ExpiryDays: 365, ReuseBanned: true}, false},
+		{"no expiry", PasswordPolicy{MinLength: 8, ExpiryDays: 0}, false},
+		{"zero min length", PasswordPolicy{MinLength: 0}, true},
+		{"negative min length", PasswordPolicy{MinLength: -1}, true},
+		{"short min length", PasswordPolicy{MinLength: 7}, true},
+		{"long min length", PasswordPolicy{MinLength: 129}, true},
+		{"negative history depth", PasswordPolicy{MinLength: 8, HistoryDepth: -1}, true},
+		{"large history depth", PasswordPolicy{MinLength: 8, HistoryDepth: 25}, true},
+		{"negative expiry days", PasswordPolicy{MinLength: 8, ExpiryDays: -1}, true},
+		{"large expiry days", PasswordPolicy{MinLength: 8, ExpiryDays: 366}, true},
+		{"reuse banned without history", PasswordPolicy{MinLength: 8, ReuseBanned: true}, true},
+	}
+	for _, tt := range tests {
+		if err := tt.policy.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("%s: Validate() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestPasswordPolicyCheck(t *testing.T) {
+	strict := PasswordPolicy{MinLength: 8, RequireUppercase: true, RequireLowercase: true, RequireDigit: true, RequireSpecial: true}
+
+	tests := []struct {
+		name     string
+		policy   PasswordPolicy
+		password string
+		wantErr  string
+	}{
+		{"default ok", DefaultPasswordPolicy(), "abcdefgh", ""},
+		{"default too short", DefaultPasswordPolicy(), "abcdefg", "at least 8"},
+		{"multibyte counted as runes", PasswordPolicy{MinLength: 8}, "가나다라마바사아", ""},
+		{"multibyte too short", PasswordPolicy{MinLength: 8}, "가나다라마바사", "at least 8"},
+		{"strict ok", strict, "Abcdef1!", ""},
+		{"missing uppercase", strict, "abcdef1!", "uppercase"},
+		{"missing lowercase", strict, "ABCDEF1!", "lowercase"},
+		{"missing digit", strict, "Abcdefg!", "digit"},
+		{"missing special", strict, "Abcdefg1", "special"},
+		{"unicode symbol as special", strict, "Abcdef1€", ""},
+	}
+	for _, tt := range tests {
+		err := tt.policy.Check(tt.password)
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Errorf("%s: Check() error = %v, want nil", tt.name, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("%s: Check() error = %v, want containing %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestPasswordPolicyGenerateRandomPassword(t *testing.T) {
+	tests := []struct {
+		name       string
+		policy     PasswordPolicy
+		wantLength int
+	}{
+		{"default", DefaultPasswordPolicy(), 8},
+		{"short min length", PasswordPolicy{MinLength: 4}, 8},
+		{"long min length", PasswordPolicy{MinLength: 20}, 20},
+		{"uppercase", PasswordPolicy{MinLength: 8, RequireUppercase: true}, 8},
+		{"digit and special", PasswordPolicy{MinLength: 8, RequireDigit: true, RequireSpecial: true}, 8},
+		{"all rules", PasswordPolicy{MinLength: 12, RequireUppercase: true, RequireLowercase: true, RequireDigit: true, RequireSpecial: true}, 12},
+	}
+	for _, tt := range tests {
+		// 문자 위치가 무작위이므로 여러 번 생성해 확인한다.
+		for i := 0; i < 100; i++ {
+			password := tt.policy.GenerateRandomPassword()
+			if got := utf8.RuneCountInString(password); got != tt.wantLength {
+				t.Fatalf("%s: len(%q) = %d, want %d", tt.name, password, got, tt.wantLength)
+			}
+			if err := tt.policy.Check(password); err != nil {
+				t.Fatalf("%s: Check(%q) error = %v", tt.name, password, err)
+			}
+			if strings.IndexFunc(password, unicode.IsSpace) >= 0 {
+				t.Fatalf("%s: %q contains whitespace", tt.name, password)
+			}
+		}
+	}
+}
@@ -40,6 +40,7 @@ const (
 	MiddleClusterAccessControlKey            = "STACK-CLUSTER_ACCESS_CONTROL"
 	TopPolicyKey                             = "POLICY"
 	MiddlePolicyKey                          = "POLICY-POLICY"
+	MiddlePolicyApprovalKey                  = "POLICY-POLICY_APPROVAL"
 	TopNotificationKey                       = "NOTIFICATION"
 	MiddleNotificationKey                    = "NOTIFICATION-SYSTEM_NOTIFICATION"
 	MiddlePolicyNotificationKey              = "NOTIFICATION-POLICY_NOTIFICATION"
@@ -160,6 +161,12 @@ func newDashboard() *Permission {
 							api.GetChartDashboard,
 							api.GetStacksDashboard,
 							api.GetResourcesDashboard,
+							api.GetCostDashboard,
+							api.ListDashboardWidgets,
+							api.GetDashboardWidget,
+							api.GetDashboardWidgetData,
+							api.ExportDashboard,
+							api.GetDefaultDashboard,
 						),
 					},
 					{
@@ -167,6 +174,13 @@ func newDashboard() *Permission {
 						Name:      "수정",
 						Key:       OperationUpdate,
 						IsAllowed: helper.BoolP(false),
+						Endpoints: endpointObjects(
+							api.CreateDashboardWidget,
+							api.UpdateDashboardWidget,
+							api.DeleteDashboardWidget,
+							api.ResetDashboard,
+							api.ImportDashboard,
+						),
 					},
 				},
 			},
@@ -198,6 +212,7 @@ func newStack() *Permission {
 							api.CheckStackName,
 							api.GetStackStatus,
 							api.GetStackKubeConfig,
+							api.GetStackCost,
 
 							api.SetFavoriteStack,
 							api.DeleteFavoriteStack,
@@ -223,6 +238,7 @@ func newStack() *Permission {
 						Endpoints: endpointObjects(
 							api.CreateStack,
 							api.InstallStack,
+							api.EstimateStackCost,
 							api.CreateAppgroup,
 
 							// Cluster
@@ -325,16 +341,45 @@ func newPolicy() *Permission {
 							api.Admin_GetPolicyTemplateVersion,
 							api.Admin_ExistsPolicyTemplateName,
 							api.Admin_ExistsPolicyTemplateKind,
+							api.Admin_ExportPolicyTemplate,
 
 							// StackPolicyStatus
 							api.ListStackPolicyStatus,
 							api.GetStackPolicyTemplateStatus,
+							api.ListStackPolicyTemplateHistories,
 
 							// Policy
 							api.GetMandatoryPolicies,
 							api.ListPolicy,
 							api.GetPolicy,
 							api.ExistsPolicyName,
+							api.ListPolicyExceptions,
+							api.GetPolicyRollout,
+
+							// PolicyViolation
+							api.ListPolicyViolations,
+							api.ExportPolicyViolations,
+							api.GetPolicyViolation,
+
+							// PolicyBundle
+							api.ListPolicyBundles,
+							api.GetPolicyBundle,
+							api.PreviewPolicyBundleUpdate,
+
+							// ComplianceReport
+							api.ListComplianceReports,
+							api.GetComplianceReport,
+							api.DownloadComplianceReport,
+
+							// MutationPolicy
+							api.ListMutationPolicyTemplates,
+							api.ListMutationPolicies,
+							api.GetMutationPolicy,
+
+							// PolicyChangeRequest
+							api.GetPolicyApprovalSetting,
+							api.ListPolicyChangeRequests,
+							api.GetPolicyChangeRequest,
 
 							// OrganizationPolicyTemplate
 							api.ListPolicyTemplate,
@@ -345,6 +390,7 @@ func newPolicy() *Permission {
 							api.GetPolicyTemplateVersion,
 							api.ExistsPolicyTemplateKind,
 							api.ExistsPolicyTemplateName,
+							api.ExportPolicyTemplate,
 
 							// PolicyTemplateExample
 							api.ListPolicyTemplateExample,
@@ -360,14 +406,26 @@ func newPolicy() *Permission {
 							// PolicyTemplate
 							api.Admin_CreatePolicyTemplate,
 							api.Admin_CreatePolicyTemplateVersion,
+							api.Admin_ImportPolicyTemplate,
 
 							// Policy
 							api.SetMandatoryPolicies,
 							api.CreatePolicy,
+							api.CreatePolicyException,
+
+							// PolicyBundle
+							api.CreatePolicyBundle,
+
+							// ComplianceReport
+							api.CreateComplianceReport,
+
+							// MutationPolicy
+							api.CreateMutationPolicy,
 
 							// OrganizationPolicyTemplate
 							api.CreatePolicyTemplate,
 							api.CreatePolicyTemplateVersion,
+							api.ImportPolicyTemplate,
 						),
 					},
 					{
@@ -385,6 +443,19 @@ func newPolicy() *Permission {
 							// Policy
 							api.UpdatePolicy,
 							api.UpdatePolicyTargetClusters,
+							api.StartPolicyRollout,
+							api.CancelPolicyRollout,
+
+							// PolicyViolation
+							api.UpdatePolicyViolationStatus,
+
+							// PolicyBundle
+							api.UpdatePolicyBundle,
+							api.AssignPolicyBundleToStack,
+							api.UnassignPolicyBundleFromStack,
+
+							// MutationPolicy
+							api.UpdateMutationPolicy,
 
 							// OrganizationPolicyTemplate
 							api.UpdatePolicyTemplate,
@@ -405,6 +476,16 @@ func newPolicy() *Permission {
 
 							// Policy
 							api.DeletePolicy,
+							api.DeletePolicyException,
+
+							// PolicyBundle
+							api.DeletePolicyBundle,
+
+							// ComplianceReport
+							api.DeleteComplianceReport,
+
+							// MutationPolicy
+							api.DeleteMutationPolicy,
 
 							// OrganizationPolicyTemplate
 							api.DeletePolicyTemplate,
@@ -416,6 +497,26 @@ func newPolicy() *Permission {
 					},
 				},
 			},
+			// 정책 변경 승인 설정과 승인/반려는 정책 수정 권한과 분리하여 관리자 역할에만 기본 부여한다.
+			{
+				ID:   uuid.New(),
+				Name: "정책 변경 승인",
+				Key:  MiddlePolicyApprovalKey,
+				Children: []*Permission{
+					{
+						ID:        uuid.New(),
+						Name:      "수정",
+						Key:       OperationUpdate,
+						IsAllowed: helper.BoolP(false),
+						Endpoints: endpointObjects(
+							// PolicyChangeRequest
+							api.UpdatePolicyApprovalSetting,
+							api.ApprovePolicyChangeRequest,
+							api.RejectPolicyChangeRequest,
+						),
+					},
+				},
+			},
 		},
 	}
 
@@ -509,6 +610,9 @@ func newProject() *Permission {
 							api.GetProjects,
 							api.GetProject,
 							api.GetProjectKubeconfig,
+							api.GetProjectCost,
+							api.GetProjectUsage,
+							api.ExportProjectUsage,
 						),
 					},
 					{
@@ -744,12 +848,22 @@ func newConfiguration() *Permission {
 						Name:      "조회",
 						Key:       OperationRead,
 						IsAllowed: helper.BoolP(false),
+						Endpoints: endpointObjects(
+							api.GetMfaPolicy,
+							api.GetPasswordPolicy,
+						),
 					},
 					{
 						ID:        uuid.New(),
 						Name:      "수정",
 						Key:       OperationUpdate,
 						IsAllowed: helper.BoolP(false),
+						Endpoints: endpointObjects(
+							api.UpdateMfaPolicy,
+							api.UpdatePasswordPolicy,
+							api.SetDefaultDashboard,
+							api.DeleteDefaultDashboard,
+						),
 					},
 				},
 			},
@@ -769,6 +883,7 @@ func newConfiguration() *Permission {
 							api.CheckCloudAccountName,
 							api.CheckAwsAccountId,
 							api.GetResourceQuota,
+							api.CheckCloudAccountCredential,
 						),
 					},
 					{
@@ -787,6 +902,7 @@ func newConfiguration() *Permission {
 						IsAllowed: helper.BoolP(false),
 						Endpoints: endpointObjects(
 							api.UpdateCloudAccount,
+							api.RotateCloudAccountCredential,
 						),
 					},
 					{
@@ -835,6 +951,10 @@ func newConfiguration() *Permission {
 							api.GetUser,
 							api.CheckId,
 							api.CheckEmail,
+							api.ExportUsers,
+							api.GetServiceAccounts,
+							api.GetServiceAccount,
+							api.GetServiceAccountKeys,
 						),
 					},
 					{
@@ -846,6 +966,9 @@ func newConfiguration() *Permission {
 							api.CreateUser,
 							api.CheckId,
 							api.CheckEmail,
+							api.ImportUsers,
+							api.CreateServiceAccount,
+							api.CreateServiceAccountKey,
 						),
 					},
 					{
@@ -856,6 +979,7 @@ func newConfiguration() *Permission {
 						Endpoints: endpointObjects(
 							api.UpdateUser,
 							api.ResetPassword,
+							api.UnlockUser,
 						),
 					},
 					{
@@ -865,6 +989,8 @@ func newConfiguration() *Permission {
 						IsAllowed: helper.BoolP(false),
 						Endpoints: endpointObjects(
 							api.DeleteUser,
+							api.DeleteServiceAccount,
+							api.RevokeServiceAccountKey,
 						),
 					},
 				},
@@ -929,6 +1055,13 @@ func newConfiguration() *Permission {
 						Endpoints: endpointObjects(
 							api.GetSystemNotificationRules,
 							api.GetSystemNotificationRule,
+							api.PreviewSystemNotificationRule,
+							api.GetSystemNotificationSilences,
+							api.GetSystemNotificationSilence,
+							api.GetStackMaintenanceWindows,
+							api.GetOnCallSchedules,
+							api.GetOnCallSchedule,
+							api.GetSystemNotificationEscalationPolicy,
 						),
 					},
 					{
@@ -938,6 +1071,9 @@ func newConfiguration() *Permission {
 						IsAllowed: helper.BoolP(false),
 						Endpoints: endpointObjects(
 							api.CreateSystemNotificationRule,
+							api.CreateSystemNotificationSilence,
+							api.CreateStackMaintenanceWindow,
+							api.CreateOnCallSchedule,
 						),
 					},
 					{
@@ -947,6 +1083,9 @@ func newConfiguration() *Permission {
 						IsAllowed: helper.BoolP(false),
 						Endpoints: endpointObjects(
 							api.UpdateSystemNotificationRule,
+							api.UpdateStackMaintenanceWindow,
+							api.UpdateOnCallSchedule,
+							api.SetSystemNotificationEscalationPolicy,
 						),
 					},
 					{
@@ -956,6 +1095,10 @@ func newConfiguration() *Permission {
 						IsAllowed: helper.BoolP(false),
 						Endpoints: endpointObjects(
 							api.DeleteSystemNotificationRule,
+							api.ExpireSystemNotificationSilence,
+							api.DeleteStackMaintenanceWindow,
+							api.DeleteOnCallSchedule,
+							api.DeleteSystemNotificationEscalationPolicy,
 						),
 					},
 				},
@@ -998,6 +1141,15 @@ func newCommon() *Permission {
 			api.UpdateMyPassword,
 			api.RenewPasswordExpiredDate,
 			api.DeleteMyProfile,
+			api.CreateMyAccessToken,
+			api.GetMyAccessTokens,
+			api.RevokeMyAccessToken,
+			api.GetMyMfa,
+			api.EnrollMfa,
+			api.ConfirmMfa,
+			api.DisableMfa,
+			api.RegenerateMfaRecoveryCodes,
+			api.VerifyMfa,
 
 			// StackTemplate
 			api.GetOrganizationStackTemplates,
@@ -0,0 +1,76 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"gorm.io/gorm"
+)
+
+// PolicyBundle 은 스택에 하나의 단위로 적용하는 조직 단위의 정책 묶음이다.
+type PolicyBundle struct {
+	gorm.Model
+
+	ID             uuid.UUID `gorm:"primarykey;type:varchar(36);not null"`
+	OrganizationId string
+	Name           string
+	Description    string
+
+	Items    []PolicyBundleItem `gorm:"foreignKey:PolicyBundleId"`
+	Clusters []Cluster          `gorm:"many2many:policy_bundle_clusters"`
+
+	CreatorId *uuid.UUID `gorm:"type:uuid"`
+	Creator   User       `gorm:"foreignKey:CreatorId"`
+	UpdatorId *uuid.UUID `gorm:"type:uuid"`
+	Updator   User       `gorm:"foreignKey:UpdatorId"`
+}
+
+func (p *PolicyBundle) BeforeCreate(tx *gorm.DB) (err error) {
+	if p.ID == uuid.Nil {
+		p.ID = uuid.New()
+	}
+	return nil
+}
+
+// PolicyIds returns the ids of the policies in the bundle.
+func (p *PolicyBundle) PolicyIds() []uuid.UUID {
+	ids := make([]uuid.UUID, len(p.Items))
+	for i, item := range p.Items {
+		ids[i] = item.PolicyId
+	}
+	return ids
+}
+
+// PolicyBundleItem 은 번들에 포함된 정책과 번들 적용 시 정책에 설정할 파라미터, 적용 액션을 나타낸다.
+// 값이 비어 있으면 정책에 설정된 값을 그대로 사용한다.
+type PolicyBundleItem struct {
+	PolicyBundleId    uuid.UUID     `gorm:"primarykey;type:varchar(36)"`
+	PolicyBundle      *PolicyBundle `gorm:"foreignKey:PolicyBundleId"`
+	PolicyId          uuid.UUID     `gorm:"primarykey;type:varchar(36)"`
+	Policy            Policy        `gorm:"foreignKey:PolicyId"`
+	EnforcementAction string
+	Parameters        string `gorm:"type:text"`
+}
+
+// HasPreset returns whether the item overrides the parameters or the enforcement action of the policy.
+func (p *PolicyBundleItem) HasPreset() bool {
+	return p.EnforcementAction != "" || p.Parameters != ""
+}
+
+// IsAssignedTo returns whether the bundle of the item is assigned to the cluster.
+// PolicyBundle.Clusters must be preloaded.
+func (p *PolicyBundleItem) IsAssignedTo(clusterId string) bool {
+	if p.PolicyBundle == nil {
+		return false
+	}
+	for _, cluster := range p.PolicyBundle.Clusters {
+		if cluster.ID.String() == clusterId {
+			return true
+		}
+	}
+	return false
+}
+
+type PolicyBundleCluster struct {
+	PolicyBundleId uuid.UUID        `gorm:"primarykey"`
+	ClusterId      domain.ClusterId `gorm:"primarykey"`
+}
@@ -0,0 +1,69 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"gorm.io/gorm"
+)
+
+// PolicyApprovalSetting 은 조직별 정책 변경 승인 모드 설정이며 Organization 에 embedded 된다.
+// 활성화되면 정책 수정/삭제/대상 클러스터 변경은 다른 사용자의 승인 후에 적용된다.
+type PolicyApprovalSetting struct {
+	Enabled     bool `gorm:"default:false"`
+	ExpiryHours int  `gorm:"default:72"`
+}
+
+func (s PolicyApprovalSetting) ExpiryDuration() time.Duration {
+	return time.Duration(s.ExpiryHours) * time.Hour
+}
+
+// PolicyChangeRequest 는 승인 대기 중인 정책 변경 요청이다.
+// Payload 에는 승인 시 적용할 요청 본문이, Diff 에는 요청 시점의 변경 전후 값이 저장된다.
+type PolicyChangeRequest struct {
+	gorm.Model
+
+	ID             uuid.UUID `gorm:"primarykey;type:varchar(36);not null"`
+	OrganizationId string
+	PolicyId       uuid.UUID `gorm:"type:varchar(36);index"`
+	PolicyName     string
+	ChangeType     domain.PolicyChangeType
+	Payload        string                           `gorm:"type:text"`
+	Diff           string                           `gorm:"type:text"`
+	DiffItems      []domain.PolicyChangeDiff        `gorm:"-:all"`
+	Status         domain.PolicyChangeRequestStatus `gorm:"index"`
+	ExpiresAt      time.Time
+
+	RequesterId   *uuid.UUID `gorm:"type:uuid"`
+	Requester     User       `gorm:"foreignKey:RequesterId"`
+	ReviewerId    *uuid.UUID `gorm:"type:uuid"`
+	Reviewer      User       `gorm:"foreignKey:ReviewerId"`
+	ReviewComment string
+	ReviewedAt    *time.Time
+}
+
+func (p *PolicyChangeRequest) BeforeCreate(tx *gorm.DB) (err error) {
+	if p.ID == uuid.Nil {
+		p.ID = uuid.New()
+	}
+
+	jsonBytes, err := json.Marshal(p.DiffItems)
+	if err != nil {
+		return err
+	}
+	p.Diff = string(jsonBytes)
+
+	return nil
+}
+
+func (p *PolicyChangeRequest) AfterFind(tx *gorm.DB) (err error) {
+	// 목록 조회 시 에러가 발생해서 전체 조회가 실패하는 것을 방지하기 위해서 에러는 무시
+	_ = json.Unmarshal([]byte(p.Diff), &p.DiffItems)
+	return
+}
+
+func (p *PolicyChangeRequest) IsExpired(now time.Time) bool {
+	return p.Status == domain.PolicyChangeRequestStatusPending && !p.ExpiresAt.After(now)
+}
@@ -0,0 +1,47 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"gorm.io/gorm"
+)
+
+// PolicyException 은 특정 클러스터의 네임스페이스를 정해진 기간 동안 정책 적용에서 제외한다.
+type PolicyException struct {
+	gorm.Model
+
+	ID             uuid.UUID `gorm:"primarykey;type:varchar(36);not null"`
+	OrganizationId string
+	PolicyId       uuid.UUID        `gorm:"type:varchar(36);index"`
+	ClusterId      domain.ClusterId `gorm:"type:varchar(36)"`
+	Namespace      string
+
+	Justification string     `gorm:"type:text"`
+	ApproverId    *uuid.UUID `gorm:"type:uuid"`
+	Approver      User       `gorm:"foreignKey:ApproverId"`
+	ExpiredAt     time.Time
+	// 만료 처리되어 TKSPolicy CR 에서 제거된 경우 true
+	Expired bool
+
+	CreatorId *uuid.UUID `gorm:"type:uuid"`
+	Creator   User       `gorm:"foreignKey:CreatorId"`
+}
+
+func (p *PolicyException) BeforeCreate(tx *gorm.DB) (err error) {
+	if p.ID == uuid.Nil {
+		p.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsActive returns whether the exception should be rendered into the TKSPolicy CR at the given time.
+func (p *PolicyException) IsActive(now time.Time) bool {
+	return !p.Expired && now.Before(p.ExpiredAt)
+}
+
+// Covers returns whether the exception applies to the given namespace of the cluster.
+func (p *PolicyException) Covers(clusterId domain.ClusterId, namespace string) bool {
+	return p.ClusterId == clusterId && p.Namespace == namespace
+}
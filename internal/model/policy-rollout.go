@@ -0,0 +1,52 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"gorm.io/gorm"
+)
+
+// PolicyRollout 은 정책을 dryrun/warn 으로 먼저 적용하고, 관찰 기간 동안 위반 수가 임계치 이하인 스택부터 deny 로 승격한다.
+type PolicyRollout struct {
+	gorm.Model
+
+	ID                       uuid.UUID `gorm:"primarykey;type:varchar(36);not null"`
+	OrganizationId           string
+	PolicyId                 uuid.UUID `gorm:"type:varchar(36);index"`
+	InitialEnforcementAction string
+	// 단계적 적용을 시작하기 전 정책의 적용 액션. 취소하면 이 값으로 되돌린다.
+	BaseEnforcementAction string
+	ObservationHours      int
+	ViolationThreshold    int
+	Status                domain.PolicyRolloutStatus `gorm:"index"`
+	CompletedAt           *time.Time
+
+	Stacks []PolicyRolloutStack `gorm:"foreignKey:PolicyRolloutId"`
+
+	CreatorId *uuid.UUID `gorm:"type:uuid"`
+	Creator   User       `gorm:"foreignKey:CreatorId"`
+}
+
+func (p *PolicyRollout) BeforeCreate(tx *gorm.DB) (err error) {
+	if p.ID == uuid.Nil {
+		p.ID = uuid.New()
+	}
+	return nil
+}
+
+// ObservationPeriod returns how long a stack stays in the initial action before promotion is evaluated.
+func (p *PolicyRollout) ObservationPeriod() time.Duration {
+	return time.Duration(p.ObservationHours) * time.Hour
+}
+
+// PolicyRolloutStack 은 스택별 현재 적용 단계를 나타낸다.
+type PolicyRolloutStack struct {
+	PolicyRolloutId    uuid.UUID        `gorm:"primarykey;type:varchar(36)"`
+	ClusterId          domain.ClusterId `gorm:"primarykey;type:varchar(36)"`
+	EnforcementAction  string
+	StageStartedAt     time.Time
+	LastViolationCount int
+	PromotedAt         *time.Time
+}
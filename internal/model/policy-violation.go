@@ -0,0 +1,42 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"gorm.io/gorm"
+)
+
+// PolicyViolation 은 클러스터 자원 단위의 정책 위반 기록이다.
+// 정책 알림과 TKSPolicy CR 의 audit 결과로부터 같은 자원에 대해 하나의 레코드로 갱신된다.
+type PolicyViolation struct {
+	gorm.Model
+
+	ID                 uuid.UUID        `gorm:"primarykey;type:varchar(36);not null"`
+	OrganizationId     string           `gorm:"uniqueIndex:idx_policy_violation_resource"`
+	ClusterId          domain.ClusterId `gorm:"uniqueIndex:idx_policy_violation_resource"`
+	PolicyId           *uuid.UUID       `gorm:"type:varchar(36)"`
+	PolicyName         string           `gorm:"uniqueIndex:idx_policy_violation_resource"`
+	PolicyTemplateKind string
+	Namespace          string `gorm:"uniqueIndex:idx_policy_violation_resource"`
+	Kind               string `gorm:"uniqueIndex:idx_policy_violation_resource"`
+	Name               string `gorm:"uniqueIndex:idx_policy_violation_resource"`
+	Message            string `gorm:"type:text"`
+	EnforcementAction  string
+	Status             domain.PolicyViolationStatus `gorm:"index"`
+	// 예외(PolicyException)에 의해 excepted 상태가 된 경우 true. 사용자가 직접 지정한 상태는 예외가 삭제되거나 만료되어도 유지한다.
+	ExceptedByException bool
+	FirstSeenAt         time.Time
+	LastSeenAt          time.Time
+
+	UpdatorId *uuid.UUID `gorm:"type:uuid"`
+	Updator   User       `gorm:"foreignKey:UpdatorId"`
+}
+
+func (p *PolicyViolation) BeforeCreate(tx *gorm.DB) (err error) {
+	if p.ID == uuid.Nil {
+		p.ID = uuid.New()
+	}
+	return nil
+}
@@ -23,6 +23,9 @@ type Policy struct {
 	TargetClusters   []Cluster `gorm:"many2many:policy_target_clusters"`
 
 	EnforcementAction string
+	// 단계적 적용 중인 클러스터별 적용 액션. 포함되지 않은 클러스터는 EnforcementAction 을 따른다.
+	ClusterEnforcementActions   string            `gorm:"type:text"`
+	ClusterEnforcementActionMap map[string]string `gorm:"-:all"`
 
 	Parameters  string        `gorm:"type:text"`
 	PolicyMatch string        `gorm:"type:text"`
@@ -33,6 +36,10 @@ type Policy struct {
 	TemplateId     uuid.UUID      `gorm:"type:uuid"`
 	PolicyTemplate PolicyTemplate `gorm:"foreignKey:TemplateId"`
 
+	Exceptions []PolicyException `gorm:"foreignKey:PolicyId"`
+	// 정책을 포함한 번들 항목. 번들이 할당된 스택에는 항목의 프리셋을 적용한다.
+	BundleItems []PolicyBundleItem `gorm:"foreignKey:PolicyId"`
+
 	CreatorId *uuid.UUID `gorm:"type:uuid"`
 	Creator   User       `gorm:"foreignKey:CreatorId"`
 	UpdatorId *uuid.UUID `gorm:"type:uuid"`
@@ -67,6 +74,10 @@ func (p *Policy) AfterFind(tx *gorm.DB) (err error) {
 		p.Match = &match
 	}
 
+	if len(p.ClusterEnforcementActions) > 0 {
+		_ = json.Unmarshal([]byte(p.ClusterEnforcementActions), &p.ClusterEnforcementActionMap)
+	}
+
 	p.TargetClusterIds = make([]string, len(p.TargetClusters))
 	for i, cluster := range p.TargetClusters {
 		p.TargetClusterIds[i] = cluster.ID.String()
@@ -75,6 +86,17 @@ func (p *Policy) AfterFind(tx *gorm.DB) (err error) {
 	return
 }
 
+// BundlePreset returns the preset of the bundle assigned to the cluster.
+// Presets of the bundles assigned to the same cluster never conflict, so the first one is returned.
+func (p *Policy) BundlePreset(clusterId string) (PolicyBundleItem, bool) {
+	for _, item := range p.BundleItems {
+		if item.HasPreset() && item.IsAssignedTo(clusterId) {
+			return item, true
+		}
+	}
+	return PolicyBundleItem{}, false
+}
+
 type PolicyTargetCluster struct {
 	PolicyId  uuid.UUID        `gorm:"primarykey"`
 	ClusterId domain.ClusterId `gorm:"primarykey"`
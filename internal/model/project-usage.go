@@ -0,0 +1,31 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ProjectUsage 는 프로젝트 네임스페이스의 일별 자원 사용량 집계이다.
+// 사용량은 하루 동안의 시간당 평균을 합산한 값이다.
+type ProjectUsage struct {
+	ID              uuid.UUID `gorm:"primarykey;type:varchar(36);not null"`
+	OrganizationId  string    `gorm:"index"`
+	ProjectId       string    `gorm:"uniqueIndex:idx_project_usage_namespace_date"`
+	StackId         string    `gorm:"uniqueIndex:idx_project_usage_namespace_date"`
+	Namespace       string    `gorm:"uniqueIndex:idx_project_usage_namespace_date"`
+	Date            time.Time `gorm:"type:date;uniqueIndex:idx_project_usage_namespace_date"`
+	CpuCoreHours    float64
+	MemoryGibHours  float64
+	StorageGibHours float64
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func (p *ProjectUsage) BeforeCreate(tx *gorm.DB) (err error) {
+	if p.ID == uuid.Nil {
+		p.ID = uuid.New()
+	}
+	return nil
+}
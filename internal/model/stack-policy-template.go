@@ -0,0 +1,39 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"gorm.io/gorm"
+)
+
+// StackPolicyTemplateVersion 은 스택에 적용하도록 지정한 정책 템플릿 버전이다.
+// Pinned 가 true 이면 고정을 해제하기 전까지 다른 버전으로 변경할 수 없다.
+type StackPolicyTemplateVersion struct {
+	ClusterId        domain.ClusterId `gorm:"primarykey"`
+	PolicyTemplateId uuid.UUID        `gorm:"primarykey;type:uuid"`
+	Version          string
+	Pinned           bool
+	UpdatorId        *uuid.UUID `gorm:"type:uuid"`
+}
+
+// StackPolicyTemplateHistory 는 스택별 정책 템플릿 버전 변경 이력이다.
+type StackPolicyTemplateHistory struct {
+	gorm.Model
+
+	ID               uuid.UUID        `gorm:"primarykey;type:varchar(36);not null"`
+	ClusterId        domain.ClusterId `gorm:"index:idx_stack_policy_template_history"`
+	PolicyTemplateId uuid.UUID        `gorm:"index:idx_stack_policy_template_history;type:uuid"`
+	FromVersion      string
+	ToVersion        string
+	Action           string
+	Pinned           bool
+	CreatorId        *uuid.UUID `gorm:"type:uuid"`
+	Creator          User       `gorm:"foreignKey:CreatorId"`
+}
+
+func (h *StackPolicyTemplateHistory) BeforeCreate(tx *gorm.DB) (err error) {
+	if h.ID == uuid.Nil {
+		h.ID = uuid.New()
+	}
+	return nil
+}
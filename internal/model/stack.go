@@ -31,6 +31,7 @@ type Stack = struct {
 	ClusterEndpoint string
 	Resource        domain.DashboardStack
 	PolicyIds       []string
+	PolicyBundleIds []string
 	Conf            StackConf
 	AppServeAppCnt  int
 }
@@ -45,4 +46,5 @@ type StackConf struct {
 	TksUserNode      int
 	TksUserNodeMax   int
 	TksUserNodeType  string
+	ClusterRegion    string
 }
@@ -0,0 +1,131 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"gorm.io/gorm"
+)
+
+// OnCallSchedule 은 조직의 당직 순번이다. RotationStartAt 부터 RotationHour 마다 다음 참여자에게 당직이 인계된다.
+// 현재 당직자가 1차(primary), 다음 순번의 참여자가 2차(secondary) 당직자가 된다.
+type OnCallSchedule struct {
+	gorm.Model
+
+	ID               uuid.UUID `gorm:"primarykey;type:varchar(36);not null"`
+	OrganizationId   string    `gorm:"index"`
+	Name             string
+	Description      string
+	Participants     string      `gorm:"type:text"`
+	ParticipantIds   []uuid.UUID `gorm:"-:all"`
+	ParticipantUsers []User      `gorm:"-:all"`
+	RotationStartAt  time.Time
+	RotationHour     int
+	CreatorId        *uuid.UUID `gorm:"type:uuid"`
+	Creator          User       `gorm:"foreignKey:CreatorId"`
+}
+
+func (s *OnCallSchedule) BeforeCreate(tx *gorm.DB) (err error) {
+	if s.ID == uuid.Nil {
+		s.ID = uuid.New()
+	}
+
+	jsonBytes, err := json.Marshal(s.ParticipantIds)
+	if err != nil {
+		return err
+	}
+	s.Participants = string(jsonBytes)
+
+	return nil
+}
+
+func (s *OnCallSchedule) AfterFind(tx *gorm.DB) (err error) {
+	if len(s.Participants) > 0 {
+		// 목록 조회 시 에러가 발생해서 전체 조회가 실패하는 것을 방지하기 위해서 에러는 무시
+		_ = json.Unmarshal([]byte(s.Participants), &s.ParticipantIds)
+	}
+	return nil
+}
+
+// rotation 은 now 에 진행 중인 당직 순번을 반환한다.
+// RotationStartAt 이전은 음수 순번이 되므로 참여자 순서는 거꾸로 돌아간다.
+func (s *OnCallSchedule) rotation(now time.Time) int64 {
+	period := time.Duration(s.RotationHour) * time.Hour
+	elapsed := now.Sub(s.RotationStartAt)
+	idx := int64(elapsed / period)
+	if elapsed < 0 && elapsed%period != 0 {
+		idx--
+	}
+	return idx
+}
+
+// OnCall 은 now 에 당직인 참여자를 반환한다. 참여자가 없거나 순번 주기가 없으면 false 를 반환한다.
+// level 0 은 1차 당직자, level 1 은 2차 당직자(다음 순번)이다.
+func (s *OnCallSchedule) OnCall(now time.Time, level int) (uuid.UUID, bool) {
+	n := int64(len(s.ParticipantIds))
+	if n == 0 || s.RotationHour <= 0 {
+		return uuid.Nil, false
+	}
+	idx := ((s.rotation(now)+int64(level))%n + n) % n
+	return s.ParticipantIds[idx], true
+}
+
+// NextHandoffAt 은 현재 당직자가 다음 참여자에게 당직을 인계하는 시각이다.
+func (s *OnCallSchedule) NextHandoffAt(now time.Time) time.Time {
+	if s.RotationHour <= 0 {
+		return time.Time{}
+	}
+	period := time.Duration(s.RotationHour) * time.Hour
+	return s.RotationStartAt.Add(time.Duration(s.rotation(now)+1) * period)
+}
+
+// SystemNotificationEscalationPolicy 는 알림 설정별 에스컬레이션 단계이다.
+// 알림이 발생한 뒤 조치(액션)가 없는 상태로 단계별 DelayMinute 이 지나면 해당 단계의 대상에게 알림을 발송한다.
+type SystemNotificationEscalationPolicy struct {
+	gorm.Model
+
+	ID                       uuid.UUID                                 `gorm:"primarykey;type:varchar(36);not null"`
+	OrganizationId           string                                    `gorm:"index"`
+	SystemNotificationRuleId uuid.UUID                                 `gorm:"type:uuid;uniqueIndex"`
+	Steps                    string                                    `gorm:"type:text"`
+	StepsData                []domain.SystemNotificationEscalationStep `gorm:"-:all"`
+	Enabled                  bool
+	CreatorId                *uuid.UUID `gorm:"type:uuid"`
+	Creator                  User       `gorm:"foreignKey:CreatorId"`
+}
+
+func (p *SystemNotificationEscalationPolicy) BeforeSave(tx *gorm.DB) (err error) {
+	if p.ID == uuid.Nil {
+		p.ID = uuid.New()
+	}
+
+	jsonBytes, err := json.Marshal(p.StepsData)
+	if err != nil {
+		return err
+	}
+	p.Steps = string(jsonBytes)
+
+	return nil
+}
+
+func (p *SystemNotificationEscalationPolicy) AfterFind(tx *gorm.DB) (err error) {
+	if len(p.Steps) > 0 {
+		// 목록 조회 시 에러가 발생해서 전체 조회가 실패하는 것을 방지하기 위해서 에러는 무시
+		_ = json.Unmarshal([]byte(p.Steps), &p.StepsData)
+	}
+	return nil
+}
+
+// DueLevel 은 firedAt 에 발생한 알림에 대해 now 까지 지연 시간이 지난 단계의 수를 반환한다.
+func (p *SystemNotificationEscalationPolicy) DueLevel(firedAt time.Time, now time.Time) int {
+	level := 0
+	for _, step := range p.StepsData {
+		if now.Before(firedAt.Add(time.Duration(step.DelayMinute) * time.Minute)) {
+			break
+		}
+		level++
+	}
+	return level
+}
@@ -0,0 +1,103 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+func TestOnCallScheduleOnCall(t *testing.T) {
+	startAt := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+	schedule := OnCallSchedule{ParticipantIds: []uuid.UUID{a, b, c}, RotationStartAt: startAt, RotationHour: 24}
+
+	tests := []struct {
+		name      string
+		now       time.Time
+		primary   uuid.UUID
+		secondary uuid.UUID
+	}{
+		{"at start", startAt, a, b},
+		{"in first rotation", startAt.Add(23 * time.Hour), a, b},
+		{"second rotation", startAt.Add(24 * time.Hour), b, c},
+		{"wraps around", startAt.Add(72 * time.Hour), a, b},
+		{"last participant", startAt.Add(48 * time.Hour), c, a},
+		// RotationStartAt 이전은 순서가 거꾸로 돌아간다
+		{"before start", startAt.Add(-time.Hour), c, a},
+		{"two rotations before start", startAt.Add(-25 * time.Hour), b, c},
+	}
+	for _, tt := range tests {
+		primary, ok := schedule.OnCall(tt.now, 0)
+		if !ok || primary != tt.primary {
+			t.Errorf("%s: primary = %v, want %v", tt.name, primary, tt.primary)
+		}
+		secondary, ok := schedule.OnCall(tt.now, 1)
+		if !ok || secondary != tt.secondary {
+			t.Errorf("%s: secondary = %v, want %v", tt.name, secondary, tt.secondary)
+		}
+	}
+
+	for name, schedule := range map[string]OnCallSchedule{
+		"no participants":   {RotationStartAt: startAt, RotationHour: 24},
+		"no rotation hours": {ParticipantIds: []uuid.UUID{a}, RotationStartAt: startAt},
+	} {
+		if _, ok := schedule.OnCall(startAt, 0); ok {
+			t.Errorf("%s: OnCall() must return false", name)
+		}
+	}
+}
+
+func TestOnCallScheduleNextHandoffAt(t *testing.T) {
+	startAt := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	schedule := OnCallSchedule{ParticipantIds: []uuid.UUID{uuid.New()}, RotationStartAt: startAt, RotationHour: 8}
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want time.Time
+	}{
+		{"at start", startAt, startAt.Add(8 * time.Hour)},
+		{"in rotation", startAt.Add(10 * time.Hour), startAt.Add(16 * time.Hour)},
+		{"at handoff", startAt.Add(16 * time.Hour), startAt.Add(24 * time.Hour)},
+		{"before start", startAt.Add(-time.Hour), startAt},
+	}
+	for _, tt := range tests {
+		if got := schedule.NextHandoffAt(tt.now); !got.Equal(tt.want) {
+			t.Errorf("%s: NextHandoffAt() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	if got := (&OnCallSchedule{RotationStartAt: startAt}).NextHandoffAt(startAt); !got.IsZero() {
+		t.Errorf("NextHandoffAt() without rotation hours = %v, want zero time", got)
+	}
+}
+
+func TestSystemNotificationEscalationPolicyDueLevel(t *testing.T) {
+	firedAt := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	policy := SystemNotificationEscalationPolicy{StepsData: []domain.SystemNotificationEscalationStep{
+		{DelayMinute: 0}, {DelayMinute: 10}, {DelayMinute: 30},
+	}}
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want int
+	}{
+		{"at fire", firedAt, 1},
+		{"before second step", firedAt.Add(9 * time.Minute), 1},
+		{"at second step", firedAt.Add(10 * time.Minute), 2},
+		{"after last step", firedAt.Add(time.Hour), 3},
+		{"before fire", firedAt.Add(-time.Minute), 0},
+	}
+	for _, tt := range tests {
+		if got := policy.DueLevel(firedAt, tt.now); got != tt.want {
+			t.Errorf("%s: DueLevel() = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+
+	if got := (&SystemNotificationEscalationPolicy{}).DueLevel(firedAt, firedAt.Add(time.Hour)); got != 0 {
+		t.Errorf("DueLevel() without steps = %d, want 0", got)
+	}
+}
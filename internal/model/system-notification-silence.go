@@ -0,0 +1,139 @@
+package model
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"gorm.io/gorm"
+)
+
+// SystemNotificationSilence 는 정해진 기간 동안 조건에 맞는 알림을 억제한다.
+// 비어 있는 조건은 모든 값에 해당한다. 조직의 모든 알림이 억제되지 않도록 조건이 하나 이상 있어야 한다.
+type SystemNotificationSilence struct {
+	gorm.Model
+
+	ID                       uuid.UUID        `gorm:"primarykey;type:varchar(36);not null"`
+	OrganizationId           string           `gorm:"index"`
+	ClusterId                domain.ClusterId `gorm:"type:varchar(36)"`
+	Node                     string
+	AlertName                string
+	SystemNotificationRuleId *uuid.UUID `gorm:"type:uuid"`
+	StartsAt                 time.Time
+	EndsAt                   time.Time
+	Comment                  string     `gorm:"type:text"`
+	CreatorId                *uuid.UUID `gorm:"type:uuid"`
+	Creator                  User       `gorm:"foreignKey:CreatorId"`
+}
+
+func (s *SystemNotificationSilence) BeforeCreate(tx *gorm.DB) (err error) {
+	if s.ID == uuid.Nil {
+		s.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsActive returns whether the silence suppresses notifications at the given time.
+func (s *SystemNotificationSilence) IsActive(now time.Time) bool {
+	return !now.Before(s.StartsAt) && now.Before(s.EndsAt)
+}
+
+// HasMatcher returns whether the silence has at least one of cluster, node, alert name or rule condition.
+func (s *SystemNotificationSilence) HasMatcher() bool {
+	return s.ClusterId != "" || s.Node != "" || s.AlertName != "" || s.SystemNotificationRuleId != nil
+}
+
+// Matches returns whether the silence applies to the given notification.
+// 조건이 없는 사일런스는 어떤 알림에도 해당하지 않는다.
+func (s *SystemNotificationSilence) Matches(n SystemNotification) bool {
+	if !s.HasMatcher() || s.OrganizationId != n.OrganizationId {
+		return false
+	}
+	if s.ClusterId != "" && s.ClusterId != n.ClusterId {
+		return false
+	}
+	if s.Node != "" && s.Node != n.Node {
+		return false
+	}
+	if s.AlertName != "" && s.AlertName != n.Name {
+		return false
+	}
+	if s.SystemNotificationRuleId != nil && (n.SystemNotificationRuleId == nil || *s.SystemNotificationRuleId != *n.SystemNotificationRuleId) {
+		return false
+	}
+	return true
+}
+
+// StackMaintenanceWindow 는 스택의 정기 점검 시간이다. 점검 시간 동안 스택에서 발생한 알림은 억제된다.
+// 점검은 지정한 요일의 StartTime(HH:MM, Timezone 기준)에 시작하여 DurationMinute 동안 지속된다.
+type StackMaintenanceWindow struct {
+	gorm.Model
+
+	ID             uuid.UUID      `gorm:"primarykey;type:varchar(36);not null"`
+	OrganizationId string         `gorm:"index"`
+	StackId        domain.StackId `gorm:"type:varchar(36);index"`
+	Name           string
+	Description    string
+	Weekdays       string         `gorm:"type:text"`
+	WeekdaysData   []time.Weekday `gorm:"-:all"`
+	StartTime      string
+	DurationMinute int
+	Timezone       string
+	Enabled        bool       `gorm:"default:true"`
+	CreatorId      *uuid.UUID `gorm:"type:uuid"`
+	Creator        User       `gorm:"foreignKey:CreatorId"`
+}
+
+func (w *StackMaintenanceWindow) BeforeCreate(tx *gorm.DB) (err error) {
+	if w.ID == uuid.Nil {
+		w.ID = uuid.New()
+	}
+
+	jsonBytes, err := json.Marshal(w.WeekdaysData)
+	if err != nil {
+		return err
+	}
+	w.Weekdays = string(jsonBytes)
+
+	return nil
+}
+
+func (w *StackMaintenanceWindow) AfterFind(tx *gorm.DB) (err error) {
+	if len(w.Weekdays) > 0 {
+		// 목록 조회 시 에러가 발생해서 전체 조회가 실패하는 것을 방지하기 위해서 에러는 무시
+		_ = json.Unmarshal([]byte(w.Weekdays), &w.WeekdaysData)
+	}
+	return nil
+}
+
+// IsActive returns whether the maintenance window is in progress at the given time.
+// 자정을 넘기는 점검을 위해 전날 시작한 점검도 확인한다.
+func (w *StackMaintenanceWindow) IsActive(now time.Time) bool {
+	if !w.Enabled || w.DurationMinute <= 0 {
+		return false
+	}
+	loc, err := time.LoadLocation(w.Timezone)
+	if err != nil {
+		loc = time.Local
+	}
+	start, err := time.ParseInLocation("15:04", w.StartTime, loc)
+	if err != nil {
+		return false
+	}
+
+	now = now.In(loc)
+	for _, offset := range []int{0, -1} {
+		day := now.AddDate(0, 0, offset)
+		if !slices.Contains(w.WeekdaysData, day.Weekday()) {
+			continue
+		}
+		startsAt := time.Date(day.Year(), day.Month(), day.Day(), start.Hour(), start.Minute(), 0, 0, loc)
+		endsAt := startsAt.Add(time.Duration(w.DurationMinute) * time.Minute)
+		if !now.Before(startsAt) && now.Before(endsAt) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+func TestSystemNotificationSilenceIsActive(t *testing.T) {
+	startsAt := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	silence := SystemNotificationSilence{StartsAt: startsAt, EndsAt: startsAt.Add(time.Hour)}
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want bool
+	}{
+		{"before start", startsAt.Add(-time.Second), false},
+		{"at start", startsAt, true},
+		{"in period", startsAt.Add(30 * time.Minute), true},
+		{"at end", startsAt.Add(time.Hour), false},
+	}
+	for _, tt := range tests {
+		if got := silence.IsActive(tt.now); got != tt.want {
+			t.Errorf("%s: IsActive() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSystemNotificationSilenceMatches(t *testing.T) {
+	ruleId := uuid.New()
+	otherRuleId := uuid.New()
+	notification := SystemNotification{
+		OrganizationId:           "org1",
+		ClusterId:                domain.ClusterId("cluster1"),
+		Node:                     "node1",
+		Name:                     "node-cpu-high-load",
+		SystemNotificationRuleId: &ruleId,
+	}
+
+	tests := []struct {
+		name    string
+		silence SystemNotificationSilence
+		want    bool
+	}{
+		{"no matcher", SystemNotificationSilence{OrganizationId: "org1"}, false},
+		{"cluster", SystemNotificationSilence{OrganizationId: "org1", ClusterId: "cluster1"}, true},
+		{"other organization", SystemNotificationSilence{OrganizationId: "org2", ClusterId: "cluster1"}, false},
+		{"other cluster", SystemNotificationSilence{OrganizationId: "org1", ClusterId: "cluster2"}, false},
+		{"node", SystemNotificationSilence{OrganizationId: "org1", Node: "node1"}, true},
+		{"other node", SystemNotificationSilence{OrganizationId: "org1", Node: "node2"}, false},
+		{"alert name", SystemNotificationSilence{OrganizationId: "org1", AlertName: "node-cpu-high-load"}, true},
+		{"other alert name", SystemNotificationSilence{OrganizationId: "org1", AlertName: "pvc-full"}, false},
+		{"rule", SystemNotificationSilence{OrganizationId: "org1", SystemNotificationRuleId: &ruleId}, true},
+		{"other rule", SystemNotificationSilence{OrganizationId: "org1", SystemNotificationRuleId: &otherRuleId}, false},
+		{"all matchers", SystemNotificationSilence{OrganizationId: "org1", ClusterId: "cluster1", Node: "node1", AlertName: "node-cpu-high-load", SystemNotificationRuleId: &ruleId}, true},
+		{"one of matchers differs", SystemNotificationSilence{OrganizationId: "org1", ClusterId: "cluster1", Node: "node2"}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.silence.Matches(notification); got != tt.want {
+			t.Errorf("%s: Matches() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	// 알림 설정이 없는 알림은 알림 설정 조건에 해당하지 않는다
+	silence := SystemNotificationSilence{OrganizationId: "org1", SystemNotificationRuleId: &ruleId}
+	if silence.Matches(SystemNotification{OrganizationId: "org1"}) {
+		t.Error("expected silence with rule not to match notification without rule")
+	}
+}
+
+func TestStackMaintenanceWindowIsActive(t *testing.T) {
+	// 2024-01-01 은 월요일이다
+	monday := func(hour int, minute int) time.Time {
+		return time.Date(2024, 1, 1, hour, minute, 0, 0, time.UTC)
+	}
+	window := func(modify func(w *StackMaintenanceWindow)) StackMaintenanceWindow {
+		w := StackMaintenanceWindow{
+			WeekdaysData:   []time.Weekday{time.Monday},
+			StartTime:      "02:00",
+			DurationMinute: 120,
+			Timezone:       "UTC",
+			Enabled:        true,
+		}
+		if modify != nil {
+			modify(&w)
+		}
+		return w
+	}
+
+	tests := []struct {
+		name   string
+		window StackMaintenanceWindow
+		now    time.Time
+		want   bool
+	}{
+		{"before start", window(nil), monday(1, 59), false},
+		{"at start", window(nil), monday(2, 0), true},
+		{"in window", window(nil), monday(3, 30), true},
+		{"at end", window(nil), monday(4, 0), false},
+		{"other weekday", window(nil), monday(2, 30).AddDate(0, 0, 1), false},
+		{"disabled", window(func(w *StackMaintenanceWindow) { w.Enabled = false }), monday(2, 30), false},
+		{"zero duration", window(func(w *StackMaintenanceWindow) { w.DurationMinute = 0 }), monday(2, 30), false},
+		{"invalid start time", window(func(w *StackMaintenanceWindow) { w.StartTime = "2am" }), monday(2, 30), false},
+		{"past midnight", window(func(w *StackMaintenanceWindow) { w.StartTime = "23:00"; w.DurationMinute = 180 }), monday(1, 0).AddDate(0, 0, 1), true},
+		{"past midnight ended", window(func(w *StackMaintenanceWindow) { w.StartTime = "23:00"; w.DurationMinute = 180 }), monday(2, 0).AddDate(0, 0, 1), false},
+		{"timezone", window(func(w *StackMaintenanceWindow) { w.Timezone = "Asia/Seoul" }), monday(2, 30).Add(-9 * time.Hour), true},
+		{"timezone weekday", window(func(w *StackMaintenanceWindow) { w.Timezone = "Asia/Seoul" }), monday(2, 30), false},
+	}
+	for _, tt := range tests {
+		if got := tt.window.IsActive(tt.now); got != tt.want {
+			t.Errorf("%s: IsActive() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
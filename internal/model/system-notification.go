@@ -40,6 +40,13 @@ type SystemNotification struct {
 	Readers                   []User                                `gorm:"many2many:system_notification_users;constraint:OnUpdate:RESTRICT,OnDelete:RESTRICT"`
 	SystemNotificationRuleId  *uuid.UUID
 	PolicyName                string
+	// 사일런스 또는 스택 점검 시간에 해당하여 억제된 알림. 억제된 알림은 발송하지 않는다.
+	Suppressed                  bool       `gorm:"index;default:false"`
+	SystemNotificationSilenceId *uuid.UUID `gorm:"type:varchar(36)"`
+	StackMaintenanceWindowId    *uuid.UUID `gorm:"type:varchar(36)"`
+	// 에스컬레이션 정책에서 알림을 발송한 단계 수
+	EscalationLevel int `gorm:"default:0"`
+	EscalatedAt     *time.Time
 }
 
 type SystemNotificationAction struct {
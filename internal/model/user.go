@@ -24,12 +24,19 @@ type User struct {
 	UpdatedAt         time.Time    `json:"updatedAt"`
 	PasswordUpdatedAt time.Time    `json:"passwordUpdatedAt"`
 	PasswordExpired   bool         `json:"passwordExpired"`
+	LockedUntil       *time.Time   `json:"lockedUntil"`
+
+	MfaEnrollmentRequired bool `gorm:"-:all" json:"mfaEnrollmentRequired"`
 
 	Email       string `json:"email"`
 	Department  string `json:"department"`
 	Description string `json:"description"`
 }
 
+func (u *User) IsLocked() bool {
+	return u.LockedUntil != nil && time.Now().Before(*u.LockedUntil)
+}
+
 func (u *User) BeforeDelete(db *gorm.DB) (err error) {
 	err = db.Table("user_roles").Unscoped().Where("user_id = ?", u.ID).Delete(nil).Error
 	if err != nil {
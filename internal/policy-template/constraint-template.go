@@ -0,0 +1,286 @@
+package policytemplate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"gopkg.in/yaml.v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+const (
+	GatekeeperAdmissionTarget = "admission.k8s.gatekeeper.sh"
+	GatekeeperTitleAnnotation = "metadata.gatekeeper.sh/title"
+	DescriptionAnnotation     = "description"
+
+	maxConstraintTemplateSize = 1 << 20
+)
+
+// ConstraintTemplate 은 Gatekeeper 의 templates.gatekeeper.sh/v1 ConstraintTemplate 이다.
+// Gatekeeper 라이브러리의 템플릿을 가져오거나 TKS 템플릿을 내보낼 때 사용한다.
+type ConstraintTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ConstraintTemplateSpec `json:"spec,omitempty"`
+}
+
+type ConstraintTemplateSpec struct {
+	CRD     CRD      `json:"crd,omitempty"`
+	Targets []Target `json:"targets,omitempty"`
+}
+
+// ParseConstraintTemplate 은 YAML 또는 JSON 형식의 ConstraintTemplate 을 파싱한다.
+func ParseConstraintTemplate(data []byte) (*ConstraintTemplate, error) {
+	var ct ConstraintTemplate
+
+	decoder := k8syaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+	if err := decoder.Decode(&ct); err != nil {
+		return nil, err
+	}
+
+	if ct.Kind != "ConstraintTemplate" {
+		return nil, fmt.Errorf("kind '%s' is not ConstraintTemplate", ct.Kind)
+	}
+
+	if ct.Spec.CRD.Spec.Names.Kind == "" {
+		return nil, fmt.Errorf("spec.crd.spec.names.kind is empty")
+	}
+
+	if ct.RegoTarget() == nil {
+		return nil, fmt.Errorf("no rego target for %s", GatekeeperAdmissionTarget)
+	}
+
+	return &ct, nil
+}
+
+// FetchConstraintTemplate 은 허용된 git 호스트의 ConstraintTemplate 파일을 가져온다.
+// github 의 blob 경로는 raw 경로로 변환한다.
+// 내부망 접근을 막기 위해 리다이렉트를 포함한 모든 요청의 호스트를 allowedHosts 로 제한하고,
+// DNS 조회 결과가 사설, loopback, link-local 주소이면 연결하지 않는다.
+func FetchConstraintTemplate(ctx context.Context, sourceUrl string, allowedHosts []string) ([]byte, error) {
+	u, err := url.Parse(sourceUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkConstraintTemplateUrl(u, allowedHosts); err != nil {
+		return nil, err
+	}
+
+	// https://github.com/{owner}/{repo}/blob/{ref}/{path} -> https://raw.githubusercontent.com/{owner}/{repo}/{ref}/{path}
+	if u.Host == "github.com" {
+		if parts := strings.SplitN(strings.TrimPrefix(u.Path, "/"), "/", 4); len(parts) == 4 && parts[2] == "blob" {
+			u.Host = "raw.githubusercontent.com"
+			u.Path = "/" + parts[0] + "/" + parts[1] + "/" + parts[3]
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := newConstraintTemplateClient(allowedHosts).Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch %s: %s", u.String(), resp.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxConstraintTemplateSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxConstraintTemplateSize {
+		return nil, fmt.Errorf("constraint template is larger than %d bytes", maxConstraintTemplateSize)
+	}
+
+	return data, nil
+}
+
+func checkConstraintTemplateUrl(u *url.URL, allowedHosts []string) error {
+	if u.Scheme != "https" {
+		return fmt.Errorf("only https source is supported")
+	}
+	if u.Port() != "" && u.Port() != "443" {
+		return fmt.Errorf("port %s is not allowed", u.Port())
+	}
+
+	host := strings.ToLower(u.Hostname())
+	for _, allowedHost := range allowedHosts {
+		if host == strings.ToLower(strings.TrimSpace(allowedHost)) {
+			return nil
+		}
+	}
+	return fmt.Errorf("host '%s' is not an allowed git host", u.Hostname())
+}
+
+// newConstraintTemplateClient 는 연결할 주소와 리다이렉트 대상을 검사하는 http client 를 만든다.
+// 프록시를 거치면 실제 연결 주소를 검사할 수 없으므로 프록시는 사용하지 않는다.
+func newConstraintTemplateClient(allowedHosts []string) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 5 * time.Second,
+		Control: func(network string, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !isPublicIP(ip) {
+				return fmt.Errorf("address %s is not allowed", host)
+			}
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: 5 * time.Second,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 5 {
+				return fmt.Errorf("stopped after %d redirects", len(via))
+			}
+			return checkConstraintTemplateUrl(req.URL, allowedHosts)
+		},
+	}
+}
+
+// 100.64.0.0/10 (carrier-grade NAT) 은 net.IP.IsPrivate 에 포함되지 않는다.
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+func isPublicIP(ip net.IP) bool {
+	return ip.IsGlobalUnicast() && !ip.IsPrivate() && !sharedAddressSpace.Contains(ip)
+}
+
+// RegoTarget 은 Gatekeeper admission 대상의 rego target 을 반환한다.
+func (ct *ConstraintTemplate) RegoTarget() *Target {
+	for i, target := range ct.Spec.Targets {
+		if target.Target == GatekeeperAdmissionTarget && strings.TrimSpace(target.Rego) != "" {
+			return &ct.Spec.Targets[i]
+		}
+	}
+	return nil
+}
+
+// ToPolicyTemplate 은 ConstraintTemplate 의 kind, 파라미터 스키마, rego, libs, sync 설정을 정책 템플릿으로 변환한다.
+func (ct *ConstraintTemplate) ToPolicyTemplate() model.PolicyTemplate {
+	target := ct.RegoTarget()
+
+	policyTemplate := model.PolicyTemplate{
+		TemplateName: ct.Annotations[GatekeeperTitleAnnotation],
+		Description:  ct.Annotations[DescriptionAnnotation],
+		Kind:         ct.Spec.CRD.Spec.Names.Kind,
+		Rego:         target.Rego,
+		Libs:         target.Libs,
+	}
+
+	if policyTemplate.TemplateName == "" {
+		policyTemplate.TemplateName = policyTemplate.Kind
+	}
+
+	if policyTemplate.Libs == nil {
+		policyTemplate.Libs = []string{}
+	}
+
+	if validation := ct.Spec.CRD.Spec.Validation; validation != nil && validation.OpenAPIV3Schema != nil {
+		schema := validation.OpenAPIV3Schema.DeepCopy()
+		if schema.Type == "" && len(schema.Properties) > 0 {
+			schema.Type = "object"
+		}
+		if schema.Type == "object" {
+			policyTemplate.ParametersSchema = JSONSchemaProeprtiesToParamDefs(schema)
+		}
+	}
+
+	// Gatekeeper 는 sync 설정을 따옴표로 감싼 JSON 문자열로 기록한다.
+	if syncData := strings.TrimSpace(ct.Annotations[RequireSyncDataAnnotation]); syncData != "" {
+		syncJson := strings.TrimSuffix(strings.TrimPrefix(syncData, "\""), "\"")
+		policyTemplate.SyncJson = &syncJson
+	}
+
+	return policyTemplate
+}
+
+// PolicyTemplateToConstraintTemplate 은 정책 템플릿의 특정 버전을 Gatekeeper ConstraintTemplate 으로 변환한다.
+// TKS 가드 코드가 추가되기 전의 rego 를 사용하므로 다시 가져오기 할 수 있다.
+func PolicyTemplateToConstraintTemplate(policyTemplate *model.PolicyTemplate) *ConstraintTemplate {
+	if policyTemplate == nil {
+		return nil
+	}
+
+	tksPolicyTemplate := PolicyTemplateToTksPolicyTemplateCR(policyTemplate)
+
+	annotations := map[string]string{
+		GatekeeperTitleAnnotation: policyTemplate.TemplateName,
+	}
+	if policyTemplate.Description != "" {
+		annotations[DescriptionAnnotation] = policyTemplate.Description
+	}
+	if syncData, ok := tksPolicyTemplate.Annotations[RequireSyncDataAnnotation]; ok {
+		annotations[RequireSyncDataAnnotation] = syncData
+	}
+
+	return &ConstraintTemplate{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "templates.gatekeeper.sh/v1",
+			Kind:       "ConstraintTemplate",
+		},
+
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        strings.ToLower(policyTemplate.Kind),
+			Annotations: annotations,
+		},
+
+		Spec: ConstraintTemplateSpec{
+			CRD: tksPolicyTemplate.Spec.CRD,
+			Targets: []Target{{
+				Target: GatekeeperAdmissionTarget,
+				Rego:   stripCarriageReturn(policyTemplate.Rego),
+				Libs:   stripCarriageReturns(policyTemplate.Libs),
+			}},
+		},
+	}
+}
+
+func (ct *ConstraintTemplate) YAML() (string, error) {
+	target := map[string]interface{}{}
+
+	jsonBytes, err := json.Marshal(ct)
+	if err != nil {
+		return "", err
+	}
+
+	if err := json.Unmarshal(jsonBytes, &target); err != nil {
+		return "", err
+	}
+
+	if metadata, ok := target["metadata"].(map[string]interface{}); ok {
+		delete(metadata, "creationTimestamp")
+	}
+
+	result, err := yaml.Marshal(&target)
+	if err != nil {
+		return "", err
+	}
+
+	return string(result), nil
+}
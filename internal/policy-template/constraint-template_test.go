@@ -0,0 +1,311 @@
+package policytemplate
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+const requiredLabelsTemplate = `apiVersion: templates.gatekeeper.sh/v1
+kind: ConstraintTemplate
+metadata:
+  name: k8srequiredlabels
+  annotations:
+    metadata.gatekeeper.sh/title: "Required Labels"
+    description: Requires resources to contain specified labels.
+spec:
+  crd:
+    spec:
+      names:
+        kind: K8sRequiredLabels
+      validation:
+        openAPIV3Schema:
+          type: object
+          properties:
+            message:
+              type: string
+            labels:
+              type: array
+              items:
+                type: string
+  targets:
+    - target: admission.k8s.gatekeeper.sh
+      rego: |
+        package k8srequiredlabels
+
+        violation[{"msg": msg}] {
+          provided := {label | input.review.object.metadata.labels[label]}
+          required := {label | label := input.parameters.labels[_]}
+          missing := required - provided
+          count(missing) > 0
+          msg := sprintf("missing labels: %v", [missing])
+        }
+`
+
+func TestParseConstraintTemplate(t *testing.T) {
+	ct, err := ParseConstraintTemplate([]byte(requiredLabelsTemplate))
+	if err != nil {
+		t.Fatalf("ParseConstraintTemplate() error = %v", err)
+	}
+
+	policyTemplate := ct.ToPolicyTemplate()
+	if policyTemplate.Kind != "K8sRequiredLabels" || policyTemplate.TemplateName != "Required Labels" {
+		t.Errorf("unexpected kind or name: %s, %s", policyTemplate.Kind, policyTemplate.TemplateName)
+	}
+	if policyTemplate.Description != "Requires resources to contain specified labels." {
+		t.Errorf("unexpected description: %s", policyTemplate.Description)
+	}
+	if !strings.HasPrefix(policyTemplate.Rego, "package k8srequiredlabels") {
+		t.Errorf("unexpected rego: %s", policyTemplate.Rego)
+	}
+	if policyTemplate.Libs == nil || len(policyTemplate.Libs) != 0 {
+		t.Errorf("Libs = %v, want empty", policyTemplate.Libs)
+	}
+
+	keys := []string{}
+	for _, paramDef := range policyTemplate.ParametersSchema {
+		keys = append(keys, paramDef.Key+":"+paramDef.Type)
+	}
+	slices.Sort(keys)
+	if want := []string{"labels:string[]", "message:string"}; !slices.Equal(keys, want) {
+		t.Errorf("ParametersSchema = %v, want %v", keys, want)
+	}
+}
+
+func TestParseConstraintTemplateJSON(t *testing.T) {
+	data := `{"apiVersion": "templates.gatekeeper.sh/v1", "kind": "ConstraintTemplate",
+		"spec": {"crd": {"spec": {"names": {"kind": "K8sAllowedRepos"}}},
+		"targets": [{"target": "admission.k8s.gatekeeper.sh", "rego": "package k8sallowedrepos"}]}}`
+
+	ct, err := ParseConstraintTemplate([]byte(data))
+	if err != nil {
+		t.Fatalf("ParseConstraintTemplate() error = %v", err)
+	}
+
+	// title 이 없으면 kind 를 이름으로 사용한다.
+	if policyTemplate := ct.ToPolicyTemplate(); policyTemplate.TemplateName != "K8sAllowedRepos" {
+		t.Errorf("TemplateName = %s, want K8sAllowedRepos", policyTemplate.TemplateName)
+	}
+}
+
+func TestParseConstraintTemplateInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{"not yaml", "kind: [", ""},
+		{"wrong kind", strings.Replace(requiredLabelsTemplate, "kind: ConstraintTemplate", "kind: Deployment", 1), "is not ConstraintTemplate"},
+		{"no crd kind", strings.Replace(requiredLabelsTemplate, "kind: K8sRequiredLabels", "kind: \"\"", 1), "names.kind is empty"},
+		{"other target", strings.Replace(requiredLabelsTemplate, "admission.k8s.gatekeeper.sh", "audit.example.com", 1), "no rego target"},
+		{"empty rego", `apiVersion: templates.gatekeeper.sh/v1
+kind: ConstraintTemplate
+spec:
+  crd:
+    spec:
+      names:
+        kind: K8sEmpty
+  targets:
+    - target: admission.k8s.gatekeeper.sh
+      rego: "  "
+`, "no rego target"},
+	}
+	for _, tt := range tests {
+		_, err := ParseConstraintTemplate([]byte(tt.data))
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("%s: ParseConstraintTemplate() error = %v, want containing %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestConstraintTemplateRoundTrip(t *testing.T) {
+	syncJson := `{"syncOnly":[{"group":"","version":"v1","kind":"Namespace"}]}`
+	policyTemplate := &model.PolicyTemplate{
+		TemplateName: "Required Labels",
+		Description:  "Requires resources to contain specified labels.",
+		Kind:         "K8sRequiredLabels",
+		Rego:         "package k8srequiredlabels\r\n\r\nviolation[{\"msg\": msg}] {\r\n  msg := \"denied\"\r\n}\r\n",
+		Libs:         []string{"package lib.helpers\n\nis_pod { input.review.kind.kind == \"Pod\" }\n"},
+		SyncJson:     &syncJson,
+		// 가져오기는 하위 파라미터가 없어도 빈 Children 을 만든다.
+		ParametersSchema: []*domain.ParameterDef{
+			{Key: "message", Type: "string", Children: []*domain.ParameterDef{}},
+			{Key: "labels", Type: "string[]", IsArray: true, Children: []*domain.ParameterDef{}},
+		},
+	}
+
+	exported := PolicyTemplateToConstraintTemplate(policyTemplate)
+	yamlStr, err := exported.YAML()
+	if err != nil {
+		t.Fatalf("YAML() error = %v", err)
+	}
+	if strings.Contains(yamlStr, "creationTimestamp") {
+		t.Errorf("exported YAML must not contain creationTimestamp:\n%s", yamlStr)
+	}
+	// 내보낸 rego 에는 TKS 가드 코드가 없어야 다시 가져올 수 있다.
+	if strings.Contains(yamlStr, "tks") {
+		t.Errorf("exported YAML must not contain TKS specific code:\n%s", yamlStr)
+	}
+
+	ct, err := ParseConstraintTemplate([]byte(yamlStr))
+	if err != nil {
+		t.Fatalf("ParseConstraintTemplate() error = %v\n%s", err, yamlStr)
+	}
+	if ct.Name != "k8srequiredlabels" {
+		t.Errorf("Name = %s, want k8srequiredlabels", ct.Name)
+	}
+
+	imported := ct.ToPolicyTemplate()
+	if imported.TemplateName != policyTemplate.TemplateName || imported.Description != policyTemplate.Description || imported.Kind != policyTemplate.Kind {
+		t.Errorf("unexpected metadata: %s, %s, %s", imported.TemplateName, imported.Description, imported.Kind)
+	}
+	if want := stripCarriageReturn(policyTemplate.Rego); imported.Rego != want {
+		t.Errorf("Rego = %q, want %q", imported.Rego, want)
+	}
+	if !slices.Equal(imported.Libs, policyTemplate.Libs) {
+		t.Errorf("Libs = %v, want %v", imported.Libs, policyTemplate.Libs)
+	}
+	if imported.SyncJson == nil || *imported.SyncJson != syncJson {
+		t.Errorf("SyncJson = %v, want %s", imported.SyncJson, syncJson)
+	}
+
+	// 스키마의 properties 는 map 이므로 순서를 맞춰 비교한다.
+	byKey := func(a, b *domain.ParameterDef) int { return strings.Compare(a.Key, b.Key) }
+	slices.SortFunc(imported.ParametersSchema, byKey)
+	slices.SortFunc(policyTemplate.ParametersSchema, byKey)
+	importedSchema, _ := json.Marshal(imported.ParametersSchema)
+	wantSchema, _ := json.Marshal(policyTemplate.ParametersSchema)
+	if string(importedSchema) != string(wantSchema) {
+		t.Errorf("ParametersSchema = %s, want %s", importedSchema, wantSchema)
+	}
+}
+
+func TestCheckConstraintTemplateUrl(t *testing.T) {
+	allowedHosts := []string{"github.com", " Raw.GithubUserContent.com "}
+
+	tests := []struct {
+		name    string
+		url     string
+		wantErr bool
+	}{
+		{"github", "https://github.com/open-policy-agent/gatekeeper-library/blob/master/template.yaml", false},
+		{"raw host case insensitive", "https://RAW.githubusercontent.com/o/r/master/template.yaml", false},
+		{"explicit https port", "https://github.com:443/o/r/blob/master/template.yaml", false},
+		{"http", "http://github.com/o/r/blob/master/template.yaml", true},
+		{"file", "file:///etc/passwd", true},
+		{"other port", "https://github.com:8443/o/r/blob/master/template.yaml", true},
+		{"not allowed host", "https://gitlab.com/o/r/-/raw/master/template.yaml", true},
+		{"allowed host as suffix", "https://github.com.example.com/template.yaml", true},
+		{"allowed host as subdomain", "https://evil.github.com/template.yaml", true},
+		{"userinfo", "https://github.com@169.254.169.254/latest/meta-data", true},
+		{"ip address", "https://127.0.0.1/template.yaml", true},
+	}
+	for _, tt := range tests {
+		u, err := url.Parse(tt.url)
+		if err != nil {
+			t.Fatalf("%s: url.Parse() error = %v", tt.name, err)
+		}
+		if err := checkConstraintTemplateUrl(u, allowedHosts); (err != nil) != tt.wantErr {
+			t.Errorf("%s: checkConstraintTemplateUrl() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestFetchConstraintTemplateRejectsUrl(t *testing.T) {
+	allowedHosts := []string{"github.com"}
+
+	for _, sourceUrl := range []string{
+		"http://github.com/o/r/blob/master/template.yaml",
+		"https://localhost/template.yaml",
+		"https://169.254.169.254/latest/meta-data",
+	} {
+		if _, err := FetchConstraintTemplate(context.Background(), sourceUrl, allowedHosts); err == nil {
+			t.Errorf("FetchConstraintTemplate(%s) must fail", sourceUrl)
+		}
+	}
+}
+
+func TestIsPublicIP(t *testing.T) {
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{"140.82.112.3", true},
+		{"2606:50c0:8000::154", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.0.0.1", false},
+		{"172.16.0.1", false},
+		{"192.168.0.1", false},
+		{"100.64.0.1", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"fd00::1", false},
+		{"0.0.0.0", false},
+		{"224.0.0.1", false},
+	}
+	for _, tt := range tests {
+		if got := isPublicIP(net.ParseIP(tt.ip)); got != tt.want {
+			t.Errorf("isPublicIP(%s) = %v, want %v", tt.ip, got, tt.want)
+		}
+	}
+}
+
+func TestConstraintTemplateClientRefusesPrivateAddress(t *testing.T) {
+	requested := false
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+	}))
+	defer server.Close()
+
+	// 호스트 검사를 통과하더라도 loopback 주소로는 연결하지 않아야 한다.
+	u, _ := url.Parse(server.URL)
+	client := newConstraintTemplateClient([]string{u.Hostname()})
+
+	_, err := client.Get(server.URL)
+	if err == nil || !strings.Contains(err.Error(), "is not allowed") {
+		t.Errorf("Get() error = %v, want address not allowed", err)
+	}
+	if requested {
+		t.Errorf("request must not reach the server")
+	}
+}
+
+func TestConstraintTemplateClientCheckRedirect(t *testing.T) {
+	client := newConstraintTemplateClient([]string{"github.com", "raw.githubusercontent.com"})
+
+	newRequest := func(rawUrl string) *http.Request {
+		req, err := http.NewRequest(http.MethodGet, rawUrl, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return req
+	}
+	via := []*http.Request{newRequest("https://github.com/o/r/blob/master/template.yaml")}
+
+	tests := []struct {
+		name    string
+		url     string
+		via     []*http.Request
+		wantErr bool
+	}{
+		{"allowed host", "https://raw.githubusercontent.com/o/r/master/template.yaml", via, false},
+		{"not allowed host", "https://169.254.169.254/latest/meta-data", via, true},
+		{"downgrade to http", "http://raw.githubusercontent.com/o/r/master/template.yaml", via, true},
+		{"too many redirects", "https://raw.githubusercontent.com/o/r/master/template.yaml", []*http.Request{via[0], via[0], via[0], via[0], via[0]}, true},
+	}
+	for _, tt := range tests {
+		if err := client.CheckRedirect(newRequest(tt.url), tt.via); (err != nil) != tt.wantErr {
+			t.Errorf("%s: CheckRedirect() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
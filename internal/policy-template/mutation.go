@@ -0,0 +1,399 @@
+package policytemplate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/kubernetes"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	MutationPolicyIDLabel = TksLabelPrefix + "mutation-policy-id"
+
+	MutatorKindAssign         = "Assign"
+	MutatorKindAssignMetadata = "AssignMetadata"
+
+	containersLocation = "spec.containers[name:*]"
+)
+
+var AssignGVR = schema.GroupVersionResource{
+	Group: "mutations.gatekeeper.sh", Version: "v1",
+	Resource: "assign",
+}
+
+var AssignMetadataGVR = schema.GroupVersionResource{
+	Group: "mutations.gatekeeper.sh", Version: "v1",
+	Resource: "assignmetadata",
+}
+
+// MutationTemplate 은 뮤테이션 정책 유형별 설명과 파라미터 스키마이다.
+type MutationTemplate struct {
+	Kind             domain.MutationPolicyKind
+	Name             string
+	Description      string
+	MutatorKinds     []string
+	ParametersSchema []*domain.ParameterDef
+}
+
+var MutationTemplates = []MutationTemplate{
+	{
+		Kind:         domain.MutationPolicyKindDefaultLabels,
+		Name:         "기본 Label 추가",
+		Description:  "리소스에 지정한 label 이 없으면 기본값으로 추가한다.",
+		MutatorKinds: []string{MutatorKindAssignMetadata},
+		ParametersSchema: []*domain.ParameterDef{
+			{Key: "labels", Type: "object[]", IsArray: true, Children: []*domain.ParameterDef{
+				{Key: "key", Type: "string", Children: []*domain.ParameterDef{}},
+				{Key: "value", Type: "string", Children: []*domain.ParameterDef{}},
+			}},
+		},
+	},
+	{
+		Kind:         domain.MutationPolicyKindImagePullPolicy,
+		Name:         "imagePullPolicy 지정",
+		Description:  "Pod 의 모든 컨테이너의 imagePullPolicy 를 지정한 값으로 변경한다.",
+		MutatorKinds: []string{MutatorKindAssign},
+		ParametersSchema: []*domain.ParameterDef{
+			{Key: "imagePullPolicy", Type: "string", Children: []*domain.ParameterDef{}},
+		},
+	},
+	{
+		Kind:         domain.MutationPolicyKindResourceDefaults,
+		Name:         "리소스 기본값",
+		Description:  "Pod 컨테이너에 리소스 requests/limits 가 지정되지 않은 경우 기본값을 설정한다.",
+		MutatorKinds: []string{MutatorKindAssign},
+		ParametersSchema: []*domain.ParameterDef{
+			{Key: "cpuRequest", Type: "string", Children: []*domain.ParameterDef{}},
+			{Key: "memoryRequest", Type: "string", Children: []*domain.ParameterDef{}},
+			{Key: "cpuLimit", Type: "string", Children: []*domain.ParameterDef{}},
+			{Key: "memoryLimit", Type: "string", Children: []*domain.ParameterDef{}},
+		},
+	},
+	{
+		Kind:         domain.MutationPolicyKindSecurityContext,
+		Name:         "securityContext 기본값",
+		Description:  "Pod 컨테이너의 securityContext 항목이 지정되지 않은 경우 기본값을 설정한다.",
+		MutatorKinds: []string{MutatorKindAssign},
+		ParametersSchema: []*domain.ParameterDef{
+			{Key: "runAsNonRoot", Type: "boolean", Children: []*domain.ParameterDef{}},
+			{Key: "allowPrivilegeEscalation", Type: "boolean", Children: []*domain.ParameterDef{}},
+			{Key: "readOnlyRootFilesystem", Type: "boolean", Children: []*domain.ParameterDef{}},
+		},
+	},
+}
+
+type defaultLabelsParameters struct {
+	Labels []struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	} `json:"labels"`
+}
+
+type imagePullPolicyParameters struct {
+	ImagePullPolicy string `json:"imagePullPolicy"`
+}
+
+type resourceDefaultsParameters struct {
+	CpuRequest    string `json:"cpuRequest,omitempty"`
+	MemoryRequest string `json:"memoryRequest,omitempty"`
+	CpuLimit      string `json:"cpuLimit,omitempty"`
+	MemoryLimit   string `json:"memoryLimit,omitempty"`
+}
+
+type securityContextParameters struct {
+	RunAsNonRoot             *bool `json:"runAsNonRoot,omitempty"`
+	AllowPrivilegeEscalation *bool `json:"allowPrivilegeEscalation,omitempty"`
+	ReadOnlyRootFilesystem   *bool `json:"readOnlyRootFilesystem,omitempty"`
+}
+
+// Mutator 는 Gatekeeper Assign 또는 AssignMetadata 리소스이다.
+type Mutator struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec MutatorSpec `json:"spec"`
+}
+
+type MutatorSpec struct {
+	ApplyTo    []ApplyTo         `json:"applyTo,omitempty"`
+	Match      *domain.Match     `json:"match,omitempty"`
+	Location   string            `json:"location"`
+	Parameters MutatorParameters `json:"parameters"`
+}
+
+type ApplyTo struct {
+	Groups   []string `json:"groups"`
+	Kinds    []string `json:"kinds"`
+	Versions []string `json:"versions"`
+}
+
+type MutatorParameters struct {
+	PathTests []PathTest  `json:"pathTests,omitempty"`
+	Assign    AssignField `json:"assign"`
+}
+
+type PathTest struct {
+	SubPath   string `json:"subPath"`
+	Condition string `json:"condition"`
+}
+
+type AssignField struct {
+	Value interface{} `json:"value"`
+}
+
+func GetMutationTemplate(kind domain.MutationPolicyKind) (*MutationTemplate, bool) {
+	for i := range MutationTemplates {
+		if MutationTemplates[i].Kind == kind {
+			return &MutationTemplates[i], true
+		}
+	}
+	return nil, false
+}
+
+func (m *Mutator) GVR() schema.GroupVersionResource {
+	if m.Kind == MutatorKindAssignMetadata {
+		return AssignMetadataGVR
+	}
+	return AssignGVR
+}
+
+func (m *Mutator) ToUnstructured() (*unstructured.Unstructured, error) {
+	jsonBytes, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := map[string]interface{}{}
+	if err := json.Unmarshal(jsonBytes, &obj); err != nil {
+		return nil, err
+	}
+
+	return &unstructured.Unstructured{Object: obj}, nil
+}
+
+// MutationPolicyToMutators 는 뮤테이션 정책의 유형과 파라미터로부터 Gatekeeper 뮤테이션 리소스를 생성한다.
+// 파라미터가 유형에 맞지 않으면 에러를 반환하므로 파라미터 검증에도 사용한다.
+func MutationPolicyToMutators(policy *model.MutationPolicy) ([]Mutator, error) {
+	builder := mutatorBuilder{policy: policy}
+
+	switch policy.Kind {
+	case domain.MutationPolicyKindDefaultLabels:
+		var params defaultLabelsParameters
+		if err := json.Unmarshal([]byte(policy.Parameters), &params); err != nil {
+			return nil, err
+		}
+		if len(params.Labels) == 0 {
+			return nil, fmt.Errorf("labels is empty")
+		}
+		for i, label := range params.Labels {
+			if label.Key == "" {
+				return nil, fmt.Errorf("labels[%d].key is empty", i)
+			}
+			// location 경로에 그대로 들어가므로 쿠버네티스 label key 형식만 허용한다.
+			if errs := validation.IsQualifiedName(label.Key); len(errs) > 0 {
+				return nil, fmt.Errorf("labels[%d].key '%s' is invalid: %s", i, label.Key, strings.Join(errs, ", "))
+			}
+			builder.assignMetadata(fmt.Sprintf("label-%d", i), fmt.Sprintf("metadata.labels.\"%s\"", label.Key), label.Value)
+		}
+
+	case domain.MutationPolicyKindImagePullPolicy:
+		var params imagePullPolicyParameters
+		if err := json.Unmarshal([]byte(policy.Parameters), &params); err != nil {
+			return nil, err
+		}
+		switch params.ImagePullPolicy {
+		case "Always", "IfNotPresent", "Never":
+		default:
+			return nil, fmt.Errorf("invalid imagePullPolicy '%s'", params.ImagePullPolicy)
+		}
+		builder.assignPod("image-pull-policy", containersLocation+".imagePullPolicy", params.ImagePullPolicy, false)
+
+	case domain.MutationPolicyKindResourceDefaults:
+		var params resourceDefaultsParameters
+		if err := json.Unmarshal([]byte(policy.Parameters), &params); err != nil {
+			return nil, err
+		}
+		quantities := []struct {
+			name     string
+			location string
+			value    string
+		}{
+			{"cpu-request", "resources.requests.cpu", params.CpuRequest},
+			{"memory-request", "resources.requests.memory", params.MemoryRequest},
+			{"cpu-limit", "resources.limits.cpu", params.CpuLimit},
+			{"memory-limit", "resources.limits.memory", params.MemoryLimit},
+		}
+		for _, quantity := range quantities {
+			if quantity.value == "" {
+				continue
+			}
+			if _, err := resource.ParseQuantity(quantity.value); err != nil {
+				return nil, fmt.Errorf("invalid quantity '%s': %w", quantity.value, err)
+			}
+			builder.assignPod(quantity.name, containersLocation+"."+quantity.location, quantity.value, true)
+		}
+
+	case domain.MutationPolicyKindSecurityContext:
+		var params securityContextParameters
+		if err := json.Unmarshal([]byte(policy.Parameters), &params); err != nil {
+			return nil, err
+		}
+		fields := []struct {
+			name     string
+			location string
+			value    *bool
+		}{
+			{"run-as-non-root", "securityContext.runAsNonRoot", params.RunAsNonRoot},
+			{"allow-privilege-escalation", "securityContext.allowPrivilegeEscalation", params.AllowPrivilegeEscalation},
+			{"read-only-root-filesystem", "securityContext.readOnlyRootFilesystem", params.ReadOnlyRootFilesystem},
+		}
+		for _, field := range fields {
+			if field.value == nil {
+				continue
+			}
+			builder.assignPod(field.name, containersLocation+"."+field.location, *field.value, true)
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported mutation policy kind '%s'", policy.Kind)
+	}
+
+	if len(builder.mutators) == 0 {
+		return nil, fmt.Errorf("no mutation specified in parameters")
+	}
+
+	return builder.mutators, nil
+}
+
+type mutatorBuilder struct {
+	policy   *model.MutationPolicy
+	mutators []Mutator
+}
+
+func (b *mutatorBuilder) objectMeta(suffix string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name: strings.ToLower(b.policy.PolicyResourceName + "-" + suffix),
+		Labels: map[string]string{
+			PartOfKey:             PartOfVal,
+			MutationPolicyIDLabel: b.policy.ID.String(),
+		},
+	}
+}
+
+func (b *mutatorBuilder) assignMetadata(suffix string, location string, value interface{}) {
+	b.mutators = append(b.mutators, Mutator{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "mutations.gatekeeper.sh/v1", Kind: MutatorKindAssignMetadata},
+		ObjectMeta: b.objectMeta(suffix),
+		Spec: MutatorSpec{
+			Match:      b.policy.Match,
+			Location:   location,
+			Parameters: MutatorParameters{Assign: AssignField{Value: value}},
+		},
+	})
+}
+
+// assignPod 는 Pod 필드를 변경하는 Assign 리소스를 추가한다. onlyIfMissing 이 true 이면 값이 없는 경우에만 설정한다.
+func (b *mutatorBuilder) assignPod(suffix string, location string, value interface{}, onlyIfMissing bool) {
+	parameters := MutatorParameters{Assign: AssignField{Value: value}}
+	if onlyIfMissing {
+		parameters.PathTests = []PathTest{{SubPath: location, Condition: "MustNotExist"}}
+	}
+
+	b.mutators = append(b.mutators, Mutator{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "mutations.gatekeeper.sh/v1", Kind: MutatorKindAssign},
+		ObjectMeta: b.objectMeta(suffix),
+		Spec: MutatorSpec{
+			ApplyTo:    []ApplyTo{{Groups: []string{""}, Kinds: []string{"Pod"}, Versions: []string{"v1"}}},
+			Match:      b.policy.Match,
+			Location:   location,
+			Parameters: parameters,
+		},
+	})
+}
+
+// ApplyMutators 는 클러스터에 뮤테이션 리소스를 생성 또는 갱신하고, 정책에 더 이상 포함되지 않는 리소스는 삭제한다.
+func ApplyMutators(ctx context.Context, clusterId string, mutationPolicyId string, mutators []Mutator) error {
+	if !syncToKubernetes() {
+		return nil
+	}
+
+	dynamicClient, err := kubernetes.GetDynamicClientFromClusterId(ctx, clusterId)
+	if err != nil {
+		return err
+	}
+
+	desired := map[string]bool{}
+	for _, mutator := range mutators {
+		desired[mutator.Kind+"/"+mutator.Name] = true
+
+		obj, err := mutator.ToUnstructured()
+		if err != nil {
+			return err
+		}
+
+		existing, err := dynamicClient.Resource(mutator.GVR()).Get(ctx, mutator.Name, metav1.GetOptions{})
+		if err != nil {
+			if !errors.IsNotFound(err) {
+				return err
+			}
+			if _, err := dynamicClient.Resource(mutator.GVR()).Create(ctx, obj, metav1.CreateOptions{}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		obj.SetResourceVersion(existing.GetResourceVersion())
+		if _, err := dynamicClient.Resource(mutator.GVR()).Update(ctx, obj, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+
+	selector := metav1.ListOptions{LabelSelector: MutationPolicyIDLabel + "=" + mutationPolicyId}
+	for kind, gvr := range map[string]schema.GroupVersionResource{MutatorKindAssign: AssignGVR, MutatorKindAssignMetadata: AssignMetadataGVR} {
+		list, err := dynamicClient.Resource(gvr).List(ctx, selector)
+		if err != nil {
+			return err
+		}
+		for _, item := range list.Items {
+			if desired[kind+"/"+item.GetName()] {
+				continue
+			}
+			if err := dynamicClient.Resource(gvr).Delete(ctx, item.GetName(), metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// DeleteMutators 는 클러스터에서 뮤테이션 정책의 리소스를 모두 삭제한다.
+func DeleteMutators(ctx context.Context, clusterId string, mutationPolicyId string) error {
+	if !syncToKubernetes() {
+		return nil
+	}
+
+	dynamicClient, err := kubernetes.GetDynamicClientFromClusterId(ctx, clusterId)
+	if err != nil {
+		return err
+	}
+
+	selector := metav1.ListOptions{LabelSelector: MutationPolicyIDLabel + "=" + mutationPolicyId}
+	for _, gvr := range []schema.GroupVersionResource{AssignGVR, AssignMetadataGVR} {
+		if err := dynamicClient.Resource(gvr).DeleteCollection(ctx, metav1.DeleteOptions{}, selector); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
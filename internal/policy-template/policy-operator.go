@@ -2,7 +2,10 @@ package policytemplate
 
 import (
 	"encoding/json"
+	"slices"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/openinfradev/tks-api/internal/model"
 	"github.com/openinfradev/tks-api/pkg/domain"
@@ -17,6 +20,7 @@ const (
 	TksLabelPrefix            = "tks/"
 	PolicyIDLabel             = TksLabelPrefix + "policy-id"
 	TemplateIDLabel           = TksLabelPrefix + "policy-template-id"
+	StackClusterIDLabel       = TksLabelPrefix + "stack-cluster-id"
 	RequireSyncDataAnnotation = "metadata.gatekeeper.sh/requires-sync-data"
 )
 
@@ -78,6 +82,107 @@ func PolicyToTksPolicyCR(policy *model.Policy) *TKSPolicy {
 	}
 }
 
+// StackTksPolicyName 은 정책을 특정 스택에만 다르게 적용하기 위한 스택 전용 TKSPolicy CR 의 이름이다.
+func StackTksPolicyName(resourceName string, clusterId string) string {
+	return resourceName + "-" + strings.ToLower(clusterId)
+}
+
+// PolicyToTksPolicyCRs 는 정책을 기본 TKSPolicy CR 과 스택 전용 TKSPolicy CR 목록으로 변환한다.
+// operator 는 스택마다 다른 설정을 지원하지 않으므로 번들 프리셋, 적용 액션이나 예외가 다른 스택은 해당 스택만 대상으로 하는 CR 로 분리하고
+// 기본 CR 의 대상에서 제외한다. operator 는 TKSPolicy 마다 constraint 를 만들기 때문에 같은 템플릿의 CR 이 여러 개여도 된다.
+func PolicyToTksPolicyCRs(policy *model.Policy) []*TKSPolicy {
+	tksPolicy := PolicyToTksPolicyCR(policy)
+	if tksPolicy == nil {
+		return nil
+	}
+
+	now := time.Now()
+	result := []*TKSPolicy{tksPolicy}
+	clusters := make([]string, 0)
+
+	for _, clusterId := range tksPolicy.Spec.Clusters {
+		spec, ok := stackTksPolicySpec(policy, tksPolicy.Spec, clusterId, now)
+		if !ok {
+			clusters = append(clusters, clusterId)
+			continue
+		}
+
+		labels := map[string]string{}
+		for key, value := range tksPolicy.Labels {
+			labels[key] = value
+		}
+		labels[StackClusterIDLabel] = clusterId
+
+		result = append(result, &TKSPolicy{
+			TypeMeta: tksPolicy.TypeMeta,
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   StackTksPolicyName(tksPolicy.Name, clusterId),
+				Labels: labels,
+			},
+			Spec: spec,
+		})
+	}
+
+	tksPolicy.Spec.Clusters = clusters
+	return result
+}
+
+// stackTksPolicySpec 은 스택에 기본 CR 과 다른 설정이 필요한 경우 해당 스택 전용 CR 의 spec 을 반환한다.
+// 스택에 할당된 번들의 프리셋을 먼저 반영하고, 단계적 적용 중인 스택은 단계적 적용의 적용 액션을 우선한다.
+// 만료되지 않은 예외의 네임스페이스는 match 의 excludedNamespaces 에 추가한다.
+func stackTksPolicySpec(policy *model.Policy, spec TKSPolicySpec, clusterId string, now time.Time) (TKSPolicySpec, bool) {
+	changed := false
+	if preset, ok := policy.BundlePreset(clusterId); ok {
+		if preset.EnforcementAction != "" && preset.EnforcementAction != spec.EnforcementAction {
+			spec.EnforcementAction = preset.EnforcementAction
+			changed = true
+		}
+		if preset.Parameters != "" && (spec.Parameters == nil || string(spec.Parameters.Raw) != preset.Parameters) {
+			spec.Parameters = &apiextensionsv1.JSON{Raw: []byte(preset.Parameters)}
+			changed = true
+		}
+	}
+
+	if action, ok := policy.ClusterEnforcementActionMap[clusterId]; ok && action != spec.EnforcementAction {
+		spec.EnforcementAction = action
+		changed = true
+	}
+
+	excludedNamespaces := []string{}
+	for _, exception := range policy.Exceptions {
+		if exception.ClusterId.String() != clusterId || !exception.IsActive(now) {
+			continue
+		}
+		if !slices.Contains(excludedNamespaces, exception.Namespace) {
+			excludedNamespaces = append(excludedNamespaces, exception.Namespace)
+		}
+	}
+
+	if len(excludedNamespaces) > 0 {
+		spec.Match = excludeNamespaces(spec.Match, excludedNamespaces)
+		changed = true
+	}
+
+	spec.Clusters = []string{clusterId}
+	return spec, changed
+}
+
+// excludeNamespaces 는 match 를 복사해 excludedNamespaces 에 namespaces 를 추가한다.
+func excludeNamespaces(base *domain.Match, namespaces []string) *domain.Match {
+	match := domain.Match{}
+	if base != nil {
+		match = *base
+	}
+	match.ExcludedNamespaces = slices.Clone(match.ExcludedNamespaces)
+	for _, namespace := range namespaces {
+		if !slices.Contains(match.ExcludedNamespaces, namespace) {
+			match.ExcludedNamespaces = append(match.ExcludedNamespaces, namespace)
+		}
+	}
+	sort.Strings(match.ExcludedNamespaces)
+	return &match
+}
+
 func PolicyTemplateToTksPolicyTemplateCR(policyTemplate *model.PolicyTemplate) *TKSPolicyTemplate {
 	if policyTemplate == nil {
 		return nil
@@ -0,0 +1,121 @@
+package policytemplate
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+func TestPolicyToTksPolicyCRsWithExceptions(t *testing.T) {
+	now := time.Now()
+	policy := &model.Policy{
+		ID:                 uuid.New(),
+		PolicyResourceName: "require-labels",
+		EnforcementAction:  "deny",
+		TargetClusterIds:   []string{"cluster1", "cluster2", "cluster3"},
+		Match:              &domain.Match{ExcludedNamespaces: []string{"kube-system"}},
+		PolicyTemplate:     model.PolicyTemplate{Kind: "K8sRequiredLabels"},
+		Exceptions: []model.PolicyException{
+			{ClusterId: "cluster2", Namespace: "legacy-app", ExpiredAt: now.Add(time.Hour)},
+			{ClusterId: "cluster2", Namespace: "batch", ExpiredAt: now.Add(time.Hour)},
+			// 만료된 예외는 반영하지 않음
+			{ClusterId: "cluster3", Namespace: "legacy-app", ExpiredAt: now.Add(-time.Hour)},
+			{ClusterId: "cluster3", Namespace: "batch", ExpiredAt: now.Add(time.Hour), Expired: true},
+		},
+	}
+
+	crs := PolicyToTksPolicyCRs(policy)
+	if len(crs) != 2 {
+		t.Fatalf("expected base and one stack CR, got %d", len(crs))
+	}
+
+	base, stack := crs[0], crs[1]
+	if base.Name != "require-labels" || !slices.Equal(base.Spec.Clusters, []string{"cluster1", "cluster3"}) {
+		t.Errorf("unexpected base CR: name=%s clusters=%v", base.Name, base.Spec.Clusters)
+	}
+	if base.IsStackPolicy() || !slices.Equal(base.Spec.Match.ExcludedNamespaces, []string{"kube-system"}) {
+		t.Errorf("base CR must not be changed by exceptions: %+v", base.Spec.Match)
+	}
+
+	if stack.Name != "require-labels-cluster2" || !slices.Equal(stack.Spec.Clusters, []string{"cluster2"}) {
+		t.Errorf("unexpected stack CR: name=%s clusters=%v", stack.Name, stack.Spec.Clusters)
+	}
+	if !stack.IsStackPolicy() || stack.GetPolicyID() != policy.ID.String() || stack.GetPolicyResourceName() != "require-labels" {
+		t.Errorf("unexpected stack CR labels: %v", stack.Labels)
+	}
+	if want := []string{"batch", "kube-system", "legacy-app"}; !slices.Equal(stack.Spec.Match.ExcludedNamespaces, want) {
+		t.Errorf("ExcludedNamespaces = %v, want %v", stack.Spec.Match.ExcludedNamespaces, want)
+	}
+	if stack.Spec.EnforcementAction != "deny" || stack.Spec.Template != "K8sRequiredLabels" {
+		t.Errorf("stack CR must keep the policy settings: %+v", stack.Spec)
+	}
+}
+
+func TestPolicyToTksPolicyCRsWithClusterEnforcementActions(t *testing.T) {
+	policy := &model.Policy{
+		ID:                          uuid.New(),
+		PolicyResourceName:          "require-labels",
+		EnforcementAction:           "dryrun",
+		ClusterEnforcementActionMap: map[string]string{"cluster1": "deny", "cluster2": "dryrun"},
+		TargetClusterIds:            []string{"cluster1", "cluster2"},
+		PolicyTemplate:              model.PolicyTemplate{Kind: "K8sRequiredLabels"},
+	}
+
+	crs := PolicyToTksPolicyCRs(policy)
+	if len(crs) != 2 {
+		t.Fatalf("expected base and one stack CR, got %d", len(crs))
+	}
+
+	// 기본 CR 과 같은 적용 액션인 스택은 기본 CR 에 남는다
+	if !slices.Equal(crs[0].Spec.Clusters, []string{"cluster2"}) || crs[0].Spec.EnforcementAction != "dryrun" {
+		t.Errorf("unexpected base CR: %+v", crs[0].Spec)
+	}
+	if !slices.Equal(crs[1].Spec.Clusters, []string{"cluster1"}) || crs[1].Spec.EnforcementAction != "deny" {
+		t.Errorf("unexpected stack CR: %+v", crs[1].Spec)
+	}
+	if crs[1].Spec.Match != nil {
+		t.Errorf("stack CR without exceptions must keep the policy match: %+v", crs[1].Spec.Match)
+	}
+}
+
+func TestPolicyToTksPolicyCRsWithBundlePresets(t *testing.T) {
+	bundle := &model.PolicyBundle{Clusters: []model.Cluster{{ID: "cluster1"}}}
+	policy := &model.Policy{
+		ID:                          uuid.New(),
+		PolicyResourceName:          "require-labels",
+		EnforcementAction:           "dryrun",
+		Parameters:                  `{"labels":["app"]}`,
+		ClusterEnforcementActionMap: map[string]string{"cluster1": "warn"},
+		TargetClusterIds:            []string{"cluster1", "cluster2"},
+		PolicyTemplate:              model.PolicyTemplate{Kind: "K8sRequiredLabels"},
+		BundleItems: []model.PolicyBundleItem{
+			{PolicyBundle: bundle, EnforcementAction: "deny", Parameters: `{"labels":["owner"]}`},
+			// 프리셋이 없는 번들 항목은 반영하지 않음
+			{PolicyBundle: &model.PolicyBundle{Clusters: []model.Cluster{{ID: "cluster2"}}}},
+		},
+	}
+
+	crs := PolicyToTksPolicyCRs(policy)
+	if len(crs) != 2 {
+		t.Fatalf("expected base and one stack CR, got %d", len(crs))
+	}
+
+	// 번들이 할당되지 않은 스택은 정책의 설정을 그대로 사용한다
+	base, stack := crs[0], crs[1]
+	if !slices.Equal(base.Spec.Clusters, []string{"cluster2"}) || base.Spec.EnforcementAction != "dryrun" ||
+		string(base.Spec.Parameters.Raw) != `{"labels":["app"]}` {
+		t.Errorf("unexpected base CR: %+v", base.Spec)
+	}
+
+	// 단계적 적용의 적용 액션이 번들의 프리셋보다 우선한다
+	if !slices.Equal(stack.Spec.Clusters, []string{"cluster1"}) || stack.Spec.EnforcementAction != "warn" {
+		t.Errorf("unexpected stack CR: %+v", stack.Spec)
+	}
+	if string(stack.Spec.Parameters.Raw) != `{"labels":["owner"]}` {
+		t.Errorf("stack CR must use the bundle preset parameters: %s", stack.Spec.Parameters.Raw)
+	}
+}
@@ -3,6 +3,8 @@ package policytemplate
 import (
 	"context"
 	"encoding/json"
+	"slices"
+	"strings"
 
 	"github.com/openinfradev/tks-api/pkg/domain"
 	"github.com/openinfradev/tks-api/pkg/kubernetes"
@@ -39,6 +41,18 @@ type PolicyStatus struct {
 	Reason           string `json:"reason,omitempty"`
 	LastUpdate       string `json:"lastUpdate"`
 	TemplateVersion  string `json:"templateVersion"`
+	// Gatekeeper constraint 의 audit 결과. Violations 는 audit 설정의 최대 개수까지만 포함된다.
+	TotalViolations int                    `json:"totalViolations,omitempty"`
+	Violations      []PolicyAuditViolation `json:"violations,omitempty"`
+}
+
+// PolicyAuditViolation defines a resource violating the constraint found by audit
+type PolicyAuditViolation struct {
+	EnforcementAction string `json:"enforcementAction"`
+	Kind              string `json:"kind"`
+	Name              string `json:"name"`
+	Namespace         string `json:"namespace,omitempty"`
+	Message           string `json:"message"`
 }
 
 // TKSPolicyStatus defines the observed state of TKSPolicy
@@ -75,6 +89,21 @@ func (tksPolicy *TKSPolicy) GetTemplateID() string {
 	return tksPolicy.ObjectMeta.Labels[TemplateIDLabel]
 }
 
+// IsStackPolicy 는 특정 스택에만 적용하기 위해 기본 CR 과 별도로 만든 스택 전용 CR 인지 여부를 반환한다.
+func (tksPolicy *TKSPolicy) IsStackPolicy() bool {
+	_, ok := tksPolicy.ObjectMeta.Labels[StackClusterIDLabel]
+	return ok
+}
+
+// GetPolicyResourceName 은 CR 이 속한 정책의 자원 이름을 반환한다. 스택 전용 CR 도 기본 CR 의 이름을 반환한다.
+func (tksPolicy *TKSPolicy) GetPolicyResourceName() string {
+	clusterId, ok := tksPolicy.ObjectMeta.Labels[StackClusterIDLabel]
+	if !ok {
+		return tksPolicy.Name
+	}
+	return strings.TrimSuffix(tksPolicy.Name, "-"+strings.ToLower(clusterId))
+}
+
 func (tksPolicy *TKSPolicy) JSON() (string, error) {
 	result, err := json.MarshalIndent(tksPolicy, "", "  ")
 
@@ -180,6 +209,63 @@ func DeleteTksPolicyCR(ctx context.Context, primaryClusterId string, name string
 	return nil
 }
 
+// ApplyTksPolicyCRs 는 PolicyToTksPolicyCRs 로 변환한 정책의 CR 목록을 적용하고,
+// 목록에 없는 정책의 스택 전용 CR 은 더 이상 필요하지 않으므로 삭제한다.
+func ApplyTksPolicyCRs(ctx context.Context, primaryClusterId string, tksPolicies []*TKSPolicy) error {
+	if len(tksPolicies) == 0 {
+		return nil
+	}
+
+	names := make([]string, len(tksPolicies))
+	for i, tksPolicy := range tksPolicies {
+		if err := ApplyTksPolicyCR(ctx, primaryClusterId, tksPolicy); err != nil {
+			return err
+		}
+		names[i] = tksPolicy.Name
+	}
+
+	return deleteStackTksPolicyCRs(ctx, primaryClusterId, tksPolicies[0].GetPolicyID(), names)
+}
+
+// DeleteTksPolicyCRs 는 정책의 기본 CR 과 스택 전용 CR 을 모두 삭제한다.
+func DeleteTksPolicyCRs(ctx context.Context, primaryClusterId string, name string, policyId string) error {
+	if err := DeleteTksPolicyCR(ctx, primaryClusterId, name); err != nil {
+		return err
+	}
+	return deleteStackTksPolicyCRs(ctx, primaryClusterId, policyId, nil)
+}
+
+// deleteStackTksPolicyCRs 는 정책의 스택 전용 CR 중 keep 에 포함되지 않은 CR 을 삭제한다.
+func deleteStackTksPolicyCRs(ctx context.Context, primaryClusterId string, policyId string, keep []string) error {
+	if !syncToKubernetes() {
+		return nil
+	}
+
+	dynamicClient, err := kubernetes.GetDynamicClientAdminCluster(ctx)
+	if err != nil {
+		return err
+	}
+
+	resources, err := dynamicClient.Resource(TKSPolicyGVR).Namespace(primaryClusterId).
+		List(ctx, metav1.ListOptions{LabelSelector: PolicyIDLabel + "=" + policyId + "," + StackClusterIDLabel})
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Items {
+		if slices.Contains(keep, resource.GetName()) {
+			continue
+		}
+
+		err = dynamicClient.Resource(TKSPolicyGVR).Namespace(primaryClusterId).
+			Delete(ctx, resource.GetName(), metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
 func GetTksPolicyCR(ctx context.Context, primaryClusterId string, name string) (*TKSPolicy, error) {
 	dynamicClient, err := kubernetes.GetDynamicClientAdminCluster(ctx)
 
@@ -288,8 +374,8 @@ func GetTksPolicyCRs(ctx context.Context, primaryClusterId string) (tksPolicies
 		return nil, err
 	}
 
-	var tksPolicy TKSPolicy
 	for _, c := range resources.Items {
+		var tksPolicy TKSPolicy
 		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(c.UnstructuredContent(), &tksPolicy); err != nil {
 			return nil, err
 		}
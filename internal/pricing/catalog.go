@@ -0,0 +1,126 @@
+package pricing
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strings"
+)
+
+// HoursPerMonth 는 월 비용 산정에 사용하는 한 달의 평균 시간이다.
+const HoursPerMonth = 730
+
+var (
+	//go:embed catalogs/*.json
+	defaultCatalogFS embed.FS
+)
+
+// PriceFile 은 클라우드 서비스의 한 리전에 대한 인스턴스 타입별 가격표이다.
+type PriceFile struct {
+	CloudService  string                       `json:"cloudService"`
+	Region        string                       `json:"region"`
+	Currency      string                       `json:"currency"`
+	InstanceTypes map[string]InstanceTypePrice `json:"instanceTypes"`
+}
+
+type InstanceTypePrice struct {
+	VCPU      int     `json:"vcpu"`
+	MemoryGiB float64 `json:"memoryGiB"`
+	Hourly    float64 `json:"hourly"`
+}
+
+// Catalog 는 클라우드 서비스와 리전별 가격표의 모음이다.
+type Catalog struct {
+	files map[string]PriceFile
+}
+
+// Default 는 바이너리에 포함된 기본 가격표만으로 구성한 카탈로그를 반환한다.
+func Default() *Catalog {
+	c := &Catalog{files: map[string]PriceFile{}}
+	if err := c.loadFS(defaultCatalogFS, "catalogs"); err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Load 는 기본 가격표에 dir 의 *.json 가격표를 덮어써서 카탈로그를 만든다.
+// dir 이 비어 있으면 기본 가격표만 사용한다.
+func Load(dir string) (*Catalog, error) {
+	c := Default()
+	if dir == "" {
+		return c, nil
+	}
+	if err := c.loadFS(os.DirFS(dir), "."); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Catalog) loadFS(fsys fs.FS, dir string) error {
+	paths, err := fs.Glob(fsys, path.Join(dir, "*.json"))
+	if err != nil {
+		return err
+	}
+	for _, p := range paths {
+		data, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			return err
+		}
+		var file PriceFile
+		if err := json.Unmarshal(data, &file); err != nil {
+			return fmt.Errorf("invalid price file %s: %w", p, err)
+		}
+		if file.CloudService == "" || file.Region == "" {
+			return fmt.Errorf("invalid price file %s: cloudService and region are required", p)
+		}
+		if file.Currency == "" {
+			file.Currency = "USD"
+		}
+		c.files[key(file.CloudService, file.Region)] = file
+	}
+	return nil
+}
+
+// Get 은 클라우드 서비스와 리전의 가격표를 반환한다.
+func (c *Catalog) Get(cloudService string, region string) (PriceFile, bool) {
+	file, ok := c.files[key(cloudService, region)]
+	return file, ok
+}
+
+// List 는 카탈로그의 모든 가격표를 클라우드 서비스, 리전 순으로 반환한다.
+func (c *Catalog) List() []PriceFile {
+	out := make([]PriceFile, 0, len(c.files))
+	for _, file := range c.files {
+		out = append(out, file)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return key(out[i].CloudService, out[i].Region) < key(out[j].CloudService, out[j].Region)
+	})
+	return out
+}
+
+// Price 는 인스턴스 타입의 시간당 가격과 통화를 반환한다.
+func (c *Catalog) Price(cloudService string, region string, instanceType string) (price InstanceTypePrice, currency string, err error) {
+	file, ok := c.Get(cloudService, region)
+	if !ok {
+		return price, "", fmt.Errorf("no price file for %s %s", cloudService, region)
+	}
+	price, ok = file.InstanceTypes[instanceType]
+	if !ok {
+		return price, file.Currency, fmt.Errorf("no price for instance type %s in %s %s", instanceType, cloudService, region)
+	}
+	return price, file.Currency, nil
+}
+
+// Monthly 는 시간당 가격으로 count 개 노드의 월 비용을 계산한다.
+func Monthly(hourly float64, count int) float64 {
+	return hourly * HoursPerMonth * float64(count)
+}
+
+func key(cloudService string, region string) string {
+	return strings.ToUpper(cloudService) + "/" + region
+}
@@ -0,0 +1,63 @@
+package pricing
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDefaultCatalog(t *testing.T) {
+	c := Default()
+
+	price, currency, err := c.Price("AWS", "ap-northeast-2", "t3.xlarge")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if currency != "USD" || price.Hourly != 0.208 {
+		t.Errorf("unexpected price %v %s", price, currency)
+	}
+	if _, _, err := c.Price("AWS", "ap-northeast-2", "unknown.type"); err == nil {
+		t.Error("expected error for unknown instance type")
+	}
+}
+
+func TestLoadOverridesDefault(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"aws.json":  `{"cloudService": "AWS", "region": "ap-northeast-2", "currency": "KRW", "instanceTypes": {"t3.large": {"hourly": 140}}}`,
+		"byoh.json": `{"cloudService": "BYOH", "region": "default", "instanceTypes": {"t3.large": {"hourly": 0.05}}}`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	c, err := Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if price, currency, err := c.Price("AWS", "ap-northeast-2", "t3.large"); err != nil || currency != "KRW" || price.Hourly != 140 {
+		t.Errorf("expected overridden price, got %v %s %v", price, currency, err)
+	}
+	if _, currency, err := c.Price("BYOH", "default", "t3.large"); err != nil || currency != "USD" {
+		t.Errorf("expected default currency, got %s %v", currency, err)
+	}
+	if len(c.List()) != 2 {
+		t.Errorf("expected 2 price files, got %d", len(c.List()))
+	}
+
+	if Monthly(0.1, 3) != 0.1*HoursPerMonth*3 {
+		t.Error("unexpected monthly cost")
+	}
+}
+
+func TestLoadInvalidFile(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "invalid.json"), []byte(`{"region": "x"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(dir); err == nil {
+		t.Error("expected error for price file without cloudService")
+	}
+}
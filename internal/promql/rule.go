@@ -0,0 +1,130 @@
+package promql
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// 알림 조건에 사용할 수 있는 비교 연산자
+var comparisonOperators = map[string]bool{
+	"==": true, "!=": true, ">": true, "<": true, ">=": true, "<=": true,
+}
+
+var durationRegexp = regexp.MustCompile(`^([0-9]+(ms|s|m|h|d|w|y))+$`)
+var durationPartRegexp = regexp.MustCompile(`([0-9]+)(ms|s|m|h|d|w|y)`)
+
+// duration 단위별 초. ms 는 초 단위 계산에서 무시한다.
+var durationUnitSeconds = map[string]int64{
+	"ms": 0, "s": 1, "m": 60, "h": 3600, "d": 86400, "w": 7 * 86400, "y": 365 * 86400,
+}
+
+// 템플릿 메트릭 파라미터 값. 알림 메시지에서 시계열 라벨을 참조한다. (예: $labels.taco_cluster)
+var labelReferenceRegexp = regexp.MustCompile(`^\$labels\.[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// Condition 은 알림 규칙의 조건 하나이다. 메트릭 쿼리 결과를 Value 와 Operator 로 비교한다.
+type Condition struct {
+	Operator string
+	Value    string
+}
+
+// ValidateCondition 은 조건의 연산자와 비교값을 확인한다.
+func ValidateCondition(condition Condition) error {
+	if !comparisonOperators[condition.Operator] {
+		return fmt.Errorf("invalid operator '%s'", condition.Operator)
+	}
+	if _, err := strconv.ParseFloat(strings.TrimSpace(condition.Value), 64); err != nil {
+		return fmt.Errorf("invalid value '%s': must be a number", condition.Value)
+	}
+	return nil
+}
+
+// ValidateDuration 은 알림 규칙의 for 절에 사용하는 Prometheus duration(예: 3m, 1h30m)을 확인한다.
+func ValidateDuration(duration string) error {
+	if !durationRegexp.MatchString(duration) {
+		return fmt.Errorf("invalid duration '%s'", duration)
+	}
+	return nil
+}
+
+// ParseDuration 은 Prometheus duration 을 초 단위로 변환한다.
+func ParseDuration(duration string) (int64, error) {
+	if err := ValidateDuration(duration); err != nil {
+		return 0, err
+	}
+	var seconds int64
+	for _, m := range durationPartRegexp.FindAllStringSubmatch(duration, -1) {
+		n, err := strconv.ParseInt(m[1], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration '%s'", duration)
+		}
+		seconds += n * durationUnitSeconds[m[2]]
+	}
+	return seconds, nil
+}
+
+// ValidateLabelReference 는 템플릿 메트릭 파라미터의 값이 라벨 참조 형식인지 확인한다.
+func ValidateLabelReference(value string) error {
+	if !labelReferenceRegexp.MatchString(value) {
+		return fmt.Errorf("invalid label reference '%s': must be $labels.<label_name>", value)
+	}
+	return nil
+}
+
+// RenderRule 은 메트릭 쿼리와 조건으로 알림 규칙의 expr 을 만든다.
+// 조건이 여러 개이면 모든 조건을 만족하는 시계열만 남도록 and 로 연결한다.
+func RenderRule(query string, conditions []Condition) (string, error) {
+	if err := Validate(query); err != nil {
+		return "", err
+	}
+	if len(conditions) == 0 {
+		return "", fmt.Errorf("no conditions")
+	}
+
+	query = "(" + strings.TrimSpace(query) + ")"
+	exprs := make([]string, len(conditions))
+	for i, condition := range conditions {
+		if err := ValidateCondition(condition); err != nil {
+			return "", err
+		}
+		exprs[i] = query + " " + condition.Operator + " " + strings.TrimSpace(condition.Value)
+	}
+	return strings.Join(exprs, " and "), nil
+}
+
+// FiringPeriod 는 알림이 발생했을 구간이다. (unix timestamp, 초)
+type FiringPeriod struct {
+	StartsAt int64
+	EndsAt   int64
+}
+
+// FiringPeriods 는 비교식의 range query 결과(조건을 만족한 시점들)로 알림이 발생했을 구간을 계산한다.
+// step 간격으로 연속된 시점들을 하나의 pending 구간으로 보고, 그 구간이 forSeconds 이상 지속되면
+// pending 시작 후 forSeconds 가 지난 시점부터 구간이 끝날 때까지 firing 으로 본다.
+func FiringPeriods(timestamps []int64, step int64, forSeconds int64) []FiringPeriod {
+	if len(timestamps) == 0 || step <= 0 {
+		return nil
+	}
+	sorted := append([]int64{}, timestamps...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	out := []FiringPeriod{}
+	flush := func(start int64, end int64) {
+		if end-start >= forSeconds {
+			out = append(out, FiringPeriod{StartsAt: start + forSeconds, EndsAt: end})
+		}
+	}
+
+	start, prev := sorted[0], sorted[0]
+	for _, ts := range sorted[1:] {
+		if ts-prev > step {
+			flush(start, prev)
+			start = ts
+		}
+		prev = ts
+	}
+	flush(start, prev)
+	return out
+}
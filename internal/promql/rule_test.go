@@ -0,0 +1,88 @@
+package promql
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRenderRule(t *testing.T) {
+	got, err := RenderRule(`avg by (instance) (rate(node_cpu_seconds_total{mode="idle"}[60s]))`, []Condition{
+		{Operator: "<", Value: "0.1"},
+		{Operator: ">=", Value: " 0 "},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `(avg by (instance) (rate(node_cpu_seconds_total{mode="idle"}[60s]))) < 0.1 and ` +
+		`(avg by (instance) (rate(node_cpu_seconds_total{mode="idle"}[60s]))) >= 0`
+	if got != want {
+		t.Errorf("RenderRule()\n got: %s\nwant: %s", got, want)
+	}
+
+	invalid := []struct {
+		query      string
+		conditions []Condition
+	}{
+		{`up{job="node"`, []Condition{{Operator: ">", Value: "1"}}},
+		{`sum(up`, []Condition{{Operator: ">", Value: "1"}}},
+		{`up +`, []Condition{{Operator: ">", Value: "1"}}},
+		{`foo bar`, []Condition{{Operator: ">", Value: "1"}}},
+		{`sum by (x up)`, []Condition{{Operator: ">", Value: "1"}}},
+		{`))((`, []Condition{{Operator: ">", Value: "1"}}},
+		{`up`, nil},
+		{`up`, []Condition{{Operator: "=~", Value: "1"}}},
+		{`up`, []Condition{{Operator: ">", Value: "high"}}},
+	}
+	for _, tt := range invalid {
+		if _, err := RenderRule(tt.query, tt.conditions); err == nil {
+			t.Errorf("RenderRule(%q, %v) expected error", tt.query, tt.conditions)
+		}
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := map[string]int64{"30s": 30, "3m": 180, "1h30m": 5400, "1d": 86400}
+	for duration, want := range tests {
+		got, err := ParseDuration(duration)
+		if err != nil || got != want {
+			t.Errorf("ParseDuration(%q) = %d, %v; want %d", duration, got, err, want)
+		}
+	}
+	for _, duration := range []string{"", "3", "m", "3 m", "-1m", "1.5h"} {
+		if _, err := ParseDuration(duration); err == nil {
+			t.Errorf("ParseDuration(%q) expected error", duration)
+		}
+	}
+}
+
+func TestValidateLabelReference(t *testing.T) {
+	if err := ValidateLabelReference("$labels.taco_cluster"); err != nil {
+		t.Error(err)
+	}
+	for _, value := range []string{"taco_cluster", "$labels.", "$labels.1abc", "{{ $labels.pod }}"} {
+		if err := ValidateLabelReference(value); err == nil {
+			t.Errorf("ValidateLabelReference(%q) expected error", value)
+		}
+	}
+}
+
+func TestFiringPeriods(t *testing.T) {
+	// 60초 간격으로 0~240 연속, 600~660 연속
+	timestamps := []int64{660, 0, 60, 120, 180, 240, 600}
+
+	got := FiringPeriods(timestamps, 60, 180)
+	want := []FiringPeriod{{StartsAt: 180, EndsAt: 240}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FiringPeriods() = %v, want %v", got, want)
+	}
+
+	got = FiringPeriods(timestamps, 60, 0)
+	want = []FiringPeriod{{StartsAt: 0, EndsAt: 240}, {StartsAt: 600, EndsAt: 660}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FiringPeriods() = %v, want %v", got, want)
+	}
+
+	if got := FiringPeriods(nil, 60, 0); got != nil {
+		t.Errorf("FiringPeriods(nil) = %v, want nil", got)
+	}
+}
@@ -0,0 +1,61 @@
+package promql
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+// ClusterLabel 은 Thanos 에서 클러스터를 구분하는 외부 라벨이다.
+const ClusterLabel = "taco_cluster"
+
+// ScopeQuery 는 query 의 모든 시계열 선택자(selector)에 taco_cluster 라벨 매처를 추가하여
+// clusterIds 에 해당하는 클러스터의 시계열만 조회되도록 한다.
+// 같은 선택자의 매처는 모두 AND 조건이므로 사용자가 지정한 taco_cluster 매처는 범위를 좁히기만 한다.
+// 선택자는 PromQL 파서로 찾으므로 min, group, offset 처럼 키워드와 같은 이름의 메트릭도 범위가 제한된다.
+func ScopeQuery(query string, clusterIds []string) (string, error) {
+	if len(clusterIds) == 0 {
+		return "", fmt.Errorf("no clusters to scope query")
+	}
+	if strings.TrimSpace(query) == "" {
+		return "", fmt.Errorf("empty query")
+	}
+
+	expr, err := parser.ParseExpr(query)
+	if err != nil {
+		return "", fmt.Errorf("invalid query: %s", err)
+	}
+
+	quoted := make([]string, len(clusterIds))
+	for i, clusterId := range clusterIds {
+		quoted[i] = regexp.QuoteMeta(clusterId)
+	}
+	matcher, err := labels.NewMatcher(labels.MatchRegexp, ClusterLabel, strings.Join(quoted, "|"))
+	if err != nil {
+		return "", err
+	}
+
+	parser.Inspect(expr, func(node parser.Node, _ []parser.Node) error {
+		if selector, ok := node.(*parser.VectorSelector); ok {
+			selector.LabelMatchers = append([]*labels.Matcher{matcher}, selector.LabelMatchers...)
+		}
+		return nil
+	})
+
+	return expr.String(), nil
+}
+
+// Validate 는 query 가 PromQL 문법에 맞고, ScopeQuery 로 변환한 결과도 문법에 맞는지 확인한다.
+func Validate(query string) error {
+	scoped, err := ScopeQuery(query, []string{ClusterLabel})
+	if err != nil {
+		return err
+	}
+	if _, err := parser.ParseExpr(scoped); err != nil {
+		return fmt.Errorf("failed to scope query: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package promql
+
+import (
+	"testing"
+)
+
+func TestScopeQuery(t *testing.T) {
+	clusterIds := []string{"c1", "c2"}
+	scope := `taco_cluster=~"c1|c2"`
+
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{`up`, `up{` + scope + `}`},
+		{`up{job="node"}`, `up{job="node",` + scope + `}`},
+		{`{__name__=~"node_.+"}`, `{__name__=~"node_.+",` + scope + `}`},
+		{`rate(http_requests_total{code!~"5.."}[5m] offset 1h)`, `rate(http_requests_total{code!~"5..",` + scope + `}[5m] offset 1h)`},
+		{`sum by (taco_cluster, namespace) (rate(container_cpu_usage_seconds_total[1h]))`,
+			`sum by (taco_cluster, namespace) (rate(container_cpu_usage_seconds_total{` + scope + `}[1h]))`},
+		{`sum(a) without(pod) / on(namespace) group_left(node) b > bool 0.5`,
+			`sum without (pod) (a{` + scope + `}) / on (namespace) group_left (node) b{` + scope + `} > bool 0.5`},
+		{`label_replace(up, "dst", "$1", "up", "(.*)")`, `label_replace(up{` + scope + `}, "dst", "$1", "up", "(.*)")`},
+		{`max_over_time(up[1h:5m]) or vector(1e3)`, `max_over_time(up{` + scope + `}[1h:5m]) or vector(1000)`},
+		{`up{taco_cluster="c3"}`, `up{taco_cluster="c3",` + scope + `}`},
+		// 키워드와 이름이 같은 메트릭
+		{`min`, `min{` + scope + `}`},
+		{`group + by`, `group{` + scope + `} + by{` + scope + `}`},
+		{`offset offset 5m`, `offset{` + scope + `} offset 5m`},
+		{`sum(limitk)`, `sum(limitk{` + scope + `})`},
+		{`group by (job) (rate(min[5m]))`, `group by (job) (rate(min{` + scope + `}[5m]))`},
+	}
+	for _, tt := range tests {
+		got, err := ScopeQuery(tt.query, clusterIds)
+		if err != nil {
+			t.Errorf("ScopeQuery(%q) returned error: %v", tt.query, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ScopeQuery(%q)\n got: %s\nwant: %s", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestScopeQueryInvalid(t *testing.T) {
+	for _, query := range []string{``, `up{job="node"`, `up{job="node}`, `rate(up[5m)`} {
+		if _, err := ScopeQuery(query, []string{"c1"}); err == nil {
+			t.Errorf("ScopeQuery(%q) expected error", query)
+		}
+	}
+	if _, err := ScopeQuery(`up`, nil); err == nil {
+		t.Error("expected error without clusters")
+	}
+}
@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+// Interfaces
+type IAccessTokenRepository interface {
+	GetServiceAccount(ctx context.Context, organizationId string, serviceAccountId uuid.UUID) (model.ServiceAccount, error)
+	GetServiceAccountByName(ctx context.Context, organizationId string, name string) (model.ServiceAccount, error)
+	FetchServiceAccounts(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.ServiceAccount, error)
+	CreateServiceAccount(ctx context.Context, dto model.ServiceAccount) (serviceAccountId uuid.UUID, err error)
+	DeleteServiceAccount(ctx context.Context, serviceAccountId uuid.UUID) error
+
+	Get(ctx context.Context, accessTokenId uuid.UUID) (model.AccessToken, error)
+	GetByTokenPrefix(ctx context.Context, tokenPrefix string) (model.AccessToken, error)
+	FetchByUser(ctx context.Context, organizationId string, userId uuid.UUID, pg *pagination.Pagination) ([]model.AccessToken, error)
+	FetchByServiceAccount(ctx context.Context, organizationId string, serviceAccountId uuid.UUID, pg *pagination.Pagination) ([]model.AccessToken, error)
+	Create(ctx context.Context, dto model.AccessToken) (accessTokenId uuid.UUID, err error)
+	Revoke(ctx context.Context, accessTokenId uuid.UUID) error
+	RevokeByServiceAccount(ctx context.Context, serviceAccountId uuid.UUID) error
+	UpdateLastUsedAt(ctx context.Context, accessTokenId uuid.UUID) error
+}
+
+type AccessTokenRepository struct {
+	db *gorm.DB
+}
+
+func NewAccessTokenRepository(db *gorm.DB) IAccessTokenRepository {
+	return &AccessTokenRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *AccessTokenRepository) GetServiceAccount(ctx context.Context, organizationId string, serviceAccountId uuid.UUID) (out model.ServiceAccount, err error) {
+	res := r.db.WithContext(ctx).Preload(clause.Associations).
+		First(&out, "organization_id = ? AND id = ?", organizationId, serviceAccountId)
+	if res.Error != nil {
+		return model.ServiceAccount{}, res.Error
+	}
+	return
+}
+
+func (r *AccessTokenRepository) GetServiceAccountByName(ctx context.Context, organizationId string, name string) (out model.ServiceAccount, err error) {
+	res := r.db.WithContext(ctx).First(&out, "organization_id = ? AND name = ?", organizationId, name)
+	if res.Error != nil {
+		return model.ServiceAccount{}, res.Error
+	}
+	return
+}
+
+func (r *AccessTokenRepository) FetchServiceAccounts(ctx context.Context, organizationId string, pg *pagination.Pagination) (out []model.ServiceAccount, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+
+	db := r.db.WithContext(ctx).Preload(clause.Associations).Model(&model.ServiceAccount{}).
+		Where("organization_id = ?", organizationId)
+
+	_, res := pg.Fetch(db, &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *AccessTokenRepository) CreateServiceAccount(ctx context.Context, dto model.ServiceAccount) (serviceAccountId uuid.UUID, err error) {
+	res := r.db.WithContext(ctx).Create(&dto)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return dto.ID, nil
+}
+
+func (r *AccessTokenRepository) DeleteServiceAccount(ctx context.Context, serviceAccountId uuid.UUID) error {
+	res := r.db.WithContext(ctx).Delete(&model.ServiceAccount{}, "id = ?", serviceAccountId)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *AccessTokenRepository) Get(ctx context.Context, accessTokenId uuid.UUID) (out model.AccessToken, err error) {
+	res := r.db.WithContext(ctx).Preload(clause.Associations).First(&out, "id = ?", accessTokenId)
+	if res.Error != nil {
+		return model.AccessToken{}, res.Error
+	}
+	return
+}
+
+func (r *AccessTokenRepository) GetByTokenPrefix(ctx context.Context, tokenPrefix string) (out model.AccessToken, err error) {
+	res := r.db.WithContext(ctx).
+		Preload("User").Preload("User.Roles").
+		Preload("ServiceAccount").Preload("ServiceAccount.Role").
+		First(&out, "token_prefix = ?", tokenPrefix)
+	if res.Error != nil {
+		return model.AccessToken{}, res.Error
+	}
+	return
+}
+
+func (r *AccessTokenRepository) FetchByUser(ctx context.Context, organizationId string, userId uuid.UUID, pg *pagination.Pagination) (out []model.AccessToken, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+
+	db := r.db.WithContext(ctx).Preload("Creator").Model(&model.AccessToken{}).
+		Where("organization_id = ? AND token_type = ? AND user_id = ?", organizationId, domain.AccessTokenType_PERSONAL, userId)
+
+	_, res := pg.Fetch(db, &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *AccessTokenRepository) FetchByServiceAccount(ctx context.Context, organizationId string, serviceAccountId uuid.UUID, pg *pagination.Pagination) (out []model.AccessToken, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+
+	db := r.db.WithContext(ctx).Preload("Creator").Model(&model.AccessToken{}).
+		Where("organization_id = ? AND token_type = ? AND service_account_id = ?", organizationId, domain.AccessTokenType_SERVICE_ACCOUNT, serviceAccountId)
+
+	_, res := pg.Fetch(db, &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *AccessTokenRepository) Create(ctx context.Context, dto model.AccessToken) (accessTokenId uuid.UUID, err error) {
+	res := r.db.WithContext(ctx).Create(&dto)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return dto.ID, nil
+}
+
+func (r *AccessTokenRepository) Revoke(ctx context.Context, accessTokenId uuid.UUID) error {
+	res := r.db.WithContext(ctx).Model(&model.AccessToken{}).
+		Where("id = ? AND revoked_at IS NULL", accessTokenId).
+		Update("revoked_at", time.Now())
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *AccessTokenRepository) RevokeByServiceAccount(ctx context.Context, serviceAccountId uuid.UUID) error {
+	res := r.db.WithContext(ctx).Model(&model.AccessToken{}).
+		Where("service_account_id = ? AND revoked_at IS NULL", serviceAccountId).
+		Update("revoked_at", time.Now())
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *AccessTokenRepository) UpdateLastUsedAt(ctx context.Context, accessTokenId uuid.UUID) error {
+	res := r.db.WithContext(ctx).Model(&model.AccessToken{}).
+		Where("id = ?", accessTokenId).
+		UpdateColumn("last_used_at", time.Now())
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
@@ -16,6 +16,11 @@ type IAuthRepository interface {
 	DeleteEmailCode(ctx context.Context, userId uuid.UUID) error
 	GetExpiredTimeOnToken(ctx context.Context, organizationId string, userId string) (*model.ExpiredTokenTime, error)
 	UpdateExpiredTimeOnToken(ctx context.Context, organizationId string, userId string) error
+
+	CreateAuthAttempt(ctx context.Context, attempt model.AuthAttempt) error
+	CountAuthAttemptsByAccount(ctx context.Context, attemptType string, organizationId string, accountId string, succeeded bool, since time.Time) (int64, error)
+	CountAuthAttemptsByClientIp(ctx context.Context, attemptType string, clientIp string, succeeded bool, since time.Time) (int64, error)
+	DeleteAuthAttemptsByAccount(ctx context.Context, attemptType string, organizationId string, accountId string) error
 }
 
 type AuthRepository struct {
@@ -70,3 +75,33 @@ func (r *AuthRepository) UpdateExpiredTimeOnToken(ctx context.Context, organizat
 		OrganizationId: organizationId,
 	}).Error
 }
+
+func (r *AuthRepository) CreateAuthAttempt(ctx context.Context, attempt model.AuthAttempt) error {
+	return r.db.WithContext(ctx).Create(&attempt).Error
+}
+
+func (r *AuthRepository) CountAuthAttemptsByAccount(ctx context.Context, attemptType string, organizationId string, accountId string, succeeded bool, since time.Time) (int64, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&model.AuthAttempt{}).
+		Where("attempt_type = ? AND organization_id = ? AND account_id = ? AND succeeded = ? AND created_at > ?", attemptType, organizationId, accountId, succeeded, since).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *AuthRepository) CountAuthAttemptsByClientIp(ctx context.Context, attemptType string, clientIp string, succeeded bool, since time.Time) (int64, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&model.AuthAttempt{}).
+		Where("attempt_type = ? AND client_ip = ? AND succeeded = ? AND created_at > ?", attemptType, clientIp, succeeded, since).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *AuthRepository) DeleteAuthAttemptsByAccount(ctx context.Context, attemptType string, organizationId string, accountId string) error {
+	return r.db.WithContext(ctx).Unscoped().
+		Where("attempt_type = ? AND organization_id = ? AND account_id = ?", attemptType, organizationId, accountId).
+		Delete(&model.AuthAttempt{}).Error
+}
@@ -3,6 +3,8 @@ package repository
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -18,11 +20,14 @@ type ICloudAccountRepository interface {
 	Get(ctx context.Context, cloudAccountId uuid.UUID) (model.CloudAccount, error)
 	GetByName(ctx context.Context, organizationId string, name string) (model.CloudAccount, error)
 	GetByAwsAccountId(ctx context.Context, awsAccountId string) (model.CloudAccount, error)
+	GetByAccountId(ctx context.Context, cloudService string, accountId string) (model.CloudAccount, error)
 	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.CloudAccount, error)
 	Create(ctx context.Context, dto model.CloudAccount) (cloudAccountId uuid.UUID, err error)
 	Update(ctx context.Context, dto model.CloudAccount) (err error)
 	Delete(ctx context.Context, cloudAccountId uuid.UUID) (err error)
 	InitWorkflow(ctx context.Context, cloudAccountId uuid.UUID, workflowId string, status domain.CloudAccountStatus) (err error)
+	FetchByStatus(ctx context.Context, status domain.CloudAccountStatus) ([]model.CloudAccount, error)
+	UpdateCredentialStatus(ctx context.Context, cloudAccountId uuid.UUID, status domain.CloudAccountCredentialStatus, checkedAt *time.Time, message string) error
 }
 
 type CloudAccountRepository struct {
@@ -60,6 +65,20 @@ func (r *CloudAccountRepository) GetByAwsAccountId(ctx context.Context, awsAccou
 	return
 }
 
+func (r *CloudAccountRepository) GetByAccountId(ctx context.Context, cloudService string, accountId string) (out model.CloudAccount, err error) {
+	db := r.db.WithContext(ctx).Preload(clause.Associations).Where("cloud_service = ? AND status != ?", cloudService, domain.CloudAccountStatus_DELETED)
+	if cloudService == domain.CloudService_AWS {
+		db = db.Where("account_id = ? OR aws_account_id = ?", accountId, accountId)
+	} else {
+		db = db.Where("account_id = ?", accountId)
+	}
+	res := db.First(&out)
+	if res.Error != nil {
+		return model.CloudAccount{}, res.Error
+	}
+	return
+}
+
 func (r *CloudAccountRepository) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (out []model.CloudAccount, err error) {
 	if pg == nil {
 		pg = pagination.NewPagination(nil)
@@ -82,6 +101,10 @@ func (r *CloudAccountRepository) Create(ctx context.Context, dto model.CloudAcco
 		CloudService:   dto.CloudService,
 		Resource:       dto.Resource,
 		AwsAccountId:   dto.AwsAccountId,
+		AccountId:      dto.AccountId,
+		TenantId:       dto.TenantId,
+		Endpoint:       dto.Endpoint,
+		AllowedRegions: dto.AllowedRegions,
 		CreatedIAM:     false,
 		Status:         domain.CloudAccountStatus_PENDING,
 		CreatorId:      dto.CreatorId}
@@ -95,7 +118,7 @@ func (r *CloudAccountRepository) Create(ctx context.Context, dto model.CloudAcco
 func (r *CloudAccountRepository) Update(ctx context.Context, dto model.CloudAccount) (err error) {
 	res := r.db.WithContext(ctx).Model(&model.CloudAccount{}).
 		Where("id = ?", dto.ID).
-		Updates(map[string]interface{}{"Description": dto.Description, "Resource": dto.Resource, "UpdatorId": dto.UpdatorId})
+		Updates(map[string]interface{}{"Description": dto.Description, "Resource": dto.Resource, "UpdatorId": dto.UpdatorId, "AllowedRegion": strings.Join(dto.AllowedRegions, ",")})
 	if res.Error != nil {
 		return res.Error
 	}
@@ -121,3 +144,21 @@ func (r *CloudAccountRepository) InitWorkflow(ctx context.Context, cloudAccountI
 
 	return nil
 }
+
+func (r *CloudAccountRepository) FetchByStatus(ctx context.Context, status domain.CloudAccountStatus) (out []model.CloudAccount, err error) {
+	res := r.db.WithContext(ctx).Where("status = ?", status).Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *CloudAccountRepository) UpdateCredentialStatus(ctx context.Context, cloudAccountId uuid.UUID, status domain.CloudAccountCredentialStatus, checkedAt *time.Time, message string) error {
+	res := r.db.WithContext(ctx).Model(&model.CloudAccount{}).
+		Where("id = ?", cloudAccountId).
+		Updates(map[string]interface{}{"CredentialStatus": status, "CredentialCheckedAt": checkedAt, "CredentialError": message})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
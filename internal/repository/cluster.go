@@ -143,6 +143,7 @@ func (r *ClusterRepository) Create(ctx context.Context, dto model.Cluster) (clus
 		TksUserNode:            dto.TksUserNode,
 		TksUserNodeMax:         dto.TksUserNodeMax,
 		TksUserNodeType:        dto.TksUserNodeType,
+		ClusterRegion:          dto.ClusterRegion,
 	}
 	if dto.ID != "" {
 		cluster.ID = dto.ID
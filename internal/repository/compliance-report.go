@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+)
+
+// Interfaces
+type IComplianceReportRepository interface {
+	Create(ctx context.Context, dto model.ComplianceReport) (reportId uuid.UUID, err error)
+	Get(ctx context.Context, organizationId string, reportId uuid.UUID) (model.ComplianceReport, error)
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.ComplianceReport, error)
+	ExistScheduled(ctx context.Context, organizationId string, periodStart time.Time) (bool, error)
+	Delete(ctx context.Context, reportId uuid.UUID) error
+}
+
+type ComplianceReportRepository struct {
+	db *gorm.DB
+}
+
+func NewComplianceReportRepository(db *gorm.DB) IComplianceReportRepository {
+	return &ComplianceReportRepository{
+		db: db,
+	}
+}
+
+// Logics
+// Create 는 보고서를 저장한다. 같은 기간의 월간 보고서가 이미 있으면 저장하지 않고 uuid.Nil 을 반환한다.
+func (r *ComplianceReportRepository) Create(ctx context.Context, dto model.ComplianceReport) (reportId uuid.UUID, err error) {
+	db := r.db.WithContext(ctx).Omit("Creator")
+	if dto.Scheduled {
+		// 월간 보고서는 조직별 기간마다 하나만 생성한다. 다른 서버가 먼저 생성했다면 아무것도 하지 않는다.
+		db = db.Clauses(clause.OnConflict{
+			Columns:     []clause.Column{{Name: "organization_id"}, {Name: "period_start"}},
+			TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "scheduled = true AND deleted_at IS NULL"}}},
+			DoNothing:   true,
+		})
+	}
+	res := db.Create(&dto)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return uuid.Nil, nil
+	}
+	return dto.ID, nil
+}
+
+func (r *ComplianceReportRepository) Get(ctx context.Context, organizationId string, reportId uuid.UUID) (out model.ComplianceReport, err error) {
+	res := r.db.WithContext(ctx).Preload("Creator").
+		First(&out, "organization_id = ? AND id = ?", organizationId, reportId)
+	if res.Error != nil {
+		return model.ComplianceReport{}, res.Error
+	}
+	return
+}
+
+func (r *ComplianceReportRepository) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (out []model.ComplianceReport, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+
+	_, res := pg.Fetch(r.db.WithContext(ctx).Model(&model.ComplianceReport{}).
+		Preload("Creator").
+		Where("compliance_reports.organization_id = ?", organizationId), &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *ComplianceReportRepository) ExistScheduled(ctx context.Context, organizationId string, periodStart time.Time) (bool, error) {
+	var count int64
+	res := r.db.WithContext(ctx).Model(&model.ComplianceReport{}).
+		Where("organization_id = ? AND scheduled = ? AND period_start = ?", organizationId, true, periodStart).
+		Count(&count)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return count > 0, nil
+}
+
+func (r *ComplianceReportRepository) Delete(ctx context.Context, reportId uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&model.ComplianceReport{}, "id = ?", reportId).Error
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+)
+
+// Interfaces
+type IDashboardWidgetRepository interface {
+	Create(ctx context.Context, dto model.DashboardWidget) (dashboardWidgetId uuid.UUID, err error)
+	Update(ctx context.Context, dashboardWidgetId uuid.UUID, updateMap map[string]interface{}) (err error)
+	Delete(ctx context.Context, dashboardWidgetId uuid.UUID) (err error)
+	Get(ctx context.Context, organizationId string, dashboardWidgetId uuid.UUID) (model.DashboardWidget, error)
+	Fetch(ctx context.Context, organizationId string, userId uuid.UUID, pg *pagination.Pagination) ([]model.DashboardWidget, error)
+}
+
+type DashboardWidgetRepository struct {
+	db *gorm.DB
+}
+
+func NewDashboardWidgetRepository(db *gorm.DB) IDashboardWidgetRepository {
+	return &DashboardWidgetRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *DashboardWidgetRepository) Create(ctx context.Context, dto model.DashboardWidget) (dashboardWidgetId uuid.UUID, err error) {
+	if err := r.db.WithContext(ctx).Omit("Creator").Create(&dto).Error; err != nil {
+		return uuid.Nil, err
+	}
+	return dto.ID, nil
+}
+
+func (r *DashboardWidgetRepository) Update(ctx context.Context, dashboardWidgetId uuid.UUID, updateMap map[string]interface{}) (err error) {
+	return r.db.WithContext(ctx).Model(&model.DashboardWidget{}).
+		Where("id = ?", dashboardWidgetId).Updates(updateMap).Error
+}
+
+func (r *DashboardWidgetRepository) Delete(ctx context.Context, dashboardWidgetId uuid.UUID) (err error) {
+	return r.db.WithContext(ctx).Delete(&model.DashboardWidget{}, "id = ?", dashboardWidgetId).Error
+}
+
+func (r *DashboardWidgetRepository) Get(ctx context.Context, organizationId string, dashboardWidgetId uuid.UUID) (out model.DashboardWidget, err error) {
+	res := r.db.WithContext(ctx).Preload(clause.Associations).
+		First(&out, "organization_id = ? AND id = ?", organizationId, dashboardWidgetId)
+	if res.Error != nil {
+		return model.DashboardWidget{}, res.Error
+	}
+	return
+}
+
+// Fetch 는 사용자가 생성한 위젯과 조직에 공유된 위젯을 조회한다.
+func (r *DashboardWidgetRepository) Fetch(ctx context.Context, organizationId string, userId uuid.UUID, pg *pagination.Pagination) (out []model.DashboardWidget, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+
+	_, res := pg.Fetch(r.db.WithContext(ctx).Preload(clause.Associations).
+		Where("dashboard_widgets.organization_id = ?", organizationId).
+		Where("dashboard_widgets.creator_id = ? OR dashboard_widgets.shared = ?", userId, true), &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
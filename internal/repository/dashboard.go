@@ -13,6 +13,8 @@ type IDashboardRepository interface {
 	GetDashboardById(ctx context.Context, organizationId string, dashboardId string) (*model.Dashboard, error)
 	GetDashboardByUserId(ctx context.Context, organizationId string, userId string, dashboardKey string) (*model.Dashboard, error)
 	UpdateDashboard(ctx context.Context, d *model.Dashboard) error
+	GetDefaultDashboard(ctx context.Context, organizationId string, dashboardKey string) (*model.Dashboard, error)
+	DeleteDashboard(ctx context.Context, d *model.Dashboard) error
 }
 
 type DashboardRepository struct {
@@ -76,3 +78,29 @@ func (dr DashboardRepository) UpdateDashboard(ctx context.Context, d *model.Dash
 	}
 	return nil
 }
+
+// GetDefaultDashboard 는 조직 관리자가 게시한 기본 대시보드를 조회한다. 기본 대시보드는 IsAdmin 이 true 이고 UserId 가 없다.
+func (dr DashboardRepository) GetDefaultDashboard(ctx context.Context, organizationId string, dashboardKey string) (d *model.Dashboard, err error) {
+	res := dr.db.WithContext(ctx).Limit(1).
+		Where("organization_id = ? and is_admin = ? and key = ?", organizationId, true, dashboardKey).
+		First(&d)
+	if res.Error != nil {
+		if errors.Is(res.Error, gorm.ErrRecordNotFound) {
+			log.Info(ctx, "Cannot find default dashboard")
+			return nil, nil
+		} else {
+			log.Error(ctx, res.Error)
+			return nil, res.Error
+		}
+	}
+	return d, nil
+}
+
+func (dr DashboardRepository) DeleteDashboard(ctx context.Context, d *model.Dashboard) error {
+	res := dr.db.WithContext(ctx).Delete(d)
+	if res.Error != nil {
+		log.Error(ctx, res.Error)
+		return res.Error
+	}
+	return nil
+}
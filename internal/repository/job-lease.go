@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openinfradev/tks-api/internal/model"
+)
+
+// Interfaces
+type IJobLeaseRepository interface {
+	TryAcquire(ctx context.Context, name string, holder string, ttl time.Duration) (bool, error)
+	Release(ctx context.Context, name string, holder string) error
+}
+
+type JobLeaseRepository struct {
+	db *gorm.DB
+}
+
+func NewJobLeaseRepository(db *gorm.DB) IJobLeaseRepository {
+	return &JobLeaseRepository{
+		db: db,
+	}
+}
+
+// Logics
+
+// TryAcquire takes or renews the lease of the job. It fails while another holder has an unexpired lease.
+func (r *JobLeaseRepository) TryAcquire(ctx context.Context, name string, holder string, ttl time.Duration) (bool, error) {
+	now := time.Now()
+	lease := model.JobLease{
+		Name:      name,
+		Holder:    holder,
+		ExpiresAt: now.Add(ttl),
+		UpdatedAt: now,
+	}
+	res := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"holder", "expires_at", "updated_at"}),
+		Where: clause.Where{Exprs: []clause.Expression{
+			gorm.Expr("job_leases.expires_at < ? OR job_leases.holder = ?", now, holder),
+		}},
+	}).Create(&lease)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// Release gives up the lease so that another server can take over the job without waiting for expiration.
+func (r *JobLeaseRepository) Release(ctx context.Context, name string, holder string) error {
+	return r.db.WithContext(ctx).
+		Where("name = ? AND holder = ?", name, holder).
+		Delete(&model.JobLease{}).Error
+}
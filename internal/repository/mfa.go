@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openinfradev/tks-api/internal/model"
+)
+
+// Interfaces
+type IMfaRepository interface {
+	GetUserMfa(ctx context.Context, userId uuid.UUID) (model.UserMfa, error)
+	SaveUserMfa(ctx context.Context, dto *model.UserMfa) error
+	DeleteUserMfa(ctx context.Context, userId uuid.UUID) error
+	UseTotpStep(ctx context.Context, userId uuid.UUID, step int64) (bool, error)
+	UseRecoveryCode(ctx context.Context, userId uuid.UUID, recoveryCode string, remaining string) (bool, error)
+	RecordFailure(ctx context.Context, userId uuid.UUID, maxFailures int, lockDuration time.Duration) (lockedUntil *time.Time, err error)
+
+	CreateStepUp(ctx context.Context, userId uuid.UUID, sessionId string) (model.MfaStepUp, error)
+	GetLatestStepUp(ctx context.Context, userId uuid.UUID, sessionId string) (model.MfaStepUp, error)
+
+	GetPolicy(ctx context.Context, organizationId string) (model.MfaPolicy, error)
+	UpdatePolicy(ctx context.Context, dto model.MfaPolicy) error
+}
+
+type MfaRepository struct {
+	db *gorm.DB
+}
+
+func NewMfaRepository(db *gorm.DB) IMfaRepository {
+	return &MfaRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *MfaRepository) GetUserMfa(ctx context.Context, userId uuid.UUID) (out model.UserMfa, err error) {
+	res := r.db.WithContext(ctx).First(&out, "user_id = ?", userId)
+	if res.Error != nil {
+		return model.UserMfa{}, res.Error
+	}
+	return
+}
+
+func (r *MfaRepository) SaveUserMfa(ctx context.Context, dto *model.UserMfa) error {
+	return r.db.WithContext(ctx).Save(dto).Error
+}
+
+func (r *MfaRepository) DeleteUserMfa(ctx context.Context, userId uuid.UUID) error {
+	return r.db.WithContext(ctx).Unscoped().Where("user_id = ?", userId).Delete(&model.UserMfa{}).Error
+}
+
+// UseTotpStep marks the TOTP time step as used and resets the failure count.
+// It returns false if the step or a later one has already been used, so that the same code cannot be replayed.
+func (r *MfaRepository) UseTotpStep(ctx context.Context, userId uuid.UUID, step int64) (bool, error) {
+	res := r.db.WithContext(ctx).Model(&model.UserMfa{}).
+		Where("user_id = ? AND last_used_step < ?", userId, step).
+		Updates(map[string]interface{}{"last_used_step": step, "failed_count": 0, "locked_until": nil})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// UseRecoveryCode replaces the stored recovery codes with remaining and resets the failure count.
+// It updates only when the stored codes are still recoveryCode, so that concurrent requests cannot use the same code twice.
+func (r *MfaRepository) UseRecoveryCode(ctx context.Context, userId uuid.UUID, recoveryCode string, remaining string) (bool, error) {
+	res := r.db.WithContext(ctx).Model(&model.UserMfa{}).
+		Where("user_id = ? AND recovery_code = ?", userId, recoveryCode).
+		Updates(map[string]interface{}{"recovery_code": remaining, "failed_count": 0, "locked_until": nil})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// RecordFailure increases the failure count and locks the verification when the count reaches maxFailures.
+func (r *MfaRepository) RecordFailure(ctx context.Context, userId uuid.UUID, maxFailures int, lockDuration time.Duration) (lockedUntil *time.Time, err error) {
+	var mfa model.UserMfa
+	res := r.db.WithContext(ctx).Model(&mfa).Clauses(clause.Returning{Columns: []clause.Column{{Name: "failed_count"}}}).
+		Where("user_id = ?", userId).
+		Update("failed_count", gorm.Expr("failed_count + 1"))
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	if mfa.FailedCount < maxFailures {
+		return nil, nil
+	}
+
+	until := time.Now().Add(lockDuration)
+	res = r.db.WithContext(ctx).Model(&model.UserMfa{}).
+		Where("user_id = ?", userId).
+		Updates(map[string]interface{}{"failed_count": 0, "locked_until": until})
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return &until, nil
+}
+
+func (r *MfaRepository) CreateStepUp(ctx context.Context, userId uuid.UUID, sessionId string) (out model.MfaStepUp, err error) {
+	out = model.MfaStepUp{
+		UserId:     userId,
+		SessionId:  sessionId,
+		VerifiedAt: time.Now(),
+	}
+	res := r.db.WithContext(ctx).Create(&out)
+	if res.Error != nil {
+		return model.MfaStepUp{}, res.Error
+	}
+	return
+}
+
+func (r *MfaRepository) GetLatestStepUp(ctx context.Context, userId uuid.UUID, sessionId string) (out model.MfaStepUp, err error) {
+	res := r.db.WithContext(ctx).Order("verified_at desc").
+		First(&out, "user_id = ? AND session_id = ?", userId, sessionId)
+	if res.Error != nil {
+		return model.MfaStepUp{}, res.Error
+	}
+	return
+}
+
+func (r *MfaRepository) GetPolicy(ctx context.Context, organizationId string) (out model.MfaPolicy, err error) {
+	res := r.db.WithContext(ctx).Preload(clause.Associations).First(&out, "organization_id = ?", organizationId)
+	if res.Error != nil {
+		return model.MfaPolicy{}, res.Error
+	}
+	return
+}
+
+func (r *MfaRepository) UpdatePolicy(ctx context.Context, dto model.MfaPolicy) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "organization_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"required", "updator_id", "updated_at"}),
+		}).Omit("Roles").Create(&dto).Error
+		if err != nil {
+			return err
+		}
+		return tx.Model(&dto).Association("Roles").Replace(dto.Roles)
+	})
+}
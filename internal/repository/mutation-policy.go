@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+)
+
+// Interfaces
+type IMutationPolicyRepository interface {
+	Create(ctx context.Context, dto model.MutationPolicy) (mutationPolicyId uuid.UUID, err error)
+	Update(ctx context.Context, mutationPolicyId uuid.UUID, updateMap map[string]interface{}, targetClusters *[]model.Cluster) (err error)
+	Delete(ctx context.Context, mutationPolicyId uuid.UUID) (err error)
+	Get(ctx context.Context, organizationId string, mutationPolicyId uuid.UUID) (model.MutationPolicy, error)
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.MutationPolicy, error)
+	ExistByName(ctx context.Context, organizationId string, policyName string) (bool, error)
+	ExistByResourceName(ctx context.Context, organizationId string, policyResourceName string) (bool, error)
+	CountByKind(ctx context.Context, organizationId string) ([]model.MutationPolicyCount, error)
+}
+
+type MutationPolicyRepository struct {
+	db *gorm.DB
+}
+
+func NewMutationPolicyRepository(db *gorm.DB) IMutationPolicyRepository {
+	return &MutationPolicyRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *MutationPolicyRepository) Create(ctx context.Context, dto model.MutationPolicy) (mutationPolicyId uuid.UUID, err error) {
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("TargetClusters", "Creator", "Updator").Create(&dto).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&dto).Association("TargetClusters").Append(dto.TargetClusters)
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return dto.ID, nil
+}
+
+func (r *MutationPolicyRepository) Update(ctx context.Context, mutationPolicyId uuid.UUID, updateMap map[string]interface{}, targetClusters *[]model.Cluster) (err error) {
+	var mutationPolicy model.MutationPolicy
+	mutationPolicy.ID = mutationPolicyId
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if targetClusters != nil {
+			if err := tx.Model(&mutationPolicy).Association("TargetClusters").Replace(targetClusters); err != nil {
+				return err
+			}
+		}
+
+		if len(updateMap) > 0 {
+			return tx.Omit("TargetClusters").Model(&mutationPolicy).
+				Where("id = ?", mutationPolicyId).Updates(updateMap).Error
+		}
+
+		return nil
+	})
+}
+
+func (r *MutationPolicyRepository) Delete(ctx context.Context, mutationPolicyId uuid.UUID) (err error) {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("mutation_policy_id = ?", mutationPolicyId).Delete(&model.MutationPolicyTargetCluster{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(&model.MutationPolicy{}, "id = ?", mutationPolicyId).Error
+	})
+}
+
+func (r *MutationPolicyRepository) Get(ctx context.Context, organizationId string, mutationPolicyId uuid.UUID) (out model.MutationPolicy, err error) {
+	res := r.db.WithContext(ctx).Preload(clause.Associations).
+		First(&out, "organization_id = ? AND id = ?", organizationId, mutationPolicyId)
+	if res.Error != nil {
+		return model.MutationPolicy{}, res.Error
+	}
+	return
+}
+
+func (r *MutationPolicyRepository) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (out []model.MutationPolicy, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+
+	_, res := pg.Fetch(r.db.WithContext(ctx).Preload(clause.Associations).
+		Where("mutation_policies.organization_id = ?", organizationId), &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *MutationPolicyRepository) ExistByName(ctx context.Context, organizationId string, policyName string) (bool, error) {
+	var count int64
+	res := r.db.WithContext(ctx).Model(&model.MutationPolicy{}).
+		Where("organization_id = ? AND policy_name = ?", organizationId, policyName).Count(&count)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return count > 0, nil
+}
+
+func (r *MutationPolicyRepository) ExistByResourceName(ctx context.Context, organizationId string, policyResourceName string) (bool, error) {
+	var count int64
+	res := r.db.WithContext(ctx).Model(&model.MutationPolicy{}).
+		Where("organization_id = ? AND policy_resource_name = ?", organizationId, policyResourceName).Count(&count)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return count > 0, nil
+}
+
+func (r *MutationPolicyRepository) CountByKind(ctx context.Context, organizationId string) (out []model.MutationPolicyCount, err error) {
+	err = r.db.WithContext(ctx).Model(&model.MutationPolicy{}).
+		Select("kind", "count(kind) as count").
+		Where("organization_id = ?", organizationId).
+		Group("kind").Scan(&out).Error
+	if err != nil {
+		return nil, err
+	}
+	return
+}
@@ -20,6 +20,8 @@ type IOrganizationRepository interface {
 	Update(ctx context.Context, organizationId string, in model.Organization) (model.Organization, error)
 	UpdatePrimaryClusterId(ctx context.Context, organizationId string, primaryClusterId string) error
 	UpdateAdminId(ctx context.Context, organizationId string, adminId uuid.UUID) error
+	UpdatePasswordPolicy(ctx context.Context, organizationId string, policy model.PasswordPolicy) error
+	UpdatePolicyApprovalSetting(ctx context.Context, organizationId string, setting model.PolicyApprovalSetting) error
 	AddStackTemplates(ctx context.Context, organizationId string, stackTemplates []model.StackTemplate) (err error)
 	RemoveStackTemplates(ctx context.Context, organizationId string, stackTemplates []model.StackTemplate) (err error)
 	AddSystemNotificationTemplates(ctx context.Context, organizationId string, systemNotificationTemplates []model.SystemNotificationTemplate) (err error)
@@ -161,6 +163,42 @@ func (r *OrganizationRepository) UpdateAdminId(ctx context.Context, organization
 	return nil
 }
 
+func (r *OrganizationRepository) UpdatePasswordPolicy(ctx context.Context, organizationId string, policy model.PasswordPolicy) (err error) {
+	res := r.db.WithContext(ctx).Model(&model.Organization{}).
+		Where("id = ?", organizationId).
+		Updates(map[string]interface{}{
+			"password_policy_min_length":        policy.MinLength,
+			"password_policy_require_uppercase": policy.RequireUppercase,
+			"password_policy_require_lowercase": policy.RequireLowercase,
+			"password_policy_require_digit":     policy.RequireDigit,
+			"password_policy_require_special":   policy.RequireSpecial,
+			"password_policy_history_depth":     policy.HistoryDepth,
+			"password_policy_expiry_days":       policy.ExpiryDays,
+			"password_policy_reuse_banned":      policy.ReuseBanned,
+		})
+
+	if res.Error != nil {
+		log.Errorf(ctx, "error is :%s(%T)", res.Error.Error(), res.Error)
+		return res.Error
+	}
+	return nil
+}
+
+func (r *OrganizationRepository) UpdatePolicyApprovalSetting(ctx context.Context, organizationId string, setting model.PolicyApprovalSetting) (err error) {
+	res := r.db.WithContext(ctx).Model(&model.Organization{}).
+		Where("id = ?", organizationId).
+		Updates(map[string]interface{}{
+			"policy_approval_enabled":      setting.Enabled,
+			"policy_approval_expiry_hours": setting.ExpiryHours,
+		})
+
+	if res.Error != nil {
+		log.Errorf(ctx, "error is :%s(%T)", res.Error.Error(), res.Error)
+		return res.Error
+	}
+	return nil
+}
+
 func (r *OrganizationRepository) Delete(ctx context.Context, organizationId string) error {
 	res := r.db.WithContext(ctx).Delete(&model.Organization{}, "id = ?", organizationId)
 	if res.Error != nil {
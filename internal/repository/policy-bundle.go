@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+// Interfaces
+type IPolicyBundleRepository interface {
+	Create(ctx context.Context, dto model.PolicyBundle) (policyBundleId uuid.UUID, err error)
+	Update(ctx context.Context, dto model.PolicyBundle) (err error)
+	Delete(ctx context.Context, organizationId string, policyBundleId uuid.UUID) (err error)
+	Get(ctx context.Context, organizationId string, policyBundleId uuid.UUID) (*model.PolicyBundle, error)
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (*[]model.PolicyBundle, error)
+	FetchByClusterId(ctx context.Context, clusterId domain.ClusterId) ([]model.PolicyBundle, error)
+	ExistByName(ctx context.Context, organizationId string, name string) (bool, error)
+	AddCluster(ctx context.Context, policyBundleId uuid.UUID, clusterId domain.ClusterId) (err error)
+	DeleteCluster(ctx context.Context, policyBundleId uuid.UUID, clusterId domain.ClusterId) (err error)
+}
+
+type PolicyBundleRepository struct {
+	db *gorm.DB
+}
+
+func NewPolicyBundleRepository(db *gorm.DB) IPolicyBundleRepository {
+	return &PolicyBundleRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *PolicyBundleRepository) Create(ctx context.Context, dto model.PolicyBundle) (policyBundleId uuid.UUID, err error) {
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Items", "Clusters").Create(&dto).Error; err != nil {
+			return err
+		}
+		return createPolicyBundleItems(tx, dto.ID, dto.Items)
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return dto.ID, nil
+}
+
+// Update 는 번들의 속성을 갱신하고 항목을 요청된 항목으로 교체한다.
+func (r *PolicyBundleRepository) Update(ctx context.Context, dto model.PolicyBundle) (err error) {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&model.PolicyBundle{}).
+			Where("organization_id = ? AND id = ?", dto.OrganizationId, dto.ID).
+			Updates(map[string]interface{}{
+				"name":        dto.Name,
+				"description": dto.Description,
+				"updator_id":  dto.UpdatorId,
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if err := tx.Where("policy_bundle_id = ?", dto.ID).Delete(&model.PolicyBundleItem{}).Error; err != nil {
+			return err
+		}
+		return createPolicyBundleItems(tx, dto.ID, dto.Items)
+	})
+}
+
+func createPolicyBundleItems(tx *gorm.DB, policyBundleId uuid.UUID, items []model.PolicyBundleItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+	for i := range items {
+		items[i].PolicyBundleId = policyBundleId
+	}
+	return tx.Omit("Policy", "PolicyBundle").Create(&items).Error
+}
+
+func (r *PolicyBundleRepository) Delete(ctx context.Context, organizationId string, policyBundleId uuid.UUID) (err error) {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.PolicyBundle{ID: policyBundleId}).Association("Clusters").Clear(); err != nil {
+			return err
+		}
+
+		if err := tx.Where("policy_bundle_id = ?", policyBundleId).Delete(&model.PolicyBundleItem{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Where("organization_id = ? AND id = ?", organizationId, policyBundleId).Delete(&model.PolicyBundle{}).Error
+	})
+}
+
+func (r *PolicyBundleRepository) Get(ctx context.Context, organizationId string, policyBundleId uuid.UUID) (out *model.PolicyBundle, err error) {
+	res := r.db.WithContext(ctx).
+		Preload("Items.Policy.PolicyTemplate").Preload("Clusters").Preload("Creator").Preload("Updator").
+		First(&out, "organization_id = ? AND id = ?", organizationId, policyBundleId)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *PolicyBundleRepository) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (out *[]model.PolicyBundle, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+
+	_, res := pg.Fetch(r.db.WithContext(ctx).
+		Preload("Items.Policy.PolicyTemplate").Preload("Clusters").Preload("Creator").Preload("Updator").
+		Where("policy_bundles.organization_id = ?", organizationId), &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+// FetchByClusterId returns the bundles assigned to the cluster.
+func (r *PolicyBundleRepository) FetchByClusterId(ctx context.Context, clusterId domain.ClusterId) (out []model.PolicyBundle, err error) {
+	subQueryClusterId := r.db.Table("policy_bundle_clusters").Select("policy_bundle_id").
+		Where("cluster_id = ?", clusterId)
+
+	res := r.db.WithContext(ctx).Preload("Items").
+		Where("id in (?)", subQueryClusterId).Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *PolicyBundleRepository) ExistByName(ctx context.Context, organizationId string, name string) (bool, error) {
+	var count int64
+	res := r.db.WithContext(ctx).Model(&model.PolicyBundle{}).
+		Where("organization_id = ? AND name = ?", organizationId, name).Count(&count)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return count > 0, nil
+}
+
+func (r *PolicyBundleRepository) AddCluster(ctx context.Context, policyBundleId uuid.UUID, clusterId domain.ClusterId) (err error) {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&model.PolicyBundleCluster{PolicyBundleId: policyBundleId, ClusterId: clusterId}).Error
+}
+
+func (r *PolicyBundleRepository) DeleteCluster(ctx context.Context, policyBundleId uuid.UUID, clusterId domain.ClusterId) (err error) {
+	return r.db.WithContext(ctx).
+		Where("policy_bundle_id = ? AND cluster_id = ?", policyBundleId, clusterId).
+		Delete(&model.PolicyBundleCluster{}).Error
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+// Interfaces
+type IPolicyChangeRequestRepository interface {
+	Create(ctx context.Context, dto model.PolicyChangeRequest) (changeRequestId uuid.UUID, err error)
+	Get(ctx context.Context, organizationId string, changeRequestId uuid.UUID) (model.PolicyChangeRequest, error)
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.PolicyChangeRequest, error)
+	ExistPending(ctx context.Context, policyId uuid.UUID) (bool, error)
+	UpdateStatus(ctx context.Context, changeRequestId uuid.UUID, from domain.PolicyChangeRequestStatus, to domain.PolicyChangeRequestStatus, reviewerId *uuid.UUID, comment string) (bool, error)
+	ExpirePending(ctx context.Context, now time.Time) (int64, error)
+}
+
+type PolicyChangeRequestRepository struct {
+	db *gorm.DB
+}
+
+func NewPolicyChangeRequestRepository(db *gorm.DB) IPolicyChangeRequestRepository {
+	return &PolicyChangeRequestRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *PolicyChangeRequestRepository) Create(ctx context.Context, dto model.PolicyChangeRequest) (changeRequestId uuid.UUID, err error) {
+	res := r.db.WithContext(ctx).Omit("Requester", "Reviewer").Create(&dto)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return dto.ID, nil
+}
+
+func (r *PolicyChangeRequestRepository) Get(ctx context.Context, organizationId string, changeRequestId uuid.UUID) (out model.PolicyChangeRequest, err error) {
+	res := r.db.WithContext(ctx).Preload("Requester").Preload("Reviewer").
+		First(&out, "organization_id = ? AND id = ?", organizationId, changeRequestId)
+	if res.Error != nil {
+		return model.PolicyChangeRequest{}, res.Error
+	}
+	return
+}
+
+func (r *PolicyChangeRequestRepository) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (out []model.PolicyChangeRequest, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+
+	_, res := pg.Fetch(r.db.WithContext(ctx).Model(&model.PolicyChangeRequest{}).
+		Preload("Requester").Preload("Reviewer").
+		Where("policy_change_requests.organization_id = ?", organizationId), &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *PolicyChangeRequestRepository) ExistPending(ctx context.Context, policyId uuid.UUID) (bool, error) {
+	var count int64
+	res := r.db.WithContext(ctx).Model(&model.PolicyChangeRequest{}).
+		Where("policy_id = ? AND status = ?", policyId, domain.PolicyChangeRequestStatusPending).
+		Count(&count)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return count > 0, nil
+}
+
+// UpdateStatus 는 요청이 from 상태인 경우에만 to 상태로 변경하고, 변경되었는지 여부를 반환한다.
+// 승인 대기 상태로 되돌리는 경우에는 검토 정보도 지운다.
+func (r *PolicyChangeRequestRepository) UpdateStatus(ctx context.Context, changeRequestId uuid.UUID, from domain.PolicyChangeRequestStatus, to domain.PolicyChangeRequestStatus, reviewerId *uuid.UUID, comment string) (bool, error) {
+	updateMap := map[string]interface{}{
+		"status": to,
+	}
+	if reviewerId != nil {
+		updateMap["reviewer_id"] = reviewerId
+		updateMap["review_comment"] = comment
+		updateMap["reviewed_at"] = time.Now()
+	} else if to == domain.PolicyChangeRequestStatusPending {
+		updateMap["reviewer_id"] = nil
+		updateMap["review_comment"] = ""
+		updateMap["reviewed_at"] = nil
+	}
+
+	res := r.db.WithContext(ctx).Model(&model.PolicyChangeRequest{}).
+		Where("id = ? AND status = ?", changeRequestId, from).Updates(updateMap)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// ExpirePending 은 만료 시각이 지난 승인 대기 요청을 만료 처리하고 처리된 수를 반환한다.
+func (r *PolicyChangeRequestRepository) ExpirePending(ctx context.Context, now time.Time) (int64, error) {
+	res := r.db.WithContext(ctx).Model(&model.PolicyChangeRequest{}).
+		Where("status = ? AND expires_at <= ?", domain.PolicyChangeRequestStatusPending, now).
+		Update("status", domain.PolicyChangeRequestStatusExpired)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return res.RowsAffected, nil
+}
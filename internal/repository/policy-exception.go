@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+)
+
+// Interfaces
+type IPolicyExceptionRepository interface {
+	Create(ctx context.Context, dto model.PolicyException) (exceptionId uuid.UUID, err error)
+	Get(ctx context.Context, organizationId string, policyId uuid.UUID, exceptionId uuid.UUID) (model.PolicyException, error)
+	ListByPolicyId(ctx context.Context, organizationId string, policyId uuid.UUID) ([]model.PolicyException, error)
+	ListExpirable(ctx context.Context, now time.Time) ([]model.PolicyException, error)
+	MarkExpired(ctx context.Context, exceptionIds []uuid.UUID) error
+	Delete(ctx context.Context, exceptionId uuid.UUID) error
+}
+
+type PolicyExceptionRepository struct {
+	db *gorm.DB
+}
+
+func NewPolicyExceptionRepository(db *gorm.DB) IPolicyExceptionRepository {
+	return &PolicyExceptionRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *PolicyExceptionRepository) Create(ctx context.Context, dto model.PolicyException) (exceptionId uuid.UUID, err error) {
+	res := r.db.WithContext(ctx).Create(&dto)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return dto.ID, nil
+}
+
+func (r *PolicyExceptionRepository) Get(ctx context.Context, organizationId string, policyId uuid.UUID, exceptionId uuid.UUID) (out model.PolicyException, err error) {
+	res := r.db.WithContext(ctx).Preload("Approver").Preload("Creator").
+		First(&out, "organization_id = ? AND policy_id = ? AND id = ?", organizationId, policyId, exceptionId)
+	if res.Error != nil {
+		return model.PolicyException{}, res.Error
+	}
+	return
+}
+
+func (r *PolicyExceptionRepository) ListByPolicyId(ctx context.Context, organizationId string, policyId uuid.UUID) (out []model.PolicyException, err error) {
+	res := r.db.WithContext(ctx).Preload("Approver").Preload("Creator").
+		Where("organization_id = ? AND policy_id = ?", organizationId, policyId).
+		Order("expired_at").Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+// ListExpirable returns the exceptions which have passed the expiry date but are not yet expired.
+func (r *PolicyExceptionRepository) ListExpirable(ctx context.Context, now time.Time) (out []model.PolicyException, err error) {
+	res := r.db.WithContext(ctx).Where("expired = ? AND expired_at <= ?", false, now).Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *PolicyExceptionRepository) MarkExpired(ctx context.Context, exceptionIds []uuid.UUID) error {
+	return r.db.WithContext(ctx).Model(&model.PolicyException{}).
+		Where("id IN ?", exceptionIds).Update("expired", true).Error
+}
+
+func (r *PolicyExceptionRepository) Delete(ctx context.Context, exceptionId uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&model.PolicyException{}, "id = ?", exceptionId).Error
+}
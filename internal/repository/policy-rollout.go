@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+// Interfaces
+type IPolicyRolloutRepository interface {
+	Create(ctx context.Context, dto model.PolicyRollout) (rolloutId uuid.UUID, err error)
+	GetLatest(ctx context.Context, organizationId string, policyId uuid.UUID) (model.PolicyRollout, error)
+	ExistInProgress(ctx context.Context, policyId uuid.UUID) (bool, error)
+	ListInProgress(ctx context.Context) ([]model.PolicyRollout, error)
+	SaveStacks(ctx context.Context, stacks []model.PolicyRolloutStack) error
+	UpdateStatus(ctx context.Context, rolloutId uuid.UUID, status domain.PolicyRolloutStatus) error
+}
+
+type PolicyRolloutRepository struct {
+	db *gorm.DB
+}
+
+func NewPolicyRolloutRepository(db *gorm.DB) IPolicyRolloutRepository {
+	return &PolicyRolloutRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *PolicyRolloutRepository) Create(ctx context.Context, dto model.PolicyRollout) (rolloutId uuid.UUID, err error) {
+	res := r.db.WithContext(ctx).Omit("Creator").Create(&dto)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return dto.ID, nil
+}
+
+func (r *PolicyRolloutRepository) GetLatest(ctx context.Context, organizationId string, policyId uuid.UUID) (out model.PolicyRollout, err error) {
+	res := r.db.WithContext(ctx).Preload("Stacks").Preload("Creator").
+		Where("organization_id = ? AND policy_id = ?", organizationId, policyId).
+		Order("created_at DESC").First(&out)
+	if res.Error != nil {
+		return model.PolicyRollout{}, res.Error
+	}
+	return
+}
+
+func (r *PolicyRolloutRepository) ExistInProgress(ctx context.Context, policyId uuid.UUID) (bool, error) {
+	var count int64
+	res := r.db.WithContext(ctx).Model(&model.PolicyRollout{}).
+		Where("policy_id = ? AND status = ?", policyId, domain.PolicyRolloutStatusInProgress).Count(&count)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return count > 0, nil
+}
+
+func (r *PolicyRolloutRepository) ListInProgress(ctx context.Context) (out []model.PolicyRollout, err error) {
+	res := r.db.WithContext(ctx).Preload("Stacks").
+		Where("status = ?", domain.PolicyRolloutStatusInProgress).Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *PolicyRolloutRepository) SaveStacks(ctx context.Context, stacks []model.PolicyRolloutStack) error {
+	if len(stacks) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{UpdateAll: true}).Create(&stacks).Error
+}
+
+func (r *PolicyRolloutRepository) UpdateStatus(ctx context.Context, rolloutId uuid.UUID, status domain.PolicyRolloutStatus) error {
+	updates := map[string]interface{}{"status": status}
+	if status != domain.PolicyRolloutStatusInProgress {
+		updates["completed_at"] = gorm.Expr("now()")
+	}
+	return r.db.WithContext(ctx).Model(&model.PolicyRollout{}).Where("id = ?", rolloutId).Updates(updates).Error
+}
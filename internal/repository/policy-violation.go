@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+// Interfaces
+type IPolicyViolationRepository interface {
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.PolicyViolation, error)
+	Get(ctx context.Context, organizationId string, violationId uuid.UUID) (model.PolicyViolation, error)
+	Upsert(ctx context.Context, dto model.PolicyViolation) error
+	UpdateStatus(ctx context.Context, violationId uuid.UUID, status domain.PolicyViolationStatus, updatorId uuid.UUID) error
+	MarkFixed(ctx context.Context, organizationId string, clusterId domain.ClusterId, policyName string, seenBefore time.Time) error
+	FetchExcepted(ctx context.Context, policyId uuid.UUID) ([]model.PolicyViolation, error)
+	Reopen(ctx context.Context, violationIds []uuid.UUID) error
+	FetchInPeriod(ctx context.Context, organizationId string, from time.Time, to time.Time) ([]model.PolicyViolation, error)
+}
+
+type PolicyViolationRepository struct {
+	db *gorm.DB
+}
+
+func NewPolicyViolationRepository(db *gorm.DB) IPolicyViolationRepository {
+	return &PolicyViolationRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *PolicyViolationRepository) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (out []model.PolicyViolation, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+
+	db := r.db.WithContext(ctx).Model(&model.PolicyViolation{}).
+		Preload("Updator").
+		Where("policy_violations.organization_id = ?", organizationId)
+
+	_, res := pg.Fetch(db, &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *PolicyViolationRepository) Get(ctx context.Context, organizationId string, violationId uuid.UUID) (out model.PolicyViolation, err error) {
+	res := r.db.WithContext(ctx).Preload("Updator").
+		First(&out, "organization_id = ? AND id = ?", organizationId, violationId)
+	if res.Error != nil {
+		return model.PolicyViolation{}, res.Error
+	}
+	return
+}
+
+// Upsert 는 같은 자원의 위반 기록이 있으면 메시지와 마지막 발생 시각을 갱신하고, 없으면 새로 생성한다.
+// 해결(fixed)된 기록이 다시 발생하거나 예외에 해당하게 되면, 또는 예외에 의해 excepted 가 된 기록이 더 이상 예외에 해당하지 않으면 전달된 상태로 변경한다.
+// 사용자가 직접 excepted 로 변경한 기록은 변경하지 않는다.
+// 알림과 audit 동기화가 동시에 기록하더라도 하나의 레코드만 남도록 unique index 에 대한 ON CONFLICT 로 처리한다.
+func (r *PolicyViolationRepository) Upsert(ctx context.Context, dto model.PolicyViolation) error {
+	dto.FirstSeenAt = dto.LastSeenAt
+
+	changed := gorm.Expr("policy_violations.status = ? OR (excluded.status = ? AND policy_violations.status <> ?) OR (policy_violations.status = ? AND policy_violations.excepted_by_exception)",
+		domain.PolicyViolationStatusFixed,
+		domain.PolicyViolationStatusExcepted, domain.PolicyViolationStatusExcepted,
+		domain.PolicyViolationStatusExcepted)
+
+	doUpdates := clause.AssignmentColumns([]string{"policy_id", "policy_template_kind", "message", "enforcement_action", "last_seen_at", "updated_at"})
+	doUpdates = append(doUpdates,
+		clause.Assignment{
+			Column: clause.Column{Name: "status"},
+			Value:  gorm.Expr("CASE WHEN ? THEN excluded.status ELSE policy_violations.status END", changed),
+		},
+		clause.Assignment{
+			Column: clause.Column{Name: "excepted_by_exception"},
+			Value:  gorm.Expr("CASE WHEN ? THEN excluded.excepted_by_exception ELSE policy_violations.excepted_by_exception END", changed),
+		},
+	)
+
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{
+			{Name: "organization_id"}, {Name: "cluster_id"}, {Name: "policy_name"},
+			{Name: "namespace"}, {Name: "kind"}, {Name: "name"},
+		},
+		DoUpdates: doUpdates,
+		// 늦게 도착한 과거 기록으로 최신 기록을 덮어쓰지 않는다.
+		Where: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "policy_violations.last_seen_at <= excluded.last_seen_at"}}},
+	}).Create(&dto).Error
+}
+
+func (r *PolicyViolationRepository) UpdateStatus(ctx context.Context, violationId uuid.UUID, status domain.PolicyViolationStatus, updatorId uuid.UUID) error {
+	return r.db.WithContext(ctx).Model(&model.PolicyViolation{}).
+		Where("id = ?", violationId).
+		Updates(map[string]interface{}{"status": status, "excepted_by_exception": false, "updator_id": updatorId}).Error
+}
+
+// MarkFixed 는 audit 결과에 더 이상 나타나지 않는 미해결 위반 기록을 해결(fixed) 상태로 변경한다.
+func (r *PolicyViolationRepository) MarkFixed(ctx context.Context, organizationId string, clusterId domain.ClusterId, policyName string, seenBefore time.Time) error {
+	return r.db.WithContext(ctx).Model(&model.PolicyViolation{}).
+		Where("organization_id = ? AND cluster_id = ? AND policy_name = ? AND last_seen_at < ?", organizationId, clusterId, policyName, seenBefore).
+		Where("status IN ?", []domain.PolicyViolationStatus{domain.PolicyViolationStatusOpen, domain.PolicyViolationStatusAcknowledged}).
+		Update("status", domain.PolicyViolationStatusFixed).Error
+}
+
+// FetchExcepted 는 정책의 예외(PolicyException)에 의해 예외(excepted) 상태가 된 위반 기록을 조회한다.
+func (r *PolicyViolationRepository) FetchExcepted(ctx context.Context, policyId uuid.UUID) (out []model.PolicyViolation, err error) {
+	res := r.db.WithContext(ctx).
+		Where("policy_id = ? AND status = ? AND excepted_by_exception", policyId, domain.PolicyViolationStatusExcepted).
+		Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+// Reopen 은 더 이상 예외에 해당하지 않는 위반 기록을 미해결(open) 상태로 되돌린다.
+// 그 사이 다른 상태로 변경되었거나 사용자가 직접 excepted 로 변경한 기록은 변경하지 않는다.
+func (r *PolicyViolationRepository) Reopen(ctx context.Context, violationIds []uuid.UUID) error {
+	if len(violationIds) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Model(&model.PolicyViolation{}).
+		Where("id IN ? AND status = ? AND excepted_by_exception", violationIds, domain.PolicyViolationStatusExcepted).
+		Updates(map[string]interface{}{"status": domain.PolicyViolationStatusOpen, "excepted_by_exception": false}).Error
+}
+
+// FetchInPeriod 는 주어진 기간 중에 한 번이라도 발생한 위반 기록을 상태와 관계없이 조회한다.
+func (r *PolicyViolationRepository) FetchInPeriod(ctx context.Context, organizationId string, from time.Time, to time.Time) (out []model.PolicyViolation, err error) {
+	res := r.db.WithContext(ctx).
+		Where("organization_id = ? AND first_seen_at <= ? AND last_seen_at >= ?", organizationId, to, from).
+		Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
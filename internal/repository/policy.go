@@ -26,6 +26,7 @@ type IPolicyRepository interface {
 	ExistByResourceName(ctx context.Context, organizationId string, policyName string) (exist bool, err error)
 	ExistByID(ctx context.Context, organizationId string, policyId uuid.UUID) (exist bool, err error)
 	GetByName(ctx context.Context, organizationId string, policyName string) (out *model.Policy, err error)
+	GetByResourceName(ctx context.Context, organizationId string, policyResourceName string) (out *model.Policy, err error)
 	GetByID(ctx context.Context, organizationId string, policyId uuid.UUID) (out *model.Policy, err error)
 	Delete(ctx context.Context, organizationId string, policyId uuid.UUID) (err error)
 	UpdatePolicyTargetClusters(ctx context.Context, organizationId string, policyId uuid.UUID, currentClusterIds []string, targetClusters []model.Cluster) (err error)
@@ -182,6 +183,8 @@ func (r *PolicyRepository) GetBy(ctx context.Context, organizationId string, key
 
 	var policy model.Policy
 	res := r.db.WithContext(ctx).Preload(clause.Associations).
+		Preload("Exceptions.Approver").Preload("Exceptions.Creator").
+		Preload("BundleItems.PolicyBundle.Clusters").
 		Where(query, organizationId, value).First(&policy)
 	if res.Error != nil {
 		log.Error(ctx, res.Error)
@@ -195,6 +198,10 @@ func (r *PolicyRepository) GetByName(ctx context.Context, organizationId string,
 	return r.GetBy(ctx, organizationId, "policy_name", policyName)
 }
 
+func (r *PolicyRepository) GetByResourceName(ctx context.Context, organizationId string, policyResourceName string) (out *model.Policy, err error) {
+	return r.GetBy(ctx, organizationId, "policy_resource_name", policyResourceName)
+}
+
 func (r *PolicyRepository) GetByID(ctx context.Context, organizationId string, policyId uuid.UUID) (out *model.Policy, err error) {
 	return r.GetBy(ctx, organizationId, "id", policyId)
 }
@@ -205,6 +212,10 @@ func (r *PolicyRepository) Delete(ctx context.Context, organizationId string, po
 			return err
 		}
 
+		if err := tx.Where("organization_id = ? and policy_id = ?", organizationId, policyId).Delete(&model.PolicyException{}).Error; err != nil {
+			return err
+		}
+
 		if err := tx.Where("organization_id = ? and id = ?", organizationId, policyId).Delete(&model.Policy{}).Error; err != nil {
 			return err
 		}
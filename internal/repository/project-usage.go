@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openinfradev/tks-api/internal/model"
+)
+
+// Interfaces
+type IProjectUsageRepository interface {
+	Upsert(ctx context.Context, usages []model.ProjectUsage) error
+	FetchByProjectId(ctx context.Context, organizationId string, projectId string, from time.Time, to time.Time) ([]model.ProjectUsage, error)
+	ExistsByDate(ctx context.Context, organizationId string, date time.Time) (bool, error)
+}
+
+type ProjectUsageRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectUsageRepository(db *gorm.DB) IProjectUsageRepository {
+	return &ProjectUsageRepository{
+		db: db,
+	}
+}
+
+// Logics
+// Upsert 는 같은 날짜의 네임스페이스 사용량이 있으면 갱신한다.
+func (r *ProjectUsageRepository) Upsert(ctx context.Context, usages []model.ProjectUsage) error {
+	if len(usages) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "project_id"}, {Name: "stack_id"}, {Name: "namespace"}, {Name: "date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"cpu_core_hours", "memory_gib_hours", "storage_gib_hours", "updated_at"}),
+	}).Create(&usages).Error
+}
+
+// FetchByProjectId 는 from 부터 to 까지(양 끝 포함) 프로젝트의 일별 사용량을 반환한다.
+func (r *ProjectUsageRepository) FetchByProjectId(ctx context.Context, organizationId string, projectId string, from time.Time, to time.Time) (out []model.ProjectUsage, err error) {
+	res := r.db.WithContext(ctx).
+		Where("organization_id = ? AND project_id = ? AND date >= ? AND date <= ?", organizationId, projectId, from, to).
+		Order("date, stack_id, namespace").
+		Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *ProjectUsageRepository) ExistsByDate(ctx context.Context, organizationId string, date time.Time) (bool, error) {
+	var count int64
+	res := r.db.WithContext(ctx).Model(&model.ProjectUsage{}).
+		Where("organization_id = ? AND date = ?", organizationId, date).
+		Count(&count)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return count > 0, nil
+}
@@ -42,6 +42,9 @@ type IProjectRepository interface {
 	DeleteProjectNamespace(ctx context.Context, organizationId string, projectId string, projectNamespace string, stackId string) error
 	GetAppCountByProjectId(ctx context.Context, organizationId string, projectId string) (int, error)
 	GetAppCountByNamespace(ctx context.Context, organizationId string, projectId string, namespace string) (int, error)
+	GetStackIdsByProjectId(ctx context.Context, projectId string) ([]string, error)
+	GetProjectIdsByStackId(ctx context.Context, stackId string) ([]string, error)
+	GetProjectNamespacesByOrganizationId(ctx context.Context, organizationId string) ([]model.ProjectNamespace, error)
 }
 
 type ProjectRepository struct {
@@ -659,3 +662,45 @@ func (r *ProjectRepository) GetAppCountByNamespace(ctx context.Context, organiza
 
 	return appCount, nil
 }
+
+// GetStackIdsByProjectId 는 프로젝트의 네임스페이스가 있는 스택 목록을 반환한다.
+func (r *ProjectRepository) GetStackIdsByProjectId(ctx context.Context, projectId string) (stackIds []string, err error) {
+	res := r.db.WithContext(ctx).Model(&model.ProjectNamespace{}).
+		Distinct("stack_id").
+		Where("project_id = ?", projectId).
+		Pluck("stack_id", &stackIds)
+	if res.Error != nil {
+		log.Error(ctx, res.Error)
+		return nil, res.Error
+	}
+
+	return stackIds, nil
+}
+
+// GetProjectIdsByStackId 는 스택에 네임스페이스를 가진 프로젝트 목록을 반환한다.
+func (r *ProjectRepository) GetProjectIdsByStackId(ctx context.Context, stackId string) (projectIds []string, err error) {
+	res := r.db.WithContext(ctx).Model(&model.ProjectNamespace{}).
+		Distinct("project_id").
+		Where("stack_id = ?", stackId).
+		Pluck("project_id", &projectIds)
+	if res.Error != nil {
+		log.Error(ctx, res.Error)
+		return nil, res.Error
+	}
+
+	return projectIds, nil
+}
+
+// GetProjectNamespacesByOrganizationId 는 조직의 모든 프로젝트 네임스페이스를 반환한다.
+func (r *ProjectRepository) GetProjectNamespacesByOrganizationId(ctx context.Context, organizationId string) (pns []model.ProjectNamespace, err error) {
+	res := r.db.WithContext(ctx).
+		Joins("join projects on projects.id = project_namespaces.project_id").
+		Where("projects.organization_id = ?", organizationId).
+		Find(&pns)
+	if res.Error != nil {
+		log.Error(ctx, res.Error)
+		return nil, res.Error
+	}
+
+	return pns, nil
+}
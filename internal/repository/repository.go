@@ -7,23 +7,38 @@ import (
 type FilterFunc func(user *gorm.DB) *gorm.DB
 
 type Repository struct {
-	Auth                       IAuthRepository
-	User                       IUserRepository
-	Cluster                    IClusterRepository
-	Organization               IOrganizationRepository
-	AppGroup                   IAppGroupRepository
-	AppServeApp                IAppServeAppRepository
-	CloudAccount               ICloudAccountRepository
-	StackTemplate              IStackTemplateRepository
-	Role                       IRoleRepository
-	Permission                 IPermissionRepository
-	Endpoint                   IEndpointRepository
-	Project                    IProjectRepository
-	Audit                      IAuditRepository
-	PolicyTemplate             IPolicyTemplateRepository
-	Policy                     IPolicyRepository
-	SystemNotification         ISystemNotificationRepository
-	SystemNotificationTemplate ISystemNotificationTemplateRepository
-	SystemNotificationRule     ISystemNotificationRuleRepository
-	Dashboard                  IDashboardRepository
+	Auth                         IAuthRepository
+	User                         IUserRepository
+	Cluster                      IClusterRepository
+	Organization                 IOrganizationRepository
+	AppGroup                     IAppGroupRepository
+	AppServeApp                  IAppServeAppRepository
+	CloudAccount                 ICloudAccountRepository
+	StackTemplate                IStackTemplateRepository
+	Role                         IRoleRepository
+	Permission                   IPermissionRepository
+	Endpoint                     IEndpointRepository
+	Project                      IProjectRepository
+	Audit                        IAuditRepository
+	PolicyTemplate               IPolicyTemplateRepository
+	Policy                       IPolicyRepository
+	SystemNotification           ISystemNotificationRepository
+	SystemNotificationTemplate   ISystemNotificationTemplateRepository
+	SystemNotificationRule       ISystemNotificationRuleRepository
+	Dashboard                    IDashboardRepository
+	AccessToken                  IAccessTokenRepository
+	Mfa                          IMfaRepository
+	PolicyException              IPolicyExceptionRepository
+	PolicyViolation              IPolicyViolationRepository
+	StackPolicyTemplate          IStackPolicyTemplateRepository
+	PolicyBundle                 IPolicyBundleRepository
+	PolicyRollout                IPolicyRolloutRepository
+	ComplianceReport             IComplianceReportRepository
+	MutationPolicy               IMutationPolicyRepository
+	PolicyChangeRequest          IPolicyChangeRequestRepository
+	ProjectUsage                 IProjectUsageRepository
+	DashboardWidget              IDashboardWidgetRepository
+	SystemNotificationSilence    ISystemNotificationSilenceRepository
+	SystemNotificationEscalation ISystemNotificationEscalationRepository
+	JobLease                     IJobLeaseRepository
 }
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+// Interfaces
+type IStackPolicyTemplateRepository interface {
+	GetVersion(ctx context.Context, clusterId domain.ClusterId, policyTemplateId uuid.UUID) (*model.StackPolicyTemplateVersion, error)
+	ListVersions(ctx context.Context, policyTemplateId uuid.UUID) ([]model.StackPolicyTemplateVersion, error)
+	SaveVersion(ctx context.Context, dto model.StackPolicyTemplateVersion, history model.StackPolicyTemplateHistory) error
+	ListHistories(ctx context.Context, clusterId domain.ClusterId, policyTemplateId uuid.UUID) ([]model.StackPolicyTemplateHistory, error)
+}
+
+type StackPolicyTemplateRepository struct {
+	db *gorm.DB
+}
+
+func NewStackPolicyTemplateRepository(db *gorm.DB) IStackPolicyTemplateRepository {
+	return &StackPolicyTemplateRepository{
+		db: db,
+	}
+}
+
+// Logics
+// GetVersion 은 스택에 지정된 버전이 없으면 nil 을 반환한다.
+func (r *StackPolicyTemplateRepository) GetVersion(ctx context.Context, clusterId domain.ClusterId, policyTemplateId uuid.UUID) (*model.StackPolicyTemplateVersion, error) {
+	var out model.StackPolicyTemplateVersion
+	res := r.db.WithContext(ctx).First(&out, "cluster_id = ? AND policy_template_id = ?", clusterId, policyTemplateId)
+	if res.Error != nil {
+		if errors.Is(res.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, res.Error
+	}
+	return &out, nil
+}
+
+func (r *StackPolicyTemplateRepository) ListVersions(ctx context.Context, policyTemplateId uuid.UUID) (out []model.StackPolicyTemplateVersion, err error) {
+	res := r.db.WithContext(ctx).Where("policy_template_id = ?", policyTemplateId).Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *StackPolicyTemplateRepository) SaveVersion(ctx context.Context, dto model.StackPolicyTemplateVersion, history model.StackPolicyTemplateHistory) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(&dto).Error; err != nil {
+			return err
+		}
+		return tx.Create(&history).Error
+	})
+}
+
+func (r *StackPolicyTemplateRepository) ListHistories(ctx context.Context, clusterId domain.ClusterId, policyTemplateId uuid.UUID) (out []model.StackPolicyTemplateHistory, err error) {
+	res := r.db.WithContext(ctx).Preload("Creator").
+		Where("cluster_id = ? AND policy_template_id = ?", clusterId, policyTemplateId).
+		Order("created_at DESC").Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+// Interfaces
+type ISystemNotificationEscalationRepository interface {
+	CreateOnCallSchedule(ctx context.Context, dto model.OnCallSchedule) (onCallScheduleId uuid.UUID, err error)
+	GetOnCallSchedule(ctx context.Context, organizationId string, onCallScheduleId uuid.UUID) (model.OnCallSchedule, error)
+	FetchOnCallSchedules(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.OnCallSchedule, error)
+	UpdateOnCallSchedule(ctx context.Context, onCallScheduleId uuid.UUID, updateMap map[string]interface{}) error
+	DeleteOnCallSchedule(ctx context.Context, onCallScheduleId uuid.UUID) error
+
+	GetPolicy(ctx context.Context, organizationId string, systemNotificationRuleId uuid.UUID) (model.SystemNotificationEscalationPolicy, error)
+	ListPolicies(ctx context.Context, organizationId string) ([]model.SystemNotificationEscalationPolicy, error)
+	ListEnabledPolicies(ctx context.Context) ([]model.SystemNotificationEscalationPolicy, error)
+	SavePolicy(ctx context.Context, dto model.SystemNotificationEscalationPolicy) error
+	DeletePolicy(ctx context.Context, policyId uuid.UUID) error
+
+	ListPendingNotifications(ctx context.Context, systemNotificationRuleId uuid.UUID, maxLevel int, since time.Time) ([]model.SystemNotification, error)
+	UpdateEscalationLevel(ctx context.Context, systemNotificationId uuid.UUID, from int, to int, escalatedAt *time.Time) (bool, error)
+}
+
+type SystemNotificationEscalationRepository struct {
+	db *gorm.DB
+}
+
+func NewSystemNotificationEscalationRepository(db *gorm.DB) ISystemNotificationEscalationRepository {
+	return &SystemNotificationEscalationRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *SystemNotificationEscalationRepository) CreateOnCallSchedule(ctx context.Context, dto model.OnCallSchedule) (onCallScheduleId uuid.UUID, err error) {
+	res := r.db.WithContext(ctx).Create(&dto)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return dto.ID, nil
+}
+
+func (r *SystemNotificationEscalationRepository) GetOnCallSchedule(ctx context.Context, organizationId string, onCallScheduleId uuid.UUID) (out model.OnCallSchedule, err error) {
+	res := r.db.WithContext(ctx).Preload("Creator").
+		First(&out, "organization_id = ? AND id = ?", organizationId, onCallScheduleId)
+	if res.Error != nil {
+		return model.OnCallSchedule{}, res.Error
+	}
+	return
+}
+
+func (r *SystemNotificationEscalationRepository) FetchOnCallSchedules(ctx context.Context, organizationId string, pg *pagination.Pagination) (out []model.OnCallSchedule, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+
+	_, res := pg.Fetch(r.db.WithContext(ctx).Preload("Creator").Model(&model.OnCallSchedule{}).
+		Where("organization_id = ?", organizationId), &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *SystemNotificationEscalationRepository) UpdateOnCallSchedule(ctx context.Context, onCallScheduleId uuid.UUID, updateMap map[string]interface{}) error {
+	return r.db.WithContext(ctx).Model(&model.OnCallSchedule{}).
+		Where("id = ?", onCallScheduleId).Updates(updateMap).Error
+}
+
+func (r *SystemNotificationEscalationRepository) DeleteOnCallSchedule(ctx context.Context, onCallScheduleId uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&model.OnCallSchedule{}, "id = ?", onCallScheduleId).Error
+}
+
+func (r *SystemNotificationEscalationRepository) GetPolicy(ctx context.Context, organizationId string, systemNotificationRuleId uuid.UUID) (out model.SystemNotificationEscalationPolicy, err error) {
+	res := r.db.WithContext(ctx).Preload("Creator").
+		First(&out, "organization_id = ? AND system_notification_rule_id = ?", organizationId, systemNotificationRuleId)
+	if res.Error != nil {
+		return model.SystemNotificationEscalationPolicy{}, res.Error
+	}
+	return
+}
+
+func (r *SystemNotificationEscalationRepository) ListPolicies(ctx context.Context, organizationId string) (out []model.SystemNotificationEscalationPolicy, err error) {
+	res := r.db.WithContext(ctx).Where("organization_id = ?", organizationId).Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *SystemNotificationEscalationRepository) ListEnabledPolicies(ctx context.Context) (out []model.SystemNotificationEscalationPolicy, err error) {
+	res := r.db.WithContext(ctx).Where("enabled = ?", true).Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *SystemNotificationEscalationRepository) SavePolicy(ctx context.Context, dto model.SystemNotificationEscalationPolicy) error {
+	return r.db.WithContext(ctx).Omit("Creator").Save(&dto).Error
+}
+
+func (r *SystemNotificationEscalationRepository) DeletePolicy(ctx context.Context, policyId uuid.UUID) error {
+	return r.db.WithContext(ctx).Unscoped().Delete(&model.SystemNotificationEscalationPolicy{}, "id = ?", policyId).Error
+}
+
+// ListPendingNotifications 는 아직 아무도 조치하지 않았고 마지막 단계까지 에스컬레이션되지 않은 알림 설정의 알림을 반환한다.
+func (r *SystemNotificationEscalationRepository) ListPendingNotifications(ctx context.Context, systemNotificationRuleId uuid.UUID, maxLevel int, since time.Time) (out []model.SystemNotification, err error) {
+	res := r.db.WithContext(ctx).
+		Where("system_notification_rule_id = ? AND status = ? AND suppressed = ? AND escalation_level < ? AND created_at > ?",
+			systemNotificationRuleId, domain.SystemNotificationActionStatus_CREATED, false, maxLevel, since).
+		Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+// UpdateEscalationLevel 은 그사이 다른 서버가 단계를 바꾸지 않은 경우에만 에스컬레이션 단계를 변경한다.
+// 여러 서버가 주기 작업을 실행해도 한 단계는 한 번만 발송된다. 발송에 실패한 단계를 되돌릴 때도 사용한다.
+func (r *SystemNotificationEscalationRepository) UpdateEscalationLevel(ctx context.Context, systemNotificationId uuid.UUID, from int, to int, escalatedAt *time.Time) (bool, error) {
+	res := r.db.WithContext(ctx).Model(&model.SystemNotification{}).
+		Where("id = ? AND escalation_level = ?", systemNotificationId, from).
+		Updates(map[string]interface{}{"escalation_level": to, "escalated_at": escalatedAt})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
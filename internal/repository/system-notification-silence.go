@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+// Interfaces
+type ISystemNotificationSilenceRepository interface {
+	Create(ctx context.Context, dto model.SystemNotificationSilence) (silenceId uuid.UUID, err error)
+	Get(ctx context.Context, organizationId string, silenceId uuid.UUID) (model.SystemNotificationSilence, error)
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.SystemNotificationSilence, error)
+	ListActive(ctx context.Context, organizationId string, now time.Time) ([]model.SystemNotificationSilence, error)
+	Expire(ctx context.Context, silenceId uuid.UUID, endsAt time.Time) error
+	Delete(ctx context.Context, silenceId uuid.UUID) error
+
+	CreateMaintenanceWindow(ctx context.Context, dto model.StackMaintenanceWindow) (maintenanceWindowId uuid.UUID, err error)
+	GetMaintenanceWindow(ctx context.Context, organizationId string, maintenanceWindowId uuid.UUID) (model.StackMaintenanceWindow, error)
+	ListMaintenanceWindows(ctx context.Context, organizationId string, stackId domain.StackId) ([]model.StackMaintenanceWindow, error)
+	UpdateMaintenanceWindow(ctx context.Context, maintenanceWindowId uuid.UUID, updateMap map[string]interface{}) error
+	DeleteMaintenanceWindow(ctx context.Context, maintenanceWindowId uuid.UUID) error
+}
+
+type SystemNotificationSilenceRepository struct {
+	db *gorm.DB
+}
+
+func NewSystemNotificationSilenceRepository(db *gorm.DB) ISystemNotificationSilenceRepository {
+	return &SystemNotificationSilenceRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *SystemNotificationSilenceRepository) Create(ctx context.Context, dto model.SystemNotificationSilence) (silenceId uuid.UUID, err error) {
+	res := r.db.WithContext(ctx).Create(&dto)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return dto.ID, nil
+}
+
+func (r *SystemNotificationSilenceRepository) Get(ctx context.Context, organizationId string, silenceId uuid.UUID) (out model.SystemNotificationSilence, err error) {
+	res := r.db.WithContext(ctx).Preload("Creator").
+		First(&out, "organization_id = ? AND id = ?", organizationId, silenceId)
+	if res.Error != nil {
+		return model.SystemNotificationSilence{}, res.Error
+	}
+	return
+}
+
+func (r *SystemNotificationSilenceRepository) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (out []model.SystemNotificationSilence, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+
+	_, res := pg.Fetch(r.db.WithContext(ctx).Preload("Creator").Model(&model.SystemNotificationSilence{}).
+		Where("organization_id = ?", organizationId), &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+// ListActive returns the silences of the organization which are in effect at the given time.
+func (r *SystemNotificationSilenceRepository) ListActive(ctx context.Context, organizationId string, now time.Time) (out []model.SystemNotificationSilence, err error) {
+	res := r.db.WithContext(ctx).
+		Where("organization_id = ? AND starts_at <= ? AND ends_at > ?", organizationId, now, now).
+		Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *SystemNotificationSilenceRepository) Expire(ctx context.Context, silenceId uuid.UUID, endsAt time.Time) error {
+	return r.db.WithContext(ctx).Model(&model.SystemNotificationSilence{}).
+		Where("id = ?", silenceId).Update("ends_at", endsAt).Error
+}
+
+func (r *SystemNotificationSilenceRepository) Delete(ctx context.Context, silenceId uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&model.SystemNotificationSilence{}, "id = ?", silenceId).Error
+}
+
+func (r *SystemNotificationSilenceRepository) CreateMaintenanceWindow(ctx context.Context, dto model.StackMaintenanceWindow) (maintenanceWindowId uuid.UUID, err error) {
+	res := r.db.WithContext(ctx).Create(&dto)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return dto.ID, nil
+}
+
+func (r *SystemNotificationSilenceRepository) GetMaintenanceWindow(ctx context.Context, organizationId string, maintenanceWindowId uuid.UUID) (out model.StackMaintenanceWindow, err error) {
+	res := r.db.WithContext(ctx).Preload("Creator").
+		First(&out, "organization_id = ? AND id = ?", organizationId, maintenanceWindowId)
+	if res.Error != nil {
+		return model.StackMaintenanceWindow{}, res.Error
+	}
+	return
+}
+
+func (r *SystemNotificationSilenceRepository) ListMaintenanceWindows(ctx context.Context, organizationId string, stackId domain.StackId) (out []model.StackMaintenanceWindow, err error) {
+	res := r.db.WithContext(ctx).Preload("Creator").
+		Where("organization_id = ? AND stack_id = ?", organizationId, stackId).
+		Order("created_at").Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *SystemNotificationSilenceRepository) UpdateMaintenanceWindow(ctx context.Context, maintenanceWindowId uuid.UUID, updateMap map[string]interface{}) error {
+	return r.db.WithContext(ctx).Model(&model.StackMaintenanceWindow{}).
+		Where("id = ?", maintenanceWindowId).Updates(updateMap).Error
+}
+
+func (r *SystemNotificationSilenceRepository) DeleteMaintenanceWindow(ctx context.Context, maintenanceWindowId uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&model.StackMaintenanceWindow{}, "id = ?", maintenanceWindowId).Error
+}
@@ -80,6 +80,11 @@ func (r *SystemNotificationRepository) FetchSystemNotifications(ctx context.Cont
 		Where("system_notification_rule_users.user_id is null OR system_notification_rule_users.user_id = ?", userInfo.GetUserId()).
 		Where("system_notifications.organization_id = ? AND system_notifications.notification_type = 'SYSTEM_NOTIFICATION'", organizationId)
 
+	// 억제된 알림은 suppressed 필터로 요청한 경우에만 조회한다.
+	if pg.GetFilter("suppressed") == nil {
+		db = db.Where("system_notifications.suppressed = ?", false)
+	}
+
 	readFilter := pg.GetFilter("read")
 	if readFilter != nil {
 		if readFilter.Values[0] == "true" {
@@ -114,6 +119,11 @@ func (r *SystemNotificationRepository) FetchPolicyNotifications(ctx context.Cont
 		Joins("join clusters on clusters.id = system_notifications.cluster_id AND clusters.status = 2").
 		Where("system_notifications.organization_id = ? AND system_notifications.notification_type = 'POLICY_NOTIFICATION'", organizationId)
 
+	// 억제된 알림은 suppressed 필터로 요청한 경우에만 조회한다.
+	if pg.GetFilter("suppressed") == nil {
+		db = db.Where("system_notifications.suppressed = ?", false)
+	}
+
 	readFilter := pg.GetFilter("read")
 	if readFilter != nil {
 		if readFilter.Values[0] == "true" {
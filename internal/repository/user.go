@@ -22,6 +22,9 @@ type IUserRepository interface {
 	GetByUuid(ctx context.Context, userId uuid.UUID) (model.User, error)
 	Update(ctx context.Context, user *model.User) (*model.User, error)
 	UpdatePasswordAt(ctx context.Context, userId uuid.UUID, organizationId string, isTemporary bool) error
+	UpdateLockedUntil(ctx context.Context, userId uuid.UUID, lockedUntil *time.Time) error
+	CreatePasswordHistory(ctx context.Context, userId uuid.UUID, passwordHash string) error
+	ListPasswordHistories(ctx context.Context, userId uuid.UUID, limit int) ([]model.PasswordHistory, error)
 	DeleteWithUuid(ctx context.Context, uuid uuid.UUID) error
 	Flush(ctx context.Context, organizationId string) error
 
@@ -241,6 +244,35 @@ func (r *UserRepository) UpdatePasswordAt(ctx context.Context, userId uuid.UUID,
 	return nil
 }
 
+func (r *UserRepository) UpdateLockedUntil(ctx context.Context, userId uuid.UUID, lockedUntil *time.Time) error {
+	res := r.db.WithContext(ctx).Model(&model.User{}).Where("id = ?", userId).
+		Update("locked_until", lockedUntil)
+	if res.Error != nil {
+		log.Errorf(ctx, "error is :%s(%T)", res.Error.Error(), res.Error)
+		return res.Error
+	}
+	return nil
+}
+
+func (r *UserRepository) CreatePasswordHistory(ctx context.Context, userId uuid.UUID, passwordHash string) error {
+	res := r.db.WithContext(ctx).Create(&model.PasswordHistory{UserId: userId, PasswordHash: passwordHash})
+	if res.Error != nil {
+		log.Errorf(ctx, "error is :%s(%T)", res.Error.Error(), res.Error)
+		return res.Error
+	}
+	return nil
+}
+
+// ListPasswordHistories returns the latest password histories of the user, newest first.
+func (r *UserRepository) ListPasswordHistories(ctx context.Context, userId uuid.UUID, limit int) (out []model.PasswordHistory, err error) {
+	res := r.db.WithContext(ctx).Where("user_id = ?", userId).Order("created_at DESC").Limit(limit).Find(&out)
+	if res.Error != nil {
+		log.Errorf(ctx, "error is :%s(%T)", res.Error.Error(), res.Error)
+		return nil, res.Error
+	}
+	return out, nil
+}
+
 func (r *UserRepository) DeleteWithUuid(ctx context.Context, uuid uuid.UUID) error {
 	var user model.User
 	if err := r.db.WithContext(ctx).Model(&model.User{}).Preload("Organization").Preload("Roles").Find(&user, "id = ?", uuid).Error; err != nil {
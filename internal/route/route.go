@@ -1,6 +1,7 @@
 package route
 
 import (
+	"context"
 	"net/http"
 	"time"
 
@@ -18,11 +19,13 @@ import (
 	"github.com/openinfradev/tks-api/internal/middleware/auth/authenticator"
 	authCustom "github.com/openinfradev/tks-api/internal/middleware/auth/authenticator/custom"
 	authKeycloak "github.com/openinfradev/tks-api/internal/middleware/auth/authenticator/keycloak"
+	authToken "github.com/openinfradev/tks-api/internal/middleware/auth/authenticator/token"
 	"github.com/openinfradev/tks-api/internal/middleware/auth/authorizer"
 	"github.com/openinfradev/tks-api/internal/repository"
 	"github.com/openinfradev/tks-api/internal/usecase"
 	argowf "github.com/openinfradev/tks-api/pkg/argo-client"
 	gcache "github.com/patrickmn/go-cache"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	httpSwagger "github.com/swaggo/http-swagger"
 	"gorm.io/gorm"
 )
@@ -36,57 +39,105 @@ var (
 	SYSTEM_API_PREFIX  = internal.SYSTEM_API_PREFIX
 )
 
-func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloak, asset http.Handler) http.Handler {
+func SetupRouter(ctx context.Context, db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloak, asset http.Handler) http.Handler {
 	r := mux.NewRouter()
 
 	cache := gcache.New(5*time.Minute, 10*time.Minute)
 
 	repoFactory := repository.Repository{
-		Auth:                       repository.NewAuthRepository(db),
-		User:                       repository.NewUserRepository(db),
-		Cluster:                    repository.NewClusterRepository(db),
-		Organization:               repository.NewOrganizationRepository(db),
-		AppGroup:                   repository.NewAppGroupRepository(db),
-		AppServeApp:                repository.NewAppServeAppRepository(db),
-		CloudAccount:               repository.NewCloudAccountRepository(db),
-		StackTemplate:              repository.NewStackTemplateRepository(db),
-		SystemNotification:         repository.NewSystemNotificationRepository(db),
-		SystemNotificationTemplate: repository.NewSystemNotificationTemplateRepository(db),
-		SystemNotificationRule:     repository.NewSystemNotificationRuleRepository(db),
-		Role:                       repository.NewRoleRepository(db),
-		Project:                    repository.NewProjectRepository(db),
-		Permission:                 repository.NewPermissionRepository(db),
-		Endpoint:                   repository.NewEndpointRepository(db),
-		Audit:                      repository.NewAuditRepository(db),
-		PolicyTemplate:             repository.NewPolicyTemplateRepository(db),
-		Policy:                     repository.NewPolicyRepository(db),
-		Dashboard:                  repository.NewDashboardRepository(db),
+		Auth:                         repository.NewAuthRepository(db),
+		User:                         repository.NewUserRepository(db),
+		Cluster:                      repository.NewClusterRepository(db),
+		Organization:                 repository.NewOrganizationRepository(db),
+		AppGroup:                     repository.NewAppGroupRepository(db),
+		AppServeApp:                  repository.NewAppServeAppRepository(db),
+		CloudAccount:                 repository.NewCloudAccountRepository(db),
+		StackTemplate:                repository.NewStackTemplateRepository(db),
+		SystemNotification:           repository.NewSystemNotificationRepository(db),
+		SystemNotificationTemplate:   repository.NewSystemNotificationTemplateRepository(db),
+		SystemNotificationRule:       repository.NewSystemNotificationRuleRepository(db),
+		Role:                         repository.NewRoleRepository(db),
+		Project:                      repository.NewProjectRepository(db),
+		Permission:                   repository.NewPermissionRepository(db),
+		Endpoint:                     repository.NewEndpointRepository(db),
+		Audit:                        repository.NewAuditRepository(db),
+		PolicyTemplate:               repository.NewPolicyTemplateRepository(db),
+		Policy:                       repository.NewPolicyRepository(db),
+		Dashboard:                    repository.NewDashboardRepository(db),
+		AccessToken:                  repository.NewAccessTokenRepository(db),
+		Mfa:                          repository.NewMfaRepository(db),
+		PolicyException:              repository.NewPolicyExceptionRepository(db),
+		PolicyViolation:              repository.NewPolicyViolationRepository(db),
+		StackPolicyTemplate:          repository.NewStackPolicyTemplateRepository(db),
+		PolicyBundle:                 repository.NewPolicyBundleRepository(db),
+		PolicyRollout:                repository.NewPolicyRolloutRepository(db),
+		ComplianceReport:             repository.NewComplianceReportRepository(db),
+		MutationPolicy:               repository.NewMutationPolicyRepository(db),
+		PolicyChangeRequest:          repository.NewPolicyChangeRequestRepository(db),
+		ProjectUsage:                 repository.NewProjectUsageRepository(db),
+		DashboardWidget:              repository.NewDashboardWidgetRepository(db),
+		SystemNotificationSilence:    repository.NewSystemNotificationSilenceRepository(db),
+		SystemNotificationEscalation: repository.NewSystemNotificationEscalationRepository(db),
+		JobLease:                     repository.NewJobLeaseRepository(db),
 	}
 
 	usecaseFactory := usecase.Usecase{
-		Auth:                       usecase.NewAuthUsecase(repoFactory, kc),
-		User:                       usecase.NewUserUsecase(repoFactory, kc),
-		Cluster:                    usecase.NewClusterUsecase(repoFactory, argoClient, cache, kc),
-		Organization:               usecase.NewOrganizationUsecase(repoFactory, argoClient, kc),
-		AppGroup:                   usecase.NewAppGroupUsecase(repoFactory, argoClient),
-		AppServeApp:                usecase.NewAppServeAppUsecase(repoFactory, argoClient),
-		CloudAccount:               usecase.NewCloudAccountUsecase(repoFactory, argoClient),
-		StackTemplate:              usecase.NewStackTemplateUsecase(repoFactory),
-		Dashboard:                  usecase.NewDashboardUsecase(repoFactory, cache),
-		SystemNotification:         usecase.NewSystemNotificationUsecase(repoFactory),
-		SystemNotificationTemplate: usecase.NewSystemNotificationTemplateUsecase(repoFactory),
-		SystemNotificationRule:     usecase.NewSystemNotificationRuleUsecase(repoFactory),
-		Stack:                      usecase.NewStackUsecase(repoFactory, argoClient, usecase.NewDashboardUsecase(repoFactory, cache), kc),
-		Project:                    usecase.NewProjectUsecase(repoFactory, kc, argoClient),
-		Audit:                      usecase.NewAuditUsecase(repoFactory),
-		Role:                       usecase.NewRoleUsecase(repoFactory, kc),
-		Permission:                 usecase.NewPermissionUsecase(repoFactory, kc),
-		PolicyTemplate:             usecase.NewPolicyTemplateUsecase(repoFactory),
-		Policy:                     usecase.NewPolicyUsecase(repoFactory),
+		Auth:                         usecase.NewAuthUsecase(repoFactory, kc),
+		User:                         usecase.NewUserUsecase(repoFactory, kc),
+		Cluster:                      usecase.NewClusterUsecase(repoFactory, argoClient, cache, kc),
+		Organization:                 usecase.NewOrganizationUsecase(repoFactory, argoClient, kc),
+		AppGroup:                     usecase.NewAppGroupUsecase(repoFactory, argoClient),
+		AppServeApp:                  usecase.NewAppServeAppUsecase(repoFactory, argoClient),
+		CloudAccount:                 usecase.NewCloudAccountUsecase(repoFactory, argoClient),
+		StackTemplate:                usecase.NewStackTemplateUsecase(repoFactory),
+		Dashboard:                    usecase.NewDashboardUsecase(repoFactory, cache),
+		SystemNotification:           usecase.NewSystemNotificationUsecase(repoFactory),
+		SystemNotificationTemplate:   usecase.NewSystemNotificationTemplateUsecase(repoFactory),
+		SystemNotificationRule:       usecase.NewSystemNotificationRuleUsecase(repoFactory, usecase.NewDashboardUsecase(repoFactory, cache)),
+		Stack:                        usecase.NewStackUsecase(repoFactory, argoClient, usecase.NewDashboardUsecase(repoFactory, cache), kc),
+		Project:                      usecase.NewProjectUsecase(repoFactory, kc, argoClient),
+		Audit:                        usecase.NewAuditUsecase(repoFactory),
+		Role:                         usecase.NewRoleUsecase(repoFactory, kc),
+		Permission:                   usecase.NewPermissionUsecase(repoFactory, kc),
+		PolicyTemplate:               usecase.NewPolicyTemplateUsecase(repoFactory),
+		Policy:                       usecase.NewPolicyUsecase(repoFactory),
+		AccessToken:                  usecase.NewAccessTokenUsecase(repoFactory),
+		Mfa:                          usecase.NewMfaUsecase(repoFactory),
+		PolicyException:              usecase.NewPolicyExceptionUsecase(repoFactory),
+		PolicyViolation:              usecase.NewPolicyViolationUsecase(repoFactory),
+		PolicyBundle:                 usecase.NewPolicyBundleUsecase(repoFactory, usecase.NewPolicyUsecase(repoFactory)),
+		PolicyRollout:                usecase.NewPolicyRolloutUsecase(repoFactory, usecase.NewDashboardUsecase(repoFactory, cache)),
+		ComplianceReport:             usecase.NewComplianceReportUsecase(repoFactory),
+		MutationPolicy:               usecase.NewMutationPolicyUsecase(repoFactory),
+		PolicyChangeRequest:          usecase.NewPolicyChangeRequestUsecase(repoFactory, usecase.NewPolicyUsecase(repoFactory)),
+		Cost:                         usecase.NewCostUsecase(repoFactory, usecase.NewClusterUsecase(repoFactory, argoClient, cache, kc)),
+		ProjectUsage:                 usecase.NewProjectUsageUsecase(repoFactory, usecase.NewDashboardUsecase(repoFactory, cache)),
+		DashboardWidget:              usecase.NewDashboardWidgetUsecase(repoFactory, usecase.NewDashboardUsecase(repoFactory, cache)),
+		SystemNotificationSilence:    usecase.NewSystemNotificationSilenceUsecase(repoFactory),
+		SystemNotificationEscalation: usecase.NewSystemNotificationEscalationUsecase(repoFactory),
 	}
 
+	// 주기 작업들은 replica 중 lease 를 획득한 서버 하나에서만 실행되며, 서버가 종료되어 ctx 가 취소되면 중단된다.
+
+	// 만료된 정책 예외를 주기적으로 TKSPolicy CR 에서 제거
+	go usecaseFactory.PolicyException.WatchExpiration(ctx, internal.PolicyExceptionExpirationInterval)
+	// TKSPolicy CR 의 audit 결과를 주기적으로 정책 위반 기록에 반영
+	go usecaseFactory.PolicyViolation.WatchAuditViolations(ctx, internal.PolicyViolationSyncInterval)
+	// 단계적 적용 중인 정책의 위반 수를 주기적으로 확인하여 스택별로 deny 로 승격
+	go usecaseFactory.PolicyRollout.WatchRollouts(ctx, internal.PolicyRolloutInterval)
+	// 매월 지난달의 조직별 정책 준수 보고서를 생성
+	go usecaseFactory.ComplianceReport.WatchMonthlyReports(ctx, internal.ComplianceReportInterval)
+	// 만료 시각이 지난 승인 대기 중인 정책 변경 요청을 주기적으로 만료 처리
+	go usecaseFactory.PolicyChangeRequest.WatchExpiration(ctx, internal.PolicyChangeRequestExpirationInterval)
+	// 클라우드 계정에 생성한 IAM 을 TKS 의 인증 정보로 사용할 수 있는지 주기적으로 점검
+	go usecaseFactory.CloudAccount.WatchCredentials(ctx, internal.CloudAccountCredentialCheckInterval)
+	// 프로젝트 네임스페이스의 일별 자원 사용량을 Thanos 에서 집계
+	go usecaseFactory.ProjectUsage.WatchDailyUsages(ctx, internal.ProjectUsageRollupInterval)
+	// 조치되지 않은 시스템 알림을 에스컬레이션 정책에 따라 다음 단계의 대상에게 발송
+	go usecaseFactory.SystemNotificationEscalation.WatchEscalations(ctx, internal.SystemNotificationEscalationInterval)
+
 	customMiddleware := internalMiddleware.NewMiddleware(
-		authenticator.NewAuthenticator(authKeycloak.NewKeycloakAuthenticator(kc), repoFactory, authCustom.NewCustomAuthenticator(repoFactory)),
+		authenticator.NewAuthenticator(authKeycloak.NewKeycloakAuthenticator(kc), repoFactory, authCustom.NewCustomAuthenticator(repoFactory), authToken.NewAccessTokenAuthenticator(repoFactory)),
 		authorizer.NewDefaultAuthorization(repoFactory),
 		requestRecoder.NewDefaultRequestRecoder(),
 		audit.NewDefaultAudit(repoFactory))
@@ -111,10 +162,13 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 	userHandler := delivery.NewUserHandler(usecaseFactory)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/users", customMiddleware.Handle(internalApi.CreateUser, http.HandlerFunc(userHandler.Create))).Methods(http.MethodPost)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/users", customMiddleware.Handle(internalApi.ListUser, http.HandlerFunc(userHandler.List))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/users/import", customMiddleware.Handle(internalApi.ImportUsers, http.HandlerFunc(userHandler.ImportUsers))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/users/export", customMiddleware.Handle(internalApi.ExportUsers, http.HandlerFunc(userHandler.ExportUsers))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/users/{accountId}", customMiddleware.Handle(internalApi.GetUser, http.HandlerFunc(userHandler.Get))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/users", customMiddleware.Handle(internalApi.UpdateUsers, http.HandlerFunc(userHandler.UpdateUsers))).Methods(http.MethodPut)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/users/{accountId}", customMiddleware.Handle(internalApi.UpdateUser, http.HandlerFunc(userHandler.Update))).Methods(http.MethodPut)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/users/{accountId}/reset-password", customMiddleware.Handle(internalApi.ResetPassword, http.HandlerFunc(userHandler.ResetPassword))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/users/{accountId}/unlock", customMiddleware.Handle(internalApi.UnlockUser, http.HandlerFunc(userHandler.Unlock))).Methods(http.MethodPut)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/users/{accountId}", customMiddleware.Handle(internalApi.DeleteUser, http.HandlerFunc(userHandler.Delete))).Methods(http.MethodDelete)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/users/account-id/{accountId}/existence", customMiddleware.Handle(internalApi.CheckId, http.HandlerFunc(userHandler.CheckId))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/users/email/{email}/existence", customMiddleware.Handle(internalApi.CheckEmail, http.HandlerFunc(userHandler.CheckEmail))).Methods(http.MethodGet)
@@ -126,6 +180,28 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/my-profile", customMiddleware.Handle(internalApi.DeleteMyProfile, http.HandlerFunc(userHandler.DeleteMyProfile))).Methods(http.MethodDelete)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/users/{accountId}/permissions", customMiddleware.Handle(internalApi.GetPermissionsByAccountId, http.HandlerFunc(userHandler.GetPermissionsByAccountId))).Methods(http.MethodGet)
 
+	accessTokenHandler := delivery.NewAccessTokenHandler(usecaseFactory)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/my-profile/access-tokens", customMiddleware.Handle(internalApi.CreateMyAccessToken, http.HandlerFunc(accessTokenHandler.CreateMyAccessToken))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/my-profile/access-tokens", customMiddleware.Handle(internalApi.GetMyAccessTokens, http.HandlerFunc(accessTokenHandler.GetMyAccessTokens))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/my-profile/access-tokens/{accessTokenId}", customMiddleware.Handle(internalApi.RevokeMyAccessToken, http.HandlerFunc(accessTokenHandler.RevokeMyAccessToken))).Methods(http.MethodDelete)
+	mfaHandler := delivery.NewMfaHandler(usecaseFactory)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/my-profile/mfa", customMiddleware.Handle(internalApi.GetMyMfa, http.HandlerFunc(mfaHandler.GetMyMfa))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/my-profile/mfa/enroll", customMiddleware.Handle(internalApi.EnrollMfa, http.HandlerFunc(mfaHandler.EnrollMfa))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/my-profile/mfa/confirm", customMiddleware.Handle(internalApi.ConfirmMfa, http.HandlerFunc(mfaHandler.ConfirmMfa))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/my-profile/mfa/disable", customMiddleware.Handle(internalApi.DisableMfa, http.HandlerFunc(mfaHandler.DisableMfa))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/my-profile/mfa/recovery-codes", customMiddleware.Handle(internalApi.RegenerateMfaRecoveryCodes, http.HandlerFunc(mfaHandler.RegenerateMfaRecoveryCodes))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/my-profile/mfa/verify", customMiddleware.Handle(internalApi.VerifyMfa, http.HandlerFunc(mfaHandler.VerifyMfa))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/mfa-policy", customMiddleware.Handle(internalApi.GetMfaPolicy, http.HandlerFunc(mfaHandler.GetMfaPolicy))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/mfa-policy", customMiddleware.Handle(internalApi.UpdateMfaPolicy, http.HandlerFunc(mfaHandler.UpdateMfaPolicy))).Methods(http.MethodPut)
+
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/service-accounts", customMiddleware.Handle(internalApi.CreateServiceAccount, http.HandlerFunc(accessTokenHandler.CreateServiceAccount))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/service-accounts", customMiddleware.Handle(internalApi.GetServiceAccounts, http.HandlerFunc(accessTokenHandler.GetServiceAccounts))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/service-accounts/{serviceAccountId}", customMiddleware.Handle(internalApi.GetServiceAccount, http.HandlerFunc(accessTokenHandler.GetServiceAccount))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/service-accounts/{serviceAccountId}", customMiddleware.Handle(internalApi.DeleteServiceAccount, http.HandlerFunc(accessTokenHandler.DeleteServiceAccount))).Methods(http.MethodDelete)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/service-accounts/{serviceAccountId}/keys", customMiddleware.Handle(internalApi.CreateServiceAccountKey, http.HandlerFunc(accessTokenHandler.CreateServiceAccountKey))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/service-accounts/{serviceAccountId}/keys", customMiddleware.Handle(internalApi.GetServiceAccountKeys, http.HandlerFunc(accessTokenHandler.GetServiceAccountKeys))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/service-accounts/{serviceAccountId}/keys/{accessTokenId}", customMiddleware.Handle(internalApi.RevokeServiceAccountKey, http.HandlerFunc(accessTokenHandler.RevokeServiceAccountKey))).Methods(http.MethodDelete)
+
 	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/organizations/{organizationId}/users", customMiddleware.Handle(internalApi.Admin_CreateUser, http.HandlerFunc(userHandler.Admin_Create))).Methods(http.MethodPost)
 	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/organizations/{organizationId}/users/{accountId}", customMiddleware.Handle(internalApi.Admin_UpdateUser, http.HandlerFunc(userHandler.Admin_Update))).Methods(http.MethodPut)
 	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/organizations/{organizationId}/users/{accountId}", customMiddleware.Handle(internalApi.Admin_DeleteUser, http.HandlerFunc(userHandler.Admin_Delete))).Methods(http.MethodDelete)
@@ -137,6 +213,8 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}", customMiddleware.Handle(internalApi.GetOrganization, http.HandlerFunc(organizationHandler.GetOrganization))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}", customMiddleware.Handle(internalApi.UpdateOrganization, http.HandlerFunc(organizationHandler.UpdateOrganization))).Methods(http.MethodPut)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/primary-cluster", customMiddleware.Handle(internalApi.UpdatePrimaryCluster, http.HandlerFunc(organizationHandler.UpdatePrimaryCluster))).Methods(http.MethodPatch)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/password-policy", customMiddleware.Handle(internalApi.GetPasswordPolicy, http.HandlerFunc(organizationHandler.GetPasswordPolicy))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/password-policy", customMiddleware.Handle(internalApi.UpdatePasswordPolicy, http.HandlerFunc(organizationHandler.UpdatePasswordPolicy))).Methods(http.MethodPut)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/name/{name}/existence", customMiddleware.Handle(internalApi.CheckOrganizationName, http.HandlerFunc(organizationHandler.CheckOrganizationName))).Methods(http.MethodGet)
 
 	clusterHandler := delivery.NewClusterHandler(usecaseFactory)
@@ -184,6 +262,8 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/cloud-accounts/{cloudAccountId}", customMiddleware.Handle(internalApi.DeleteCloudAccount, http.HandlerFunc(cloudAccountHandler.DeleteCloudAccount))).Methods(http.MethodDelete)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/cloud-accounts/{cloudAccountId}/error", customMiddleware.Handle(internalApi.DeleteForceCloudAccount, http.HandlerFunc(cloudAccountHandler.DeleteForceCloudAccount))).Methods(http.MethodDelete)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/cloud-accounts/{cloudAccountId}/quotas", customMiddleware.Handle(internalApi.GetResourceQuota, http.HandlerFunc(cloudAccountHandler.GetResourceQuota))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/cloud-accounts/{cloudAccountId}/credentials", customMiddleware.Handle(internalApi.RotateCloudAccountCredential, http.HandlerFunc(cloudAccountHandler.RotateCloudAccountCredential))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/cloud-accounts/{cloudAccountId}/credentials/check", customMiddleware.Handle(internalApi.CheckCloudAccountCredential, http.HandlerFunc(cloudAccountHandler.CheckCloudAccountCredential))).Methods(http.MethodPost)
 
 	stackTemplateHandler := delivery.NewStackTemplateHandler(usecaseFactory)
 	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/stack-templates", customMiddleware.Handle(internalApi.Admin_GetStackTemplates, http.HandlerFunc(stackTemplateHandler.GetStackTemplates))).Methods(http.MethodGet)
@@ -213,9 +293,25 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/widgets/policy-statistics", customMiddleware.Handle(internalApi.GetPolicyStatisticsDashboard, http.HandlerFunc(dashboardHandler.GetPolicyStatistics))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/widgets/workload", customMiddleware.Handle(internalApi.GetWorkloadDashboard, http.HandlerFunc(dashboardHandler.GetWorkload))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/widgets/policy-violation-top5", customMiddleware.Handle(internalApi.GetPolicyViolationTop5Dashboard, http.HandlerFunc(dashboardHandler.GetPolicyViolationTop5))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/widgets/cost", customMiddleware.Handle(internalApi.GetCostDashboard, http.HandlerFunc(dashboardHandler.GetCost))).Methods(http.MethodGet)
+
+	dashboardWidgetHandler := delivery.NewDashboardWidgetHandler(usecaseFactory)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/widgets/custom", customMiddleware.Handle(internalApi.CreateDashboardWidget, http.HandlerFunc(dashboardWidgetHandler.CreateDashboardWidget))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/widgets/custom", customMiddleware.Handle(internalApi.ListDashboardWidgets, http.HandlerFunc(dashboardWidgetHandler.ListDashboardWidgets))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/widgets/custom/{dashboardWidgetId}", customMiddleware.Handle(internalApi.GetDashboardWidget, http.HandlerFunc(dashboardWidgetHandler.GetDashboardWidget))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/widgets/custom/{dashboardWidgetId}", customMiddleware.Handle(internalApi.UpdateDashboardWidget, http.HandlerFunc(dashboardWidgetHandler.UpdateDashboardWidget))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/widgets/custom/{dashboardWidgetId}", customMiddleware.Handle(internalApi.DeleteDashboardWidget, http.HandlerFunc(dashboardWidgetHandler.DeleteDashboardWidget))).Methods(http.MethodDelete)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/widgets/custom/{dashboardWidgetId}/data", customMiddleware.Handle(internalApi.GetDashboardWidgetData, http.HandlerFunc(dashboardWidgetHandler.GetDashboardWidgetData))).Methods(http.MethodGet)
+
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards", customMiddleware.Handle(internalApi.CreateDashboard, http.HandlerFunc(dashboardHandler.CreateDashboard))).Methods(http.MethodPost)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/{dashboardKey}", customMiddleware.Handle(internalApi.GetDashboard, http.HandlerFunc(dashboardHandler.GetDashboard))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/{dashboardKey}", customMiddleware.Handle(internalApi.UpdateDashboard, http.HandlerFunc(dashboardHandler.UpdateDashboard))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/{dashboardKey}/reset", customMiddleware.Handle(internalApi.ResetDashboard, http.HandlerFunc(dashboardHandler.ResetDashboard))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/{dashboardKey}/export", customMiddleware.Handle(internalApi.ExportDashboard, http.HandlerFunc(dashboardHandler.ExportDashboard))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/{dashboardKey}/import", customMiddleware.Handle(internalApi.ImportDashboard, http.HandlerFunc(dashboardHandler.ImportDashboard))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/{dashboardKey}/default", customMiddleware.Handle(internalApi.GetDefaultDashboard, http.HandlerFunc(dashboardHandler.GetDefaultDashboard))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/{dashboardKey}/default", customMiddleware.Handle(internalApi.SetDefaultDashboard, http.HandlerFunc(dashboardHandler.SetDefaultDashboard))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/{dashboardKey}/default", customMiddleware.Handle(internalApi.DeleteDefaultDashboard, http.HandlerFunc(dashboardHandler.DeleteDefaultDashboard))).Methods(http.MethodDelete)
 
 	systemNotificationTemplateHandler := delivery.NewSystemNotificationTemplateHandler(usecaseFactory)
 	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/system-notification-templates", customMiddleware.Handle(internalApi.Admin_CreateSystemNotificationTemplate, http.HandlerFunc(systemNotificationTemplateHandler.CreateSystemNotificationTemplate))).Methods(http.MethodPost)
@@ -233,11 +329,32 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/system-notification-rules", customMiddleware.Handle(internalApi.CreateSystemNotificationRule, http.HandlerFunc(systemNotificationRuleHandler.CreateSystemNotificationRule))).Methods(http.MethodPost)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/system-notification-rules", customMiddleware.Handle(internalApi.GetSystemNotificationRules, http.HandlerFunc(systemNotificationRuleHandler.GetSystemNotificationRules))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/system-notification-rules/default-system-rules", customMiddleware.Handle(internalApi.CreateSystemNotificationRule, http.HandlerFunc(systemNotificationRuleHandler.MakeDefaultSystemNotificationRules))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/system-notification-rules/preview", customMiddleware.Handle(internalApi.PreviewSystemNotificationRule, http.HandlerFunc(systemNotificationRuleHandler.PreviewSystemNotificationRule))).Methods(http.MethodPost)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/system-notification-rules/name/{name}/existence", customMiddleware.Handle(internalApi.CheckSystemNotificationRuleName, http.HandlerFunc(systemNotificationRuleHandler.CheckSystemNotificationRuleName))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/system-notification-rules/{systemNotificationRuleId}", customMiddleware.Handle(internalApi.GetSystemNotificationRule, http.HandlerFunc(systemNotificationRuleHandler.GetSystemNotificationRule))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/system-notification-rules/{systemNotificationRuleId}", customMiddleware.Handle(internalApi.UpdateSystemNotificationRule, http.HandlerFunc(systemNotificationRuleHandler.UpdateSystemNotificationRule))).Methods(http.MethodPut)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/system-notification-rules/{systemNotificationRuleId}", customMiddleware.Handle(internalApi.DeleteSystemNotificationRule, http.HandlerFunc(systemNotificationRuleHandler.DeleteSystemNotificationRule))).Methods(http.MethodDelete)
 
+	systemNotificationSilenceHandler := delivery.NewSystemNotificationSilenceHandler(usecaseFactory)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/system-notification-silences", customMiddleware.Handle(internalApi.CreateSystemNotificationSilence, http.HandlerFunc(systemNotificationSilenceHandler.CreateSystemNotificationSilence))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/system-notification-silences", customMiddleware.Handle(internalApi.GetSystemNotificationSilences, http.HandlerFunc(systemNotificationSilenceHandler.GetSystemNotificationSilences))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/system-notification-silences/{systemNotificationSilenceId}", customMiddleware.Handle(internalApi.GetSystemNotificationSilence, http.HandlerFunc(systemNotificationSilenceHandler.GetSystemNotificationSilence))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/system-notification-silences/{systemNotificationSilenceId}", customMiddleware.Handle(internalApi.ExpireSystemNotificationSilence, http.HandlerFunc(systemNotificationSilenceHandler.ExpireSystemNotificationSilence))).Methods(http.MethodDelete)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/maintenance-windows", customMiddleware.Handle(internalApi.CreateStackMaintenanceWindow, http.HandlerFunc(systemNotificationSilenceHandler.CreateStackMaintenanceWindow))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/maintenance-windows", customMiddleware.Handle(internalApi.GetStackMaintenanceWindows, http.HandlerFunc(systemNotificationSilenceHandler.GetStackMaintenanceWindows))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/maintenance-windows/{maintenanceWindowId}", customMiddleware.Handle(internalApi.UpdateStackMaintenanceWindow, http.HandlerFunc(systemNotificationSilenceHandler.UpdateStackMaintenanceWindow))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/maintenance-windows/{maintenanceWindowId}", customMiddleware.Handle(internalApi.DeleteStackMaintenanceWindow, http.HandlerFunc(systemNotificationSilenceHandler.DeleteStackMaintenanceWindow))).Methods(http.MethodDelete)
+
+	systemNotificationEscalationHandler := delivery.NewSystemNotificationEscalationHandler(usecaseFactory)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/on-call-schedules", customMiddleware.Handle(internalApi.CreateOnCallSchedule, http.HandlerFunc(systemNotificationEscalationHandler.CreateOnCallSchedule))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/on-call-schedules", customMiddleware.Handle(internalApi.GetOnCallSchedules, http.HandlerFunc(systemNotificationEscalationHandler.GetOnCallSchedules))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/on-call-schedules/{onCallScheduleId}", customMiddleware.Handle(internalApi.GetOnCallSchedule, http.HandlerFunc(systemNotificationEscalationHandler.GetOnCallSchedule))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/on-call-schedules/{onCallScheduleId}", customMiddleware.Handle(internalApi.UpdateOnCallSchedule, http.HandlerFunc(systemNotificationEscalationHandler.UpdateOnCallSchedule))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/on-call-schedules/{onCallScheduleId}", customMiddleware.Handle(internalApi.DeleteOnCallSchedule, http.HandlerFunc(systemNotificationEscalationHandler.DeleteOnCallSchedule))).Methods(http.MethodDelete)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/system-notification-rules/{systemNotificationRuleId}/escalation-policy", customMiddleware.Handle(internalApi.GetSystemNotificationEscalationPolicy, http.HandlerFunc(systemNotificationEscalationHandler.GetSystemNotificationEscalationPolicy))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/system-notification-rules/{systemNotificationRuleId}/escalation-policy", customMiddleware.Handle(internalApi.SetSystemNotificationEscalationPolicy, http.HandlerFunc(systemNotificationEscalationHandler.SetSystemNotificationEscalationPolicy))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/system-notification-rules/{systemNotificationRuleId}/escalation-policy", customMiddleware.Handle(internalApi.DeleteSystemNotificationEscalationPolicy, http.HandlerFunc(systemNotificationEscalationHandler.DeleteSystemNotificationEscalationPolicy))).Methods(http.MethodDelete)
+
 	systemNotificationHandler := delivery.NewSystemNotificationHandler(usecaseFactory)
 	r.HandleFunc(SYSTEM_API_PREFIX+SYSTEM_API_VERSION+"/system-notifications", systemNotificationHandler.CreateSystemNotification).Methods(http.MethodPost)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/system-notifications", customMiddleware.Handle(internalApi.GetSystemNotifications, http.HandlerFunc(systemNotificationHandler.GetSystemNotifications))).Methods(http.MethodGet)
@@ -252,8 +369,10 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policy-notifications/{policyNotificationId}", customMiddleware.Handle(internalApi.GetSystemNotification, http.HandlerFunc(policyNotificationHandler.GetPolicyNotification))).Methods(http.MethodGet)
 
 	stackHandler := delivery.NewStackHandler(usecaseFactory)
+	costHandler := delivery.NewCostHandler(usecaseFactory)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks", customMiddleware.Handle(internalApi.GetStacks, http.HandlerFunc(stackHandler.GetStacks))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks", customMiddleware.Handle(internalApi.CreateStack, http.HandlerFunc(stackHandler.CreateStack))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/cost-estimate", customMiddleware.Handle(internalApi.EstimateStackCost, http.HandlerFunc(costHandler.EstimateStackCost))).Methods(http.MethodPost)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/name/{name}/existence", customMiddleware.Handle(internalApi.CheckStackName, http.HandlerFunc(stackHandler.CheckStackName))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}", customMiddleware.Handle(internalApi.GetStack, http.HandlerFunc(stackHandler.GetStack))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}", customMiddleware.Handle(internalApi.UpdateStack, http.HandlerFunc(stackHandler.UpdateStack))).Methods(http.MethodPut)
@@ -263,6 +382,7 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/favorite", customMiddleware.Handle(internalApi.SetFavoriteStack, http.HandlerFunc(stackHandler.SetFavorite))).Methods(http.MethodPost)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/favorite", customMiddleware.Handle(internalApi.DeleteFavoriteStack, http.HandlerFunc(stackHandler.DeleteFavorite))).Methods(http.MethodDelete)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/install", customMiddleware.Handle(internalApi.InstallStack, http.HandlerFunc(stackHandler.InstallStack))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/cost", customMiddleware.Handle(internalApi.GetStackCost, http.HandlerFunc(costHandler.GetStackCost))).Methods(http.MethodGet)
 
 	projectHandler := delivery.NewProjectHandler(usecaseFactory)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects", customMiddleware.Handle(internalApi.CreateProject, http.HandlerFunc(projectHandler.CreateProject))).Methods(http.MethodPost)
@@ -272,6 +392,9 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/existence", customMiddleware.Handle(internalApi.GetProjectNamespace, http.HandlerFunc(projectHandler.IsProjectNameExist))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}", customMiddleware.Handle(internalApi.GetProject, http.HandlerFunc(projectHandler.GetProject))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}", customMiddleware.Handle(internalApi.UpdateProject, http.HandlerFunc(projectHandler.UpdateProject))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}/cost", customMiddleware.Handle(internalApi.GetProjectCost, http.HandlerFunc(costHandler.GetProjectCost))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}/usage", customMiddleware.Handle(internalApi.GetProjectUsage, http.HandlerFunc(projectHandler.GetProjectUsage))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}/usage/export", customMiddleware.Handle(internalApi.ExportProjectUsage, http.HandlerFunc(projectHandler.ExportProjectUsage))).Methods(http.MethodGet)
 	//r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}", customMiddleware.Handle(internalApi.DeleteProject, http.HandlerFunc(projectHandler.DeleteProject))).Methods(http.MethodDelete)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}/members", customMiddleware.Handle(internalApi.AddProjectMember, http.HandlerFunc(projectHandler.AddProjectMember))).Methods(http.MethodPost)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}/members/count", customMiddleware.Handle(internalApi.GetProjectMembers, http.HandlerFunc(projectHandler.GetProjectMemberCount))).Methods(http.MethodGet)
@@ -321,6 +444,7 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 	policyTemplateHandler := delivery.NewPolicyTemplateHandler(usecaseFactory)
 	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/policy-templates", customMiddleware.Handle(internalApi.Admin_ListPolicyTemplate, http.HandlerFunc(policyTemplateHandler.Admin_ListPolicyTemplate))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/policy-templates", customMiddleware.Handle(internalApi.Admin_CreatePolicyTemplate, http.HandlerFunc(policyTemplateHandler.Admin_CreatePolicyTemplate))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/policy-templates/import", customMiddleware.Handle(internalApi.Admin_ImportPolicyTemplate, http.HandlerFunc(policyTemplateHandler.Admin_ImportPolicyTemplate))).Methods(http.MethodPost)
 	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/policy-templates/{policyTemplateId}", customMiddleware.Handle(internalApi.Admin_DeletePolicyTemplate, http.HandlerFunc(policyTemplateHandler.Admin_DeletePolicyTemplate))).Methods(http.MethodDelete)
 	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/policy-templates/{policyTemplateId}", customMiddleware.Handle(internalApi.Admin_GetPolicyTemplate, http.HandlerFunc(policyTemplateHandler.Admin_GetPolicyTemplate))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/policy-templates/{policyTemplateId}", customMiddleware.Handle(internalApi.Admin_UpdatePolicyTemplate, http.HandlerFunc(policyTemplateHandler.Admin_UpdatePolicyTemplate))).Methods(http.MethodPatch)
@@ -331,6 +455,7 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/policy-templates/{policyTemplateId}/versions/{version}", customMiddleware.Handle(internalApi.Admin_DeletePolicyTemplateVersion, http.HandlerFunc(policyTemplateHandler.Admin_DeletePolicyTemplateVersion))).Methods(http.MethodDelete)
 	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/policy-templates/{policyTemplateId}/versions/{version}", customMiddleware.Handle(internalApi.Admin_GetPolicyTemplateVersion, http.HandlerFunc(policyTemplateHandler.Admin_GetPolicyTemplateVersion))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/policy-templates/{policyTemplateId}/versions/{version}/extract-parameters", customMiddleware.Handle(internalApi.Admin_ExtractParameters, http.HandlerFunc(policyTemplateHandler.Admin_ExtractParameters))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/policy-templates/{policyTemplateId}/versions/{version}/export", customMiddleware.Handle(internalApi.Admin_ExportPolicyTemplate, http.HandlerFunc(policyTemplateHandler.Admin_ExportPolicyTemplate))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/policy-templates/kind/{policyTemplateKind}/existence", customMiddleware.Handle(internalApi.Admin_ExistsPolicyTemplateKind, http.HandlerFunc(policyTemplateHandler.Admin_ExistsPolicyTemplateKind))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/policy-templates/name/{policyTemplateName}/existence", customMiddleware.Handle(internalApi.Admin_ExistsPolicyTemplateName, http.HandlerFunc(policyTemplateHandler.Admin_ExistsPolicyTemplateName))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/organizations/{organizationId}/policyTemplates", customMiddleware.Handle(internalApi.Admin_AddPermittedPolicyTemplatesForOrganization, http.HandlerFunc(policyTemplateHandler.Admin_AddPermittedPolicyTemplatesForOrganization))).Methods(http.MethodPost)
@@ -339,6 +464,7 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 	r.Handle(API_PREFIX+API_VERSION+"/policy-templates/rego-compile", customMiddleware.Handle(internalApi.CompileRego, http.HandlerFunc(policyTemplateHandler.RegoCompile))).Methods(http.MethodPost)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policy-templates", customMiddleware.Handle(internalApi.ListPolicyTemplate, http.HandlerFunc(policyTemplateHandler.ListPolicyTemplate))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policy-templates", customMiddleware.Handle(internalApi.CreatePolicyTemplate, http.HandlerFunc(policyTemplateHandler.CreatePolicyTemplate))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policy-templates/import", customMiddleware.Handle(internalApi.ImportPolicyTemplate, http.HandlerFunc(policyTemplateHandler.ImportPolicyTemplate))).Methods(http.MethodPost)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policy-templates/{policyTemplateId}", customMiddleware.Handle(internalApi.DeletePolicyTemplate, http.HandlerFunc(policyTemplateHandler.DeletePolicyTemplate))).Methods(http.MethodDelete)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policy-templates/{policyTemplateId}", customMiddleware.Handle(internalApi.GetPolicyTemplate, http.HandlerFunc(policyTemplateHandler.GetPolicyTemplate))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policy-templates/{policyTemplateId}", customMiddleware.Handle(internalApi.UpdatePolicyTemplate, http.HandlerFunc(policyTemplateHandler.UpdatePolicyTemplate))).Methods(http.MethodPatch)
@@ -351,8 +477,11 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policy-templates/kind/{policyTemplateKind}/existence", customMiddleware.Handle(internalApi.ExistsPolicyTemplateKind, http.HandlerFunc(policyTemplateHandler.ExistsPolicyTemplateKind))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policy-templates/name/{policyTemplateName}/existence", customMiddleware.Handle(internalApi.ExistsPolicyTemplateName, http.HandlerFunc(policyTemplateHandler.ExistsPolicyTemplateName))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policy-templates/{policyTemplateId}/versions/{version}/extract-parameters", customMiddleware.Handle(internalApi.ExtractParameters, http.HandlerFunc(policyTemplateHandler.ExtractParameters))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policy-templates/{policyTemplateId}/versions/{version}/export", customMiddleware.Handle(internalApi.ExportPolicyTemplate, http.HandlerFunc(policyTemplateHandler.ExportPolicyTemplate))).Methods(http.MethodGet)
 
 	policyHandler := delivery.NewPolicyHandler(usecaseFactory)
+	policyExceptionHandler := delivery.NewPolicyExceptionHandler(usecaseFactory)
+	policyRolloutHandler := delivery.NewPolicyRolloutHandler(usecaseFactory)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/mandatory-policies", customMiddleware.Handle(internalApi.GetMandatoryPolicies, http.HandlerFunc(policyHandler.GetMandatoryPolicies))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/mandatory-policies", customMiddleware.Handle(internalApi.SetMandatoryPolicies, http.HandlerFunc(policyHandler.SetMandatoryPolicies))).Methods(http.MethodPatch)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policy-statistics", customMiddleware.Handle(internalApi.GetPolicyStatistics, http.HandlerFunc(policyHandler.GetPolicyStatistics))).Methods(http.MethodGet)
@@ -360,6 +489,12 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policies", customMiddleware.Handle(internalApi.CreatePolicy, http.HandlerFunc(policyHandler.CreatePolicy))).Methods(http.MethodPost)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policies/{policyId}/clusters", customMiddleware.Handle(internalApi.UpdatePolicyTargetClusters, http.HandlerFunc(policyHandler.UpdatePolicyTargetClusters))).Methods(http.MethodPatch)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policies/{policyId}/edit", customMiddleware.Handle(internalApi.GetPolicyEdit, http.HandlerFunc(policyHandler.GetPolicyEdit))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policies/{policyId}/exceptions", customMiddleware.Handle(internalApi.CreatePolicyException, http.HandlerFunc(policyExceptionHandler.CreatePolicyException))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policies/{policyId}/exceptions", customMiddleware.Handle(internalApi.ListPolicyExceptions, http.HandlerFunc(policyExceptionHandler.ListPolicyExceptions))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policies/{policyId}/exceptions/{exceptionId}", customMiddleware.Handle(internalApi.DeletePolicyException, http.HandlerFunc(policyExceptionHandler.DeletePolicyException))).Methods(http.MethodDelete)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policies/{policyId}/rollout", customMiddleware.Handle(internalApi.StartPolicyRollout, http.HandlerFunc(policyRolloutHandler.StartPolicyRollout))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policies/{policyId}/rollout", customMiddleware.Handle(internalApi.GetPolicyRollout, http.HandlerFunc(policyRolloutHandler.GetPolicyRollout))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policies/{policyId}/rollout", customMiddleware.Handle(internalApi.CancelPolicyRollout, http.HandlerFunc(policyRolloutHandler.CancelPolicyRollout))).Methods(http.MethodDelete)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policies/{policyId}", customMiddleware.Handle(internalApi.GetPolicy, http.HandlerFunc(policyHandler.GetPolicy))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policies/{policyId}", customMiddleware.Handle(internalApi.DeletePolicy, http.HandlerFunc(policyHandler.DeletePolicy))).Methods(http.MethodDelete)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policies/{policyId}", customMiddleware.Handle(internalApi.UpdatePolicy, http.HandlerFunc(policyHandler.UpdatePolicy))).Methods(http.MethodPatch)
@@ -372,6 +507,49 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/policy-status", customMiddleware.Handle(internalApi.ListStackPolicyStatus, http.HandlerFunc(policyHandler.ListStackPolicyStatus))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/policy-templates/{policyTemplateId}", customMiddleware.Handle(internalApi.GetStackPolicyTemplateStatus, http.HandlerFunc(policyHandler.GetStackPolicyTemplateStatus))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/policy-templates/{policyTemplateId}", customMiddleware.Handle(internalApi.UpdateStackPolicyTemplateStatus, http.HandlerFunc(policyHandler.UpdateStackPolicyTemplateStatus))).Methods(http.MethodPatch)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/policy-templates/{policyTemplateId}/histories", customMiddleware.Handle(internalApi.ListStackPolicyTemplateHistories, http.HandlerFunc(policyHandler.ListStackPolicyTemplateHistories))).Methods(http.MethodGet)
+
+	policyViolationHandler := delivery.NewPolicyViolationHandler(usecaseFactory)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policy-violations", customMiddleware.Handle(internalApi.ListPolicyViolations, http.HandlerFunc(policyViolationHandler.ListPolicyViolations))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policy-violations/export", customMiddleware.Handle(internalApi.ExportPolicyViolations, http.HandlerFunc(policyViolationHandler.ExportPolicyViolations))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policy-violations/{violationId}", customMiddleware.Handle(internalApi.GetPolicyViolation, http.HandlerFunc(policyViolationHandler.GetPolicyViolation))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policy-violations/{violationId}/status", customMiddleware.Handle(internalApi.UpdatePolicyViolationStatus, http.HandlerFunc(policyViolationHandler.UpdatePolicyViolationStatus))).Methods(http.MethodPatch)
+
+	policyBundleHandler := delivery.NewPolicyBundleHandler(usecaseFactory)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policy-bundles", customMiddleware.Handle(internalApi.CreatePolicyBundle, http.HandlerFunc(policyBundleHandler.CreatePolicyBundle))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policy-bundles", customMiddleware.Handle(internalApi.ListPolicyBundles, http.HandlerFunc(policyBundleHandler.ListPolicyBundles))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policy-bundles/{policyBundleId}", customMiddleware.Handle(internalApi.GetPolicyBundle, http.HandlerFunc(policyBundleHandler.GetPolicyBundle))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policy-bundles/{policyBundleId}", customMiddleware.Handle(internalApi.UpdatePolicyBundle, http.HandlerFunc(policyBundleHandler.UpdatePolicyBundle))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policy-bundles/{policyBundleId}", customMiddleware.Handle(internalApi.DeletePolicyBundle, http.HandlerFunc(policyBundleHandler.DeletePolicyBundle))).Methods(http.MethodDelete)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policy-bundles/{policyBundleId}/preview", customMiddleware.Handle(internalApi.PreviewPolicyBundleUpdate, http.HandlerFunc(policyBundleHandler.PreviewPolicyBundleUpdate))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/policy-bundles/{policyBundleId}", customMiddleware.Handle(internalApi.AssignPolicyBundleToStack, http.HandlerFunc(policyBundleHandler.AssignPolicyBundleToStack))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/policy-bundles/{policyBundleId}", customMiddleware.Handle(internalApi.UnassignPolicyBundleFromStack, http.HandlerFunc(policyBundleHandler.UnassignPolicyBundleFromStack))).Methods(http.MethodDelete)
+
+	complianceReportHandler := delivery.NewComplianceReportHandler(usecaseFactory)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/compliance-reports", customMiddleware.Handle(internalApi.CreateComplianceReport, http.HandlerFunc(complianceReportHandler.CreateComplianceReport))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/compliance-reports", customMiddleware.Handle(internalApi.ListComplianceReports, http.HandlerFunc(complianceReportHandler.ListComplianceReports))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/compliance-reports/{reportId}", customMiddleware.Handle(internalApi.GetComplianceReport, http.HandlerFunc(complianceReportHandler.GetComplianceReport))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/compliance-reports/{reportId}", customMiddleware.Handle(internalApi.DeleteComplianceReport, http.HandlerFunc(complianceReportHandler.DeleteComplianceReport))).Methods(http.MethodDelete)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/compliance-reports/{reportId}/download", customMiddleware.Handle(internalApi.DownloadComplianceReport, http.HandlerFunc(complianceReportHandler.DownloadComplianceReport))).Methods(http.MethodGet)
+
+	mutationPolicyHandler := delivery.NewMutationPolicyHandler(usecaseFactory)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/mutation-policy-templates", customMiddleware.Handle(internalApi.ListMutationPolicyTemplates, http.HandlerFunc(mutationPolicyHandler.ListMutationPolicyTemplates))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/mutation-policies", customMiddleware.Handle(internalApi.CreateMutationPolicy, http.HandlerFunc(mutationPolicyHandler.CreateMutationPolicy))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/mutation-policies", customMiddleware.Handle(internalApi.ListMutationPolicies, http.HandlerFunc(mutationPolicyHandler.ListMutationPolicies))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/mutation-policies/{mutationPolicyId}", customMiddleware.Handle(internalApi.GetMutationPolicy, http.HandlerFunc(mutationPolicyHandler.GetMutationPolicy))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/mutation-policies/{mutationPolicyId}", customMiddleware.Handle(internalApi.UpdateMutationPolicy, http.HandlerFunc(mutationPolicyHandler.UpdateMutationPolicy))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/mutation-policies/{mutationPolicyId}", customMiddleware.Handle(internalApi.DeleteMutationPolicy, http.HandlerFunc(mutationPolicyHandler.DeleteMutationPolicy))).Methods(http.MethodDelete)
+
+	policyChangeRequestHandler := delivery.NewPolicyChangeRequestHandler(usecaseFactory)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policy-approval-setting", customMiddleware.Handle(internalApi.GetPolicyApprovalSetting, http.HandlerFunc(policyChangeRequestHandler.GetPolicyApprovalSetting))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policy-approval-setting", customMiddleware.Handle(internalApi.UpdatePolicyApprovalSetting, http.HandlerFunc(policyChangeRequestHandler.UpdatePolicyApprovalSetting))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policy-change-requests", customMiddleware.Handle(internalApi.ListPolicyChangeRequests, http.HandlerFunc(policyChangeRequestHandler.ListPolicyChangeRequests))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policy-change-requests/{changeRequestId}", customMiddleware.Handle(internalApi.GetPolicyChangeRequest, http.HandlerFunc(policyChangeRequestHandler.GetPolicyChangeRequest))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policy-change-requests/{changeRequestId}/approve", customMiddleware.Handle(internalApi.ApprovePolicyChangeRequest, http.HandlerFunc(policyChangeRequestHandler.ApprovePolicyChangeRequest))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/policy-change-requests/{changeRequestId}/reject", customMiddleware.Handle(internalApi.RejectPolicyChangeRequest, http.HandlerFunc(policyChangeRequestHandler.RejectPolicyChangeRequest))).Methods(http.MethodPost)
+
+	// Thanos 쿼리 캐시 등 서버 메트릭
+	r.Handle("/metrics", promhttp.Handler()).Methods(http.MethodGet)
 
 	// assets
 	r.PathPrefix("/api/").HandlerFunc(http.NotFound)
@@ -0,0 +1,290 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/delivery/api"
+	"github.com/openinfradev/tks-api/internal/helper"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IAccessTokenUsecase interface {
+	CreateServiceAccount(ctx context.Context, dto model.ServiceAccount) (serviceAccountId uuid.UUID, err error)
+	GetServiceAccount(ctx context.Context, organizationId string, serviceAccountId uuid.UUID) (model.ServiceAccount, error)
+	FetchServiceAccounts(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.ServiceAccount, error)
+	DeleteServiceAccount(ctx context.Context, organizationId string, serviceAccountId uuid.UUID) error
+
+	CreatePersonalAccessToken(ctx context.Context, dto model.AccessToken, expiresIn int) (token string, out model.AccessToken, err error)
+	FetchPersonalAccessTokens(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.AccessToken, error)
+	RevokePersonalAccessToken(ctx context.Context, organizationId string, accessTokenId uuid.UUID) error
+
+	CreateServiceAccountKey(ctx context.Context, dto model.AccessToken, expiresIn int) (token string, out model.AccessToken, err error)
+	FetchServiceAccountKeys(ctx context.Context, organizationId string, serviceAccountId uuid.UUID, pg *pagination.Pagination) ([]model.AccessToken, error)
+	RevokeServiceAccountKey(ctx context.Context, organizationId string, serviceAccountId uuid.UUID, accessTokenId uuid.UUID) error
+}
+
+type AccessTokenUsecase struct {
+	repo     repository.IAccessTokenRepository
+	roleRepo repository.IRoleRepository
+	userRepo repository.IUserRepository
+}
+
+func NewAccessTokenUsecase(r repository.Repository) IAccessTokenUsecase {
+	return &AccessTokenUsecase{
+		repo:     r.AccessToken,
+		roleRepo: r.Role,
+		userRepo: r.User,
+	}
+}
+
+func (u *AccessTokenUsecase) CreateServiceAccount(ctx context.Context, dto model.ServiceAccount) (serviceAccountId uuid.UUID, err error) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid token"), "", "")
+	}
+	userId := user.GetUserId()
+	dto.CreatorId = &userId
+
+	if _, err = u.repo.GetServiceAccountByName(ctx, dto.OrganizationId, dto.Name); err == nil {
+		return uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("duplicate service account name"), "AT_CREATE_ALREADY_EXISTED_NAME", "")
+	}
+
+	if _, err = u.roleRepo.GetTksRole(ctx, dto.OrganizationId, dto.RoleId); err != nil {
+		return uuid.Nil, httpErrors.NewBadRequestError(err, "AT_INVALID_ROLE", "")
+	}
+	if err = u.checkAssignableRole(ctx, dto.OrganizationId, dto.RoleId); err != nil {
+		return uuid.Nil, err
+	}
+
+	serviceAccountId, err = u.repo.CreateServiceAccount(ctx, dto)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewInternalServerError(err, "", "")
+	}
+	return serviceAccountId, nil
+}
+
+func (u *AccessTokenUsecase) GetServiceAccount(ctx context.Context, organizationId string, serviceAccountId uuid.UUID) (out model.ServiceAccount, err error) {
+	out, err = u.repo.GetServiceAccount(ctx, organizationId, serviceAccountId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return out, httpErrors.NewNotFoundError(err, "AT_NOT_FOUND_SERVICE_ACCOUNT", "")
+		}
+		return out, err
+	}
+	return
+}
+
+func (u *AccessTokenUsecase) FetchServiceAccounts(ctx context.Context, organizationId string, pg *pagination.Pagination) (out []model.ServiceAccount, err error) {
+	out, err = u.repo.FetchServiceAccounts(ctx, organizationId, pg)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+func (u *AccessTokenUsecase) DeleteServiceAccount(ctx context.Context, organizationId string, serviceAccountId uuid.UUID) error {
+	if _, err := u.GetServiceAccount(ctx, organizationId, serviceAccountId); err != nil {
+		return err
+	}
+
+	if err := u.repo.RevokeByServiceAccount(ctx, serviceAccountId); err != nil {
+		return httpErrors.NewInternalServerError(err, "", "")
+	}
+	if err := u.repo.DeleteServiceAccount(ctx, serviceAccountId); err != nil {
+		return httpErrors.NewInternalServerError(err, "", "")
+	}
+	return nil
+}
+
+func (u *AccessTokenUsecase) CreatePersonalAccessToken(ctx context.Context, dto model.AccessToken, expiresIn int) (token string, out model.AccessToken, err error) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return "", out, httpErrors.NewBadRequestError(fmt.Errorf("Invalid token"), "", "")
+	}
+	// an access token cannot be used to issue another access token
+	if _, ok := request.AccessTokenFrom(ctx); ok {
+		return "", out, httpErrors.NewForbiddenError(fmt.Errorf("access token is not allowed"), "AT_NOT_PERMITTED_ON_ACCESS_TOKEN", "")
+	}
+	userId := user.GetUserId()
+	dto.TokenType = domain.AccessTokenType_PERSONAL
+	dto.UserId = &userId
+
+	return u.create(ctx, dto, expiresIn)
+}
+
+func (u *AccessTokenUsecase) FetchPersonalAccessTokens(ctx context.Context, organizationId string, pg *pagination.Pagination) (out []model.AccessToken, err error) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid token"), "", "")
+	}
+
+	out, err = u.repo.FetchByUser(ctx, organizationId, user.GetUserId(), pg)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+func (u *AccessTokenUsecase) RevokePersonalAccessToken(ctx context.Context, organizationId string, accessTokenId uuid.UUID) error {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return httpErrors.NewBadRequestError(fmt.Errorf("Invalid token"), "", "")
+	}
+
+	accessToken, err := u.get(ctx, organizationId, accessTokenId)
+	if err != nil {
+		return err
+	}
+	if accessToken.UserId == nil || *accessToken.UserId != user.GetUserId() {
+		return httpErrors.NewNotFoundError(fmt.Errorf("not found access token"), "AT_NOT_FOUND_ACCESS_TOKEN", "")
+	}
+
+	if err := u.repo.Revoke(ctx, accessTokenId); err != nil {
+		return httpErrors.NewInternalServerError(err, "", "")
+	}
+	return nil
+}
+
+func (u *AccessTokenUsecase) CreateServiceAccountKey(ctx context.Context, dto model.AccessToken, expiresIn int) (token string, out model.AccessToken, err error) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return "", out, httpErrors.NewBadRequestError(fmt.Errorf("Invalid token"), "", "")
+	}
+	if _, ok := request.AccessTokenFrom(ctx); ok {
+		return "", out, httpErrors.NewForbiddenError(fmt.Errorf("access token is not allowed"), "AT_NOT_PERMITTED_ON_ACCESS_TOKEN", "")
+	}
+	if dto.ServiceAccountId == nil {
+		return "", out, httpErrors.NewBadRequestError(fmt.Errorf("invalid service account"), "AT_NOT_FOUND_SERVICE_ACCOUNT", "")
+	}
+	serviceAccount, err := u.GetServiceAccount(ctx, dto.OrganizationId, *dto.ServiceAccountId)
+	if err != nil {
+		return "", out, err
+	}
+	if err = u.checkAssignableRole(ctx, dto.OrganizationId, serviceAccount.RoleId); err != nil {
+		return "", out, err
+	}
+	userId := user.GetUserId()
+	dto.TokenType = domain.AccessTokenType_SERVICE_ACCOUNT
+	dto.CreatorId = &userId
+
+	return u.create(ctx, dto, expiresIn)
+}
+
+func (u *AccessTokenUsecase) FetchServiceAccountKeys(ctx context.Context, organizationId string, serviceAccountId uuid.UUID, pg *pagination.Pagination) (out []model.AccessToken, err error) {
+	if _, err = u.GetServiceAccount(ctx, organizationId, serviceAccountId); err != nil {
+		return nil, err
+	}
+
+	out, err = u.repo.FetchByServiceAccount(ctx, organizationId, serviceAccountId, pg)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+func (u *AccessTokenUsecase) RevokeServiceAccountKey(ctx context.Context, organizationId string, serviceAccountId uuid.UUID, accessTokenId uuid.UUID) error {
+	accessToken, err := u.get(ctx, organizationId, accessTokenId)
+	if err != nil {
+		return err
+	}
+	if accessToken.ServiceAccountId == nil || *accessToken.ServiceAccountId != serviceAccountId {
+		return httpErrors.NewNotFoundError(fmt.Errorf("not found access token"), "AT_NOT_FOUND_ACCESS_TOKEN", "")
+	}
+
+	if err := u.repo.Revoke(ctx, accessTokenId); err != nil {
+		return httpErrors.NewInternalServerError(err, "", "")
+	}
+	return nil
+}
+
+func (u *AccessTokenUsecase) get(ctx context.Context, organizationId string, accessTokenId uuid.UUID) (out model.AccessToken, err error) {
+	out, err = u.repo.Get(ctx, accessTokenId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return out, httpErrors.NewNotFoundError(err, "AT_NOT_FOUND_ACCESS_TOKEN", "")
+		}
+		return out, err
+	}
+	if out.OrganizationId != organizationId {
+		return out, httpErrors.NewNotFoundError(fmt.Errorf("not found access token"), "AT_NOT_FOUND_ACCESS_TOKEN", "")
+	}
+	return
+}
+
+// checkAssignableRole 은 요청한 사용자가 서비스계정에 부여하려는 역할을 가지고 있는지 확인한다.
+// 자신이 가지지 않은 역할(예: 관리자)의 서비스계정을 만들어 권한을 상승시키는 것을 막기 위함이며, 조직 관리자는 모든 역할을 부여할 수 있다.
+func (u *AccessTokenUsecase) checkAssignableRole(ctx context.Context, organizationId string, roleId string) error {
+	requestUser, ok := request.UserFrom(ctx)
+	if !ok {
+		return httpErrors.NewBadRequestError(fmt.Errorf("Invalid token"), "", "")
+	}
+
+	organizationRole := requestUser.GetRoleOrganizationMapping()[organizationId]
+	if organizationRole == "admin" || organizationRole == "tks-admin" {
+		return nil
+	}
+
+	storedUser, err := u.userRepo.GetByUuid(ctx, requestUser.GetUserId())
+	if err == nil && storedUser.OrganizationId == organizationId {
+		for _, role := range storedUser.Roles {
+			if role.ID == roleId {
+				return nil
+			}
+		}
+	}
+	return httpErrors.NewForbiddenError(fmt.Errorf("role %s is not held by the requester", roleId), "AT_NOT_PERMITTED_ROLE", "")
+}
+
+func (u *AccessTokenUsecase) create(ctx context.Context, dto model.AccessToken, expiresIn int) (token string, out model.AccessToken, err error) {
+	if err = validateAccessTokenScopes(dto.Scopes); err != nil {
+		return "", out, err
+	}
+
+	if dto.CreatorId == nil {
+		dto.CreatorId = dto.UserId
+	}
+	if expiresIn > 0 {
+		expiredAt := time.Now().AddDate(0, 0, expiresIn)
+		dto.ExpiredAt = &expiredAt
+	}
+
+	token, dto.TokenPrefix = helper.GenerateAccessToken()
+	dto.TokenHash = helper.HashAccessToken(token)
+
+	dto.ID, err = u.repo.Create(ctx, dto)
+	if err != nil {
+		return "", out, httpErrors.NewInternalServerError(err, "", "")
+	}
+	return token, dto, nil
+}
+
+// scope 는 endpoint 이름 또는 endpoint 그룹 이름으로 지정한다.
+func validateAccessTokenScopes(scopes []string) error {
+	if len(scopes) == 0 {
+		return httpErrors.NewBadRequestError(fmt.Errorf("empty scopes"), "AT_INVALID_SCOPE", "")
+	}
+
+	for _, scope := range scopes {
+		found := false
+		for _, ep := range api.ApiMap {
+			if ep.Name == scope || ep.Group == scope {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return httpErrors.NewBadRequestError(fmt.Errorf("invalid scope %s", scope), "AT_INVALID_SCOPE", "")
+		}
+	}
+	return nil
+}
@@ -29,11 +29,11 @@ import (
 )
 
 type IAuthUsecase interface {
-	Login(ctx context.Context, accountId string, password string, organizationId string) (model.User, error)
+	Login(ctx context.Context, accountId string, password string, organizationId string, otpCode string, clientIp string) (model.User, error)
 	Logout(ctx context.Context, sessionId string, organizationId string) error
-	FindId(ctx context.Context, code string, email string, userName string, organizationId string) (string, error)
-	FindPassword(ctx context.Context, code string, accountId string, email string, userName string, organizationId string) error
-	VerifyIdentity(ctx context.Context, accountId string, email string, userName string, organizationId string) error
+	FindId(ctx context.Context, code string, email string, userName string, organizationId string, clientIp string) (string, error)
+	FindPassword(ctx context.Context, code string, accountId string, email string, userName string, organizationId string, clientIp string) error
+	VerifyIdentity(ctx context.Context, accountId string, email string, userName string, organizationId string, clientIp string) error
 	SingleSignIn(ctx context.Context, organizationId, accountId, password string) ([]*http.Cookie, error)
 	SingleSignOut(ctx context.Context, organizationId string) (string, []*http.Cookie, error)
 	VerifyToken(ctx context.Context, token string) (bool, error)
@@ -41,9 +41,12 @@ type IAuthUsecase interface {
 }
 
 const (
-	passwordLength                  = 8
 	KEYCLOAK_IDENTITY_COOKIE        = "KEYCLOAK_IDENTITY"
 	KEYCLOAK_IDENTITY_LEGACY_COOKIE = "KEYCLOAK_IDENTITY_LEGACY"
+
+	authAttemptLogin            = "LOGIN"
+	authAttemptEmailCodeRequest = "EMAIL_CODE_REQUEST"
+	authAttemptEmailCodeVerify  = "EMAIL_CODE_VERIFY"
 )
 
 type AuthUsecase struct {
@@ -53,6 +56,7 @@ type AuthUsecase struct {
 	clusterRepository      repository.IClusterRepository
 	appgroupRepository     repository.IAppGroupRepository
 	organizationRepository repository.IOrganizationRepository
+	mfaRepository          repository.IMfaRepository
 }
 
 func NewAuthUsecase(r repository.Repository, kc keycloak.IKeycloak) IAuthUsecase {
@@ -63,33 +67,75 @@ func NewAuthUsecase(r repository.Repository, kc keycloak.IKeycloak) IAuthUsecase
 		clusterRepository:      r.Cluster,
 		appgroupRepository:     r.AppGroup,
 		organizationRepository: r.Organization,
+		mfaRepository:          r.Mfa,
 	}
 }
 
-func (u *AuthUsecase) Login(ctx context.Context, accountId string, password string, organizationId string) (model.User, error) {
+func (u *AuthUsecase) Login(ctx context.Context, accountId string, password string, organizationId string, otpCode string, clientIp string) (model.User, error) {
+	if err := u.checkClientIpThrottle(ctx, authAttemptLogin, clientIp, false, internal.MaxLoginFailuresPerClientIp); err != nil {
+		return model.User{}, err
+	}
+
 	// Authentication with DB
 	user, err := u.userRepository.Get(ctx, accountId, organizationId)
 	if err != nil {
+		u.recordAuthAttempt(ctx, authAttemptLogin, organizationId, accountId, clientIp, false)
 		return model.User{}, httpErrors.NewBadRequestError(err, "A_INVALID_ID", "")
 	}
 
+	if user.IsLocked() {
+		return model.User{}, httpErrors.NewForbiddenError(fmt.Errorf("account is locked until %s", user.LockedUntil), "A_LOCKED_ACCOUNT", "")
+	}
+
 	var accountToken *model.User
 	accountToken, err = u.kc.Login(ctx, accountId, password, organizationId)
 	if err != nil {
 		apiErr, ok := err.(*gocloak.APIError)
 		if ok {
 			if apiErr.Code == 401 {
+				u.recordAuthAttempt(ctx, authAttemptLogin, organizationId, accountId, clientIp, false)
+				if locked := u.lockAccountIfExceeded(ctx, user); locked {
+					return model.User{}, httpErrors.NewForbiddenError(fmt.Errorf("account is locked"), "A_LOCKED_ACCOUNT", "")
+				}
 				return model.User{}, httpErrors.NewBadRequestError(fmt.Errorf("Mismatch password"), "A_INVALID_PASSWORD", "")
 			}
 		}
 		return model.User{}, httpErrors.NewInternalServerError(err, "", "")
 	}
 
+	// 2차 인증. MFA 를 활성화한 사용자는 TOTP 코드 또는 recovery code 가 필요하다.
+	mfa, err := u.mfaRepository.GetUserMfa(ctx, user.ID)
+	if err == nil && mfa.Enabled {
+		if otpCode == "" {
+			return model.User{}, httpErrors.NewUnauthorizedError(fmt.Errorf("mfa code is required"), "A_MFA_CODE_REQUIRED", "")
+		}
+		if err := verifyMfaCode(ctx, u.mfaRepository, &mfa, otpCode, true); err != nil {
+			u.recordAuthAttempt(ctx, authAttemptLogin, organizationId, accountId, clientIp, false)
+			if locked := u.lockAccountIfExceeded(ctx, user); locked {
+				return model.User{}, httpErrors.NewForbiddenError(fmt.Errorf("account is locked"), "A_LOCKED_ACCOUNT", "")
+			}
+			return model.User{}, err
+		}
+	} else {
+		user.MfaEnrollmentRequired = isMfaEnrollmentRequired(ctx, u.mfaRepository, user)
+	}
+
+	// 로그인에 성공하면 실패 이력과 만료된 잠금 정보를 초기화한다.
+	if err := u.authRepository.DeleteAuthAttemptsByAccount(ctx, authAttemptLogin, organizationId, accountId); err != nil {
+		log.Error(ctx, err)
+	}
+	if user.LockedUntil != nil {
+		if err := u.userRepository.UpdateLockedUntil(ctx, user.ID, nil); err != nil {
+			log.Error(ctx, err)
+		}
+		user.LockedUntil = nil
+	}
+
 	// Insert token
 	user.Token = accountToken.Token
 
 	if !(organizationId == "master" && accountId == "admin") {
-		user.PasswordExpired = helper.IsDurationExpired(user.PasswordUpdatedAt, internal.PasswordExpiredDuration)
+		user.PasswordExpired = user.Organization.PasswordPolicy.IsExpired(user.PasswordUpdatedAt)
 	}
 
 	return user, nil
@@ -104,24 +150,22 @@ func (u *AuthUsecase) Logout(ctx context.Context, sessionId string, organization
 	return nil
 }
 
-func (u *AuthUsecase) FindId(ctx context.Context, code string, email string, userName string, organizationId string) (string, error) {
+func (u *AuthUsecase) FindId(ctx context.Context, code string, email string, userName string, organizationId string, clientIp string) (string, error) {
+	if err := u.checkClientIpThrottle(ctx, authAttemptEmailCodeVerify, clientIp, false, internal.MaxEmailCodeFailuresPerClientIp); err != nil {
+		return "", err
+	}
+
 	users, err := u.userRepository.List(ctx, u.userRepository.OrganizationFilter(organizationId),
 		u.userRepository.NameFilter(userName), u.userRepository.EmailFilter(email))
 	if err != nil && users == nil {
+		u.recordAuthAttempt(ctx, authAttemptEmailCodeVerify, organizationId, "", clientIp, false)
 		return "", httpErrors.NewBadRequestError(err, "A_INVALID_ID", "")
 	}
 	if err != nil {
 		return "", httpErrors.NewInternalServerError(err, "", "")
 	}
-	emailCode, err := u.authRepository.GetEmailCode(ctx, (*users)[0].ID)
-	if err != nil {
-		return "", httpErrors.NewInternalServerError(err, "", "")
-	}
-	if !u.isExpiredEmailCode(emailCode) {
-		return "", httpErrors.NewBadRequestError(fmt.Errorf("expired code"), "A_EXPIRED_CODE", "")
-	}
-	if emailCode.Code != code {
-		return "", httpErrors.NewBadRequestError(fmt.Errorf("invalid code"), "A_INVALID_CODE", "")
+	if err := u.verifyEmailCode(ctx, (*users)[0], code, clientIp); err != nil {
+		return "", err
 	}
 	if err := u.authRepository.DeleteEmailCode(ctx, (*users)[0].ID); err != nil {
 		return "", httpErrors.NewInternalServerError(err, "", "")
@@ -130,28 +174,26 @@ func (u *AuthUsecase) FindId(ctx context.Context, code string, email string, use
 	return (*users)[0].AccountId, nil
 }
 
-func (u *AuthUsecase) FindPassword(ctx context.Context, code string, accountId string, email string, userName string, organizationId string) error {
+func (u *AuthUsecase) FindPassword(ctx context.Context, code string, accountId string, email string, userName string, organizationId string, clientIp string) error {
+	if err := u.checkClientIpThrottle(ctx, authAttemptEmailCodeVerify, clientIp, false, internal.MaxEmailCodeFailuresPerClientIp); err != nil {
+		return err
+	}
+
 	users, err := u.userRepository.List(ctx, u.userRepository.OrganizationFilter(organizationId),
 		u.userRepository.AccountIdFilter(accountId), u.userRepository.NameFilter(userName),
 		u.userRepository.EmailFilter(email))
 	if err != nil && users == nil {
+		u.recordAuthAttempt(ctx, authAttemptEmailCodeVerify, organizationId, accountId, clientIp, false)
 		return httpErrors.NewBadRequestError(err, "A_INVALID_ID", "")
 	}
 	if err != nil {
 		return httpErrors.NewInternalServerError(err, "", "")
 	}
 	user := (*users)[0]
-	emailCode, err := u.authRepository.GetEmailCode(ctx, user.ID)
-	if err != nil {
-		return httpErrors.NewInternalServerError(err, "", "")
-	}
-	if !u.isExpiredEmailCode(emailCode) {
-		return httpErrors.NewBadRequestError(fmt.Errorf("expired code"), "A_EXPIRED_CODE", "")
-	}
-	if emailCode.Code != code {
-		return httpErrors.NewBadRequestError(fmt.Errorf("invalid code"), "A_INVALID_CODE", "")
+	if err := u.verifyEmailCode(ctx, user, code, clientIp); err != nil {
+		return err
 	}
-	randomPassword := helper.GenerateRandomString(passwordLength)
+	randomPassword := user.Organization.PasswordPolicy.GenerateRandomPassword()
 
 	originUser, err := u.kc.GetUser(ctx, organizationId, accountId)
 	if err != nil {
@@ -171,6 +213,7 @@ func (u *AuthUsecase) FindPassword(ctx context.Context, code string, accountId s
 	if err = u.userRepository.UpdatePasswordAt(ctx, user.ID, organizationId, true); err != nil {
 		return httpErrors.NewInternalServerError(err, "", "")
 	}
+	recordPasswordHistory(ctx, u.userRepository, user.ID, randomPassword)
 
 	message, err := mail.MakeTemporaryPasswordMessage(ctx, email, organizationId, accountId, randomPassword)
 	if err != nil {
@@ -191,10 +234,14 @@ func (u *AuthUsecase) FindPassword(ctx context.Context, code string, accountId s
 	return nil
 }
 
-func (u *AuthUsecase) VerifyIdentity(ctx context.Context, accountId string, email string, userName string, organizationId string) error {
+func (u *AuthUsecase) VerifyIdentity(ctx context.Context, accountId string, email string, userName string, organizationId string, clientIp string) error {
 	var users *[]model.User
 	var err error
 
+	if err := u.checkClientIpThrottle(ctx, authAttemptEmailCodeRequest, clientIp, true, internal.MaxEmailCodeRequestsPerClientIp); err != nil {
+		return err
+	}
+
 	if accountId == "" {
 		users, err = u.userRepository.List(ctx, u.userRepository.OrganizationFilter(organizationId),
 			u.userRepository.NameFilter(userName), u.userRepository.EmailFilter(email))
@@ -210,6 +257,12 @@ func (u *AuthUsecase) VerifyIdentity(ctx context.Context, accountId string, emai
 		return httpErrors.NewInternalServerError(err, "", "")
 	}
 
+	user := (*users)[0]
+	if err := u.checkAccountThrottle(ctx, authAttemptEmailCodeRequest, organizationId, user.AccountId, true, internal.MaxEmailCodeRequestsPerAccount); err != nil {
+		return err
+	}
+	u.recordAuthAttempt(ctx, authAttemptEmailCodeRequest, organizationId, user.AccountId, clientIp, true)
+
 	code, err := helper.GenerateEmailCode(ctx)
 	if err != nil {
 		return httpErrors.NewInternalServerError(err, "", "")
@@ -337,6 +390,87 @@ func (u *AuthUsecase) isExpiredEmailCode(code model.CacheEmailCode) bool {
 	return !helper.IsDurationExpired(code.UpdatedAt, internal.EmailCodeExpireTime)
 }
 
+func (u *AuthUsecase) verifyEmailCode(ctx context.Context, user model.User, code string, clientIp string) error {
+	if err := u.checkAccountThrottle(ctx, authAttemptEmailCodeVerify, user.OrganizationId, user.AccountId, false, internal.MaxEmailCodeFailuresPerAccount); err != nil {
+		return err
+	}
+
+	emailCode, err := u.authRepository.GetEmailCode(ctx, user.ID)
+	if err != nil {
+		return httpErrors.NewInternalServerError(err, "", "")
+	}
+	if !u.isExpiredEmailCode(emailCode) {
+		return httpErrors.NewBadRequestError(fmt.Errorf("expired code"), "A_EXPIRED_CODE", "")
+	}
+	if emailCode.Code != code {
+		u.recordAuthAttempt(ctx, authAttemptEmailCodeVerify, user.OrganizationId, user.AccountId, clientIp, false)
+		return httpErrors.NewBadRequestError(fmt.Errorf("invalid code"), "A_INVALID_CODE", "")
+	}
+
+	if err := u.authRepository.DeleteAuthAttemptsByAccount(ctx, authAttemptEmailCodeVerify, user.OrganizationId, user.AccountId); err != nil {
+		log.Error(ctx, err)
+	}
+	return nil
+}
+
+// 기록 실패는 인증 흐름을 막지 않도록 로그만 남긴다.
+func (u *AuthUsecase) recordAuthAttempt(ctx context.Context, attemptType string, organizationId string, accountId string, clientIp string, succeeded bool) {
+	err := u.authRepository.CreateAuthAttempt(ctx, model.AuthAttempt{
+		AttemptType:    attemptType,
+		OrganizationId: organizationId,
+		AccountId:      accountId,
+		ClientIp:       clientIp,
+		Succeeded:      succeeded,
+	})
+	if err != nil {
+		log.Error(ctx, err)
+	}
+}
+
+func (u *AuthUsecase) checkAccountThrottle(ctx context.Context, attemptType string, organizationId string, accountId string, succeeded bool, limit int) error {
+	count, err := u.authRepository.CountAuthAttemptsByAccount(ctx, attemptType, organizationId, accountId, succeeded, time.Now().Add(-internal.AuthAttemptWindow))
+	if err != nil {
+		return httpErrors.NewInternalServerError(err, "", "")
+	}
+	if count >= int64(limit) {
+		return httpErrors.NewTooManyRequestsError(fmt.Errorf("too many %s attempts for account %s", attemptType, accountId), "A_TOO_MANY_ATTEMPTS", "")
+	}
+	return nil
+}
+
+func (u *AuthUsecase) checkClientIpThrottle(ctx context.Context, attemptType string, clientIp string, succeeded bool, limit int) error {
+	if clientIp == "" {
+		return nil
+	}
+	count, err := u.authRepository.CountAuthAttemptsByClientIp(ctx, attemptType, clientIp, succeeded, time.Now().Add(-internal.AuthAttemptWindow))
+	if err != nil {
+		return httpErrors.NewInternalServerError(err, "", "")
+	}
+	if count >= int64(limit) {
+		return httpErrors.NewTooManyRequestsError(fmt.Errorf("too many %s attempts from %s", attemptType, clientIp), "A_TOO_MANY_ATTEMPTS", "")
+	}
+	return nil
+}
+
+// 최근 window 내 로그인 실패 횟수가 임계치를 넘으면 계정을 일정 시간 잠근다.
+func (u *AuthUsecase) lockAccountIfExceeded(ctx context.Context, user model.User) bool {
+	count, err := u.authRepository.CountAuthAttemptsByAccount(ctx, authAttemptLogin, user.OrganizationId, user.AccountId, false, time.Now().Add(-internal.AuthAttemptWindow))
+	if err != nil {
+		log.Error(ctx, err)
+		return false
+	}
+	if count < int64(internal.MaxLoginFailuresPerAccount) {
+		return false
+	}
+
+	lockedUntil := time.Now().Add(internal.AccountLockDuration)
+	if err := u.userRepository.UpdateLockedUntil(ctx, user.ID, &lockedUntil); err != nil {
+		log.Error(ctx, err)
+		return false
+	}
+	return true
+}
+
 func extractFormAction(htmlContent string) (string, error) {
 	doc, err := html.Parse(strings.NewReader(htmlContent))
 	if err != nil {
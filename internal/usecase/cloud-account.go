@@ -3,19 +3,12 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/config"
-	"github.com/aws/aws-sdk-go-v2/credentials"
-	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
-	"github.com/aws/aws-sdk-go-v2/service/ec2"
-	"github.com/aws/aws-sdk-go-v2/service/eks"
-	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
-	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
-	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
-	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/google/uuid"
+	cloudprovider "github.com/openinfradev/tks-api/internal/cloud-provider"
 	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
 	"github.com/openinfradev/tks-api/internal/model"
 	"github.com/openinfradev/tks-api/internal/pagination"
@@ -23,7 +16,6 @@ import (
 	argowf "github.com/openinfradev/tks-api/pkg/argo-client"
 	"github.com/openinfradev/tks-api/pkg/domain"
 	"github.com/openinfradev/tks-api/pkg/httpErrors"
-	"github.com/openinfradev/tks-api/pkg/kubernetes"
 	"github.com/openinfradev/tks-api/pkg/log"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
@@ -35,25 +27,37 @@ type ICloudAccountUsecase interface {
 	Get(ctx context.Context, cloudAccountId uuid.UUID) (model.CloudAccount, error)
 	GetByName(ctx context.Context, organizationId string, name string) (model.CloudAccount, error)
 	GetByAwsAccountId(ctx context.Context, awsAccountId string) (model.CloudAccount, error)
-	GetResourceQuota(ctx context.Context, cloudAccountId uuid.UUID) (available bool, out domain.ResourceQuota, err error)
+	GetResourceQuota(ctx context.Context, cloudAccountId uuid.UUID) ([]domain.RegionResourceQuota, error)
 	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.CloudAccount, error)
 	Create(ctx context.Context, dto model.CloudAccount) (cloudAccountId uuid.UUID, err error)
 	Update(ctx context.Context, dto model.CloudAccount) error
 	Delete(ctx context.Context, dto model.CloudAccount) (model.CloudAccount, error)
 	DeleteForce(ctx context.Context, cloudAccountId uuid.UUID) (model.CloudAccount, error)
+	RotateCredential(ctx context.Context, dto model.CloudAccount) error
+	CheckCredential(ctx context.Context, organizationId string, cloudAccountId uuid.UUID) (model.CloudAccount, error)
+	CheckCredentials(ctx context.Context) error
+	WatchCredentials(ctx context.Context, interval time.Duration)
 }
 
 type CloudAccountUsecase struct {
-	repo        repository.ICloudAccountRepository
-	clusterRepo repository.IClusterRepository
-	argo        argowf.ArgoClient
+	repo                   repository.ICloudAccountRepository
+	clusterRepo            repository.IClusterRepository
+	organizationRepo       repository.IOrganizationRepository
+	systemNotificationRepo repository.ISystemNotificationRepository
+	argo                   argowf.ArgoClient
+	providers              func(cloudService string) (cloudprovider.Provider, error)
+	jobLeaseRepo           repository.IJobLeaseRepository
 }
 
 func NewCloudAccountUsecase(r repository.Repository, argoClient argowf.ArgoClient) ICloudAccountUsecase {
 	return &CloudAccountUsecase{
-		repo:        r.CloudAccount,
-		clusterRepo: r.Cluster,
-		argo:        argoClient,
+		repo:                   r.CloudAccount,
+		clusterRepo:            r.Cluster,
+		organizationRepo:       r.Organization,
+		systemNotificationRepo: r.SystemNotification,
+		argo:                   argoClient,
+		providers:              cloudprovider.New,
+		jobLeaseRepo:           r.JobLease,
 	}
 }
 
@@ -67,13 +71,40 @@ func (u *CloudAccountUsecase) Create(ctx context.Context, dto model.CloudAccount
 	dto.Resource = "TODO server result or additional information"
 	dto.CreatorId = &userId
 
+	provider, err := u.providers(dto.CloudService)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewBadRequestError(err, "C_INVALID_CLOUD_SERVICE", "")
+	}
+	if dto.CloudService == domain.CloudService_AWS {
+		dto.AccountId = provider.AccountId(dto)
+		dto.AwsAccountId = dto.AccountId
+	}
+	if err := provider.ValidateAccount(dto); err != nil {
+		return uuid.Nil, httpErrors.NewBadRequestError(err, "CA_INVALID_ACCOUNT", "")
+	}
+	if len(dto.AllowedRegions) == 0 {
+		dto.AllowedRegions = []string{provider.DefaultRegion()}
+	}
+	if err := validateRegions(provider, dto.AllowedRegions); err != nil {
+		return uuid.Nil, httpErrors.NewBadRequestError(err, "CA_INVALID_REGION", "")
+	}
+
 	_, err = u.GetByName(ctx, dto.OrganizationId, dto.Name)
 	if err == nil {
 		return uuid.Nil, httpErrors.NewBadRequestError(httpErrors.DuplicateResource, "", "조직내에 동일한 이름의 클라우드 어카운트가 존재합니다.")
 	}
-	_, err = u.GetByAwsAccountId(ctx, dto.AwsAccountId)
+	_, err = u.repo.GetByAccountId(ctx, dto.CloudService, dto.AccountId)
 	if err == nil {
-		return uuid.Nil, httpErrors.NewBadRequestError(httpErrors.DuplicateResource, "", "사용 중인 AwsAccountId 입니다. 관리자에게 문의하세요.")
+		return uuid.Nil, httpErrors.NewBadRequestError(httpErrors.DuplicateResource, "", "사용 중인 AccountId 입니다. 관리자에게 문의하세요.")
+	}
+
+	// FOR TEST. ADD MAGIC KEYWORD
+	isIncluster := strings.Contains(dto.Name, domain.CLOUD_ACCOUNT_INCLUSTER)
+	if !isIncluster {
+		if err := provider.ValidateCredential(ctx, dto); err != nil {
+			log.Error(ctx, "failed to validate cloud credential. err : ", err)
+			return uuid.Nil, httpErrors.NewBadRequestError(err, "CA_INVALID_CREDENTIAL", "")
+		}
 	}
 
 	cloudAccountId, err = u.repo.Create(ctx, dto)
@@ -82,26 +113,20 @@ func (u *CloudAccountUsecase) Create(ctx context.Context, dto model.CloudAccount
 	}
 	log.Info(ctx, "newly created CloudAccount ID:", cloudAccountId)
 
-	// FOR TEST. ADD MAGIC KEYWORD
-	if strings.Contains(dto.Name, domain.CLOUD_ACCOUNT_INCLUSTER) {
+	if isIncluster {
 		if err := u.repo.InitWorkflow(ctx, cloudAccountId, "", domain.CloudAccountStatus_CREATED); err != nil {
 			return uuid.Nil, errors.Wrap(err, "Failed to initialize status")
 		}
 		return cloudAccountId, nil
 	}
 
+	dto.ID = cloudAccountId
+	workflow := provider.BootstrapWorkflow(dto, dto.AllowedRegions[0])
 	workflowId, err := u.argo.SumbitWorkflowFromWftpl(
 		ctx,
-		"tks-create-aws-cloud-account",
+		workflow.Template,
 		argowf.SubmitOptions{
-			Parameters: []string{
-				"aws_region=" + "ap-northeast-2",
-				"tks_cloud_account_id=" + cloudAccountId.String(),
-				"aws_account_id=" + dto.AwsAccountId,
-				"aws_access_key_id=" + dto.AccessKeyId,
-				"aws_secret_access_key=" + dto.SecretAccessKey,
-				"aws_session_token=" + dto.SessionToken,
-			},
+			Parameters: workflow.Parameters,
 		})
 	if err != nil {
 		log.Error(ctx, "failed to submit argo workflow template. err : ", err)
@@ -123,9 +148,44 @@ func (u *CloudAccountUsecase) Update(ctx context.Context, dto model.CloudAccount
 	}
 	userId := user.GetUserId()
 
+	cloudAccount, err := u.repo.Get(ctx, dto.ID)
+	if err != nil {
+		return httpErrors.NewNotFoundError(err, "", "")
+	}
+
+	if dto.AllowedRegions == nil {
+		dto.AllowedRegions = cloudAccount.AllowedRegions
+	} else {
+		provider, err := u.providers(cloudAccount.CloudService)
+		if err != nil {
+			return httpErrors.NewBadRequestError(err, "C_INVALID_CLOUD_SERVICE", "")
+		}
+		if err := validateRegions(provider, dto.AllowedRegions); err != nil {
+			return httpErrors.NewBadRequestError(err, "CA_INVALID_REGION", "")
+		}
+
+		// 사용 중인 클러스터가 있는 리전은 제외할 수 없다
+		clusters, err := u.clusterRepo.FetchByCloudAccountId(ctx, dto.ID, nil)
+		if err != nil {
+			return httpErrors.NewInternalServerError(err, "", "")
+		}
+		for _, cluster := range clusters {
+			if cluster.Status == domain.ClusterStatus_DELETED {
+				continue
+			}
+			region := cluster.ClusterRegion
+			if region == "" {
+				region = provider.DefaultRegion()
+			}
+			if !slices.Contains(dto.AllowedRegions, region) {
+				return httpErrors.NewBadRequestError(fmt.Errorf("region %s is used by cluster %s", region, cluster.ID), "CA_REGION_IN_USE", "")
+			}
+		}
+	}
+
 	dto.Resource = "TODO server result or additional information"
 	dto.UpdatorId = &userId
-	err := u.repo.Update(ctx, dto)
+	err = u.repo.Update(ctx, dto)
 	if err != nil {
 		return httpErrors.NewInternalServerError(err, "", "")
 	}
@@ -196,18 +256,24 @@ func (u *CloudAccountUsecase) Delete(ctx context.Context, dto model.CloudAccount
 		return cloudAccount, fmt.Errorf("사용 중인 클러스터가 있어 삭제할 수 없습니다.")
 	}
 
+	provider, err := u.providers(cloudAccount.CloudService)
+	if err != nil {
+		return cloudAccount, httpErrors.NewBadRequestError(err, "C_INVALID_CLOUD_SERVICE", "")
+	}
+
+	// 삭제 요청의 인증 정보와 저장된 계정 식별자로 워크플로우 파라미터를 만든다
+	dto.CloudService = cloudAccount.CloudService
+	dto.AwsAccountId = cloudAccount.AwsAccountId
+	dto.AccountId = cloudAccount.AccountId
+	dto.TenantId = cloudAccount.TenantId
+	dto.Endpoint = cloudAccount.Endpoint
+
+	workflow := provider.CleanupWorkflow(dto, cloudprovider.AllowedRegions(provider, cloudAccount)[0])
 	workflowId, err := u.argo.SumbitWorkflowFromWftpl(
 		ctx,
-		"tks-delete-aws-cloud-account",
+		workflow.Template,
 		argowf.SubmitOptions{
-			Parameters: []string{
-				"aws_region=" + "ap-northeast-2",
-				"tks_cloud_account_id=" + dto.ID.String(),
-				"aws_account_id=" + cloudAccount.AwsAccountId,
-				"aws_access_key_id=" + dto.AccessKeyId,
-				"aws_secret_access_key=" + dto.SecretAccessKey,
-				"aws_session_token=" + dto.SessionToken,
-			},
+			Parameters: workflow.Parameters,
 		})
 	if err != nil {
 		log.Error(ctx, "failed to submit argo workflow template. err : ", err)
@@ -245,203 +311,180 @@ func (u *CloudAccountUsecase) DeleteForce(ctx context.Context, cloudAccountId uu
 	return cloudAccount, nil
 }
 
-func (u *CloudAccountUsecase) GetResourceQuota(ctx context.Context, cloudAccountId uuid.UUID) (available bool, out domain.ResourceQuota, err error) {
-	cloudAccount, err := u.repo.Get(ctx, cloudAccountId)
+// RotateCredential 은 계정을 삭제하지 않고 새 인증 정보로 IAM 생성 워크플로우를 다시 실행한다.
+func (u *CloudAccountUsecase) RotateCredential(ctx context.Context, dto model.CloudAccount) error {
+	cloudAccount, err := u.repo.Get(ctx, dto.ID)
 	if err != nil {
-		return false, out, err
+		return httpErrors.NewNotFoundError(err, "", "")
+	}
+	if cloudAccount.OrganizationId != dto.OrganizationId {
+		return httpErrors.NewNotFoundError(fmt.Errorf("not found cloudAccount in organization"), "", "")
+	}
+	if strings.Contains(cloudAccount.Name, domain.CLOUD_ACCOUNT_INCLUSTER) {
+		return httpErrors.NewBadRequestError(fmt.Errorf("incluster cloud account has no credential"), "CA_INVALID_STATUS_FOR_ROTATION", "")
+	}
+	if cloudAccount.Status != domain.CloudAccountStatus_CREATED && cloudAccount.Status != domain.CloudAccountStatus_CREATE_ERROR {
+		return httpErrors.NewBadRequestError(fmt.Errorf("the status is %s", cloudAccount.Status), "CA_INVALID_STATUS_FOR_ROTATION", "")
 	}
 
-	awsAccessKeyId, awsSecretAccessKey, _ := kubernetes.GetAwsSecret(ctx)
-	if err != nil || awsAccessKeyId == "" || awsSecretAccessKey == "" {
-		log.Error(ctx, err)
-		return false, out, httpErrors.NewInternalServerError(fmt.Errorf("Invalid aws secret."), "", "")
+	provider, err := u.providers(cloudAccount.CloudService)
+	if err != nil {
+		return httpErrors.NewBadRequestError(err, "C_INVALID_CLOUD_SERVICE", "")
+	}
+
+	// 저장된 계정 식별자에 새 인증 정보를 더해 검증한다
+	cloudAccount.AccessKeyId = dto.AccessKeyId
+	cloudAccount.SecretAccessKey = dto.SecretAccessKey
+	cloudAccount.SessionToken = dto.SessionToken
+	cloudAccount.ClientId = dto.ClientId
+	cloudAccount.ClientSecret = dto.ClientSecret
+	cloudAccount.ServiceAccountKey = dto.ServiceAccountKey
+	cloudAccount.Username = dto.Username
+	cloudAccount.Password = dto.Password
+	if err := provider.ValidateAccount(cloudAccount); err != nil {
+		return httpErrors.NewBadRequestError(err, "CA_INVALID_ACCOUNT", "")
+	}
+	if err := provider.ValidateCredential(ctx, cloudAccount); err != nil {
+		log.Error(ctx, "failed to validate cloud credential. err : ", err)
+		return httpErrors.NewBadRequestError(err, "CA_INVALID_CREDENTIAL", "")
 	}
 
-	cfg, err := config.LoadDefaultConfig(ctx,
-		config.WithCredentialsProvider(credentials.StaticCredentialsProvider{
-			Value: aws.Credentials{
-				AccessKeyID: awsAccessKeyId, SecretAccessKey: awsSecretAccessKey,
-			},
-		}))
+	workflow := provider.BootstrapWorkflow(cloudAccount, cloudprovider.AllowedRegions(provider, cloudAccount)[0])
+	workflowId, err := u.argo.SumbitWorkflowFromWftpl(
+		ctx,
+		workflow.Template,
+		argowf.SubmitOptions{
+			Parameters: workflow.Parameters,
+		})
 	if err != nil {
+		log.Error(ctx, "failed to submit argo workflow template. err : ", err)
+		return fmt.Errorf("Failed to call argo workflow : %s", err)
+	}
+	log.Info(ctx, "submited workflow :", workflowId)
+
+	if err := u.repo.InitWorkflow(ctx, cloudAccount.ID, workflowId, domain.CloudAccountStatus_CREATING); err != nil {
+		return errors.Wrap(err, "Failed to initialize status")
+	}
+	// IAM 이 다시 생성되므로 다음 점검까지 인증 정보 상태를 알 수 없다
+	if err := u.repo.UpdateCredentialStatus(ctx, cloudAccount.ID, domain.CloudAccountCredentialStatus_UNKNOWN, nil, ""); err != nil {
 		log.Error(ctx, err)
 	}
 
-	stsSvc := sts.NewFromConfig(cfg)
+	return nil
+}
+
+// CheckCredential 은 TKS 의 인증 정보로 계정의 IAM 을 사용할 수 있는지 확인하여 결과를 저장한다.
+// 정상이던 계정이 실패하면 시스템 알림을 생성한다.
+func (u *CloudAccountUsecase) CheckCredential(ctx context.Context, organizationId string, cloudAccountId uuid.UUID) (out model.CloudAccount, err error) {
+	cloudAccount, err := u.repo.Get(ctx, cloudAccountId)
+	if err != nil {
+		return out, httpErrors.NewNotFoundError(err, "", "")
+	}
+	if cloudAccount.OrganizationId != organizationId {
+		return out, httpErrors.NewNotFoundError(fmt.Errorf("not found cloudAccount in organization"), "", "")
+	}
+
+	provider, err := u.providers(cloudAccount.CloudService)
+	if err != nil {
+		return out, httpErrors.NewBadRequestError(err, "C_INVALID_CLOUD_SERVICE", "")
+	}
 
-	if !strings.Contains(cloudAccount.Name, domain.CLOUD_ACCOUNT_INCLUSTER) {
-		log.Info(ctx, "Use assume role. awsAccountId : ", cloudAccount.AwsAccountId)
-		creds := stscreds.NewAssumeRoleProvider(stsSvc, "arn:aws:iam::"+cloudAccount.AwsAccountId+":role/controllers.cluster-api-provider-aws.sigs.k8s.io")
-		cfg.Credentials = aws.NewCredentialsCache(creds)
+	status := domain.CloudAccountCredentialStatus_HEALTHY
+	message := ""
+	if err := provider.CheckHealth(ctx, cloudAccount); err != nil {
+		status = domain.CloudAccountCredentialStatus_UNHEALTHY
+		message = err.Error()
 	}
-	client := servicequotas.NewFromConfig(cfg)
 
-	quotaMap := map[string]string{
-		"L-69A177A2": "elasticloadbalancing", // NLB
-		"L-E9E9831D": "elasticloadbalancing", // Classic
-		"L-A4707A72": "vpc",                  // IGW
-		"L-1194D53C": "eks",                  // Cluster
-		"L-0263D0A3": "ec2",                  // Elastic IP
+	now := time.Now()
+	if err := u.repo.UpdateCredentialStatus(ctx, cloudAccountId, status, &now, message); err != nil {
+		return out, httpErrors.NewInternalServerError(err, "", "")
 	}
 
-	// current usage
-	type CurrentUsage struct {
-		NLB     int
-		CLB     int
-		IGW     int
-		Cluster int
-		EIP     int
+	if status == domain.CloudAccountCredentialStatus_UNHEALTHY && cloudAccount.CredentialStatus != domain.CloudAccountCredentialStatus_UNHEALTHY {
+		u.notifyCredentialFailure(ctx, cloudAccount, message)
 	}
 
-	out.Quotas = make([]domain.ResourceQuotaAttr, 0)
+	cloudAccount.CredentialStatus = status
+	cloudAccount.CredentialCheckedAt = &now
+	cloudAccount.CredentialError = message
+	return cloudAccount, nil
+}
 
-	// get current usage
-	currentUsage := CurrentUsage{}
-	{
-		c := elasticloadbalancingv2.NewFromConfig(cfg)
-		pageSize := int32(100)
-		res, err := c.DescribeLoadBalancers(ctx, &elasticloadbalancingv2.DescribeLoadBalancersInput{
-			PageSize: &pageSize,
-		}, func(o *elasticloadbalancingv2.Options) {
-			o.Region = "ap-northeast-2"
-		})
-		if err != nil {
-			return false, out, err
-		}
+func (u *CloudAccountUsecase) CheckCredentials(ctx context.Context) error {
+	cloudAccounts, err := u.repo.FetchByStatus(ctx, domain.CloudAccountStatus_CREATED)
+	if err != nil {
+		return err
+	}
 
-		for _, elb := range res.LoadBalancers {
-			switch elb.Type {
-			case "network":
-				currentUsage.NLB += 1
-			}
+	for _, cloudAccount := range cloudAccounts {
+		if strings.Contains(cloudAccount.Name, domain.CLOUD_ACCOUNT_INCLUSTER) {
+			continue
+		}
+		if _, err := u.CheckCredential(ctx, cloudAccount.OrganizationId, cloudAccount.ID); err != nil {
+			log.Errorf(ctx, "failed to check credential of cloud account %s. err : %s", cloudAccount.ID, err)
 		}
 	}
+	return nil
+}
 
-	{
-		c := elasticloadbalancing.NewFromConfig(cfg)
-		pageSize := int32(100)
-		res, err := c.DescribeLoadBalancers(ctx, &elasticloadbalancing.DescribeLoadBalancersInput{
-			PageSize: &pageSize,
-		}, func(o *elasticloadbalancing.Options) {
-			o.Region = "ap-northeast-2"
-		})
-		if err != nil {
-			return false, out, err
-		}
-		currentUsage.CLB = len(res.LoadBalancerDescriptions)
+func (u *CloudAccountUsecase) WatchCredentials(ctx context.Context, interval time.Duration) {
+	watchExclusively(ctx, u.jobLeaseRepo, "cloud-account-credential-check", interval, u.CheckCredentials)
+}
+
+// notifyCredentialFailure 는 조직의 primary 클러스터에 시스템 알림을 생성한다.
+func (u *CloudAccountUsecase) notifyCredentialFailure(ctx context.Context, cloudAccount model.CloudAccount, message string) {
+	organization, err := u.organizationRepo.Get(ctx, cloudAccount.OrganizationId)
+	if err != nil || organization.PrimaryClusterId == "" {
+		log.Warnf(ctx, "skip credential notification of cloud account %s. no primary cluster", cloudAccount.ID)
+		return
 	}
 
-	{
-		c := ec2.NewFromConfig(cfg)
-		res, err := c.DescribeInternetGateways(ctx, &ec2.DescribeInternetGatewaysInput{}, func(o *ec2.Options) {
-			o.Region = "ap-northeast-2"
-		})
-		if err != nil {
-			return false, out, err
-		}
-		currentUsage.IGW = len(res.InternetGateways)
+	title := fmt.Sprintf("클라우드 계정 [%s] 의 인증 정보를 사용할 수 없습니다.", cloudAccount.Name)
+	notification := model.SystemNotification{
+		OrganizationId:        cloudAccount.OrganizationId,
+		Name:                  "cloud-account-credential",
+		Severity:              "critical",
+		ClusterId:             domain.ClusterId(organization.PrimaryClusterId),
+		MessageTitle:          title,
+		MessageContent:        message,
+		MessageActionProposal: "클라우드 계정의 IAM 을 확인하거나 인증 정보를 교체하세요.",
+		Summary:               title,
 	}
+	if _, err := u.systemNotificationRepo.Create(ctx, notification); err != nil {
+		log.Error(ctx, "failed to create system notification: ", err)
+	}
+}
 
-	{
-		c := eks.NewFromConfig(cfg)
-		res, err := c.ListClusters(ctx, &eks.ListClustersInput{}, func(o *eks.Options) {
-			o.Region = "ap-northeast-2"
-		})
-		if err != nil {
-			return false, out, err
-		}
-		currentUsage.Cluster = len(res.Clusters)
+func (u *CloudAccountUsecase) GetResourceQuota(ctx context.Context, cloudAccountId uuid.UUID) (out []domain.RegionResourceQuota, err error) {
+	cloudAccount, err := u.repo.Get(ctx, cloudAccountId)
+	if err != nil {
+		return nil, err
 	}
 
-	{
-		c := ec2.NewFromConfig(cfg)
-		res, err := c.DescribeAddresses(ctx, &ec2.DescribeAddressesInput{}, func(o *ec2.Options) {
-			o.Region = "ap-northeast-2"
-		})
-		if err != nil {
-			log.Error(ctx, err)
-			return false, out, err
-		}
-		currentUsage.EIP = len(res.Addresses)
+	provider, err := u.providers(cloudAccount.CloudService)
+	if err != nil {
+		return nil, httpErrors.NewBadRequestError(err, "C_INVALID_CLOUD_SERVICE", "")
 	}
 
-	for key, val := range quotaMap {
-		res, err := getServiceQuota(client, key, val)
+	// 조회에 실패한 리전은 사유를 담아 반환하고, 모든 리전이 실패한 경우에만 에러를 반환한다
+	regions := cloudprovider.AllowedRegions(provider, cloudAccount)
+	out = make([]domain.RegionResourceQuota, len(regions))
+	failed := 0
+	for i, region := range regions {
+		out[i].Region = region
+		out[i].Available, out[i].ResourceQuota, err = provider.GetResourceQuota(ctx, cloudAccount, region)
 		if err != nil {
-			return false, out, err
-		}
-		log.Debugf(ctx, "%s %s %v", *res.Quota.QuotaName, *res.Quota.QuotaCode, *res.Quota.Value)
-
-		quotaValue := int(*res.Quota.Value)
-
-		// stack 1개 생성하는데 필요한 quota
-		// Classic 1
-		// Network 5
-		// IGW 1
-		// EIP 3
-		// Cluster 1
-		switch key {
-		case "L-69A177A2": // NLB
-			log.Infof(ctx, "NLB : usage %d, quota %d", currentUsage.NLB, quotaValue)
-			out.Quotas = append(out.Quotas, domain.ResourceQuotaAttr{
-				Type:     "NLB",
-				Usage:    currentUsage.NLB,
-				Quota:    quotaValue,
-				Required: 5,
-			})
-			if quotaValue >= currentUsage.NLB+5 {
-				available = true
-			}
-		case "L-E9E9831D": // Classic
-			log.Infof(ctx, "CLB : usage %d, quota %d", currentUsage.CLB, quotaValue)
-			out.Quotas = append(out.Quotas, domain.ResourceQuotaAttr{
-				Type:     "CLB",
-				Usage:    currentUsage.CLB,
-				Quota:    quotaValue,
-				Required: 1,
-			})
-			if quotaValue >= currentUsage.CLB+1 {
-				available = true
-			}
-		case "L-A4707A72": // IGW
-			log.Infof(ctx, "IGW : usage %d, quota %d", currentUsage.IGW, quotaValue)
-			out.Quotas = append(out.Quotas, domain.ResourceQuotaAttr{
-				Type:     "IGW",
-				Usage:    currentUsage.IGW,
-				Quota:    quotaValue,
-				Required: 1,
-			})
-			if quotaValue >= currentUsage.IGW+1 {
-				available = true
-			}
-		case "L-1194D53C": // Cluster
-			log.Infof(ctx, "Cluster : usage %d, quota %d", currentUsage.Cluster, quotaValue)
-			out.Quotas = append(out.Quotas, domain.ResourceQuotaAttr{
-				Type:     "EKS",
-				Usage:    currentUsage.Cluster,
-				Quota:    quotaValue,
-				Required: 1,
-			})
-			if quotaValue >= currentUsage.Cluster+1 {
-				available = true
-			}
-		case "L-0263D0A3": // Elastic IP
-			log.Infof(ctx, "Elastic IP : usage %d, quota %d", currentUsage.EIP, quotaValue)
-			out.Quotas = append(out.Quotas, domain.ResourceQuotaAttr{
-				Type:     "EIP",
-				Usage:    currentUsage.EIP,
-				Quota:    quotaValue,
-				Required: 3,
-			})
-			if quotaValue >= currentUsage.EIP+3 {
-				available = true
-			}
+			log.Errorf(ctx, "failed to get resource quota in region %s. err : %s", region, err)
+			out[i].Error = err.Error()
+			failed++
 		}
-
+	}
+	if failed == len(regions) {
+		return nil, httpErrors.NewInternalServerError(err, "", "")
 	}
 
-	//return fmt.Errorf("Always return err")
-	return available, out, nil
+	return out, nil
 }
 
 func (u *CloudAccountUsecase) getClusterCnt(ctx context.Context, cloudAccountId uuid.UUID) (cnt int) {
@@ -462,15 +505,14 @@ func (u *CloudAccountUsecase) getClusterCnt(ctx context.Context, cloudAccountId
 	return cnt
 }
 
-func getServiceQuota(client *servicequotas.Client, quotaCode string, serviceCode string) (res *servicequotas.GetServiceQuotaOutput, err error) {
-	res, err = client.GetServiceQuota(context.TODO(), &servicequotas.GetServiceQuotaInput{
-		QuotaCode:   &quotaCode,
-		ServiceCode: &serviceCode,
-	}, func(o *servicequotas.Options) {
-		o.Region = "ap-northeast-2"
-	})
-	if err != nil {
-		return nil, err
+func validateRegions(provider cloudprovider.Provider, regions []string) error {
+	for i, region := range regions {
+		if err := provider.ValidateRegion(region); err != nil {
+			return err
+		}
+		if slices.Contains(regions[:i], region) {
+			return fmt.Errorf("duplicated region '%s'", region)
+		}
 	}
-	return
+	return nil
 }
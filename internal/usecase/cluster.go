@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	cloudprovider "github.com/openinfradev/tks-api/internal/cloud-provider"
 	"github.com/openinfradev/tks-api/internal/helper"
 	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
 	"github.com/openinfradev/tks-api/internal/model"
@@ -156,12 +157,18 @@ func (u *ClusterUsecase) Create(ctx context.Context, dto model.Cluster) (cluster
 
 	tksCloudAccountId := dto.CloudAccountId.String()
 	isExist := false
+	var cloudAccountParameters []string
 	for _, ca := range cloudAccounts {
 		if ca.ID == *dto.CloudAccountId {
 
 			// FOR TEST. ADD MAGIC KEYWORD
 			if strings.Contains(ca.Name, domain.CLOUD_ACCOUNT_INCLUSTER) {
 				tksCloudAccountId = "NULL"
+			} else if provider, err := cloudprovider.New(ca.CloudService); err == nil {
+				cloudAccountParameters = provider.ClusterWorkflowParameters(ca)
+				if dto.ClusterRegion, err = cloudprovider.ResolveRegion(provider, ca, dto.ClusterRegion); err != nil {
+					return "", httpErrors.NewBadRequestError(err, "CL_INVALID_CLUSTER_REGION", "")
+				}
 			}
 			isExist = true
 			break
@@ -191,7 +198,7 @@ func (u *ClusterUsecase) Create(ctx context.Context, dto model.Cluster) (cluster
 		ctx,
 		"create-tks-usercluster",
 		argowf.SubmitOptions{
-			Parameters: []string{
+			Parameters: append([]string{
 				fmt.Sprintf("tks_api_url=%s", viper.GetString("external-address")),
 				"contract_id=" + dto.OrganizationId,
 				"cluster_id=" + clusterId.String(),
@@ -203,7 +210,7 @@ func (u *ClusterUsecase) Create(ctx context.Context, dto model.Cluster) (cluster
 				"base_repo_branch=" + viper.GetString("revision"),
 				"keycloak_url=" + viper.GetString("keycloak-address"),
 				"policy_ids=" + strings.Join(dto.PolicyIds, ","),
-			},
+			}, cloudAccountParameters...),
 		})
 	if err != nil {
 		log.Error(ctx, "failed to submit argo workflow template. err : ", err)
@@ -509,7 +516,6 @@ func (u *ClusterUsecase) GetClusterSiteValues(ctx context.Context, clusterId dom
 	}
 
 	out.SshKeyName = "tks-seoul"
-	out.ClusterRegion = "ap-northeast-2"
 
 	if err := serializer.Map(ctx, cluster, &out); err != nil {
 		log.Error(ctx, err)
@@ -524,6 +530,11 @@ func (u *ClusterUsecase) GetClusterSiteValues(ctx context.Context, clusterId dom
 		log.Error(ctx, err)
 	}
 
+	// 리전을 지정하지 않고 생성된 클러스터는 기본 리전을 사용한다
+	if out.ClusterRegion == "" {
+		out.ClusterRegion = "ap-northeast-2"
+	}
+
 	/*
 		// 기능 변경 : 20230614 : machine deployment 사용하지 않음. 단, aws-standard 는 사용할 여지가 있으므로 주석처리해둔다.
 		const MAX_AZ_NUM = 4
@@ -656,6 +667,55 @@ func (u *ClusterUsecase) GetBootstrapKubeconfig(ctx context.Context, clusterId d
 	return out, nil
 }
 
+// getKubeNodes 는 BYOH 가 아닌 스택의 노드 현황을 스택 클러스터의 노드 목록으로 조회한다.
+// control-plane 노드는 TKS_CP_NODE, taco-lma 라벨이 있는 노드는 TKS_INFRA_NODE, 나머지는 TKS_USER_NODE 로 분류한다.
+func (u *ClusterUsecase) getKubeNodes(ctx context.Context, cluster model.Cluster) (out []domain.ClusterNode, err error) {
+	client, err := kubernetes.GetClientFromClusterId(ctx, string(cluster.ID))
+	if err != nil {
+		return out, err
+	}
+
+	nodes, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return out, err
+	}
+
+	out = []domain.ClusterNode{
+		{Type: "TKS_CP_NODE", Targeted: cluster.TksCpNode, Hosts: make([]domain.ClusterHost, 0)},
+		{Type: "TKS_INFRA_NODE", Targeted: cluster.TksInfraNode, Hosts: make([]domain.ClusterHost, 0)},
+		{Type: "TKS_USER_NODE", Targeted: cluster.TksUserNode, Hosts: make([]domain.ClusterHost, 0)},
+	}
+	for _, node := range nodes.Items {
+		idx := 2
+		if _, ok := node.Labels["node-role.kubernetes.io/control-plane"]; ok {
+			idx = 0
+		} else if _, ok := node.Labels["taco-lma"]; ok {
+			idx = 1
+		}
+
+		status := "NotReady"
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == "Ready" && condition.Status == "True" {
+				status = "Ready"
+			}
+		}
+		if status == "Ready" {
+			out[idx].Registered++
+		} else {
+			out[idx].Registering++
+		}
+		out[idx].Hosts = append(out[idx].Hosts, domain.ClusterHost{Name: node.Name, Status: status})
+	}
+
+	for i := range out {
+		out[i].Status = "COMPLETED"
+		if out[i].Targeted > out[i].Registered {
+			out[i].Status = "INPROGRESS"
+		}
+	}
+	return out, nil
+}
+
 func (u *ClusterUsecase) GetNodes(ctx context.Context, clusterId domain.ClusterId) (out []domain.ClusterNode, err error) {
 	cluster, err := u.repo.Get(ctx, clusterId)
 	if err != nil {
@@ -665,7 +725,7 @@ func (u *ClusterUsecase) GetNodes(ctx context.Context, clusterId domain.ClusterI
 		return out, err
 	}
 	if cluster.CloudService != domain.CloudService_BYOH {
-		return out, httpErrors.NewBadRequestError(fmt.Errorf("Invalid cloud service"), "", "")
+		return u.getKubeNodes(ctx, cluster)
 	}
 
 	client, err := kubernetes.GetClientAdminCluster(ctx)
@@ -0,0 +1,253 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type IComplianceReportUsecase interface {
+	Create(ctx context.Context, dto model.ComplianceReport) (reportId uuid.UUID, err error)
+	Get(ctx context.Context, organizationId string, reportId uuid.UUID) (model.ComplianceReport, error)
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.ComplianceReport, error)
+	Delete(ctx context.Context, organizationId string, reportId uuid.UUID) error
+	GenerateMonthlyReports(ctx context.Context) error
+	WatchMonthlyReports(ctx context.Context, interval time.Duration)
+}
+
+type ComplianceReportUsecase struct {
+	repo                    repository.IComplianceReportRepository
+	organizationRepo        repository.IOrganizationRepository
+	clusterRepo             repository.IClusterRepository
+	policyRepo              repository.IPolicyRepository
+	policyTemplateRepo      repository.IPolicyTemplateRepository
+	policyViolationRepo     repository.IPolicyViolationRepository
+	stackPolicyTemplateRepo repository.IStackPolicyTemplateRepository
+	jobLeaseRepo            repository.IJobLeaseRepository
+}
+
+func NewComplianceReportUsecase(r repository.Repository) IComplianceReportUsecase {
+	return &ComplianceReportUsecase{
+		repo:                    r.ComplianceReport,
+		organizationRepo:        r.Organization,
+		clusterRepo:             r.Cluster,
+		policyRepo:              r.Policy,
+		policyTemplateRepo:      r.PolicyTemplate,
+		policyViolationRepo:     r.PolicyViolation,
+		stackPolicyTemplateRepo: r.StackPolicyTemplate,
+		jobLeaseRepo:            r.JobLease,
+	}
+}
+
+func (u *ComplianceReportUsecase) Create(ctx context.Context, dto model.ComplianceReport) (reportId uuid.UUID, err error) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return uuid.Nil, httpErrors.NewUnauthorizedError(fmt.Errorf("invalid token"), "A_INVALID_TOKEN", "")
+	}
+
+	if dto.ClusterId != "" {
+		cluster, err := u.clusterRepo.Get(ctx, dto.ClusterId)
+		if err != nil || cluster.OrganizationId != dto.OrganizationId {
+			return uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("invalid stackId"), "C_INVALID_STACK_ID", "")
+		}
+	}
+
+	userId := user.GetUserId()
+	dto.CreatorId = &userId
+	dto.Scheduled = false
+
+	reportId, err = u.generate(ctx, dto)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewInternalServerError(err, "CR_FAILED_TO_GENERATE_COMPLIANCE_REPORT", "")
+	}
+	return reportId, nil
+}
+
+func (u *ComplianceReportUsecase) Get(ctx context.Context, organizationId string, reportId uuid.UUID) (model.ComplianceReport, error) {
+	report, err := u.repo.Get(ctx, organizationId, reportId)
+	if err != nil {
+		return model.ComplianceReport{}, httpErrors.NewNotFoundError(err, "CR_NOT_FOUND_COMPLIANCE_REPORT", "")
+	}
+	return report, nil
+}
+
+func (u *ComplianceReportUsecase) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.ComplianceReport, error) {
+	reports, err := u.repo.Fetch(ctx, organizationId, pg)
+	if err != nil {
+		return nil, httpErrors.NewInternalServerError(err, "", "")
+	}
+	return reports, nil
+}
+
+func (u *ComplianceReportUsecase) Delete(ctx context.Context, organizationId string, reportId uuid.UUID) error {
+	if _, err := u.repo.Get(ctx, organizationId, reportId); err != nil {
+		return httpErrors.NewNotFoundError(err, "CR_NOT_FOUND_COMPLIANCE_REPORT", "")
+	}
+
+	if err := u.repo.Delete(ctx, reportId); err != nil {
+		return httpErrors.NewInternalServerError(err, "", "")
+	}
+	return nil
+}
+
+// GenerateMonthlyReports 는 지난달 보고서가 없는 조직마다 조직 전체 스택을 대상으로 하는 보고서를 생성한다.
+func (u *ComplianceReportUsecase) GenerateMonthlyReports(ctx context.Context) error {
+	organizations, err := u.organizationRepo.Fetch(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	periodEnd := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	periodStart := periodEnd.AddDate(0, -1, 0)
+
+	for _, organization := range *organizations {
+		exists, err := u.repo.ExistScheduled(ctx, organization.ID, periodStart)
+		if err != nil {
+			log.Errorf(ctx, "failed to check compliance report of organization %s: %v", organization.ID, err)
+			continue
+		}
+		if exists {
+			continue
+		}
+
+		_, err = u.generate(ctx, model.ComplianceReport{
+			OrganizationId: organization.ID,
+			PeriodStart:    periodStart,
+			PeriodEnd:      periodEnd,
+			Scheduled:      true,
+		})
+		if err != nil {
+			log.Errorf(ctx, "failed to generate compliance report of organization %s: %v", organization.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func (u *ComplianceReportUsecase) WatchMonthlyReports(ctx context.Context, interval time.Duration) {
+	watchExclusively(ctx, u.jobLeaseRepo, "compliance-report-monthly", interval, u.GenerateMonthlyReports)
+}
+
+// generate 는 현재 정책 상태와 기간 내 위반 기록으로 보고서 내용을 만들어 저장한다.
+func (u *ComplianceReportUsecase) generate(ctx context.Context, dto model.ComplianceReport) (reportId uuid.UUID, err error) {
+	organization, err := u.organizationRepo.Get(ctx, dto.OrganizationId)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	var clusters []model.Cluster
+	if dto.ClusterId != "" {
+		cluster, err := u.clusterRepo.Get(ctx, dto.ClusterId)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		clusters = []model.Cluster{cluster}
+	} else {
+		clusters, err = u.clusterRepo.FetchByOrganizationId(ctx, dto.OrganizationId, uuid.Nil, nil)
+		if err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	violations, err := u.policyViolationRepo.FetchInPeriod(ctx, dto.OrganizationId, dto.PeriodStart, dto.PeriodEnd)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	// 클러스터 + 정책 리소스 이름 별 위반 수
+	violationCounts := make(map[string]int)
+	for _, violation := range violations {
+		violationCounts[violation.ClusterId.String()+"/"+violation.PolicyName]++
+	}
+
+	now := time.Now()
+	content := domain.ComplianceReportContent{
+		OrganizationId:   organization.ID,
+		OrganizationName: organization.Name,
+		PeriodStart:      dto.PeriodStart,
+		PeriodEnd:        dto.PeriodEnd,
+		GeneratedAt:      now,
+		Stacks:           make([]domain.ComplianceReportStack, 0, len(clusters)),
+	}
+
+	for _, cluster := range clusters {
+		policies, err := u.policyRepo.FetchByClusterId(ctx, cluster.ID.String(), nil)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		sort.Slice(*policies, func(i, j int) bool {
+			return (*policies)[i].PolicyName < (*policies)[j].PolicyName
+		})
+
+		stack := domain.ComplianceReportStack{
+			StackId:   cluster.ID.String(),
+			StackName: cluster.Name,
+			Policies:  make([]domain.ComplianceReportPolicy, 0, len(*policies)),
+		}
+
+		for _, policy := range *policies {
+			item := domain.ComplianceReportPolicy{
+				PolicyId:          policy.ID.String(),
+				PolicyName:        policy.PolicyName,
+				Mandatory:         policy.Mandatory,
+				TemplateName:      policy.PolicyTemplate.TemplateName,
+				TemplateKind:      policy.PolicyTemplate.Kind,
+				EnforcementAction: policy.EnforcementAction,
+				ViolationCount:    violationCounts[cluster.ID.String()+"/"+policy.PolicyResourceName],
+				OpenExceptions:    []domain.ComplianceReportException{},
+			}
+			if action, ok := policy.ClusterEnforcementActionMap[cluster.ID.String()]; ok {
+				item.EnforcementAction = action
+			}
+			item.TemplateVersion, item.VersionPinned = u.templateVersion(ctx, cluster.ID, policy.TemplateId)
+
+			for _, exception := range policy.Exceptions {
+				if exception.ClusterId != cluster.ID || exception.Expired || exception.ExpiredAt.Before(now) {
+					continue
+				}
+				item.OpenExceptions = append(item.OpenExceptions, domain.ComplianceReportException{
+					ExceptionId:   exception.ID.String(),
+					Namespace:     exception.Namespace,
+					Justification: exception.Justification,
+					ExpiredAt:     exception.ExpiredAt,
+				})
+			}
+
+			stack.ViolationCount += item.ViolationCount
+			stack.OpenExceptionCount += len(item.OpenExceptions)
+			stack.Policies = append(stack.Policies, item)
+		}
+
+		content.Stacks = append(content.Stacks, stack)
+	}
+
+	dto.ContentData = &content
+	return u.repo.Create(ctx, dto)
+}
+
+// templateVersion 은 스택에 지정된 템플릿 버전을 반환하고, 지정된 버전이 없으면 템플릿의 최신 버전을 반환한다.
+func (u *ComplianceReportUsecase) templateVersion(ctx context.Context, clusterId domain.ClusterId, policyTemplateId uuid.UUID) (version string, pinned bool) {
+	stackVersion, err := u.stackPolicyTemplateRepo.GetVersion(ctx, clusterId, policyTemplateId)
+	if err != nil {
+		log.Error(ctx, err)
+	}
+	if stackVersion != nil {
+		return stackVersion.Version, stackVersion.Pinned
+	}
+
+	version, err = u.policyTemplateRepo.GetLatestTemplateVersion(ctx, policyTemplateId)
+	if err != nil {
+		log.Error(ctx, err)
+	}
+	return version, false
+}
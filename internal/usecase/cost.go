@@ -0,0 +1,318 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	cloudprovider "github.com/openinfradev/tks-api/internal/cloud-provider"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pricing"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+)
+
+// BYOH 와 같이 리전이 없는 스택은 가격표의 default 리전을 사용한다.
+const defaultPriceRegion = "default"
+
+type ICostUsecase interface {
+	EstimateStack(ctx context.Context, organizationId string, dto model.Stack) (domain.CostEstimate, error)
+	GetStackCost(ctx context.Context, organizationId string, stackId domain.StackId) (domain.StackCost, error)
+	GetProjectCost(ctx context.Context, organizationId string, projectId string) (domain.ProjectCost, error)
+	GetDashboardCost(ctx context.Context, organizationId string) (domain.DashboardCost, error)
+}
+
+type CostUsecase struct {
+	catalog           *pricing.Catalog
+	clusterRepo       repository.IClusterRepository
+	stackTemplateRepo repository.IStackTemplateRepository
+	cloudAccountRepo  repository.ICloudAccountRepository
+	projectRepo       repository.IProjectRepository
+	clusterUsecase    IClusterUsecase
+}
+
+func NewCostUsecase(r repository.Repository, clusterUsecase IClusterUsecase) ICostUsecase {
+	catalog, err := pricing.Load(viper.GetString("pricing-catalog-dir"))
+	if err != nil {
+		log.Error(context.Background(), "Failed to load pricing catalog. use default catalog : ", err)
+		catalog = pricing.Default()
+	}
+
+	return &CostUsecase{
+		catalog:           catalog,
+		clusterRepo:       r.Cluster,
+		stackTemplateRepo: r.StackTemplate,
+		cloudAccountRepo:  r.CloudAccount,
+		projectRepo:       r.Project,
+		clusterUsecase:    clusterUsecase,
+	}
+}
+
+// EstimateStack 은 생성할 스택의 노드 구성으로 월 비용을 추정한다.
+// 노드 수와 타입의 기본값은 스택 및 클러스터 생성 시와 동일하게 적용한다.
+func (u *CostUsecase) EstimateStack(ctx context.Context, organizationId string, dto model.Stack) (out domain.CostEstimate, err error) {
+	cloudService := dto.CloudService
+	region := dto.Conf.ClusterRegion
+	if cloudService != domain.CloudService_BYOH {
+		var cloudAccount model.CloudAccount
+		if dto.CloudAccountId != uuid.Nil {
+			cloudAccount, err = u.cloudAccountRepo.Get(ctx, dto.CloudAccountId)
+			if err != nil || cloudAccount.OrganizationId != organizationId {
+				return out, httpErrors.NewBadRequestError(fmt.Errorf("invalid cloudAccountId"), "CO_INVALID_CLOUD_ACCOUNT", "")
+			}
+			cloudService = cloudAccount.CloudService
+		}
+		provider, err := cloudprovider.New(cloudService)
+		if err != nil {
+			return out, httpErrors.NewBadRequestError(err, "CO_INVALID_CLOUD_ACCOUNT", "")
+		}
+		if region, err = cloudprovider.ResolveRegion(provider, cloudAccount, region); err != nil {
+			return out, httpErrors.NewBadRequestError(err, "CO_INVALID_CLUSTER_REGION", "")
+		}
+	}
+
+	if dto.StackTemplateId != uuid.Nil {
+		stackTemplate, err := u.stackTemplateRepo.Get(ctx, dto.StackTemplateId)
+		if err != nil {
+			return out, httpErrors.NewBadRequestError(errors.Wrap(err, "Invalid stackTemplateId"), "CO_INVALID_STACK_TEMPLATE", "")
+		}
+		if stackTemplate.CloudService == "AWS" && stackTemplate.KubeType == "AWS" && dto.Conf.TksCpNode == 0 {
+			dto.Conf.TksCpNode = 3
+			dto.Conf.TksInfraNode = 3
+		}
+	}
+
+	cluster := model.Cluster{
+		TksCpNode:        dto.Conf.TksCpNode,
+		TksCpNodeType:    dto.Conf.TksCpNodeType,
+		TksInfraNode:     dto.Conf.TksInfraNode,
+		TksInfraNodeType: dto.Conf.TksInfraNodeType,
+		TksUserNode:      dto.Conf.TksUserNode,
+		TksUserNodeType:  dto.Conf.TksUserNodeType,
+	}
+	cluster.SetDefaultConf()
+
+	return u.estimate(cloudService, region, u.configuredNodes(cluster)), nil
+}
+
+func (u *CostUsecase) GetStackCost(ctx context.Context, organizationId string, stackId domain.StackId) (out domain.StackCost, err error) {
+	cluster, err := u.clusterRepo.Get(ctx, domain.ClusterId(stackId))
+	if err != nil || cluster.OrganizationId != organizationId {
+		return out, httpErrors.NewNotFoundError(fmt.Errorf("not found stack"), "CO_NOT_FOUND_STACK", "")
+	}
+	return u.stackCost(ctx, cluster), nil
+}
+
+func (u *CostUsecase) GetProjectCost(ctx context.Context, organizationId string, projectId string) (out domain.ProjectCost, err error) {
+	project, err := u.projectRepo.GetProjectById(ctx, organizationId, projectId)
+	if err != nil || project == nil {
+		return out, httpErrors.NewNotFoundError(fmt.Errorf("not found project"), "CO_NOT_FOUND_PROJECT", "")
+	}
+
+	out, err = u.projectCost(ctx, organizationId, project.ID, project.Name, map[domain.StackId]domain.StackCost{})
+	if err != nil {
+		return out, httpErrors.NewInternalServerError(err, "CO_FAILED_TO_GET_COST", "")
+	}
+	return out, nil
+}
+
+// GetDashboardCost 는 조직의 스택별, 프로젝트별 월 비용을 반환한다.
+// 통화가 다른 스택은 합계에서 제외하고 warnings 에 표시한다.
+func (u *CostUsecase) GetDashboardCost(ctx context.Context, organizationId string) (out domain.DashboardCost, err error) {
+	clusters, err := u.clusterRepo.FetchByOrganizationId(ctx, organizationId, uuid.Nil, nil)
+	if err != nil {
+		return out, httpErrors.NewInternalServerError(err, "CO_FAILED_TO_GET_COST", "")
+	}
+
+	stackCosts := map[domain.StackId]domain.StackCost{}
+	out.Stacks = make([]domain.StackCost, 0)
+	for _, cluster := range clusters {
+		if cluster.Status == domain.ClusterStatus_DELETED {
+			continue
+		}
+		stackCost := u.stackCost(ctx, cluster)
+		stackCosts[stackCost.StackId] = stackCost
+		out.Stacks = append(out.Stacks, stackCost)
+
+		if stackCost.Status == domain.CostStatus_NO_PRICE_CATALOG {
+			out.Warnings = append(out.Warnings, fmt.Sprintf("stack %s has no price catalog, excluded from total", stackCost.StackName))
+			continue
+		}
+		if stackCost.Currency != "" && out.Currency != "" && stackCost.Currency != out.Currency {
+			out.Warnings = append(out.Warnings, fmt.Sprintf("stack %s is priced in %s, excluded from total", stackCost.StackName, stackCost.Currency))
+			continue
+		}
+		if out.Currency == "" {
+			out.Currency = stackCost.Currency
+		}
+		out.MonthlyCost += stackCost.MonthlyCost
+	}
+	sort.Slice(out.Stacks, func(i, j int) bool {
+		return out.Stacks[i].MonthlyCost > out.Stacks[j].MonthlyCost
+	})
+
+	projects, err := u.projectRepo.GetAllProjects(ctx, organizationId, "", nil)
+	if err != nil {
+		return out, httpErrors.NewInternalServerError(err, "CO_FAILED_TO_GET_COST", "")
+	}
+	out.Projects = make([]domain.ProjectCost, 0, len(projects))
+	for _, project := range projects {
+		projectCost, err := u.projectCost(ctx, organizationId, project.ID, project.Name, stackCosts)
+		if err != nil {
+			return out, httpErrors.NewInternalServerError(err, "CO_FAILED_TO_GET_COST", "")
+		}
+		out.Projects = append(out.Projects, projectCost)
+	}
+	sort.Slice(out.Projects, func(i, j int) bool {
+		return out.Projects[i].MonthlyCost > out.Projects[j].MonthlyCost
+	})
+
+	return out, nil
+}
+
+// projectCost 는 프로젝트의 네임스페이스가 있는 스택의 비용을 스택을 사용하는 프로젝트 수로 나누어 합산한다.
+// stackCosts 는 이미 계산한 스택 비용이며, 없는 스택은 계산하여 추가한다.
+func (u *CostUsecase) projectCost(ctx context.Context, organizationId string, projectId string, projectName string, stackCosts map[domain.StackId]domain.StackCost) (out domain.ProjectCost, err error) {
+	out = domain.ProjectCost{
+		ProjectId:   projectId,
+		ProjectName: projectName,
+		Stacks:      make([]domain.ProjectStackCost, 0),
+	}
+
+	stackIds, err := u.projectRepo.GetStackIdsByProjectId(ctx, projectId)
+	if err != nil {
+		return out, err
+	}
+
+	for _, stackId := range stackIds {
+		stackCost, ok := stackCosts[domain.StackId(stackId)]
+		if !ok {
+			cluster, err := u.clusterRepo.Get(ctx, domain.ClusterId(stackId))
+			if err != nil || cluster.OrganizationId != organizationId || cluster.Status == domain.ClusterStatus_DELETED {
+				continue
+			}
+			stackCost = u.stackCost(ctx, cluster)
+			stackCosts[stackCost.StackId] = stackCost
+		}
+
+		projectIds, err := u.projectRepo.GetProjectIdsByStackId(ctx, stackId)
+		if err != nil {
+			return out, err
+		}
+		projectCount := len(projectIds)
+		if projectCount == 0 {
+			projectCount = 1
+		}
+
+		if stackCost.Status == domain.CostStatus_NO_PRICE_CATALOG {
+			out.Warnings = append(out.Warnings, fmt.Sprintf("stack %s has no price catalog, excluded from total", stackCost.StackName))
+			continue
+		}
+		if stackCost.Currency != "" && out.Currency != "" && stackCost.Currency != out.Currency {
+			out.Warnings = append(out.Warnings, fmt.Sprintf("stack %s is priced in %s, excluded from total", stackCost.StackName, stackCost.Currency))
+			continue
+		}
+		if out.Currency == "" {
+			out.Currency = stackCost.Currency
+		}
+
+		share := stackCost.MonthlyCost / float64(projectCount)
+		out.Stacks = append(out.Stacks, domain.ProjectStackCost{
+			StackId:          stackCost.StackId,
+			StackName:        stackCost.StackName,
+			ProjectCount:     projectCount,
+			StackMonthlyCost: stackCost.MonthlyCost,
+			MonthlyCost:      share,
+		})
+		out.MonthlyCost += share
+	}
+
+	return out, nil
+}
+
+// stackCost 는 스택의 노드 현황과 가격표로 월 비용을 계산한다.
+// 노드 수는 스택에서 조회한 노드 현황(BYOH 는 등록된 호스트, 그 외는 클러스터 노드)을 사용하며,
+// 조회에 실패하면 설정된 노드 수를 사용한다.
+func (u *CostUsecase) stackCost(ctx context.Context, cluster model.Cluster) domain.StackCost {
+	nodes := u.configuredNodes(cluster)
+	var warnings []string
+	clusterNodes, err := u.clusterUsecase.GetNodes(ctx, cluster.ID)
+	if err != nil {
+		log.Error(ctx, err)
+		warnings = append(warnings, "failed to get nodes of stack, configured node counts are used")
+	} else {
+		for _, clusterNode := range clusterNodes {
+			for i := range nodes {
+				if nodes[i].Type == clusterNode.Type {
+					nodes[i].Count = clusterNode.Registered
+				}
+			}
+		}
+	}
+
+	region := cluster.ClusterRegion
+	if region == "" && cluster.CloudService != domain.CloudService_BYOH {
+		// 리전 정보가 없는 이전 클러스터는 클라우드 서비스의 기본 리전에 생성되었다
+		if provider, err := cloudprovider.New(cluster.CloudService); err == nil {
+			region = provider.DefaultRegion()
+		}
+	}
+
+	estimate := u.estimate(cluster.CloudService, region, nodes)
+	estimate.Warnings = append(warnings, estimate.Warnings...)
+	return domain.StackCost{
+		StackId:      domain.StackId(cluster.ID),
+		StackName:    cluster.Name,
+		CostEstimate: estimate,
+	}
+}
+
+func (u *CostUsecase) configuredNodes(cluster model.Cluster) []domain.NodeCost {
+	return []domain.NodeCost{
+		{Type: "TKS_CP_NODE", InstanceType: cluster.TksCpNodeType, Count: cluster.TksCpNode},
+		{Type: "TKS_INFRA_NODE", InstanceType: cluster.TksInfraNodeType, Count: cluster.TksInfraNode},
+		{Type: "TKS_USER_NODE", InstanceType: cluster.TksUserNodeType, Count: cluster.TksUserNode},
+	}
+}
+
+func (u *CostUsecase) estimate(cloudService string, region string, nodes []domain.NodeCost) (out domain.CostEstimate) {
+	if region == "" {
+		region = defaultPriceRegion
+	}
+	out = domain.CostEstimate{
+		CloudService: cloudService,
+		Region:       region,
+		Nodes:        nodes,
+	}
+
+	file, ok := u.catalog.Get(cloudService, region)
+	if !ok {
+		out.Status = domain.CostStatus_NO_PRICE_CATALOG
+		out.Warnings = append(out.Warnings, fmt.Sprintf("no price file for %s %s", cloudService, region))
+		return out
+	}
+	out.Currency = file.Currency
+	out.Status = domain.CostStatus_PRICED
+
+	for i, node := range out.Nodes {
+		price, _, err := u.catalog.Price(cloudService, region, node.InstanceType)
+		if err != nil {
+			if node.Count > 0 {
+				out.Status = domain.CostStatus_PARTIALLY_PRICED
+			}
+			out.Warnings = append(out.Warnings, err.Error())
+			continue
+		}
+		out.Nodes[i].Priced = true
+		out.Nodes[i].HourlyPrice = price.Hourly
+		out.Nodes[i].MonthlyCost = pricing.Monthly(price.Hourly, node.Count)
+		out.MonthlyCost += out.Nodes[i].MonthlyCost
+	}
+
+	return out
+}
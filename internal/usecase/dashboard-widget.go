@@ -0,0 +1,269 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/promql"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"gorm.io/gorm"
+)
+
+type IDashboardWidgetUsecase interface {
+	Create(ctx context.Context, organizationId string, dto model.DashboardWidget) (dashboardWidgetId uuid.UUID, err error)
+	Update(ctx context.Context, organizationId string, dashboardWidgetId uuid.UUID, input domain.UpdateDashboardWidgetRequest) (err error)
+	Delete(ctx context.Context, organizationId string, dashboardWidgetId uuid.UUID) (err error)
+	Get(ctx context.Context, organizationId string, dashboardWidgetId uuid.UUID) (*model.DashboardWidget, error)
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (*[]model.DashboardWidget, error)
+	Query(ctx context.Context, organizationId string, dashboardWidgetId uuid.UUID, duration string, interval string) (*model.DashboardWidget, domain.DashboardWidgetData, error)
+}
+
+type DashboardWidgetUsecase struct {
+	repo             repository.IDashboardWidgetRepository
+	clusterRepo      repository.IClusterRepository
+	dashboardUsecase IDashboardUsecase
+}
+
+func NewDashboardWidgetUsecase(r repository.Repository, dashboardUsecase IDashboardUsecase) IDashboardWidgetUsecase {
+	return &DashboardWidgetUsecase{
+		repo:             r.DashboardWidget,
+		clusterRepo:      r.Cluster,
+		dashboardUsecase: dashboardUsecase,
+	}
+}
+
+func (u *DashboardWidgetUsecase) Create(ctx context.Context, organizationId string, dto model.DashboardWidget) (dashboardWidgetId uuid.UUID, err error) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return uuid.Nil, httpErrors.NewUnauthorizedError(fmt.Errorf("invalid token"), "A_INVALID_TOKEN", "")
+	}
+
+	if err := promql.Validate(dto.Query); err != nil {
+		return uuid.Nil, httpErrors.NewBadRequestError(err, "DW_INVALID_QUERY", "")
+	}
+
+	dto.OrganizationId = organizationId
+	userId := user.GetUserId()
+	dto.CreatorId = &userId
+
+	return u.repo.Create(ctx, dto)
+}
+
+func (u *DashboardWidgetUsecase) Update(ctx context.Context, organizationId string, dashboardWidgetId uuid.UUID, input domain.UpdateDashboardWidgetRequest) (err error) {
+	if _, err := u.getOwned(ctx, organizationId, dashboardWidgetId); err != nil {
+		return err
+	}
+
+	updateMap := make(map[string]interface{})
+	if input.Name != nil {
+		updateMap["name"] = *input.Name
+	}
+	if input.Description != nil {
+		updateMap["description"] = *input.Description
+	}
+	if input.Query != nil {
+		if err := promql.Validate(*input.Query); err != nil {
+			return httpErrors.NewBadRequestError(err, "DW_INVALID_QUERY", "")
+		}
+		updateMap["query"] = *input.Query
+	}
+	if input.ChartType != nil {
+		updateMap["chart_type"] = *input.ChartType
+	}
+	if input.Unit != nil {
+		updateMap["unit"] = *input.Unit
+	}
+	if input.Thresholds != nil {
+		thresholds, err := json.Marshal(*input.Thresholds)
+		if err != nil {
+			return httpErrors.NewBadRequestError(err, "DW_INVALID_THRESHOLDS", "")
+		}
+		updateMap["thresholds"] = string(thresholds)
+	}
+	if input.Shared != nil {
+		updateMap["shared"] = *input.Shared
+	}
+
+	if len(updateMap) == 0 {
+		return nil
+	}
+	return u.repo.Update(ctx, dashboardWidgetId, updateMap)
+}
+
+func (u *DashboardWidgetUsecase) Delete(ctx context.Context, organizationId string, dashboardWidgetId uuid.UUID) (err error) {
+	if _, err := u.getOwned(ctx, organizationId, dashboardWidgetId); err != nil {
+		return err
+	}
+	return u.repo.Delete(ctx, dashboardWidgetId)
+}
+
+// Get 은 위젯을 조회한다. 공유되지 않은 다른 사용자의 위젯은 존재하지 않는 것으로 처리한다.
+func (u *DashboardWidgetUsecase) Get(ctx context.Context, organizationId string, dashboardWidgetId uuid.UUID) (*model.DashboardWidget, error) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return nil, httpErrors.NewUnauthorizedError(fmt.Errorf("invalid token"), "A_INVALID_TOKEN", "")
+	}
+
+	dashboardWidget, err := u.repo.Get(ctx, organizationId, dashboardWidgetId)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, httpErrors.NewNotFoundError(err, "DW_NOT_FOUND_DASHBOARD_WIDGET", "")
+		}
+		return nil, err
+	}
+	if !dashboardWidget.Shared && !isDashboardWidgetCreator(dashboardWidget, user.GetUserId()) {
+		return nil, httpErrors.NewNotFoundError(fmt.Errorf("not shared dashboard widget"), "DW_NOT_FOUND_DASHBOARD_WIDGET", "")
+	}
+	return &dashboardWidget, nil
+}
+
+func (u *DashboardWidgetUsecase) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (*[]model.DashboardWidget, error) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return nil, httpErrors.NewUnauthorizedError(fmt.Errorf("invalid token"), "A_INVALID_TOKEN", "")
+	}
+
+	dashboardWidgets, err := u.repo.Fetch(ctx, organizationId, user.GetUserId(), pg)
+	if err != nil {
+		return nil, err
+	}
+	return &dashboardWidgets, nil
+}
+
+// Query 는 위젯의 PromQL 을 조직 클러스터로 범위를 제한하여 Thanos 에서 조회한다.
+func (u *DashboardWidgetUsecase) Query(ctx context.Context, organizationId string, dashboardWidgetId uuid.UUID, duration string, interval string) (*model.DashboardWidget, domain.DashboardWidgetData, error) {
+	out := domain.DashboardWidgetData{
+		Duration: duration,
+		Interval: interval,
+	}
+
+	dashboardWidget, err := u.Get(ctx, organizationId, dashboardWidgetId)
+	if err != nil {
+		return nil, out, err
+	}
+
+	clusters, err := u.clusterRepo.FetchByOrganizationId(ctx, organizationId, uuid.Nil, nil)
+	if err != nil {
+		return nil, out, httpErrors.NewInternalServerError(err, "S_FAILED_FETCH_CLUSTERS", "")
+	}
+	clusterNames := make(map[string]string, len(clusters))
+	clusterIds := make([]string, len(clusters))
+	for i, cluster := range clusters {
+		clusterIds[i] = cluster.ID.String()
+		clusterNames[cluster.ID.String()] = cluster.Name
+	}
+	if len(clusterIds) == 0 {
+		out.UpdatedAt = time.Now()
+		return dashboardWidget, out, nil
+	}
+
+	query, err := promql.ScopeQuery(dashboardWidget.Query, clusterIds)
+	if err != nil {
+		return nil, out, httpErrors.NewBadRequestError(err, "DW_INVALID_QUERY", "")
+	}
+	out.Query = query
+
+	thanosClient, err := u.dashboardUsecase.GetThanosClient(ctx, organizationId)
+	if err != nil {
+		return nil, out, err
+	}
+
+	durationSec, intervalSec := getDurationAndIntervalSec(duration, interval)
+	now := int(time.Now().Unix())
+	result, err := thanosClient.FetchRange(ctx, query, now-durationSec, now, intervalSec)
+	if err != nil {
+		log.Error(ctx, err)
+		return nil, out, httpErrors.NewInternalServerError(err, "DW_FAILED_TO_QUERY", "")
+	}
+
+	// 모든 시계열의 timestamp 를 x 축으로 사용하고 값이 없는 지점은 빈 문자열로 채운다
+	xAxisSet := map[int]bool{}
+	seriesValues := make([]map[int]string, len(result.Data.Result))
+	for i, val := range result.Data.Result {
+		seriesValues[i] = map[int]string{}
+		for _, value := range val.Values {
+			pair, ok := value.([]interface{})
+			if !ok || len(pair) != 2 {
+				continue
+			}
+			ts, ok := pair[0].(float64)
+			if !ok {
+				continue
+			}
+			x := int(math.Round(ts))
+			xAxisSet[x] = true
+			seriesValues[i][x], _ = pair[1].(string)
+		}
+	}
+	xAxisData := make([]int, 0, len(xAxisSet))
+	for x := range xAxisSet {
+		xAxisData = append(xAxisData, x)
+	}
+	sort.Ints(xAxisData)
+
+	out.ChartData.XAxis = &domain.Axis{Data: make([]string, len(xAxisData))}
+	for i, x := range xAxisData {
+		out.ChartData.XAxis.Data[i] = strconv.Itoa(x)
+	}
+	for i, val := range result.Data.Result {
+		yAxisData := make([]string, len(xAxisData))
+		for j, x := range xAxisData {
+			yAxisData[j] = seriesValues[i][x]
+		}
+
+		name := []string{}
+		if clusterName, ok := clusterNames[val.Metric.TacoCluster]; ok {
+			name = append(name, clusterName)
+		}
+		for _, label := range []string{val.Metric.Namespace, val.Metric.Name} {
+			if label != "" {
+				name = append(name, label)
+			}
+		}
+		if len(name) == 0 {
+			name = append(name, dashboardWidget.Name)
+		}
+
+		out.ChartData.Series = append(out.ChartData.Series, domain.Unit{
+			Name: strings.Join(name, "/"),
+			Data: yAxisData,
+		})
+	}
+	out.UpdatedAt = time.Now()
+
+	return dashboardWidget, out, nil
+}
+
+// getOwned 는 위젯을 조회하고 요청한 사용자가 생성자인지 확인한다.
+func (u *DashboardWidgetUsecase) getOwned(ctx context.Context, organizationId string, dashboardWidgetId uuid.UUID) (*model.DashboardWidget, error) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return nil, httpErrors.NewUnauthorizedError(fmt.Errorf("invalid token"), "A_INVALID_TOKEN", "")
+	}
+
+	dashboardWidget, err := u.Get(ctx, organizationId, dashboardWidgetId)
+	if err != nil {
+		return nil, err
+	}
+	if !isDashboardWidgetCreator(*dashboardWidget, user.GetUserId()) {
+		return nil, httpErrors.NewForbiddenError(fmt.Errorf("not creator of dashboard widget"), "DW_NOT_PERMITTED", "")
+	}
+	return dashboardWidget, nil
+}
+
+func isDashboardWidgetCreator(dashboardWidget model.DashboardWidget, userId uuid.UUID) bool {
+	return dashboardWidget.CreatorId != nil && *dashboardWidget.CreatorId == userId
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal"
 	"github.com/openinfradev/tks-api/internal/helper"
 	"github.com/openinfradev/tks-api/internal/model"
 	policytemplate "github.com/openinfradev/tks-api/internal/policy-template"
@@ -32,6 +33,11 @@ type IDashboardUsecase interface {
 	CreateDashboard(ctx context.Context, dashboard *model.Dashboard) (string, error)
 	GetDashboard(ctx context.Context, organizationId string, userId string, dashboardKey string) (*model.Dashboard, error)
 	UpdateDashboard(ctx context.Context, dashboard *model.Dashboard) error
+	SaveDashboard(ctx context.Context, organizationId string, userId uuid.UUID, dashboardKey string, content string) error
+	GetDefaultDashboard(ctx context.Context, organizationId string, dashboardKey string) (*model.Dashboard, error)
+	SetDefaultDashboard(ctx context.Context, organizationId string, dashboardKey string, content string) error
+	DeleteDefaultDashboard(ctx context.Context, organizationId string, dashboardKey string) error
+	ResetDashboard(ctx context.Context, organizationId string, userId uuid.UUID, dashboardKey string) (*model.Dashboard, error)
 	GetCharts(ctx context.Context, organizationId string, chartType domain.ChartType, duration string, interval string, year string, month string) (res []domain.DashboardChart, err error)
 	GetStacks(ctx context.Context, organizationId string) (out []domain.DashboardStack, err error)
 	GetResources(ctx context.Context, organizationId string) (out domain.DashboardResource, err error)
@@ -92,6 +98,87 @@ func (u *DashboardUsecase) UpdateDashboard(ctx context.Context, dashboard *model
 	return nil
 }
 
+// SaveDashboard 는 사용자의 대시보드 내용을 저장한다. 대시보드가 없으면 생성한다.
+func (u *DashboardUsecase) SaveDashboard(ctx context.Context, organizationId string, userId uuid.UUID, dashboardKey string, content string) error {
+	dashboard, err := u.dashboardRepo.GetDashboardByUserId(ctx, organizationId, userId.String(), dashboardKey)
+	if err != nil {
+		return errors.Wrap(err, "Failed to get dashboard.")
+	}
+	if dashboard == nil {
+		_, err = u.CreateDashboard(ctx, &model.Dashboard{
+			OrganizationId: organizationId,
+			UserId:         userId,
+			Key:            dashboardKey,
+			Content:        content,
+			IsAdmin:        false,
+		})
+		return err
+	}
+
+	dashboard.Content = content
+	return u.UpdateDashboard(ctx, dashboard)
+}
+
+func (u *DashboardUsecase) GetDefaultDashboard(ctx context.Context, organizationId string, dashboardKey string) (*model.Dashboard, error) {
+	dashboard, err := u.dashboardRepo.GetDefaultDashboard(ctx, organizationId, dashboardKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to get default dashboard.")
+	}
+	return dashboard, nil
+}
+
+// SetDefaultDashboard 는 조직의 기본 대시보드를 게시한다. 자신의 대시보드가 없는 사용자는 기본 대시보드를 보게 된다.
+func (u *DashboardUsecase) SetDefaultDashboard(ctx context.Context, organizationId string, dashboardKey string, content string) error {
+	dashboard, err := u.GetDefaultDashboard(ctx, organizationId, dashboardKey)
+	if err != nil {
+		return err
+	}
+	if dashboard == nil {
+		_, err = u.CreateDashboard(ctx, &model.Dashboard{
+			OrganizationId: organizationId,
+			UserId:         uuid.Nil,
+			Key:            dashboardKey,
+			Content:        content,
+			IsAdmin:        true,
+		})
+		return err
+	}
+
+	dashboard.Content = content
+	return u.UpdateDashboard(ctx, dashboard)
+}
+
+func (u *DashboardUsecase) DeleteDefaultDashboard(ctx context.Context, organizationId string, dashboardKey string) error {
+	dashboard, err := u.GetDefaultDashboard(ctx, organizationId, dashboardKey)
+	if err != nil {
+		return err
+	}
+	if dashboard == nil {
+		return httpErrors.NewNotFoundError(fmt.Errorf("not found default dashboard"), "D_NOT_FOUND_DEFAULT_DASHBOARD", "")
+	}
+	if err := u.dashboardRepo.DeleteDashboard(ctx, dashboard); err != nil {
+		return errors.Wrap(err, "Failed to delete default dashboard")
+	}
+	return nil
+}
+
+// ResetDashboard 는 사용자의 대시보드를 조직의 기본 대시보드 내용으로 되돌린다.
+// 사용자의 대시보드가 없으면 기본 대시보드를 복사하여 생성한다.
+func (u *DashboardUsecase) ResetDashboard(ctx context.Context, organizationId string, userId uuid.UUID, dashboardKey string) (*model.Dashboard, error) {
+	defaultDashboard, err := u.GetDefaultDashboard(ctx, organizationId, dashboardKey)
+	if err != nil {
+		return nil, err
+	}
+	if defaultDashboard == nil {
+		return nil, httpErrors.NewNotFoundError(fmt.Errorf("not found default dashboard"), "D_NOT_FOUND_DEFAULT_DASHBOARD", "")
+	}
+
+	if err := u.SaveDashboard(ctx, organizationId, userId, dashboardKey, defaultDashboard.Content); err != nil {
+		return nil, err
+	}
+	return u.GetDashboard(ctx, organizationId, userId.String(), dashboardKey)
+}
+
 func (u *DashboardUsecase) GetCharts(ctx context.Context, organizationId string, chartType domain.ChartType, duration string, interval string, year string, month string) (out []domain.DashboardChart, err error) {
 	_, err = u.organizationRepo.Get(ctx, organizationId)
 	if err != nil {
@@ -120,15 +207,9 @@ func (u *DashboardUsecase) GetStacks(ctx context.Context, organizationId string)
 		return out, err
 	}
 
-	thanosUrl, err := u.getThanosUrl(ctx, organizationId)
-	if err != nil {
-		log.Error(ctx, err)
-		return out, httpErrors.NewInternalServerError(err, "D_INVALID_PRIMARY_STACK", "")
-	}
-	address, port := helper.SplitAddress(ctx, thanosUrl)
-	thanosClient, err := thanos.New(address, port, false, "")
+	thanosClient, err := u.GetThanosClient(ctx, organizationId)
 	if err != nil {
-		return out, errors.Wrap(err, "failed to create thanos client")
+		return out, err
 	}
 	stackMemoryDisk, err := thanosClient.Get(ctx, "sum by (__name__, taco_cluster) ({__name__=~\"node_memory_MemFree_bytes|machine_memory_bytes|kubelet_volume_stats_used_bytes|kubelet_volume_stats_capacity_bytes\"})")
 	if err != nil {
@@ -179,15 +260,9 @@ func (u *DashboardUsecase) GetStacks(ctx context.Context, organizationId string)
 }
 
 func (u *DashboardUsecase) GetResources(ctx context.Context, organizationId string) (out domain.DashboardResource, err error) {
-	thanosUrl, err := u.getThanosUrl(ctx, organizationId)
-	if err != nil {
-		log.Error(ctx, err)
-		return out, httpErrors.NewInternalServerError(err, "D_INVALID_PRIMARY_STACK", "")
-	}
-	address, port := helper.SplitAddress(ctx, thanosUrl)
-	thanosClient, err := thanos.New(address, port, false, "")
+	thanosClient, err := u.GetThanosClient(ctx, organizationId)
 	if err != nil {
-		return out, errors.Wrap(err, "failed to create thanos client")
+		return out, err
 	}
 
 	// Stack
@@ -290,15 +365,9 @@ func (u *DashboardUsecase) GetResources(ctx context.Context, organizationId stri
 }
 
 func (u *DashboardUsecase) getChartFromPrometheus(ctx context.Context, organizationId string, chartType string, duration string, interval string, year string, month string) (res domain.DashboardChart, err error) {
-	thanosUrl, err := u.getThanosUrl(ctx, organizationId)
-	if err != nil {
-		log.Error(ctx, err)
-		return res, httpErrors.NewInternalServerError(err, "D_INVALID_PRIMARY_STACK", "")
-	}
-	address, port := helper.SplitAddress(ctx, thanosUrl)
-	thanosClient, err := thanos.New(address, port, false, "")
+	thanosClient, err := u.GetThanosClient(ctx, organizationId)
 	if err != nil {
-		return res, errors.Wrap(err, "failed to create thanos client")
+		return res, err
 	}
 
 	now := time.Now()
@@ -640,6 +709,10 @@ func (u *DashboardUsecase) GetPolicyUpdate(ctx context.Context, policyTemplates
 	outdatedPolicyCount := 0
 
 	for _, policy := range policies {
+		// 스택 전용 CR 은 기본 CR 과 같은 정책이므로 세지 않음
+		if policy.IsStackPolicy() {
+			continue
+		}
 		templateId := policy.Labels[policytemplate.TemplateIDLabel]
 
 		if slices.Contains(outdatedTemplateIds, templateId) {
@@ -1146,10 +1219,22 @@ func (u *DashboardUsecase) GetThanosClient(ctx context.Context, organizationId s
 	//address = "http://a93c60de70c794ef39b495976588c989-d7cd29ca75def693.elb.ap-northeast-2.amazonaws.com"
 	//port = 9090
 
-	client, err := thanos.New(address, port, false, "")
+	// 같은 Thanos 를 조회하는 요청이 결과 캐시를 공유하도록 주소별로 클라이언트를 하나만 생성한다
+	const prefix = "CACHE_KEY_THANOS_CLIENT"
+	if value, found := u.cache.Get(prefix + thanosUrl); found {
+		return value.(thanos.ThanosClient), nil
+	}
+
+	client, err := thanos.NewCached(address, port, false, "", internal.ThanosQueryCacheTTL)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create thanos client")
 	}
+	if err := u.cache.Add(prefix+thanosUrl, client, gcache.NoExpiration); err != nil {
+		// 동시에 생성된 경우 먼저 등록된 클라이언트를 사용한다
+		if value, found := u.cache.Get(prefix + thanosUrl); found {
+			return value.(thanos.ThanosClient), nil
+		}
+	}
 	return client, nil
 }
 
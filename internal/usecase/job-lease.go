@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+// jobLeaseHolder 는 이 서버 프로세스를 식별한다.
+var jobLeaseHolder = func() string {
+	hostname, _ := os.Hostname()
+	return hostname + "-" + uuid.New().String()
+}()
+
+// watchExclusively 는 interval 마다 fn 을 실행한다.
+// 여러 replica 가 같은 작업을 중복 실행하지 않도록 lease 를 획득한 서버에서만 실행하며,
+// lease 는 주기보다 길게 유지하여 lease 를 가진 서버가 살아 있는 동안 계속 이어서 실행하도록 한다.
+// fn 이 lease 유지 시간보다 오래 걸려도 다른 서버가 실행하지 않도록 fn 이 끝날 때까지 lease 를 갱신한다.
+// ctx 가 취소되면(서버 종료) lease 를 반환하고 종료한다.
+func watchExclusively(ctx context.Context, repo repository.IJobLeaseRepository, name string, interval time.Duration, fn func(ctx context.Context) error) {
+	ttl := interval + interval/2
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if err := repo.Release(context.Background(), name, jobLeaseHolder); err != nil {
+				log.Error(context.Background(), fmt.Sprintf("failed to release job lease %s: ", name), err)
+			}
+			return
+		case <-ticker.C:
+			acquired, err := repo.TryAcquire(ctx, name, jobLeaseHolder, ttl)
+			if err != nil {
+				log.Error(ctx, fmt.Sprintf("failed to acquire job lease %s: ", name), err)
+				continue
+			}
+			if !acquired {
+				continue
+			}
+			if err := runWithLease(ctx, repo, name, ttl, fn); err != nil {
+				log.Error(ctx, fmt.Sprintf("failed to run job %s: ", name), err)
+			}
+		}
+	}
+}
+
+// runWithLease 는 fn 을 실행하는 동안 ttl 의 절반마다 lease 를 갱신한다.
+// lease 를 갱신하지 못하면 다른 서버가 작업을 이어받을 수 있으므로 fn 의 ctx 를 취소한다.
+func runWithLease(ctx context.Context, repo repository.IJobLeaseRepository, name string, ttl time.Duration, fn func(ctx context.Context) error) error {
+	jobCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		ticker := time.NewTicker(ttl / 2)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-jobCtx.Done():
+				return
+			case <-ticker.C:
+				renewed, err := repo.TryAcquire(jobCtx, name, jobLeaseHolder, ttl)
+				select {
+				case <-done:
+					return
+				default:
+				}
+				if err != nil || !renewed {
+					log.Error(ctx, fmt.Sprintf("lost job lease %s: ", name), err)
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	return fn(jobCtx)
+}
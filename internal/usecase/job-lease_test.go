@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeJobLeaseRepository 는 renewable 이 false 가 되면 lease 갱신에 실패한다.
+type fakeJobLeaseRepository struct {
+	mu        sync.Mutex
+	renewable bool
+	renewals  int
+}
+
+func (r *fakeJobLeaseRepository) TryAcquire(ctx context.Context, name string, holder string, ttl time.Duration) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.renewals++
+	return r.renewable, nil
+}
+
+func (r *fakeJobLeaseRepository) Release(ctx context.Context, name string, holder string) error {
+	return nil
+}
+
+func TestRunWithLeaseRenewsWhileRunning(t *testing.T) {
+	repo := &fakeJobLeaseRepository{renewable: true}
+	ttl := 20 * time.Millisecond
+
+	err := runWithLease(context.Background(), repo, "job", ttl, func(ctx context.Context) error {
+		time.Sleep(3 * ttl)
+		return ctx.Err()
+	})
+	if err != nil {
+		t.Fatalf("job must not be canceled while the lease is renewed: %v", err)
+	}
+
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.renewals < 2 {
+		t.Errorf("expected the lease to be renewed while the job runs, got %d renewals", repo.renewals)
+	}
+}
+
+func TestRunWithLeaseCancelsWhenLeaseIsLost(t *testing.T) {
+	repo := &fakeJobLeaseRepository{renewable: false}
+	ttl := 20 * time.Millisecond
+
+	err := runWithLease(context.Background(), repo, "job", ttl, func(ctx context.Context) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(10 * ttl):
+			return nil
+		}
+	})
+	if err != context.Canceled {
+		t.Errorf("expected the job to be canceled after losing the lease, got %v", err)
+	}
+}
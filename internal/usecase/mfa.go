@@ -0,0 +1,287 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/openinfradev/tks-api/internal"
+	"github.com/openinfradev/tks-api/internal/helper"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IMfaUsecase interface {
+	GetMyMfa(ctx context.Context) (model.UserMfa, error)
+	Enroll(ctx context.Context) (secret string, provisioningUri string, err error)
+	Confirm(ctx context.Context, code string) (recoveryCodes []string, err error)
+	Disable(ctx context.Context, code string) error
+	RegenerateRecoveryCodes(ctx context.Context, code string) (recoveryCodes []string, err error)
+	VerifyStepUp(ctx context.Context, code string) (expiredAt time.Time, err error)
+
+	GetPolicy(ctx context.Context, organizationId string) (model.MfaPolicy, error)
+	UpdatePolicy(ctx context.Context, dto model.MfaPolicy) error
+}
+
+type MfaUsecase struct {
+	repo     repository.IMfaRepository
+	userRepo repository.IUserRepository
+	roleRepo repository.IRoleRepository
+}
+
+func NewMfaUsecase(r repository.Repository) IMfaUsecase {
+	return &MfaUsecase{
+		repo:     r.Mfa,
+		userRepo: r.User,
+		roleRepo: r.Role,
+	}
+}
+
+func (u *MfaUsecase) GetMyMfa(ctx context.Context) (out model.UserMfa, err error) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return out, httpErrors.NewBadRequestError(fmt.Errorf("Invalid token"), "", "")
+	}
+
+	out, err = u.repo.GetUserMfa(ctx, user.GetUserId())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.UserMfa{UserId: user.GetUserId()}, nil
+		}
+		return out, httpErrors.NewInternalServerError(err, "", "")
+	}
+	return out, nil
+}
+
+// Enroll 은 새 secret 을 발급한다. Confirm 으로 코드를 확인하기 전까지 MFA 는 활성화되지 않는다.
+func (u *MfaUsecase) Enroll(ctx context.Context) (secret string, provisioningUri string, err error) {
+	mfa, err := u.GetMyMfa(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	if mfa.Enabled {
+		return "", "", httpErrors.NewBadRequestError(fmt.Errorf("mfa is already enabled"), "MFA_ALREADY_ENABLED", "")
+	}
+
+	storedUser, err := u.userRepo.GetByUuid(ctx, mfa.UserId)
+	if err != nil {
+		return "", "", httpErrors.NewInternalServerError(err, "", "")
+	}
+
+	secret, err = helper.GenerateTotpSecret()
+	if err != nil {
+		return "", "", httpErrors.NewInternalServerError(err, "", "")
+	}
+	mfa.Secret = secret
+	if err = u.repo.SaveUserMfa(ctx, &mfa); err != nil {
+		return "", "", httpErrors.NewInternalServerError(err, "", "")
+	}
+
+	accountName := storedUser.OrganizationId + "/" + storedUser.AccountId
+	return secret, helper.TotpProvisioningUri(internal.MfaIssuer, accountName, secret), nil
+}
+
+func (u *MfaUsecase) Confirm(ctx context.Context, code string) (recoveryCodes []string, err error) {
+	mfa, err := u.GetMyMfa(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if mfa.Secret == "" {
+		return nil, httpErrors.NewBadRequestError(fmt.Errorf("mfa is not enrolled"), "MFA_NOT_ENROLLED", "")
+	}
+	if mfa.Enabled {
+		return nil, httpErrors.NewBadRequestError(fmt.Errorf("mfa is already enabled"), "MFA_ALREADY_ENABLED", "")
+	}
+	if err = verifyMfaCode(ctx, u.repo, &mfa, code, false); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	mfa.Enabled = true
+	mfa.EnabledAt = &now
+	recoveryCodes = u.resetRecoveryCodes(&mfa)
+	if err = u.repo.SaveUserMfa(ctx, &mfa); err != nil {
+		return nil, httpErrors.NewInternalServerError(err, "", "")
+	}
+	return recoveryCodes, nil
+}
+
+func (u *MfaUsecase) Disable(ctx context.Context, code string) error {
+	mfa, err := u.getEnabledMfa(ctx)
+	if err != nil {
+		return err
+	}
+	if err = verifyMfaCode(ctx, u.repo, &mfa, code, true); err != nil {
+		return err
+	}
+
+	// 조직 정책상 MFA 가 필수인 사용자는 해제할 수 없다.
+	storedUser, err := u.userRepo.GetByUuid(ctx, mfa.UserId)
+	if err != nil {
+		return httpErrors.NewInternalServerError(err, "", "")
+	}
+	if policy, err := u.repo.GetPolicy(ctx, storedUser.OrganizationId); err == nil && policy.IsRequiredFor(storedUser.Roles) {
+		return httpErrors.NewBadRequestError(fmt.Errorf("mfa is required by organization policy"), "MFA_REQUIRED_BY_POLICY", "")
+	}
+
+	if err = u.repo.DeleteUserMfa(ctx, mfa.UserId); err != nil {
+		return httpErrors.NewInternalServerError(err, "", "")
+	}
+	return nil
+}
+
+func (u *MfaUsecase) RegenerateRecoveryCodes(ctx context.Context, code string) (recoveryCodes []string, err error) {
+	mfa, err := u.getEnabledMfa(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = verifyMfaCode(ctx, u.repo, &mfa, code, false); err != nil {
+		return nil, err
+	}
+
+	recoveryCodes = u.resetRecoveryCodes(&mfa)
+	if err = u.repo.SaveUserMfa(ctx, &mfa); err != nil {
+		return nil, httpErrors.NewInternalServerError(err, "", "")
+	}
+	return recoveryCodes, nil
+}
+
+func (u *MfaUsecase) VerifyStepUp(ctx context.Context, code string) (expiredAt time.Time, err error) {
+	sessionId, ok := request.SessionFrom(ctx)
+	if !ok {
+		return expiredAt, httpErrors.NewBadRequestError(fmt.Errorf("session id is not found"), "A_NO_SESSION", "")
+	}
+	mfa, err := u.getEnabledMfa(ctx)
+	if err != nil {
+		return expiredAt, err
+	}
+	if err = verifyMfaCode(ctx, u.repo, &mfa, code, true); err != nil {
+		return expiredAt, err
+	}
+
+	stepUp, err := u.repo.CreateStepUp(ctx, mfa.UserId, sessionId)
+	if err != nil {
+		return expiredAt, httpErrors.NewInternalServerError(err, "", "")
+	}
+	return stepUp.VerifiedAt.Add(internal.MfaStepUpDuration), nil
+}
+
+func (u *MfaUsecase) GetPolicy(ctx context.Context, organizationId string) (out model.MfaPolicy, err error) {
+	out, err = u.repo.GetPolicy(ctx, organizationId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.MfaPolicy{OrganizationId: organizationId}, nil
+		}
+		return out, httpErrors.NewInternalServerError(err, "", "")
+	}
+	return out, nil
+}
+
+func (u *MfaUsecase) UpdatePolicy(ctx context.Context, dto model.MfaPolicy) error {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return httpErrors.NewBadRequestError(fmt.Errorf("Invalid token"), "", "")
+	}
+	userId := user.GetUserId()
+	dto.UpdatorId = &userId
+
+	dto.Roles = make([]model.Role, 0)
+	for _, roleId := range dto.RoleIds {
+		role, err := u.roleRepo.GetTksRole(ctx, dto.OrganizationId, roleId)
+		if err != nil {
+			return httpErrors.NewBadRequestError(err, "MFA_INVALID_ROLE", "")
+		}
+		dto.Roles = append(dto.Roles, *role)
+	}
+
+	if err := u.repo.UpdatePolicy(ctx, dto); err != nil {
+		return httpErrors.NewInternalServerError(err, "", "")
+	}
+	return nil
+}
+
+func (u *MfaUsecase) getEnabledMfa(ctx context.Context) (model.UserMfa, error) {
+	mfa, err := u.GetMyMfa(ctx)
+	if err != nil {
+		return mfa, err
+	}
+	if !mfa.Enabled {
+		return mfa, httpErrors.NewBadRequestError(fmt.Errorf("mfa is not enabled"), "MFA_NOT_ENROLLED", "")
+	}
+	return mfa, nil
+}
+
+func (u *MfaUsecase) resetRecoveryCodes(mfa *model.UserMfa) []string {
+	recoveryCodes := helper.GenerateRecoveryCodes(internal.MfaRecoveryCodeCount)
+	mfa.RecoveryCodeHashs = make([]string, len(recoveryCodes))
+	for i, recoveryCode := range recoveryCodes {
+		mfa.RecoveryCodeHashs[i] = helper.HashRecoveryCode(recoveryCode)
+	}
+	return recoveryCodes
+}
+
+// verifyMfaCode accepts a TOTP code, or an unused recovery code if allowRecovery is set.
+// A matched recovery code is removed from the stored codes with a conditional update,
+// so that concurrent requests cannot use the same recovery code twice.
+// 이미 사용한 TOTP 코드는 다시 받지 않으며, 연속으로 실패하면 일정 시간 동안 검증을 잠근다.
+func verifyMfaCode(ctx context.Context, repo repository.IMfaRepository, mfa *model.UserMfa, code string, allowRecovery bool) error {
+	if mfa.IsLocked() {
+		return httpErrors.NewForbiddenError(fmt.Errorf("mfa is locked until %s", mfa.LockedUntil), "A_LOCKED_MFA", "")
+	}
+
+	if step, ok := helper.MatchTotpCode(mfa.Secret, code, time.Now()); ok {
+		used, err := repo.UseTotpStep(ctx, mfa.UserId, step)
+		if err != nil {
+			return httpErrors.NewInternalServerError(err, "", "")
+		}
+		if used {
+			mfa.LastUsedStep = step
+			mfa.FailedCount = 0
+			mfa.LockedUntil = nil
+			return nil
+		}
+		log.Warn(ctx, fmt.Sprintf("mfa code of time step %d is replayed by user %s", step, mfa.UserId))
+	} else if allowRecovery {
+		hashed := helper.HashRecoveryCode(code)
+		if i := slices.Index(mfa.RecoveryCodeHashs, hashed); i >= 0 {
+			remaining := slices.Delete(slices.Clone(mfa.RecoveryCodeHashs), i, i+1)
+			used, err := repo.UseRecoveryCode(ctx, mfa.UserId, mfa.RecoveryCode, strings.Join(remaining, ","))
+			if err != nil {
+				return httpErrors.NewInternalServerError(err, "", "")
+			}
+			if used {
+				mfa.RecoveryCodeHashs = remaining
+				mfa.RecoveryCode = strings.Join(remaining, ",")
+				mfa.FailedCount = 0
+				mfa.LockedUntil = nil
+				return nil
+			}
+			log.Warn(ctx, fmt.Sprintf("recovery code is used concurrently by user %s", mfa.UserId))
+		}
+	}
+
+	lockedUntil, err := repo.RecordFailure(ctx, mfa.UserId, internal.MaxMfaFailures, internal.MfaLockDuration)
+	if err != nil {
+		log.Error(ctx, err)
+	}
+	if lockedUntil != nil {
+		return httpErrors.NewForbiddenError(fmt.Errorf("mfa is locked until %s", lockedUntil), "A_LOCKED_MFA", "")
+	}
+	return httpErrors.NewBadRequestError(fmt.Errorf("invalid mfa code"), "A_INVALID_MFA_CODE", "")
+}
+
+// isMfaEnrollmentRequired returns whether the organization policy forces MFA to the user who has not enabled it yet.
+func isMfaEnrollmentRequired(ctx context.Context, repo repository.IMfaRepository, user model.User) bool {
+	policy, err := repo.GetPolicy(ctx, user.OrganizationId)
+	if err != nil {
+		return false
+	}
+	return policy.IsRequiredFor(user.Roles)
+}
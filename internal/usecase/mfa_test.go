@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/helper"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+// fakeMfaRepository 는 recovery code 를 조건부로 갱신하는 DB 동작을 흉내 낸다.
+type fakeMfaRepository struct {
+	repository.IMfaRepository
+	mu           sync.Mutex
+	recoveryCode string
+}
+
+func (r *fakeMfaRepository) UseRecoveryCode(ctx context.Context, userId uuid.UUID, recoveryCode string, remaining string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.recoveryCode != recoveryCode {
+		return false, nil
+	}
+	r.recoveryCode = remaining
+	return true, nil
+}
+
+func (r *fakeMfaRepository) RecordFailure(ctx context.Context, userId uuid.UUID, maxFailures int, lockDuration time.Duration) (*time.Time, error) {
+	return nil, nil
+}
+
+func TestVerifyMfaCodeUsesRecoveryCodeOnce(t *testing.T) {
+	hashs := []string{helper.HashRecoveryCode("code-1"), helper.HashRecoveryCode("code-2")}
+	repo := &fakeMfaRepository{recoveryCode: strings.Join(hashs, ",")}
+
+	// 같은 시점에 읽은 MFA 정보로 같은 recovery code 를 동시에 사용하는 두 요청
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i := range errs {
+		mfa := model.UserMfa{UserId: uuid.New(), Secret: "JBSWY3DPEHPK3PXP", RecoveryCode: repo.recoveryCode, RecoveryCodeHashs: append([]string{}, hashs...)}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = verifyMfaCode(context.Background(), repo, &mfa, "code-1", true)
+		}(i)
+	}
+	wg.Wait()
+
+	if (errs[0] == nil) == (errs[1] == nil) {
+		t.Fatalf("exactly one request must use the recovery code: %v", errs)
+	}
+	if repo.recoveryCode != hashs[1] {
+		t.Errorf("only the used recovery code must be removed, got %s", repo.recoveryCode)
+	}
+}
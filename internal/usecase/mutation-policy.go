@@ -0,0 +1,253 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	policytemplate "github.com/openinfradev/tks-api/internal/policy-template"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"gorm.io/gorm"
+	"k8s.io/utils/strings/slices"
+)
+
+type IMutationPolicyUsecase interface {
+	ListTemplates(ctx context.Context) []policytemplate.MutationTemplate
+	Create(ctx context.Context, organizationId string, dto model.MutationPolicy) (mutationPolicyId uuid.UUID, err error)
+	Update(ctx context.Context, organizationId string, mutationPolicyId uuid.UUID, input domain.UpdateMutationPolicyRequest) (err error)
+	Delete(ctx context.Context, organizationId string, mutationPolicyId uuid.UUID) (err error)
+	Get(ctx context.Context, organizationId string, mutationPolicyId uuid.UUID) (*model.MutationPolicy, error)
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (*[]model.MutationPolicy, error)
+}
+
+type MutationPolicyUsecase struct {
+	repo             repository.IMutationPolicyRepository
+	clusterRepo      repository.IClusterRepository
+	organizationRepo repository.IOrganizationRepository
+}
+
+func NewMutationPolicyUsecase(r repository.Repository) IMutationPolicyUsecase {
+	return &MutationPolicyUsecase{
+		repo:             r.MutationPolicy,
+		clusterRepo:      r.Cluster,
+		organizationRepo: r.Organization,
+	}
+}
+
+func (u *MutationPolicyUsecase) ListTemplates(ctx context.Context) []policytemplate.MutationTemplate {
+	return policytemplate.MutationTemplates
+}
+
+func (u *MutationPolicyUsecase) Create(ctx context.Context, organizationId string, dto model.MutationPolicy) (mutationPolicyId uuid.UUID, err error) {
+	dto.OrganizationId = organizationId
+
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return uuid.Nil, httpErrors.NewUnauthorizedError(fmt.Errorf("invalid token"), "A_INVALID_TOKEN", "")
+	}
+	// mutator 는 클러스터에 바로 적용되므로 정책 변경과 같이 승인 모드에서는 바로 생성할 수 없다.
+	if err := checkPolicyApproval(ctx, u.organizationRepo, organizationId); err != nil {
+		return uuid.Nil, err
+	}
+
+	exists, err := u.repo.ExistByName(ctx, organizationId, dto.PolicyName)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if exists {
+		return uuid.Nil, httpErrors.NewBadRequestError(httpErrors.DuplicateResource, "MP_CREATE_ALREADY_EXISTED_NAME", "")
+	}
+
+	if len(dto.PolicyResourceName) == 0 {
+		dto.PolicyResourceName = randomResouceName(string(dto.Kind))
+	}
+
+	exists, err = u.repo.ExistByResourceName(ctx, organizationId, dto.PolicyResourceName)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if exists {
+		return uuid.Nil, httpErrors.NewBadRequestError(httpErrors.DuplicateResource, "MP_INVALID_POLICY_RESOURCE_NAME", "")
+	}
+
+	dto.TargetClusters, err = u.getTargetClusters(ctx, organizationId, dto.TargetClusterIds)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	// k8s에 label로 mutation policy ID를 기록해 주기 위해 DB 컬럼 생성 시 ID를 생성하지 않고 미리 생성
+	dto.ID = uuid.New()
+
+	mutators, err := policytemplate.MutationPolicyToMutators(&dto)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewBadRequestError(err, "MP_INVALID_PARAMETER", "")
+	}
+
+	// DB 에 먼저 저장하고, 클러스터 적용에 실패하면 적용한 mutator 와 DB 레코드를 모두 되돌린다.
+	userId := user.GetUserId()
+	dto.CreatorId = &userId
+
+	mutationPolicyId, err = u.repo.Create(ctx, dto)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	for i, clusterId := range dto.TargetClusterIds {
+		if err := policytemplate.ApplyMutators(ctx, clusterId, dto.ID.String(), mutators); err != nil {
+			log.Errorf(ctx, "failed to apply mutators to cluster %s: %v", clusterId, err)
+			u.deleteMutators(ctx, dto.TargetClusterIds[:i+1], dto.ID)
+			if err := u.repo.Delete(ctx, dto.ID); err != nil {
+				log.Errorf(ctx, "failed to delete mutation policy %s: %v", dto.ID, err)
+			}
+			return uuid.Nil, httpErrors.NewInternalServerError(err, "P_FAILED_TO_CALL_KUBERNETES", "")
+		}
+	}
+
+	return mutationPolicyId, nil
+}
+
+// deleteMutators 는 클러스터들에 적용된 mutation policy 의 mutator 를 삭제한다. 삭제 실패는 기록만 한다.
+func (u *MutationPolicyUsecase) deleteMutators(ctx context.Context, clusterIds []string, mutationPolicyId uuid.UUID) {
+	for _, clusterId := range clusterIds {
+		if err := policytemplate.DeleteMutators(ctx, clusterId, mutationPolicyId.String()); err != nil {
+			log.Errorf(ctx, "failed to delete mutators from cluster %s: %v", clusterId, err)
+		}
+	}
+}
+
+func (u *MutationPolicyUsecase) Update(ctx context.Context, organizationId string, mutationPolicyId uuid.UUID, input domain.UpdateMutationPolicyRequest) (err error) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return httpErrors.NewUnauthorizedError(fmt.Errorf("invalid token"), "A_INVALID_TOKEN", "")
+	}
+	if err := checkPolicyApproval(ctx, u.organizationRepo, organizationId); err != nil {
+		return err
+	}
+
+	mutationPolicy, err := u.Get(ctx, organizationId, mutationPolicyId)
+	if err != nil {
+		return err
+	}
+
+	updateMap := make(map[string]interface{})
+
+	if input.PolicyName != nil && *input.PolicyName != mutationPolicy.PolicyName {
+		exists, err := u.repo.ExistByName(ctx, organizationId, *input.PolicyName)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return httpErrors.NewBadRequestError(httpErrors.DuplicateResource, "MP_CREATE_ALREADY_EXISTED_NAME", "")
+		}
+		updateMap["policy_name"] = *input.PolicyName
+	}
+
+	if input.Description != nil {
+		updateMap["description"] = *input.Description
+	}
+
+	if input.Parameters != nil {
+		mutationPolicy.Parameters = *input.Parameters
+		updateMap["parameters"] = *input.Parameters
+	}
+
+	if input.Match != nil {
+		mutationPolicy.Match = input.Match
+		updateMap["policy_match"] = input.Match.JSON()
+	}
+
+	var targetClusters *[]model.Cluster
+	currentClusterIds := mutationPolicy.TargetClusterIds
+	if input.TargetClusterIds != nil {
+		clusters, err := u.getTargetClusters(ctx, organizationId, *input.TargetClusterIds)
+		if err != nil {
+			return err
+		}
+		targetClusters = &clusters
+		mutationPolicy.TargetClusterIds = *input.TargetClusterIds
+	}
+
+	mutators, err := policytemplate.MutationPolicyToMutators(mutationPolicy)
+	if err != nil {
+		return httpErrors.NewBadRequestError(err, "MP_INVALID_PARAMETER", "")
+	}
+
+	for _, clusterId := range mutationPolicy.TargetClusterIds {
+		if err := policytemplate.ApplyMutators(ctx, clusterId, mutationPolicyId.String(), mutators); err != nil {
+			log.Errorf(ctx, "failed to apply mutators to cluster %s: %v", clusterId, err)
+			return httpErrors.NewInternalServerError(err, "P_FAILED_TO_CALL_KUBERNETES", "")
+		}
+	}
+
+	// 대상에서 제외된 클러스터에서는 뮤테이션 리소스를 삭제
+	for _, clusterId := range currentClusterIds {
+		if slices.Contains(mutationPolicy.TargetClusterIds, clusterId) {
+			continue
+		}
+		if err := policytemplate.DeleteMutators(ctx, clusterId, mutationPolicyId.String()); err != nil {
+			log.Errorf(ctx, "failed to delete mutators from cluster %s: %v", clusterId, err)
+			return httpErrors.NewInternalServerError(err, "P_FAILED_TO_CALL_KUBERNETES", "")
+		}
+	}
+
+	updateMap["updator_id"] = user.GetUserId()
+
+	return u.repo.Update(ctx, mutationPolicyId, updateMap, targetClusters)
+}
+
+func (u *MutationPolicyUsecase) Delete(ctx context.Context, organizationId string, mutationPolicyId uuid.UUID) (err error) {
+	if err := checkPolicyApproval(ctx, u.organizationRepo, organizationId); err != nil {
+		return err
+	}
+
+	mutationPolicy, err := u.Get(ctx, organizationId, mutationPolicyId)
+	if err != nil {
+		return err
+	}
+
+	for _, clusterId := range mutationPolicy.TargetClusterIds {
+		if err := policytemplate.DeleteMutators(ctx, clusterId, mutationPolicyId.String()); err != nil {
+			log.Errorf(ctx, "failed to delete mutators from cluster %s: %v", clusterId, err)
+			return httpErrors.NewInternalServerError(err, "P_FAILED_TO_CALL_KUBERNETES", "")
+		}
+	}
+
+	return u.repo.Delete(ctx, mutationPolicyId)
+}
+
+func (u *MutationPolicyUsecase) Get(ctx context.Context, organizationId string, mutationPolicyId uuid.UUID) (*model.MutationPolicy, error) {
+	mutationPolicy, err := u.repo.Get(ctx, organizationId, mutationPolicyId)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, httpErrors.NewNotFoundError(err, "MP_NOT_FOUND_MUTATION_POLICY", "")
+		}
+		return nil, err
+	}
+	return &mutationPolicy, nil
+}
+
+func (u *MutationPolicyUsecase) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (*[]model.MutationPolicy, error) {
+	mutationPolicies, err := u.repo.Fetch(ctx, organizationId, pg)
+	if err != nil {
+		return nil, err
+	}
+	return &mutationPolicies, nil
+}
+
+func (u *MutationPolicyUsecase) getTargetClusters(ctx context.Context, organizationId string, clusterIds []string) ([]model.Cluster, error) {
+	clusters := make([]model.Cluster, len(clusterIds))
+	for i, clusterId := range clusterIds {
+		cluster, err := u.clusterRepo.Get(ctx, domain.ClusterId(clusterId))
+		if err != nil || cluster.OrganizationId != organizationId {
+			return nil, httpErrors.NewBadRequestError(fmt.Errorf("invalid clusterId %s", clusterId), "P_FAILED_FETCH_CLUSTER", "")
+		}
+		clusters[i] = cluster
+	}
+	return clusters, nil
+}
@@ -27,6 +27,8 @@ type IOrganizationUsecase interface {
 	Update(ctx context.Context, organizationId string, dto model.Organization) (model.Organization, error)
 	UpdatePrimaryClusterId(ctx context.Context, organizationId string, clusterId string) (err error)
 	ChangeAdminId(ctx context.Context, organizationId string, adminId uuid.UUID) error
+	GetPasswordPolicy(ctx context.Context, organizationId string) (model.PasswordPolicy, error)
+	UpdatePasswordPolicy(ctx context.Context, organizationId string, policy model.PasswordPolicy) error
 	Delete(ctx context.Context, organizationId string, accessToken string) error
 }
 
@@ -69,6 +71,10 @@ func (u *OrganizationUsecase) Create(ctx context.Context, in *model.Organization
 		return "", err
 	}
 	in.ID = organizationId
+	in.PasswordPolicy = model.DefaultPasswordPolicy()
+	if err = u.kc.UpdateRealmPasswordPolicy(ctx, organizationId, in.PasswordPolicy); err != nil {
+		log.Error(ctx, "failed to update realm password policy. err : ", err)
+	}
 
 	// Create organization in DB
 	_, err = u.repo.Create(ctx, in)
@@ -208,6 +214,31 @@ func (u *OrganizationUsecase) UpdatePrimaryClusterId(ctx context.Context, organi
 	return nil
 }
 
+func (u *OrganizationUsecase) GetPasswordPolicy(ctx context.Context, organizationId string) (model.PasswordPolicy, error) {
+	organization, err := u.repo.Get(ctx, organizationId)
+	if err != nil {
+		return model.PasswordPolicy{}, httpErrors.NewNotFoundError(err, "", "")
+	}
+	return organization.PasswordPolicy, nil
+}
+
+func (u *OrganizationUsecase) UpdatePasswordPolicy(ctx context.Context, organizationId string, policy model.PasswordPolicy) error {
+	if _, err := u.repo.Get(ctx, organizationId); err != nil {
+		return httpErrors.NewNotFoundError(err, "", "")
+	}
+	if err := policy.Validate(); err != nil {
+		return httpErrors.NewBadRequestError(err, "O_INVALID_PASSWORD_POLICY", "")
+	}
+
+	if err := u.repo.UpdatePasswordPolicy(ctx, organizationId, policy); err != nil {
+		return httpErrors.NewInternalServerError(err, "", "")
+	}
+	if err := u.kc.UpdateRealmPasswordPolicy(ctx, organizationId, policy); err != nil {
+		return httpErrors.NewInternalServerError(err, "", "")
+	}
+	return nil
+}
+
 func (u *OrganizationUsecase) ChangeAdminId(ctx context.Context, organizationId string, adminId uuid.UUID) error {
 	_, err := u.Get(ctx, organizationId)
 	if err != nil {
@@ -0,0 +1,511 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	policytemplate "github.com/openinfradev/tks-api/internal/policy-template"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type IPolicyBundleUsecase interface {
+	Create(ctx context.Context, organizationId string, dto model.PolicyBundle) (policyBundleId uuid.UUID, err error)
+	Update(ctx context.Context, organizationId string, dto model.PolicyBundle) (err error)
+	Delete(ctx context.Context, organizationId string, policyBundleId uuid.UUID) (err error)
+	Get(ctx context.Context, organizationId string, policyBundleId uuid.UUID) (*model.PolicyBundle, error)
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (*[]model.PolicyBundle, error)
+	PreviewUpdate(ctx context.Context, organizationId string, dto model.PolicyBundle) ([]domain.PolicyBundleAffectedClusterResponse, error)
+	AssignToStack(ctx context.Context, organizationId string, policyBundleId uuid.UUID, clusterId domain.ClusterId) (err error)
+	UnassignFromStack(ctx context.Context, organizationId string, policyBundleId uuid.UUID, clusterId domain.ClusterId) (err error)
+}
+
+type PolicyBundleUsecase struct {
+	repo             repository.IPolicyBundleRepository
+	policyRepo       repository.IPolicyRepository
+	clusterRepo      repository.IClusterRepository
+	organizationRepo repository.IOrganizationRepository
+	policyUsecase    IPolicyUsecase
+}
+
+func NewPolicyBundleUsecase(r repository.Repository, policyUsecase IPolicyUsecase) IPolicyBundleUsecase {
+	return &PolicyBundleUsecase{
+		repo:             r.PolicyBundle,
+		policyRepo:       r.Policy,
+		clusterRepo:      r.Cluster,
+		organizationRepo: r.Organization,
+		policyUsecase:    policyUsecase,
+	}
+}
+
+// policyBundleChange 는 번들 항목 변경으로 인해 스택에 반영해야 하는 내용이다.
+type policyBundleChange struct {
+	added   []uuid.UUID
+	removed []uuid.UUID
+	// 프리셋이 새로 지정, 변경되거나 해제되어 번들이 할당된 스택의 CR 에 반영해야 하는 항목
+	updated []model.PolicyBundleItem
+}
+
+func diffPolicyBundleItems(current []model.PolicyBundleItem, target []model.PolicyBundleItem) (change policyBundleChange) {
+	currentItems := make(map[uuid.UUID]model.PolicyBundleItem, len(current))
+	for _, item := range current {
+		currentItems[item.PolicyId] = item
+	}
+
+	targetIds := make(map[uuid.UUID]bool, len(target))
+	for _, item := range target {
+		targetIds[item.PolicyId] = true
+
+		currentItem, exists := currentItems[item.PolicyId]
+		if !exists {
+			change.added = append(change.added, item.PolicyId)
+		}
+
+		if !exists {
+			if item.HasPreset() {
+				change.updated = append(change.updated, item)
+			}
+		} else if currentItem.EnforcementAction != item.EnforcementAction || currentItem.Parameters != item.Parameters {
+			change.updated = append(change.updated, item)
+		}
+	}
+
+	for _, item := range current {
+		if !targetIds[item.PolicyId] {
+			change.removed = append(change.removed, item.PolicyId)
+		}
+	}
+	return
+}
+
+func (u *PolicyBundleUsecase) Create(ctx context.Context, organizationId string, dto model.PolicyBundle) (policyBundleId uuid.UUID, err error) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return uuid.Nil, httpErrors.NewUnauthorizedError(fmt.Errorf("invalid token"), "A_INVALID_TOKEN", "")
+	}
+
+	exists, err := u.repo.ExistByName(ctx, organizationId, dto.Name)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewInternalServerError(err, "", "")
+	}
+	if exists {
+		return uuid.Nil, httpErrors.NewBadRequestError(httpErrors.DuplicateResource, "PB_CREATE_ALREADY_EXISTED_NAME", "policy bundle name already exists")
+	}
+
+	if err := u.validateItems(ctx, organizationId, dto.Items); err != nil {
+		return uuid.Nil, err
+	}
+
+	userId := user.GetUserId()
+	dto.ID = uuid.New()
+	dto.OrganizationId = organizationId
+	dto.CreatorId = &userId
+
+	policyBundleId, err = u.repo.Create(ctx, dto)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewInternalServerError(err, "", "")
+	}
+
+	return policyBundleId, nil
+}
+
+// Update 는 번들을 갱신하고 변경 사항을 번들이 할당된 모든 스택에 반영한다.
+func (u *PolicyBundleUsecase) Update(ctx context.Context, organizationId string, dto model.PolicyBundle) (err error) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return httpErrors.NewUnauthorizedError(fmt.Errorf("invalid token"), "A_INVALID_TOKEN", "")
+	}
+
+	policyBundle, err := u.Get(ctx, organizationId, dto.ID)
+	if err != nil {
+		return err
+	}
+
+	if policyBundle.Name != dto.Name {
+		exists, err := u.repo.ExistByName(ctx, organizationId, dto.Name)
+		if err != nil {
+			return httpErrors.NewInternalServerError(err, "", "")
+		}
+		if exists {
+			return httpErrors.NewBadRequestError(httpErrors.DuplicateResource, "PB_CREATE_ALREADY_EXISTED_NAME", "policy bundle name already exists")
+		}
+	}
+
+	if err := u.validateItems(ctx, organizationId, dto.Items); err != nil {
+		return err
+	}
+
+	userId := user.GetUserId()
+	dto.OrganizationId = organizationId
+	dto.UpdatorId = &userId
+
+	change := diffPolicyBundleItems(policyBundle.Items, dto.Items)
+
+	// 번들 저장 후 스택에 정책을 반영하다가 거부되지 않도록 승인 모드와 프리셋 충돌 여부를 먼저 확인한다.
+	if len(policyBundle.Clusters) > 0 && (len(change.added) > 0 || len(change.removed) > 0 || len(change.updated) > 0) {
+		if err := checkPolicyApproval(ctx, u.organizationRepo, organizationId); err != nil {
+			return err
+		}
+	}
+	for _, cluster := range policyBundle.Clusters {
+		if err := u.checkPresetConflicts(ctx, dto, cluster.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := u.repo.Update(ctx, dto); err != nil {
+		return httpErrors.NewInternalServerError(err, "", "")
+	}
+
+	for _, cluster := range policyBundle.Clusters {
+		if len(change.added) > 0 {
+			if err := u.policyUsecase.AddPoliciesForClusterID(ctx, organizationId, cluster.ID, change.added); err != nil {
+				log.Errorf(ctx, "failed to add policies of bundle '%s' to cluster '%s': %v", dto.ID, cluster.ID, err)
+				return err
+			}
+		}
+
+		removed, err := u.removablePolicyIds(ctx, dto.ID, cluster.ID, change.removed)
+		if err != nil {
+			return err
+		}
+		if len(removed) > 0 {
+			if err := u.policyUsecase.DeletePoliciesForClusterID(ctx, organizationId, cluster.ID, removed); err != nil {
+				log.Errorf(ctx, "failed to delete policies of bundle '%s' from cluster '%s': %v", dto.ID, cluster.ID, err)
+				return err
+			}
+		}
+	}
+
+	if len(policyBundle.Clusters) == 0 {
+		return nil
+	}
+
+	// 프리셋이 변경되었거나 프리셋이 있던 항목이 빠진 정책은 번들이 할당된 스택의 CR 을 다시 만든다.
+	policyIds := []uuid.UUID{}
+	for _, item := range change.updated {
+		policyIds = append(policyIds, item.PolicyId)
+	}
+	for _, item := range policyBundle.Items {
+		if item.HasPreset() && slices.Contains(change.removed, item.PolicyId) {
+			policyIds = append(policyIds, item.PolicyId)
+		}
+	}
+	return u.applyPolicyCRs(ctx, organizationId, policyIds)
+}
+
+func (u *PolicyBundleUsecase) Delete(ctx context.Context, organizationId string, policyBundleId uuid.UUID) (err error) {
+	policyBundle, err := u.Get(ctx, organizationId, policyBundleId)
+	if err != nil {
+		return err
+	}
+
+	if len(policyBundle.Clusters) > 0 {
+		return httpErrors.NewBadRequestError(fmt.Errorf("policy bundle is assigned to %d stacks", len(policyBundle.Clusters)), "PB_POLICY_BUNDLE_IN_USE", "")
+	}
+
+	if err := u.repo.Delete(ctx, organizationId, policyBundleId); err != nil {
+		return httpErrors.NewInternalServerError(err, "", "")
+	}
+	return nil
+}
+
+func (u *PolicyBundleUsecase) Get(ctx context.Context, organizationId string, policyBundleId uuid.UUID) (*model.PolicyBundle, error) {
+	policyBundle, err := u.repo.Get(ctx, organizationId, policyBundleId)
+	if err != nil {
+		return nil, httpErrors.NewNotFoundError(err, "PB_NOT_FOUND_POLICY_BUNDLE", "")
+	}
+	return policyBundle, nil
+}
+
+func (u *PolicyBundleUsecase) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (*[]model.PolicyBundle, error) {
+	policyBundles, err := u.repo.Fetch(ctx, organizationId, pg)
+	if err != nil {
+		return nil, httpErrors.NewInternalServerError(err, "", "")
+	}
+	return policyBundles, nil
+}
+
+// PreviewUpdate 는 번들을 갱신하지 않고 갱신 시 영향을 받는 클러스터와 정책을 계산한다.
+// 프리셋은 번들이 할당된 스택에만 적용되므로 프리셋이 변경된 정책도 번들의 스택에만 영향을 준다.
+func (u *PolicyBundleUsecase) PreviewUpdate(ctx context.Context, organizationId string, dto model.PolicyBundle) ([]domain.PolicyBundleAffectedClusterResponse, error) {
+	policyBundle, err := u.Get(ctx, organizationId, dto.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.validateItems(ctx, organizationId, dto.Items); err != nil {
+		return nil, err
+	}
+
+	change := diffPolicyBundleItems(policyBundle.Items, dto.Items)
+
+	affected := map[domain.ClusterId]*domain.PolicyBundleAffectedClusterResponse{}
+	clusterIds := []domain.ClusterId{}
+	affectedCluster := func(clusterId domain.ClusterId) *domain.PolicyBundleAffectedClusterResponse {
+		if _, ok := affected[clusterId]; !ok {
+			affected[clusterId] = &domain.PolicyBundleAffectedClusterResponse{
+				Cluster:          domain.SimpleClusterResponse{ID: clusterId, OrganizationId: organizationId},
+				AddedPolicyIds:   []string{},
+				RemovedPolicyIds: []string{},
+				UpdatedPolicyIds: []string{},
+			}
+			clusterIds = append(clusterIds, clusterId)
+		}
+		return affected[clusterId]
+	}
+
+	for _, cluster := range policyBundle.Clusters {
+		removed, err := u.removablePolicyIds(ctx, dto.ID, cluster.ID, change.removed)
+		if err != nil {
+			return nil, err
+		}
+		if len(change.added) == 0 && len(removed) == 0 {
+			continue
+		}
+
+		out := affectedCluster(cluster.ID)
+		out.Cluster.Name = cluster.Name
+		for _, policyId := range change.added {
+			out.AddedPolicyIds = append(out.AddedPolicyIds, policyId.String())
+		}
+		for _, policyId := range removed {
+			out.RemovedPolicyIds = append(out.RemovedPolicyIds, policyId.String())
+		}
+	}
+
+	for _, item := range change.updated {
+		if slices.Contains(change.added, item.PolicyId) {
+			continue
+		}
+		for _, cluster := range policyBundle.Clusters {
+			out := affectedCluster(cluster.ID)
+			out.Cluster.Name = cluster.Name
+			out.UpdatedPolicyIds = append(out.UpdatedPolicyIds, item.PolicyId.String())
+		}
+	}
+
+	out := make([]domain.PolicyBundleAffectedClusterResponse, len(clusterIds))
+	for i, clusterId := range clusterIds {
+		out[i] = *affected[clusterId]
+	}
+	return out, nil
+}
+
+func (u *PolicyBundleUsecase) AssignToStack(ctx context.Context, organizationId string, policyBundleId uuid.UUID, clusterId domain.ClusterId) (err error) {
+	policyBundle, err := u.Get(ctx, organizationId, policyBundleId)
+	if err != nil {
+		return err
+	}
+
+	cluster, err := u.clusterRepo.Get(ctx, clusterId)
+	if err != nil || cluster.OrganizationId != organizationId {
+		return httpErrors.NewBadRequestError(fmt.Errorf("invalid stackId"), "C_INVALID_STACK_ID", "")
+	}
+
+	if err := checkPolicyApproval(ctx, u.organizationRepo, organizationId); err != nil {
+		return err
+	}
+	if err := u.checkPresetConflicts(ctx, *policyBundle, clusterId); err != nil {
+		return err
+	}
+
+	// 정책의 CR 을 만들 때 번들의 프리셋이 반영되도록 할당을 먼저 저장한다.
+	if err := u.repo.AddCluster(ctx, policyBundleId, clusterId); err != nil {
+		return httpErrors.NewInternalServerError(err, "", "")
+	}
+
+	if err := u.policyUsecase.AddPoliciesForClusterID(ctx, organizationId, clusterId, policyBundle.PolicyIds()); err != nil {
+		if err := u.repo.DeleteCluster(ctx, policyBundleId, clusterId); err != nil {
+			log.Errorf(ctx, "failed to rollback assignment of policy bundle '%s' to cluster '%s': %v", policyBundleId, clusterId, err)
+		}
+		return err
+	}
+
+	// 이미 스택에 적용되어 있던 정책은 AddPoliciesForClusterID 에서 CR 을 다시 만들지 않으므로 프리셋을 따로 반영한다.
+	return u.applyPolicyCRs(ctx, organizationId, presetPolicyIds(policyBundle.Items))
+}
+
+// UnassignFromStack 는 번들의 할당을 해제하고 번들의 정책을 스택에서 제거한다.
+// 스택에 할당된 다른 번들에 포함된 정책은 제거하지 않는다.
+func (u *PolicyBundleUsecase) UnassignFromStack(ctx context.Context, organizationId string, policyBundleId uuid.UUID, clusterId domain.ClusterId) (err error) {
+	policyBundle, err := u.Get(ctx, organizationId, policyBundleId)
+	if err != nil {
+		return err
+	}
+
+	assigned := false
+	for _, cluster := range policyBundle.Clusters {
+		if cluster.ID == clusterId {
+			assigned = true
+			break
+		}
+	}
+	if !assigned {
+		return httpErrors.NewBadRequestError(fmt.Errorf("policy bundle is not assigned to stack '%s'", clusterId), "PB_NOT_ASSIGNED_POLICY_BUNDLE", "")
+	}
+
+	if err := checkPolicyApproval(ctx, u.organizationRepo, organizationId); err != nil {
+		return err
+	}
+
+	removed, err := u.removablePolicyIds(ctx, policyBundleId, clusterId, policyBundle.PolicyIds())
+	if err != nil {
+		return err
+	}
+
+	// 정책의 CR 을 다시 만들 때 번들의 프리셋이 빠지도록 할당을 먼저 해제한다.
+	if err := u.repo.DeleteCluster(ctx, policyBundleId, clusterId); err != nil {
+		return httpErrors.NewInternalServerError(err, "", "")
+	}
+
+	if len(removed) > 0 {
+		if err := u.policyUsecase.DeletePoliciesForClusterID(ctx, organizationId, clusterId, removed); err != nil {
+			return err
+		}
+	}
+
+	// 다른 번들 때문에 스택에 남는 정책은 이 번들의 프리셋이 빠진 CR 로 다시 만든다.
+	kept := []uuid.UUID{}
+	for _, policyId := range presetPolicyIds(policyBundle.Items) {
+		if !slices.Contains(removed, policyId) {
+			kept = append(kept, policyId)
+		}
+	}
+	return u.applyPolicyCRs(ctx, organizationId, kept)
+}
+
+// removablePolicyIds 는 클러스터에 할당된 다른 번들에 포함되지 않은 정책만 반환한다.
+func (u *PolicyBundleUsecase) removablePolicyIds(ctx context.Context, policyBundleId uuid.UUID, clusterId domain.ClusterId, policyIds []uuid.UUID) ([]uuid.UUID, error) {
+	if len(policyIds) == 0 {
+		return nil, nil
+	}
+
+	policyBundles, err := u.repo.FetchByClusterId(ctx, clusterId)
+	if err != nil {
+		return nil, httpErrors.NewInternalServerError(err, "", "")
+	}
+
+	kept := map[uuid.UUID]bool{}
+	for _, policyBundle := range policyBundles {
+		if policyBundle.ID == policyBundleId {
+			continue
+		}
+		for _, item := range policyBundle.Items {
+			kept[item.PolicyId] = true
+		}
+	}
+
+	removable := []uuid.UUID{}
+	for _, policyId := range policyIds {
+		if !kept[policyId] {
+			removable = append(removable, policyId)
+		}
+	}
+	return removable, nil
+}
+
+func (u *PolicyBundleUsecase) validateItems(ctx context.Context, organizationId string, items []model.PolicyBundleItem) error {
+	policyIds := map[uuid.UUID]bool{}
+	for _, item := range items {
+		if policyIds[item.PolicyId] {
+			return httpErrors.NewBadRequestError(fmt.Errorf("duplicated policy '%s'", item.PolicyId), "PB_DUPLICATED_POLICY", "")
+		}
+		policyIds[item.PolicyId] = true
+
+		policy, err := u.policyRepo.GetByID(ctx, organizationId, item.PolicyId)
+		if err != nil {
+			return httpErrors.NewBadRequestError(fmt.Errorf("invalid policyId '%s'", item.PolicyId), "C_INVALID_POLICY_ID", "")
+		}
+
+		if item.Parameters != "" {
+			if err := policytemplate.ValidateJSONusingParamdefs(policy.PolicyTemplate.ParametersSchema, item.Parameters); err != nil {
+				log.Errorf(ctx, "error is :%s(%T)", err.Error(), err)
+				return httpErrors.NewBadRequestError(err, "P_INVALID_POLICY_PARAMETER", "")
+			}
+		}
+	}
+	return nil
+}
+
+// checkPresetConflicts 는 클러스터에 할당된 다른 번들에 같은 정책의 다른 프리셋이 있는지 확인한다.
+// 스택에는 하나의 프리셋만 적용할 수 있으므로 같은 정책에 프리셋을 지정한 번들은 프리셋이 같아야 한다.
+func (u *PolicyBundleUsecase) checkPresetConflicts(ctx context.Context, policyBundle model.PolicyBundle, clusterId domain.ClusterId) error {
+	policyBundles, err := u.repo.FetchByClusterId(ctx, clusterId)
+	if err != nil {
+		return httpErrors.NewInternalServerError(err, "", "")
+	}
+
+	others := []model.PolicyBundle{}
+	for _, other := range policyBundles {
+		if other.ID != policyBundle.ID {
+			others = append(others, other)
+		}
+	}
+	return checkPresetConflicts(append(others, policyBundle))
+}
+
+// checkPresetConflicts 는 같은 스택에 적용할 번들 사이에 같은 정책의 프리셋이 서로 다른지 확인한다.
+func checkPresetConflicts(policyBundles []model.PolicyBundle) error {
+	presets := map[uuid.UUID]model.PolicyBundleItem{}
+	for _, policyBundle := range policyBundles {
+		for _, item := range policyBundle.Items {
+			if !item.HasPreset() {
+				continue
+			}
+			preset, ok := presets[item.PolicyId]
+			if !ok {
+				presets[item.PolicyId] = item
+				continue
+			}
+			if preset.EnforcementAction != item.EnforcementAction || preset.Parameters != item.Parameters {
+				return httpErrors.NewBadRequestError(fmt.Errorf("policy bundle '%s' has a conflicting preset of policy '%s'", policyBundle.Name, item.PolicyId), "PB_CONFLICTING_PRESET", "")
+			}
+		}
+	}
+	return nil
+}
+
+// presetPolicyIds 는 프리셋이 지정된 항목의 정책 아이디를 반환한다.
+func presetPolicyIds(items []model.PolicyBundleItem) []uuid.UUID {
+	policyIds := []uuid.UUID{}
+	for _, item := range items {
+		if item.HasPreset() {
+			policyIds = append(policyIds, item.PolicyId)
+		}
+	}
+	return policyIds
+}
+
+// applyPolicyCRs 는 번들의 프리셋 변경을 반영하도록 정책의 TKSPolicy CR 을 다시 만든다.
+func (u *PolicyBundleUsecase) applyPolicyCRs(ctx context.Context, organizationId string, policyIds []uuid.UUID) error {
+	if len(policyIds) == 0 {
+		return nil
+	}
+
+	organization, err := u.organizationRepo.Get(ctx, organizationId)
+	if err != nil {
+		return httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+	}
+
+	for _, policyId := range policyIds {
+		policy, err := u.policyRepo.GetByID(ctx, organizationId, policyId)
+		if err != nil {
+			return httpErrors.NewBadRequestError(fmt.Errorf("invalid policyId"), "C_INVALID_POLICY_ID", "")
+		}
+
+		policyCRs := policytemplate.PolicyToTksPolicyCRs(policy)
+		if err = policytemplate.ApplyTksPolicyCRs(ctx, organization.PrimaryClusterId, policyCRs); err != nil {
+			log.Errorf(ctx, "failed to apply TksPolicyCR: %v", err)
+			return httpErrors.NewInternalServerError(err, "P_FAILED_TO_APPLY_KUBERNETES", "")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,408 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"gorm.io/gorm"
+)
+
+type IPolicyChangeRequestUsecase interface {
+	GetApprovalSetting(ctx context.Context, organizationId string) (model.PolicyApprovalSetting, error)
+	UpdateApprovalSetting(ctx context.Context, organizationId string, setting model.PolicyApprovalSetting) error
+	RequestIfRequired(ctx context.Context, organizationId string, policyId uuid.UUID, changeType domain.PolicyChangeType, payload interface{}) (changeRequestId uuid.UUID, err error)
+	Get(ctx context.Context, organizationId string, changeRequestId uuid.UUID) (*model.PolicyChangeRequest, error)
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (*[]model.PolicyChangeRequest, error)
+	Approve(ctx context.Context, organizationId string, changeRequestId uuid.UUID, comment string) error
+	Reject(ctx context.Context, organizationId string, changeRequestId uuid.UUID, comment string) error
+	ExpireRequests(ctx context.Context) error
+	WatchExpiration(ctx context.Context, interval time.Duration)
+}
+
+type PolicyChangeRequestUsecase struct {
+	repo             repository.IPolicyChangeRequestRepository
+	organizationRepo repository.IOrganizationRepository
+	policyRepo       repository.IPolicyRepository
+	accessTokenRepo  repository.IAccessTokenRepository
+	policyUsecase    IPolicyUsecase
+	jobLeaseRepo     repository.IJobLeaseRepository
+}
+
+func NewPolicyChangeRequestUsecase(r repository.Repository, policyUsecase IPolicyUsecase) IPolicyChangeRequestUsecase {
+	return &PolicyChangeRequestUsecase{
+		repo:             r.PolicyChangeRequest,
+		organizationRepo: r.Organization,
+		policyRepo:       r.Policy,
+		accessTokenRepo:  r.AccessToken,
+		policyUsecase:    policyUsecase,
+		jobLeaseRepo:     r.JobLease,
+	}
+}
+
+func (u *PolicyChangeRequestUsecase) GetApprovalSetting(ctx context.Context, organizationId string) (model.PolicyApprovalSetting, error) {
+	organization, err := u.organizationRepo.Get(ctx, organizationId)
+	if err != nil {
+		return model.PolicyApprovalSetting{}, httpErrors.NewNotFoundError(err, "", "")
+	}
+	return organization.PolicyApproval, nil
+}
+
+func (u *PolicyChangeRequestUsecase) UpdateApprovalSetting(ctx context.Context, organizationId string, setting model.PolicyApprovalSetting) error {
+	if _, err := u.organizationRepo.Get(ctx, organizationId); err != nil {
+		return httpErrors.NewNotFoundError(err, "", "")
+	}
+	return u.organizationRepo.UpdatePolicyApprovalSetting(ctx, organizationId, setting)
+}
+
+// RequestIfRequired 는 조직의 승인 모드가 활성화되어 있으면 정책 변경 요청을 생성하고 그 ID 를 반환한다.
+// 승인 모드가 아니면 uuid.Nil 을 반환하며, 호출자는 변경을 바로 적용한다.
+func (u *PolicyChangeRequestUsecase) RequestIfRequired(ctx context.Context, organizationId string, policyId uuid.UUID,
+	changeType domain.PolicyChangeType, payload interface{}) (changeRequestId uuid.UUID, err error) {
+	organization, err := u.organizationRepo.Get(ctx, organizationId)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+	}
+
+	if !organization.PolicyApproval.Enabled {
+		return uuid.Nil, nil
+	}
+
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return uuid.Nil, httpErrors.NewUnauthorizedError(fmt.Errorf("invalid token"), "A_INVALID_TOKEN", "")
+	}
+
+	policy, err := u.policyRepo.GetByID(ctx, organizationId, policyId)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return uuid.Nil, httpErrors.NewNotFoundError(err, "P_NOT_FOUND_POLICY", "")
+		}
+		return uuid.Nil, err
+	}
+
+	exists, err := u.repo.ExistPending(ctx, policyId)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if exists {
+		return uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("pending change request already exists"), "PCR_ALREADY_PENDING", "")
+	}
+
+	jsonBytes, err := json.Marshal(payload)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewBadRequestError(err, "PCR_INVALID_PAYLOAD", "")
+	}
+
+	diff, err := policyChangeDiff(policy, changeType, jsonBytes)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewBadRequestError(err, "PCR_INVALID_PAYLOAD", "")
+	}
+
+	userId := user.GetUserId()
+	dto := model.PolicyChangeRequest{
+		OrganizationId: organizationId,
+		PolicyId:       policyId,
+		PolicyName:     policy.PolicyName,
+		ChangeType:     changeType,
+		Payload:        string(jsonBytes),
+		DiffItems:      diff,
+		Status:         domain.PolicyChangeRequestStatusPending,
+		ExpiresAt:      time.Now().Add(organization.PolicyApproval.ExpiryDuration()),
+		RequesterId:    &userId,
+	}
+
+	return u.repo.Create(ctx, dto)
+}
+
+func (u *PolicyChangeRequestUsecase) Get(ctx context.Context, organizationId string, changeRequestId uuid.UUID) (*model.PolicyChangeRequest, error) {
+	changeRequest, err := u.repo.Get(ctx, organizationId, changeRequestId)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, httpErrors.NewNotFoundError(err, "PCR_NOT_FOUND_POLICY_CHANGE_REQUEST", "")
+		}
+		return nil, err
+	}
+	return &changeRequest, nil
+}
+
+func (u *PolicyChangeRequestUsecase) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (*[]model.PolicyChangeRequest, error) {
+	changeRequests, err := u.repo.Fetch(ctx, organizationId, pg)
+	if err != nil {
+		return nil, err
+	}
+	return &changeRequests, nil
+}
+
+// Approve 는 요청자가 아닌 사용자의 승인으로 변경 내용을 정책과 TKSPolicy CR 에 적용한다.
+// 승인 대기 상태인 경우에만 승인 상태로 변경하므로 동시에 승인해도 한 번만 적용된다.
+// 적용에 실패하면 요청은 승인 대기 상태로 되돌린다.
+func (u *PolicyChangeRequestUsecase) Approve(ctx context.Context, organizationId string, changeRequestId uuid.UUID, comment string) error {
+	changeRequest, reviewerId, err := u.getReviewable(ctx, organizationId, changeRequestId)
+	if err != nil {
+		return err
+	}
+
+	if err := u.transition(ctx, changeRequestId, domain.PolicyChangeRequestStatusApproved, &reviewerId, comment); err != nil {
+		return err
+	}
+
+	if err := u.apply(withApprovedPolicyChange(ctx), changeRequest); err != nil {
+		log.Errorf(ctx, "failed to apply policy change request %s: %v", changeRequestId, err)
+		if _, err := u.repo.UpdateStatus(ctx, changeRequestId, domain.PolicyChangeRequestStatusApproved, domain.PolicyChangeRequestStatusPending, nil, ""); err != nil {
+			log.Error(ctx, err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (u *PolicyChangeRequestUsecase) Reject(ctx context.Context, organizationId string, changeRequestId uuid.UUID, comment string) error {
+	_, reviewerId, err := u.getReviewable(ctx, organizationId, changeRequestId)
+	if err != nil {
+		return err
+	}
+
+	return u.transition(ctx, changeRequestId, domain.PolicyChangeRequestStatusRejected, &reviewerId, comment)
+}
+
+// transition 은 승인 대기 상태인 요청만 검토 결과 상태로 변경한다.
+func (u *PolicyChangeRequestUsecase) transition(ctx context.Context, changeRequestId uuid.UUID, status domain.PolicyChangeRequestStatus, reviewerId *uuid.UUID, comment string) error {
+	updated, err := u.repo.UpdateStatus(ctx, changeRequestId, domain.PolicyChangeRequestStatusPending, status, reviewerId, comment)
+	if err != nil {
+		return httpErrors.NewInternalServerError(err, "", "")
+	}
+	if !updated {
+		return httpErrors.NewBadRequestError(fmt.Errorf("change request is not pending"), "PCR_NOT_PENDING", "")
+	}
+	return nil
+}
+
+// ExpireRequests 는 만료 시각이 지난 승인 대기 요청을 만료 처리한다.
+func (u *PolicyChangeRequestUsecase) ExpireRequests(ctx context.Context) error {
+	count, err := u.repo.ExpirePending(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		log.Infof(ctx, "expired %d policy change requests", count)
+	}
+	return nil
+}
+
+func (u *PolicyChangeRequestUsecase) WatchExpiration(ctx context.Context, interval time.Duration) {
+	watchExclusively(ctx, u.jobLeaseRepo, "policy-change-request-expiration", interval, u.ExpireRequests)
+}
+
+// getReviewable 은 승인 또는 반려할 수 있는 요청인지 확인하고 검토자 ID 를 반환한다.
+func (u *PolicyChangeRequestUsecase) getReviewable(ctx context.Context, organizationId string, changeRequestId uuid.UUID) (*model.PolicyChangeRequest, uuid.UUID, error) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return nil, uuid.Nil, httpErrors.NewUnauthorizedError(fmt.Errorf("invalid token"), "A_INVALID_TOKEN", "")
+	}
+
+	changeRequest, err := u.Get(ctx, organizationId, changeRequestId)
+	if err != nil {
+		return nil, uuid.Nil, err
+	}
+
+	if changeRequest.IsExpired(time.Now()) {
+		if _, err := u.repo.UpdateStatus(ctx, changeRequestId, domain.PolicyChangeRequestStatusPending, domain.PolicyChangeRequestStatusExpired, nil, ""); err != nil {
+			log.Error(ctx, err)
+		}
+		return nil, uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("change request expired"), "PCR_EXPIRED", "")
+	}
+
+	if changeRequest.Status != domain.PolicyChangeRequestStatusPending {
+		return nil, uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("change request is %s", changeRequest.Status), "PCR_NOT_PENDING", "")
+	}
+
+	reviewerId := user.GetUserId()
+	if changeRequest.RequesterId != nil {
+		actorIds, err := u.actorIds(ctx, organizationId, reviewerId)
+		if err != nil {
+			return nil, uuid.Nil, err
+		}
+		if slices.Contains(actorIds, *changeRequest.RequesterId) {
+			return nil, uuid.Nil, httpErrors.NewForbiddenError(fmt.Errorf("requester cannot review own change request"), "PCR_SELF_REVIEW", "")
+		}
+	}
+
+	return changeRequest, reviewerId, nil
+}
+
+// actorIds 는 요청한 주체의 ID 와, 액세스 토큰 또는 서비스 계정으로 요청한 경우 이를 생성한 사용자의 ID 를 반환한다.
+// 요청자가 만든 토큰이나 서비스 계정으로 자신의 변경 요청을 승인하지 못하도록 하기 위해 사용한다.
+func (u *PolicyChangeRequestUsecase) actorIds(ctx context.Context, organizationId string, userId uuid.UUID) ([]uuid.UUID, error) {
+	actorIds := []uuid.UUID{userId}
+
+	accessTokenInfo, ok := request.AccessTokenFrom(ctx)
+	if !ok {
+		return actorIds, nil
+	}
+
+	accessToken, err := u.accessTokenRepo.Get(ctx, accessTokenInfo.TokenId)
+	if err != nil {
+		return nil, httpErrors.NewInternalServerError(err, "", "")
+	}
+	if accessToken.CreatorId != nil {
+		actorIds = append(actorIds, *accessToken.CreatorId)
+	}
+
+	if accessToken.ServiceAccountId != nil {
+		serviceAccount, err := u.accessTokenRepo.GetServiceAccount(ctx, organizationId, *accessToken.ServiceAccountId)
+		if err != nil {
+			return nil, httpErrors.NewInternalServerError(err, "", "")
+		}
+		if serviceAccount.CreatorId != nil {
+			actorIds = append(actorIds, *serviceAccount.CreatorId)
+		}
+	}
+
+	return actorIds, nil
+}
+
+func (u *PolicyChangeRequestUsecase) apply(ctx context.Context, changeRequest *model.PolicyChangeRequest) error {
+	switch changeRequest.ChangeType {
+	case domain.PolicyChangeTypeUpdate:
+		var input domain.UpdatePolicyRequest
+		if err := json.Unmarshal([]byte(changeRequest.Payload), &input); err != nil {
+			return httpErrors.NewInternalServerError(err, "PCR_INVALID_PAYLOAD", "")
+		}
+
+		var templateId *uuid.UUID
+		if input.TemplateId != nil {
+			tuuid, err := uuid.Parse(*input.TemplateId)
+			if err != nil {
+				return httpErrors.NewBadRequestError(fmt.Errorf("invalid policyTemplateId"), "C_INVALID_POLICY_TEMPLATE_ID", "")
+			}
+			templateId = &tuuid
+		}
+
+		return u.policyUsecase.Update(ctx, changeRequest.OrganizationId, changeRequest.PolicyId,
+			input.Mandatory, input.PolicyName, input.Description, templateId, input.EnforcementAction,
+			input.Parameters, input.Match, input.MatchYaml, input.TargetClusterIds)
+
+	case domain.PolicyChangeTypeDelete:
+		return u.policyUsecase.Delete(ctx, changeRequest.OrganizationId, changeRequest.PolicyId)
+
+	case domain.PolicyChangeTypeUpdateTargetClusters:
+		var input domain.UpdatePolicyClustersRequest
+		if err := json.Unmarshal([]byte(changeRequest.Payload), &input); err != nil {
+			return httpErrors.NewInternalServerError(err, "PCR_INVALID_PAYLOAD", "")
+		}
+
+		return u.policyUsecase.UpdatePolicyTargetClusters(ctx, changeRequest.OrganizationId, changeRequest.PolicyId,
+			input.CurrentTargetClusterIds, input.NewTargetClusterIds)
+	}
+
+	return httpErrors.NewInternalServerError(fmt.Errorf("unsupported change type '%s'", changeRequest.ChangeType), "PCR_INVALID_PAYLOAD", "")
+}
+
+type approvedPolicyChangeKey struct{}
+
+// withApprovedPolicyChange 는 승인된 변경 요청을 적용하는 중임을 ctx 에 표시한다.
+func withApprovedPolicyChange(ctx context.Context) context.Context {
+	return context.WithValue(ctx, approvedPolicyChangeKey{}, true)
+}
+
+// checkPolicyApproval 은 조직의 정책 변경 승인 모드가 활성화되어 있으면 승인된 변경 요청을 적용하는 경우에만 정책 변경을 허용한다.
+// 변경 요청으로 만들 수 없는 번들 프리셋, 단계적 적용, 스택별 정책 및 템플릿 버전 변경은 승인 모드에서 거부된다.
+func checkPolicyApproval(ctx context.Context, organizationRepo repository.IOrganizationRepository, organizationId string) error {
+	if approved, _ := ctx.Value(approvedPolicyChangeKey{}).(bool); approved {
+		return nil
+	}
+
+	organization, err := organizationRepo.Get(ctx, organizationId)
+	if err != nil {
+		return httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+	}
+	if organization.PolicyApproval.Enabled {
+		return httpErrors.NewForbiddenError(fmt.Errorf("policy change requires approval"), "PCR_APPROVAL_REQUIRED", "")
+	}
+	return nil
+}
+
+// policyChangeDiff 는 현재 정책과 요청 본문을 비교해 변경되는 필드만 반환한다.
+func policyChangeDiff(policy *model.Policy, changeType domain.PolicyChangeType, payload []byte) (diff []domain.PolicyChangeDiff, err error) {
+	add := func(field string, before string, after *string) {
+		if after != nil && *after != before {
+			diff = append(diff, domain.PolicyChangeDiff{Field: field, Before: before, After: *after})
+		}
+	}
+
+	switch changeType {
+	case domain.PolicyChangeTypeUpdate:
+		var input domain.UpdatePolicyRequest
+		if err := json.Unmarshal(payload, &input); err != nil {
+			return nil, err
+		}
+
+		add("policyName", policy.PolicyName, input.PolicyName)
+		add("description", policy.Description, input.Description)
+		add("templateId", policy.TemplateId.String(), input.TemplateId)
+		add("enforcementAction", policy.EnforcementAction, input.EnforcementAction)
+		add("parameters", policy.Parameters, input.Parameters)
+		if input.Mandatory != nil {
+			mandatory := strconv.FormatBool(*input.Mandatory)
+			add("mandatory", strconv.FormatBool(policy.Mandatory), &mandatory)
+		}
+		if input.Match != nil {
+			match := input.Match.JSON()
+			add("match", policy.Match.JSON(), &match)
+		}
+		if input.MatchYaml != nil {
+			matchYaml := ""
+			if policy.MatchYaml != nil {
+				matchYaml = *policy.MatchYaml
+			}
+			add("matchYaml", matchYaml, input.MatchYaml)
+		}
+		if input.TargetClusterIds != nil {
+			targetClusterIds := joinClusterIds(*input.TargetClusterIds)
+			add("targetClusterIds", joinClusterIds(policy.TargetClusterIds), &targetClusterIds)
+		}
+
+	case domain.PolicyChangeTypeDelete:
+		deleted := ""
+		add("policy", policy.PolicyName, &deleted)
+
+	case domain.PolicyChangeTypeUpdateTargetClusters:
+		var input domain.UpdatePolicyClustersRequest
+		if err := json.Unmarshal(payload, &input); err != nil {
+			return nil, err
+		}
+
+		targetClusterIds := joinClusterIds(input.NewTargetClusterIds)
+		add("targetClusterIds", joinClusterIds(policy.TargetClusterIds), &targetClusterIds)
+
+	default:
+		return nil, fmt.Errorf("unsupported change type '%s'", changeType)
+	}
+
+	if len(diff) == 0 {
+		return nil, fmt.Errorf("no changes requested")
+	}
+
+	return diff, nil
+}
+
+func joinClusterIds(clusterIds []string) string {
+	sorted := append([]string{}, clusterIds...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ",")
+}
@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/user"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+)
+
+// fakeOrganizationRepository 는 Get 만 구현한다. 나머지 메소드를 호출하면 panic 이 발생한다.
+type fakeOrganizationRepository struct {
+	repository.IOrganizationRepository
+	organization model.Organization
+}
+
+func (r *fakeOrganizationRepository) Get(ctx context.Context, organizationId string) (model.Organization, error) {
+	return r.organization, nil
+}
+
+func assertApprovalRequired(t *testing.T, name string, err error) {
+	t.Helper()
+	httpErr, ok := err.(httpErrors.IRestError)
+	if !ok || httpErr.Code() != "PCR_APPROVAL_REQUIRED" {
+		t.Errorf("%s: expected PCR_APPROVAL_REQUIRED, got %v", name, err)
+	}
+}
+
+func TestPolicyApprovalRequiredOnCreate(t *testing.T) {
+	organizationRepo := &fakeOrganizationRepository{organization: model.Organization{
+		ID:             "org1",
+		PolicyApproval: model.PolicyApprovalSetting{Enabled: true},
+	}}
+	ctx := request.WithUser(context.Background(), &user.DefaultInfo{UserId: uuid.New(), OrganizationId: "org1"})
+
+	// 승인 모드에서는 저장소나 클러스터에 접근하기 전에 거부되어야 한다.
+	policyUsecase := &PolicyUsecase{organizationRepo: organizationRepo}
+	_, err := policyUsecase.Create(ctx, "org1", model.Policy{PolicyName: "deny-all", EnforcementAction: "deny"})
+	assertApprovalRequired(t, "policy", err)
+
+	exceptionUsecase := &PolicyExceptionUsecase{organizationRepo: organizationRepo}
+	_, err = exceptionUsecase.Create(ctx, "org1", uuid.New(), model.PolicyException{})
+	assertApprovalRequired(t, "policy exception", err)
+
+	mutationUsecase := &MutationPolicyUsecase{organizationRepo: organizationRepo}
+	_, err = mutationUsecase.Create(ctx, "org1", model.MutationPolicy{PolicyName: "add-label"})
+	assertApprovalRequired(t, "mutation policy", err)
+
+	// 승인된 변경 요청을 적용하는 중에는 허용된다.
+	if err := checkPolicyApproval(withApprovedPolicyChange(ctx), organizationRepo, "org1"); err != nil {
+		t.Errorf("expected approved change to pass, got %v", err)
+	}
+	organizationRepo.organization.PolicyApproval.Enabled = false
+	if err := checkPolicyApproval(ctx, organizationRepo, "org1"); err != nil {
+		t.Errorf("expected change to pass without approval mode, got %v", err)
+	}
+}
@@ -0,0 +1,210 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	policytemplate "github.com/openinfradev/tks-api/internal/policy-template"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"k8s.io/utils/strings/slices"
+)
+
+type IPolicyExceptionUsecase interface {
+	Create(ctx context.Context, organizationId string, policyId uuid.UUID, dto model.PolicyException) (exceptionId uuid.UUID, err error)
+	List(ctx context.Context, organizationId string, policyId uuid.UUID) ([]model.PolicyException, error)
+	Delete(ctx context.Context, organizationId string, policyId uuid.UUID, exceptionId uuid.UUID) error
+	ExpireExceptions(ctx context.Context) error
+	WatchExpiration(ctx context.Context, interval time.Duration)
+}
+
+type PolicyExceptionUsecase struct {
+	repo             repository.IPolicyExceptionRepository
+	policyRepo       repository.IPolicyRepository
+	organizationRepo repository.IOrganizationRepository
+	userRepo         repository.IUserRepository
+	violationRepo    repository.IPolicyViolationRepository
+	jobLeaseRepo     repository.IJobLeaseRepository
+}
+
+func NewPolicyExceptionUsecase(r repository.Repository) IPolicyExceptionUsecase {
+	return &PolicyExceptionUsecase{
+		repo:             r.PolicyException,
+		policyRepo:       r.Policy,
+		organizationRepo: r.Organization,
+		userRepo:         r.User,
+		violationRepo:    r.PolicyViolation,
+		jobLeaseRepo:     r.JobLease,
+	}
+}
+
+func (u *PolicyExceptionUsecase) Create(ctx context.Context, organizationId string, policyId uuid.UUID, dto model.PolicyException) (exceptionId uuid.UUID, err error) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return uuid.Nil, httpErrors.NewUnauthorizedError(fmt.Errorf("invalid token"), "A_INVALID_TOKEN", "")
+	}
+	// 예외는 정책의 적용을 해제하므로 정책 변경과 같이 승인 모드에서는 바로 생성할 수 없다.
+	if err := checkPolicyApproval(ctx, u.organizationRepo, organizationId); err != nil {
+		return uuid.Nil, err
+	}
+
+	policy, err := u.policyRepo.GetByID(ctx, organizationId, policyId)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewNotFoundError(err, "P_NOT_FOUND_POLICY", "")
+	}
+	if !slices.Contains(policy.TargetClusterIds, dto.ClusterId.String()) {
+		return uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("cluster '%s' is not a target of the policy", dto.ClusterId), "PE_INVALID_CLUSTER", "")
+	}
+
+	now := time.Now()
+	if !dto.ExpiredAt.After(now) || dto.ExpiredAt.After(now.Add(internal.MaxPolicyExceptionDuration)) {
+		return uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("expiredAt must be within %s from now", internal.MaxPolicyExceptionDuration), "PE_INVALID_EXPIRED_AT", "")
+	}
+
+	// 예외 요청자가 스스로 승인할 수 없도록 승인자는 요청자와 다른 같은 조직의 사용자여야 한다.
+	userId := user.GetUserId()
+	if dto.ApproverId == nil || *dto.ApproverId == userId {
+		return uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("approver must be another user"), "PE_INVALID_APPROVER", "")
+	}
+	approver, err := u.userRepo.GetByUuid(ctx, *dto.ApproverId)
+	if err != nil || approver.OrganizationId != organizationId {
+		return uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("approver not found"), "PE_INVALID_APPROVER", "")
+	}
+
+	dto.ID = uuid.New()
+	dto.OrganizationId = organizationId
+	dto.PolicyId = policyId
+	dto.CreatorId = &userId
+
+	exceptionId, err = u.repo.Create(ctx, dto)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewInternalServerError(err, "", "")
+	}
+
+	if err = u.applyPolicyCR(ctx, organizationId, policyId); err != nil {
+		if err := u.repo.Delete(ctx, exceptionId); err != nil {
+			log.Error(ctx, err)
+		}
+		return uuid.Nil, err
+	}
+
+	return exceptionId, nil
+}
+
+func (u *PolicyExceptionUsecase) List(ctx context.Context, organizationId string, policyId uuid.UUID) ([]model.PolicyException, error) {
+	if _, err := u.policyRepo.GetByID(ctx, organizationId, policyId); err != nil {
+		return nil, httpErrors.NewNotFoundError(err, "P_NOT_FOUND_POLICY", "")
+	}
+
+	exceptions, err := u.repo.ListByPolicyId(ctx, organizationId, policyId)
+	if err != nil {
+		return nil, httpErrors.NewInternalServerError(err, "", "")
+	}
+	return exceptions, nil
+}
+
+func (u *PolicyExceptionUsecase) Delete(ctx context.Context, organizationId string, policyId uuid.UUID, exceptionId uuid.UUID) error {
+	if _, err := u.repo.Get(ctx, organizationId, policyId, exceptionId); err != nil {
+		return httpErrors.NewNotFoundError(err, "PE_NOT_FOUND_POLICY_EXCEPTION", "")
+	}
+
+	if err := u.repo.Delete(ctx, exceptionId); err != nil {
+		return httpErrors.NewInternalServerError(err, "", "")
+	}
+
+	if err := u.reopenViolations(ctx, organizationId, policyId); err != nil {
+		log.Errorf(ctx, "failed to reopen violations of policy %s: %v", policyId, err)
+	}
+
+	return u.applyPolicyCR(ctx, organizationId, policyId)
+}
+
+// ExpireExceptions 는 만료일이 지난 예외를 만료 처리하고 해당 정책의 TKSPolicy CR 에서 제거한다.
+// 만료된 예외는 이력으로 남겨 정책 상세에서 조회할 수 있다.
+func (u *PolicyExceptionUsecase) ExpireExceptions(ctx context.Context) error {
+	exceptions, err := u.repo.ListExpirable(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+	if len(exceptions) == 0 {
+		return nil
+	}
+
+	exceptionIds := make([]uuid.UUID, len(exceptions))
+	policyOrganizations := make(map[uuid.UUID]string)
+	for i, exception := range exceptions {
+		exceptionIds[i] = exception.ID
+		policyOrganizations[exception.PolicyId] = exception.OrganizationId
+	}
+
+	if err = u.repo.MarkExpired(ctx, exceptionIds); err != nil {
+		return err
+	}
+
+	for policyId, organizationId := range policyOrganizations {
+		if err := u.reopenViolations(ctx, organizationId, policyId); err != nil {
+			log.Errorf(ctx, "failed to reopen violations of policy %s: %v", policyId, err)
+		}
+		if err := u.applyPolicyCR(ctx, organizationId, policyId); err != nil {
+			log.Errorf(ctx, "failed to remove expired exceptions from policy %s: %v", policyId, err)
+		}
+	}
+	log.Infof(ctx, "expired %d policy exceptions", len(exceptionIds))
+
+	return nil
+}
+
+func (u *PolicyExceptionUsecase) WatchExpiration(ctx context.Context, interval time.Duration) {
+	watchExclusively(ctx, u.jobLeaseRepo, "policy-exception-expiration", interval, u.ExpireExceptions)
+}
+
+// reopenViolations 는 예외 상태인 위반 기록을 남은 유효한 예외로 다시 판정해, 더 이상 예외에 해당하지 않으면 미해결 상태로 되돌린다.
+// 다음 audit 동기화를 기다리지 않고 예외 삭제 또는 만료 즉시 위반이 다시 드러나도록 한다.
+func (u *PolicyExceptionUsecase) reopenViolations(ctx context.Context, organizationId string, policyId uuid.UUID) error {
+	policy, err := u.policyRepo.GetByID(ctx, organizationId, policyId)
+	if err != nil {
+		return err
+	}
+
+	violations, err := u.violationRepo.FetchExcepted(ctx, policyId)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	var violationIds []uuid.UUID
+	for _, violation := range violations {
+		violation.LastSeenAt = now
+		if newPolicyViolation(policy, violation).Status != domain.PolicyViolationStatusExcepted {
+			violationIds = append(violationIds, violation.ID)
+		}
+	}
+
+	return u.violationRepo.Reopen(ctx, violationIds)
+}
+
+func (u *PolicyExceptionUsecase) applyPolicyCR(ctx context.Context, organizationId string, policyId uuid.UUID) error {
+	organization, err := u.organizationRepo.Get(ctx, organizationId)
+	if err != nil {
+		return httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+	}
+
+	policy, err := u.policyRepo.GetByID(ctx, organizationId, policyId)
+	if err != nil {
+		return httpErrors.NewBadRequestError(fmt.Errorf("invalid policyId"), "C_INVALID_POLICY_ID", "")
+	}
+
+	policyCRs := policytemplate.PolicyToTksPolicyCRs(policy)
+	if err = policytemplate.ApplyTksPolicyCRs(ctx, organization.PrimaryClusterId, policyCRs); err != nil {
+		log.Errorf(ctx, "failed to apply TksPolicyCR: %v", err)
+		return httpErrors.NewInternalServerError(err, "P_FAILED_TO_APPLY_KUBERNETES", "")
+	}
+	return nil
+}
@@ -0,0 +1,392 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	policytemplate "github.com/openinfradev/tks-api/internal/policy-template"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"k8s.io/utils/strings/slices"
+)
+
+const policyRolloutTargetAction = "deny"
+
+type IPolicyRolloutUsecase interface {
+	Start(ctx context.Context, organizationId string, policyId uuid.UUID, dto model.PolicyRollout) (rolloutId uuid.UUID, err error)
+	Get(ctx context.Context, organizationId string, policyId uuid.UUID) (model.PolicyRollout, error)
+	Cancel(ctx context.Context, organizationId string, policyId uuid.UUID) error
+	EvaluateRollouts(ctx context.Context) error
+	WatchRollouts(ctx context.Context, interval time.Duration)
+}
+
+type PolicyRolloutUsecase struct {
+	repo                   repository.IPolicyRolloutRepository
+	policyRepo             repository.IPolicyRepository
+	organizationRepo       repository.IOrganizationRepository
+	userRepo               repository.IUserRepository
+	auditRepo              repository.IAuditRepository
+	systemNotificationRepo repository.ISystemNotificationRepository
+	dashboardUsecase       IDashboardUsecase
+	jobLeaseRepo           repository.IJobLeaseRepository
+}
+
+func NewPolicyRolloutUsecase(r repository.Repository, dashboardUsecase IDashboardUsecase) IPolicyRolloutUsecase {
+	return &PolicyRolloutUsecase{
+		repo:                   r.PolicyRollout,
+		policyRepo:             r.Policy,
+		organizationRepo:       r.Organization,
+		userRepo:               r.User,
+		auditRepo:              r.Audit,
+		systemNotificationRepo: r.SystemNotification,
+		dashboardUsecase:       dashboardUsecase,
+		jobLeaseRepo:           r.JobLease,
+	}
+}
+
+// Start 는 정책의 적용 액션을 초기 적용 액션으로 두고 대상 스택별 단계적 적용을 시작한다.
+// 정책 자체의 적용 액션은 완료될 때까지 초기 적용 액션으로 유지되므로, 단계에 포함되지 않은 스택이 deny 로 적용되지 않는다.
+func (u *PolicyRolloutUsecase) Start(ctx context.Context, organizationId string, policyId uuid.UUID, dto model.PolicyRollout) (rolloutId uuid.UUID, err error) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return uuid.Nil, httpErrors.NewUnauthorizedError(fmt.Errorf("invalid token"), "A_INVALID_TOKEN", "")
+	}
+
+	if err := checkPolicyApproval(ctx, u.organizationRepo, organizationId); err != nil {
+		return uuid.Nil, err
+	}
+
+	policy, err := u.policyRepo.GetByID(ctx, organizationId, policyId)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewNotFoundError(err, "P_NOT_FOUND_POLICY", "")
+	}
+
+	exists, err := u.repo.ExistInProgress(ctx, policyId)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewInternalServerError(err, "", "")
+	}
+	if exists {
+		return uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("policy rollout is already in progress"), "PR_POLICY_ROLLOUT_IN_PROGRESS", "")
+	}
+
+	if len(policy.TargetClusterIds) == 0 {
+		return uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("policy has no target stacks"), "PR_NO_TARGET_STACK", "")
+	}
+
+	now := time.Now()
+	userId := user.GetUserId()
+	dto.ID = uuid.New()
+	dto.OrganizationId = organizationId
+	dto.PolicyId = policyId
+	dto.BaseEnforcementAction = policy.EnforcementAction
+	dto.Status = domain.PolicyRolloutStatusInProgress
+	dto.CreatorId = &userId
+	dto.Stacks = make([]model.PolicyRolloutStack, len(policy.TargetClusterIds))
+	for i, clusterId := range policy.TargetClusterIds {
+		dto.Stacks[i] = model.PolicyRolloutStack{
+			PolicyRolloutId:   dto.ID,
+			ClusterId:         domain.ClusterId(clusterId),
+			EnforcementAction: dto.InitialEnforcementAction,
+			StageStartedAt:    now,
+		}
+	}
+
+	rolloutId, err = u.repo.Create(ctx, dto)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewInternalServerError(err, "", "")
+	}
+
+	if err = u.applyStages(ctx, organizationId, policyId, dto.InitialEnforcementAction, dto.Stacks); err != nil {
+		if err := u.repo.UpdateStatus(ctx, rolloutId, domain.PolicyRolloutStatusCancelled); err != nil {
+			log.Error(ctx, err)
+		}
+		return uuid.Nil, err
+	}
+
+	for _, stack := range dto.Stacks {
+		u.recordTransition(ctx, policy, stack.ClusterId, "warning",
+			fmt.Sprintf("정책 [%s] 단계적 적용 시작", policy.PolicyName),
+			fmt.Sprintf("스택 [%s]에 정책 [%s]을 %s 로 적용하였습니다. %d시간 동안 위반이 %d건 이하이면 deny 로 승격됩니다.",
+				stack.ClusterId, policy.PolicyName, dto.InitialEnforcementAction, dto.ObservationHours, dto.ViolationThreshold))
+	}
+
+	return rolloutId, nil
+}
+
+func (u *PolicyRolloutUsecase) Get(ctx context.Context, organizationId string, policyId uuid.UUID) (model.PolicyRollout, error) {
+	rollout, err := u.repo.GetLatest(ctx, organizationId, policyId)
+	if err != nil {
+		return model.PolicyRollout{}, httpErrors.NewNotFoundError(err, "PR_NOT_FOUND_POLICY_ROLLOUT", "")
+	}
+	return rollout, nil
+}
+
+// Cancel 은 진행 중인 단계적 적용을 중단하고 정책의 적용 액션을 단계적 적용 전의 값으로 되돌린다.
+func (u *PolicyRolloutUsecase) Cancel(ctx context.Context, organizationId string, policyId uuid.UUID) error {
+	rollout, err := u.Get(ctx, organizationId, policyId)
+	if err != nil {
+		return err
+	}
+	if rollout.Status != domain.PolicyRolloutStatusInProgress {
+		return httpErrors.NewBadRequestError(fmt.Errorf("policy rollout is not in progress"), "PR_NOT_IN_PROGRESS", "")
+	}
+
+	policy, err := u.policyRepo.GetByID(ctx, organizationId, policyId)
+	if err != nil {
+		return httpErrors.NewNotFoundError(err, "P_NOT_FOUND_POLICY", "")
+	}
+
+	if err := u.applyStages(ctx, organizationId, policyId, rollout.BaseEnforcementAction, nil); err != nil {
+		return err
+	}
+
+	if err := u.repo.UpdateStatus(ctx, rollout.ID, domain.PolicyRolloutStatusCancelled); err != nil {
+		return httpErrors.NewInternalServerError(err, "", "")
+	}
+
+	for _, stack := range rollout.Stacks {
+		u.recordTransition(ctx, policy, stack.ClusterId, "warning",
+			fmt.Sprintf("정책 [%s] 단계적 적용 취소", policy.PolicyName),
+			fmt.Sprintf("스택 [%s]의 정책 [%s] 단계적 적용이 취소되어 %s 로 적용됩니다.",
+				stack.ClusterId, policy.PolicyName, rollout.BaseEnforcementAction))
+	}
+
+	return nil
+}
+
+// EvaluateRollouts 는 관찰 기간이 지난 스택의 위반 수를 Thanos 에서 조회하여 임계치 이하이면 deny 로 승격한다.
+// 임계치를 넘으면 관찰 기간을 다시 시작한다. 모든 스택이 승격되면 단계적 적용을 완료한다.
+func (u *PolicyRolloutUsecase) EvaluateRollouts(ctx context.Context) error {
+	rollouts, err := u.repo.ListInProgress(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, rollout := range rollouts {
+		policy, err := u.policyRepo.GetByID(ctx, rollout.OrganizationId, rollout.PolicyId)
+		if err != nil {
+			log.Errorf(ctx, "failed to get policy %s of rollout %s: %v", rollout.PolicyId, rollout.ID, err)
+			continue
+		}
+
+		// 단계적 적용 중에 추가된 대상 스택도 초기 적용 액션부터 시작한다.
+		for _, clusterId := range policy.TargetClusterIds {
+			found := false
+			for _, stack := range rollout.Stacks {
+				if stack.ClusterId.String() == clusterId {
+					found = true
+					break
+				}
+			}
+			if !found {
+				rollout.Stacks = append(rollout.Stacks, model.PolicyRolloutStack{
+					PolicyRolloutId:   rollout.ID,
+					ClusterId:         domain.ClusterId(clusterId),
+					EnforcementAction: rollout.InitialEnforcementAction,
+					StageStartedAt:    now,
+				})
+			}
+		}
+
+		promoted := []domain.ClusterId{}
+		held := map[domain.ClusterId]int{}
+		for i, stack := range rollout.Stacks {
+			if stack.EnforcementAction == policyRolloutTargetAction ||
+				!slices.Contains(policy.TargetClusterIds, stack.ClusterId.String()) ||
+				now.Before(stack.StageStartedAt.Add(rollout.ObservationPeriod())) {
+				continue
+			}
+
+			count, found, err := u.violationCount(ctx, rollout.OrganizationId, stack.ClusterId, policy.PolicyResourceName, rollout.ObservationPeriod())
+			if err != nil {
+				log.Errorf(ctx, "failed to get violation count of policy %s on %s: %v", policy.ID, stack.ClusterId, err)
+				continue
+			}
+			// 위반 수 메트릭이 없으면 위반이 없는 것인지 수집되지 않은 것인지 알 수 없으므로 승격하지 않는다.
+			if !found {
+				log.Warnf(ctx, "violation metric of policy %s on %s is not found. skip promotion", policy.ID, stack.ClusterId)
+				continue
+			}
+
+			rollout.Stacks[i].LastViolationCount = count
+			rollout.Stacks[i].StageStartedAt = now
+			if count <= rollout.ViolationThreshold {
+				promotedAt := now
+				rollout.Stacks[i].EnforcementAction = policyRolloutTargetAction
+				rollout.Stacks[i].PromotedAt = &promotedAt
+				promoted = append(promoted, stack.ClusterId)
+			} else {
+				held[stack.ClusterId] = count
+			}
+		}
+
+		if err := u.repo.SaveStacks(ctx, rollout.Stacks); err != nil {
+			log.Errorf(ctx, "failed to save rollout stacks of %s: %v", rollout.ID, err)
+			continue
+		}
+
+		completed := true
+		for _, stack := range rollout.Stacks {
+			if stack.EnforcementAction != policyRolloutTargetAction && slices.Contains(policy.TargetClusterIds, stack.ClusterId.String()) {
+				completed = false
+				break
+			}
+		}
+
+		// 진행 중에는 승격된 스택만 클러스터별 적용 액션으로 deny 를 적용하고, 완료되면 정책의 적용 액션을 deny 로 변경한다.
+		if completed {
+			err = u.applyStages(ctx, rollout.OrganizationId, rollout.PolicyId, policyRolloutTargetAction, nil)
+		} else {
+			err = u.applyStages(ctx, rollout.OrganizationId, rollout.PolicyId, rollout.InitialEnforcementAction, rollout.Stacks)
+		}
+		if err != nil {
+			log.Errorf(ctx, "failed to apply rollout stages of %s: %v", rollout.ID, err)
+			continue
+		}
+
+		for _, clusterId := range promoted {
+			u.recordTransition(ctx, policy, clusterId, "warning",
+				fmt.Sprintf("정책 [%s] deny 승격", policy.PolicyName),
+				fmt.Sprintf("스택 [%s]에서 %d시간 동안 정책 [%s] 위반이 %d건 이하로 유지되어 deny 로 승격하였습니다.",
+					clusterId, rollout.ObservationHours, policy.PolicyName, rollout.ViolationThreshold))
+		}
+		for clusterId, count := range held {
+			u.recordTransition(ctx, policy, clusterId, "warning",
+				fmt.Sprintf("정책 [%s] deny 승격 보류", policy.PolicyName),
+				fmt.Sprintf("스택 [%s]의 정책 [%s] 위반이 %d건으로 임계치 %d건을 초과하여 승격을 보류하고 관찰 기간을 다시 시작합니다.",
+					clusterId, policy.PolicyName, count, rollout.ViolationThreshold))
+		}
+
+		if completed {
+			if err := u.repo.UpdateStatus(ctx, rollout.ID, domain.PolicyRolloutStatusCompleted); err != nil {
+				log.Errorf(ctx, "failed to complete rollout %s: %v", rollout.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (u *PolicyRolloutUsecase) WatchRollouts(ctx context.Context, interval time.Duration) {
+	watchExclusively(ctx, u.jobLeaseRepo, "policy-rollout", interval, u.EvaluateRollouts)
+}
+
+// violationCount returns the maximum number of violations of the constraint on the cluster during the period.
+// found 는 기간 중 위반 수 메트릭이 수집되었는지 여부이다.
+// 스택 전용 CR 로 적용된 스택은 constraint 이름이 다르므로 두 이름을 모두 조회한다.
+func (u *PolicyRolloutUsecase) violationCount(ctx context.Context, organizationId string, clusterId domain.ClusterId, policyResourceName string, period time.Duration) (count int, found bool, err error) {
+	thanosClient, err := u.dashboardUsecase.GetThanosClient(ctx, organizationId)
+	if err != nil {
+		return 0, false, err
+	}
+
+	query := fmt.Sprintf("max_over_time(sum(opa_scorecard_constraint_violations{taco_cluster='%s', name=~'%s|%s'})[%dh:])",
+		clusterId, policyResourceName, policytemplate.StackTksPolicyName(policyResourceName, clusterId.String()), int(period.Hours()))
+	result, err := thanosClient.Get(ctx, query)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if len(result.Data.Result) == 0 || len(result.Data.Result[0].Value) < 2 {
+		return 0, false, nil
+	}
+
+	value, err := strconv.ParseFloat(result.Data.Result[0].Value[1].(string), 64)
+	if err != nil {
+		return 0, false, err
+	}
+	return int(value), true, nil
+}
+
+// applyStages 는 정책의 적용 액션을 enforcementAction 으로 변경하고, 이와 단계가 다른 스택만 클러스터별 적용 액션으로 CR 에 반영한다.
+func (u *PolicyRolloutUsecase) applyStages(ctx context.Context, organizationId string, policyId uuid.UUID, enforcementAction string, stacks []model.PolicyRolloutStack) error {
+	clusterEnforcementActions := map[string]string{}
+	for _, stack := range stacks {
+		if stack.EnforcementAction != enforcementAction {
+			clusterEnforcementActions[stack.ClusterId.String()] = stack.EnforcementAction
+		}
+	}
+
+	var actions interface{}
+	if len(clusterEnforcementActions) > 0 {
+		jsonBytes, err := json.Marshal(clusterEnforcementActions)
+		if err != nil {
+			return httpErrors.NewInternalServerError(err, "", "")
+		}
+		actions = string(jsonBytes)
+	}
+
+	updateMap := map[string]interface{}{
+		"enforcement_action":          enforcementAction,
+		"cluster_enforcement_actions": actions,
+	}
+	if err := u.policyRepo.Update(ctx, organizationId, policyId, updateMap, nil); err != nil {
+		return httpErrors.NewInternalServerError(err, "", "")
+	}
+
+	organization, err := u.organizationRepo.Get(ctx, organizationId)
+	if err != nil {
+		return httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+	}
+
+	policy, err := u.policyRepo.GetByID(ctx, organizationId, policyId)
+	if err != nil {
+		return httpErrors.NewBadRequestError(fmt.Errorf("invalid policyId"), "C_INVALID_POLICY_ID", "")
+	}
+
+	policyCRs := policytemplate.PolicyToTksPolicyCRs(policy)
+	if err = policytemplate.ApplyTksPolicyCRs(ctx, organization.PrimaryClusterId, policyCRs); err != nil {
+		log.Errorf(ctx, "failed to apply TksPolicyCR: %v", err)
+		return httpErrors.NewInternalServerError(err, "P_FAILED_TO_APPLY_KUBERNETES", "")
+	}
+	return nil
+}
+
+// recordTransition 은 단계 전환을 시스템 알림과 감사 로그로 남긴다.
+// 요청자가 없는 자동 전환은 system 사용자로 기록한다.
+func (u *PolicyRolloutUsecase) recordTransition(ctx context.Context, policy *model.Policy, clusterId domain.ClusterId, severity string, title string, content string) {
+	notification := model.SystemNotification{
+		OrganizationId:   policy.OrganizationId,
+		Name:             "policy-rollout",
+		NotificationType: "POLICY_NOTIFICATION",
+		Severity:         severity,
+		ClusterId:        clusterId,
+		MessageTitle:     title,
+		MessageContent:   content,
+		Summary:          title,
+		PolicyName:       policy.PolicyName,
+	}
+	if _, err := u.systemNotificationRepo.Create(ctx, notification); err != nil {
+		log.Error(ctx, "failed to create system notification: ", err)
+	}
+
+	audit := model.Audit{
+		OrganizationId: policy.OrganizationId,
+		Group:          "Policy",
+		Message:        title,
+		Description:    content,
+		UserName:       "system",
+	}
+	if organization, err := u.organizationRepo.Get(ctx, policy.OrganizationId); err == nil {
+		audit.OrganizationName = organization.Name
+	}
+	if user, ok := request.UserFrom(ctx); ok {
+		if requester, err := u.userRepo.GetByUuid(ctx, user.GetUserId()); err == nil {
+			audit.UserId = &requester.ID
+			audit.UserAccountId = requester.AccountId
+			audit.UserName = requester.Name
+		}
+	}
+	if _, err := u.auditRepo.Create(ctx, audit); err != nil {
+		log.Error(ctx, "failed to create audit: ", err)
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/Masterminds/semver/v3"
+
 	"github.com/openinfradev/tks-api/pkg/log"
 	"k8s.io/apimachinery/pkg/api/errors"
 
@@ -16,6 +18,7 @@ import (
 	"github.com/openinfradev/tks-api/internal/repository"
 	"github.com/openinfradev/tks-api/pkg/domain"
 	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/spf13/viper"
 )
 
 type IPolicyTemplateUsecase interface {
@@ -48,21 +51,26 @@ type IPolicyTemplateUsecase interface {
 	AddPermittedPolicyTemplatesForOrganization(ctx context.Context, organizationId string, policyTemplateIds []uuid.UUID) (err error)
 	UpdatePermittedPolicyTemplatesForOrganization(ctx context.Context, organizationId string, policyTemplateIds []uuid.UUID) (err error)
 	DeletePermittedPolicyTemplatesForOrganization(ctx context.Context, organizationId string, policyTemplateIds []uuid.UUID) (err error)
+
+	ImportConstraintTemplate(ctx context.Context, organizationId *string, dto domain.ImportPolicyTemplateRequest) (policyTemplateId uuid.UUID, version string, err error)
+	ExportConstraintTemplate(ctx context.Context, organizationId *string, policyTemplateId uuid.UUID, version string) (constraintTemplate string, err error)
 }
 
 type PolicyTemplateUsecase struct {
-	organizationRepo repository.IOrganizationRepository
-	clusterRepo      repository.IClusterRepository
-	policyRepo       repository.IPolicyRepository
-	repo             repository.IPolicyTemplateRepository
+	organizationRepo  repository.IOrganizationRepository
+	clusterRepo       repository.IClusterRepository
+	policyRepo        repository.IPolicyRepository
+	repo              repository.IPolicyTemplateRepository
+	stackTemplateRepo repository.IStackPolicyTemplateRepository
 }
 
 func NewPolicyTemplateUsecase(r repository.Repository) IPolicyTemplateUsecase {
 	return &PolicyTemplateUsecase{
-		repo:             r.PolicyTemplate,
-		policyRepo:       r.Policy,
-		organizationRepo: r.Organization,
-		clusterRepo:      r.Cluster,
+		repo:              r.PolicyTemplate,
+		policyRepo:        r.Policy,
+		organizationRepo:  r.Organization,
+		clusterRepo:       r.Cluster,
+		stackTemplateRepo: r.StackPolicyTemplate,
 	}
 }
 
@@ -507,6 +515,19 @@ func (u *PolicyTemplateUsecase) DeletePolicyTemplateVersion(ctx context.Context,
 			"PT_NOT_PERMITTED_ON_TKS_POLICY_TEMPLATE", "")
 	}
 
+	// 스택에 적용된 버전은 삭제할 수 없음
+	stackVersions, err := u.stackTemplateRepo.ListVersions(ctx, policyTemplateId)
+	if err != nil {
+		return err
+	}
+	for _, stackVersion := range stackVersions {
+		if stackVersion.Version == version {
+			return httpErrors.NewBadRequestError(fmt.Errorf(
+				"policy template version is used by stack %s", stackVersion.ClusterId),
+				"PT_POLICY_TEMPLATE_VERSION_IN_USE", "")
+		}
+	}
+
 	return u.repo.DeletePolicyTemplateVersion(ctx, policyTemplateId, version)
 }
 
@@ -812,3 +833,107 @@ func (u *PolicyTemplateUsecase) UpdatePermittedPolicyTemplatesForOrganization(ct
 func (u *PolicyTemplateUsecase) DeletePermittedPolicyTemplatesForOrganization(ctx context.Context, organizationId string, policyTemplateIds []uuid.UUID) (err error) {
 	return u.organizationRepo.DeletePermittedPolicyTemplatesByID(ctx, organizationId, policyTemplateIds)
 }
+
+// ImportConstraintTemplate 은 Gatekeeper ConstraintTemplate 을 가져와 새 정책 템플릿을 생성하거나,
+// policyTemplateId 가 지정된 경우 해당 템플릿의 새 버전으로 추가한다.
+func (u *PolicyTemplateUsecase) ImportConstraintTemplate(ctx context.Context, organizationId *string, dto domain.ImportPolicyTemplateRequest) (policyTemplateId uuid.UUID, version string, err error) {
+	data := []byte(dto.ConstraintTemplate)
+	if len(strings.TrimSpace(dto.ConstraintTemplate)) == 0 {
+		data, err = policytemplate.FetchConstraintTemplate(ctx, dto.SourceUrl,
+			strings.Split(viper.GetString("constraint-template-allowed-hosts"), ","))
+		if err != nil {
+			return uuid.Nil, "", httpErrors.NewBadRequestError(err, "PT_FAILED_TO_FETCH_CONSTRAINT_TEMPLATE", "")
+		}
+	}
+
+	constraintTemplate, err := policytemplate.ParseConstraintTemplate(data)
+	if err != nil {
+		return uuid.Nil, "", httpErrors.NewBadRequestError(err, "PT_INVALID_CONSTRAINT_TEMPLATE", "")
+	}
+
+	imported := constraintTemplate.ToPolicyTemplate()
+
+	if dto.PolicyTemplateId == "" {
+		if dto.TemplateName != "" {
+			imported.TemplateName = dto.TemplateName
+		}
+		if dto.Description != "" {
+			imported.Description = dto.Description
+		}
+		imported.Severity = dto.Severity
+		if imported.Severity == "" {
+			imported.Severity = "medium"
+		}
+
+		if organizationId == nil {
+			imported.Type = "tks"
+			imported.PermittedOrganizationIds = dto.PermittedOrganizationIds
+		} else {
+			imported.Type = "organization"
+			imported.OrganizationId = organizationId
+		}
+
+		policyTemplateId, err = u.Create(ctx, imported)
+		if err != nil {
+			return uuid.Nil, "", err
+		}
+		return policyTemplateId, "v1.0.0", nil
+	}
+
+	policyTemplateId, err = uuid.Parse(dto.PolicyTemplateId)
+	if err != nil {
+		return uuid.Nil, "", httpErrors.NewBadRequestError(err, "C_INVALID_POLICY_TEMPLATE_ID", "")
+	}
+
+	policyTemplate, err := u.repo.GetByID(ctx, policyTemplateId)
+	if err != nil || policyTemplate == nil || !policyTemplate.IsPermittedToOrganization(organizationId) {
+		return uuid.Nil, "", httpErrors.NewNotFoundError(fmt.Errorf("policy template not found"), "PT_NOT_FOUND_POLICY_TEMPLATE", "")
+	}
+
+	if policyTemplate.Kind != imported.Kind {
+		return uuid.Nil, "", httpErrors.NewBadRequestError(fmt.Errorf("kind mismatch '%s' != '%s'", imported.Kind, policyTemplate.Kind),
+			"PT_CONSTRAINT_TEMPLATE_KIND_MISMATCH", "")
+	}
+
+	latestVersion, err := u.repo.GetLatestTemplateVersion(ctx, policyTemplateId)
+	if err != nil {
+		return uuid.Nil, "", err
+	}
+
+	currentVer, err := semver.NewVersion(latestVersion)
+	if err != nil {
+		return uuid.Nil, "", httpErrors.NewBadRequestError(err, "PT_INVALID_POLICY_TEMPLATE_VERSION", "")
+	}
+
+	var newVer semver.Version
+	switch strings.ToLower(dto.VersionUpType) {
+	case "major":
+		newVer = currentVer.IncMajor()
+	case "patch":
+		newVer = currentVer.IncPatch()
+	default:
+		newVer = currentVer.IncMinor()
+	}
+
+	version, err = u.CreatePolicyTemplateVersion(ctx, organizationId, policyTemplateId, newVer.Original(), imported.ParametersSchema,
+		imported.Rego, imported.Libs, nil, imported.SyncJson)
+	if err != nil {
+		return uuid.Nil, "", err
+	}
+
+	return policyTemplateId, version, nil
+}
+
+// ExportConstraintTemplate 은 정책 템플릿의 특정 버전을 Gatekeeper ConstraintTemplate YAML 로 변환한다.
+func (u *PolicyTemplateUsecase) ExportConstraintTemplate(ctx context.Context, organizationId *string, policyTemplateId uuid.UUID, version string) (constraintTemplate string, err error) {
+	policyTemplate, err := u.GetPolicyTemplateVersion(ctx, organizationId, policyTemplateId, version)
+	if err != nil {
+		return "", err
+	}
+
+	if policyTemplate == nil {
+		return "", httpErrors.NewNotFoundError(fmt.Errorf("policy template version not found"), "PT_NOT_FOUND_POLICY_TEMPLATE_VERSION", "")
+	}
+
+	return policytemplate.PolicyTemplateToConstraintTemplate(policyTemplate).YAML()
+}
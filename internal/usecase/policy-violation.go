@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	policytemplate "github.com/openinfradev/tks-api/internal/policy-template"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type IPolicyViolationUsecase interface {
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.PolicyViolation, error)
+	Get(ctx context.Context, organizationId string, violationId uuid.UUID) (model.PolicyViolation, error)
+	UpdateStatus(ctx context.Context, organizationId string, violationId uuid.UUID, status domain.PolicyViolationStatus) error
+	SyncAuditViolations(ctx context.Context) error
+	WatchAuditViolations(ctx context.Context, interval time.Duration)
+}
+
+type PolicyViolationUsecase struct {
+	repo             repository.IPolicyViolationRepository
+	policyRepo       repository.IPolicyRepository
+	organizationRepo repository.IOrganizationRepository
+	jobLeaseRepo     repository.IJobLeaseRepository
+}
+
+func NewPolicyViolationUsecase(r repository.Repository) IPolicyViolationUsecase {
+	return &PolicyViolationUsecase{
+		repo:             r.PolicyViolation,
+		policyRepo:       r.Policy,
+		organizationRepo: r.Organization,
+		jobLeaseRepo:     r.JobLease,
+	}
+}
+
+func (u *PolicyViolationUsecase) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.PolicyViolation, error) {
+	violations, err := u.repo.Fetch(ctx, organizationId, pg)
+	if err != nil {
+		return nil, httpErrors.NewInternalServerError(err, "", "")
+	}
+	return violations, nil
+}
+
+func (u *PolicyViolationUsecase) Get(ctx context.Context, organizationId string, violationId uuid.UUID) (model.PolicyViolation, error) {
+	violation, err := u.repo.Get(ctx, organizationId, violationId)
+	if err != nil {
+		return model.PolicyViolation{}, httpErrors.NewNotFoundError(err, "PV_NOT_FOUND_POLICY_VIOLATION", "")
+	}
+	return violation, nil
+}
+
+func (u *PolicyViolationUsecase) UpdateStatus(ctx context.Context, organizationId string, violationId uuid.UUID, status domain.PolicyViolationStatus) error {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return httpErrors.NewUnauthorizedError(fmt.Errorf("invalid token"), "A_INVALID_TOKEN", "")
+	}
+
+	if _, err := u.repo.Get(ctx, organizationId, violationId); err != nil {
+		return httpErrors.NewNotFoundError(err, "PV_NOT_FOUND_POLICY_VIOLATION", "")
+	}
+
+	if err := u.repo.UpdateStatus(ctx, violationId, status, user.GetUserId()); err != nil {
+		return httpErrors.NewInternalServerError(err, "", "")
+	}
+	return nil
+}
+
+// SyncAuditViolations 는 각 조직의 TKSPolicy CR 에 기록된 audit 결과를 위반 기록에 반영한다.
+// audit 결과에 더 이상 나타나지 않는 위반은 해결(fixed) 상태로 변경한다.
+func (u *PolicyViolationUsecase) SyncAuditViolations(ctx context.Context) error {
+	organizations, err := u.organizationRepo.Fetch(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, organization := range *organizations {
+		if organization.PrimaryClusterId == "" {
+			continue
+		}
+
+		tksPolicies, err := policytemplate.GetTksPolicyCRs(ctx, organization.PrimaryClusterId)
+		if err != nil {
+			log.Errorf(ctx, "failed to get tkspolicies of organization %s: %v", organization.ID, err)
+			continue
+		}
+
+		for _, tksPolicy := range tksPolicies {
+			u.syncTksPolicy(ctx, organization.ID, tksPolicy)
+		}
+	}
+
+	return nil
+}
+
+func (u *PolicyViolationUsecase) syncTksPolicy(ctx context.Context, organizationId string, tksPolicy policytemplate.TKSPolicy) {
+	policy, err := u.policyRepo.GetByResourceName(ctx, organizationId, tksPolicy.GetPolicyResourceName())
+	if err != nil {
+		policy = nil
+	}
+
+	for clusterId, status := range tksPolicy.Status.Clusters {
+		syncedAt := time.Now()
+
+		for _, violation := range status.Violations {
+			dto := newPolicyViolation(policy, model.PolicyViolation{
+				OrganizationId:     organizationId,
+				ClusterId:          domain.ClusterId(clusterId),
+				PolicyName:         tksPolicy.GetPolicyResourceName(),
+				PolicyTemplateKind: tksPolicy.Spec.Template,
+				Namespace:          violation.Namespace,
+				Kind:               violation.Kind,
+				Name:               violation.Name,
+				Message:            violation.Message,
+				EnforcementAction:  violation.EnforcementAction,
+				LastSeenAt:         syncedAt,
+			})
+			if err := u.repo.Upsert(ctx, dto); err != nil {
+				log.Error(ctx, err)
+			}
+		}
+
+		// audit 결과가 최대 개수를 넘어 잘린 경우에는 누락된 위반을 해결된 것으로 판단할 수 없다.
+		if status.TotalViolations > len(status.Violations) {
+			continue
+		}
+		if err := u.repo.MarkFixed(ctx, organizationId, domain.ClusterId(clusterId), tksPolicy.GetPolicyResourceName(), syncedAt); err != nil {
+			log.Error(ctx, err)
+		}
+	}
+}
+
+func (u *PolicyViolationUsecase) WatchAuditViolations(ctx context.Context, interval time.Duration) {
+	watchExclusively(ctx, u.jobLeaseRepo, "policy-violation-audit-sync", interval, u.SyncAuditViolations)
+}
+
+// newPolicyViolation 은 위반 기록에 정책 식별자와 상태를 채운다.
+// 유효한 정책 예외에 해당하는 자원은 excepted 상태로 기록한다.
+func newPolicyViolation(policy *model.Policy, dto model.PolicyViolation) model.PolicyViolation {
+	dto.Status = domain.PolicyViolationStatusOpen
+	if policy == nil {
+		return dto
+	}
+
+	dto.PolicyId = &policy.ID
+	for _, exception := range policy.Exceptions {
+		if exception.IsActive(dto.LastSeenAt) && exception.Covers(dto.ClusterId, dto.Namespace) {
+			dto.Status = domain.PolicyViolationStatusExcepted
+			dto.ExceptedByException = true
+			break
+		}
+	}
+	return dto
+}
@@ -39,28 +39,36 @@ type IPolicyUsecase interface {
 	ListStackPolicyStatus(ctx context.Context, clusterId string, pg *pagination.Pagination) (policyStatuses []domain.StackPolicyStatusResponse, err error)
 	GetStackPolicyTemplateStatus(ctx context.Context, clusterId string, policyTemplateId uuid.UUID) (clusterPolicyTemplateStatusResponse *domain.GetStackPolicyTemplateStatusResponse, err error)
 	UpdateStackPolicyTemplateStatus(ctx context.Context, clusterId string, policyTemplateId uuid.UUID,
-		currentVersion string, targetVerson string) (err error)
+		currentVersion string, targetVerson string, pinned *bool) (err error)
+	ListStackPolicyTemplateHistories(ctx context.Context, clusterId string, policyTemplateId uuid.UUID) ([]model.StackPolicyTemplateHistory, error)
 	GetPolicyStatistics(ctx context.Context, organizationId string) (response *domain.PolicyStatisticsResponse, err error)
 	AddPoliciesForClusterID(ctx context.Context, organizationId string, clusterId domain.ClusterId, policyIds []uuid.UUID) (err error)
 	UpdatePoliciesForClusterID(ctx context.Context, organizationId string, clusterId domain.ClusterId, policyIds []uuid.UUID) (err error)
 	DeletePoliciesForClusterID(ctx context.Context, organizationId string, clusterId domain.ClusterId, policyIds []uuid.UUID) (err error)
+	DeletePoliciesForDeletedCluster(ctx context.Context, organizationId string, clusterId domain.ClusterId, policyIds []uuid.UUID) (err error)
 	GetStackPolicyStatistics(ctx context.Context, organizationId string, clusterId domain.ClusterId) (statistics *domain.StackPolicyStatistics, err error)
 	GetPolicyIDsByClusterID(ctx context.Context, clusterId domain.ClusterId) (out *[]uuid.UUID, err error)
 }
 
 type PolicyUsecase struct {
-	organizationRepo repository.IOrganizationRepository
-	clusterRepo      repository.IClusterRepository
-	templateRepo     repository.IPolicyTemplateRepository
-	repo             repository.IPolicyRepository
+	organizationRepo  repository.IOrganizationRepository
+	clusterRepo       repository.IClusterRepository
+	templateRepo      repository.IPolicyTemplateRepository
+	repo              repository.IPolicyRepository
+	stackTemplateRepo repository.IStackPolicyTemplateRepository
+	rolloutRepo       repository.IPolicyRolloutRepository
+	mutationRepo      repository.IMutationPolicyRepository
 }
 
 func NewPolicyUsecase(r repository.Repository) IPolicyUsecase {
 	return &PolicyUsecase{
-		repo:             r.Policy,
-		templateRepo:     r.PolicyTemplate,
-		organizationRepo: r.Organization,
-		clusterRepo:      r.Cluster,
+		repo:              r.Policy,
+		templateRepo:      r.PolicyTemplate,
+		organizationRepo:  r.Organization,
+		clusterRepo:       r.Cluster,
+		stackTemplateRepo: r.StackPolicyTemplate,
+		rolloutRepo:       r.PolicyRollout,
+		mutationRepo:      r.MutationPolicy,
 	}
 }
 
@@ -77,6 +85,9 @@ func (u *PolicyUsecase) Create(ctx context.Context, organizationId string, dto m
 	if !ok {
 		return uuid.Nil, httpErrors.NewUnauthorizedError(fmt.Errorf("invalid token"), "A_INVALID_TOKEN", "")
 	}
+	if err := checkPolicyApproval(ctx, u.organizationRepo, organizationId); err != nil {
+		return uuid.Nil, err
+	}
 
 	exists, err := u.repo.ExistByName(ctx, dto.OrganizationId, dto.PolicyName)
 	if err != nil {
@@ -234,6 +245,10 @@ func (u *PolicyUsecase) Update(ctx context.Context, organizationId string, polic
 		return httpErrors.NewBadRequestError(fmt.Errorf("invalid token"), "A_INVALID_TOKEN", "")
 	}
 
+	if err := checkPolicyApproval(ctx, u.organizationRepo, organizationId); err != nil {
+		return err
+	}
+
 	policy, err := u.repo.GetByID(ctx, organizationId, policyId)
 	if err != nil {
 		return httpErrors.NewNotFoundError(err, "P_FAILED_FETCH_POLICY", "")
@@ -286,6 +301,17 @@ func (u *PolicyUsecase) Update(ctx context.Context, organizationId string, polic
 	}
 
 	if enforcementAction != nil {
+		// 단계적 적용 중에는 스택별 적용 액션을 단계적 적용에서 관리한다.
+		if *enforcementAction != policy.EnforcementAction {
+			inProgress, err := u.rolloutRepo.ExistInProgress(ctx, policyId)
+			if err != nil {
+				return err
+			}
+			if inProgress {
+				return httpErrors.NewBadRequestError(fmt.Errorf("policy rollout is in progress"), "PR_POLICY_ROLLOUT_IN_PROGRESS", "")
+			}
+		}
+
 		updateMap["enforcement_action"] = enforcementAction
 	}
 
@@ -348,9 +374,9 @@ func (u *PolicyUsecase) Update(ctx context.Context, organizationId string, polic
 			return httpErrors.NewBadRequestError(fmt.Errorf("invalid policyId"), "C_INVALID_POLICY_ID", "")
 		}
 
-		policyCR := policytemplate.PolicyToTksPolicyCR(policy)
+		policyCRs := policytemplate.PolicyToTksPolicyCRs(policy)
 
-		err = policytemplate.ApplyTksPolicyCR(ctx, organization.PrimaryClusterId, policyCR)
+		err = policytemplate.ApplyTksPolicyCRs(ctx, organization.PrimaryClusterId, policyCRs)
 
 		if err != nil {
 			log.Errorf(ctx, "failed to apply TksPolicyCR: %v", err)
@@ -364,6 +390,10 @@ func (u *PolicyUsecase) Update(ctx context.Context, organizationId string, polic
 }
 
 func (u *PolicyUsecase) Delete(ctx context.Context, organizationId string, policyId uuid.UUID) (err error) {
+	if err := checkPolicyApproval(ctx, u.organizationRepo, organizationId); err != nil {
+		return err
+	}
+
 	policy, err := u.repo.GetByID(ctx, organizationId, policyId)
 	if err != nil {
 		return err
@@ -382,7 +412,7 @@ func (u *PolicyUsecase) Delete(ctx context.Context, organizationId string, polic
 	}
 
 	if exists {
-		err = policytemplate.DeleteTksPolicyCR(ctx, organization.PrimaryClusterId, policy.PolicyResourceName)
+		err = policytemplate.DeleteTksPolicyCRs(ctx, organization.PrimaryClusterId, policy.PolicyResourceName, policy.ID.String())
 
 		if err != nil {
 			log.Errorf(ctx, "failed to delete TksPolicyCR: %v", err)
@@ -448,6 +478,10 @@ func (u *PolicyUsecase) IsPolicyIdExist(ctx context.Context, organizationId stri
 }
 
 func (u *PolicyUsecase) UpdatePolicyTargetClusters(ctx context.Context, organizationId string, policyId uuid.UUID, currentClusterIds []string, targetClusterIds []string) (err error) {
+	if err := checkPolicyApproval(ctx, u.organizationRepo, organizationId); err != nil {
+		return err
+	}
+
 	targetClusters := make([]model.Cluster, len(targetClusterIds))
 
 	for i, clusterId := range targetClusterIds {
@@ -477,9 +511,9 @@ func (u *PolicyUsecase) UpdatePolicyTargetClusters(ctx context.Context, organiza
 		return httpErrors.NewBadRequestError(fmt.Errorf("invalid policyId"), "C_INVALID_POLICY_ID", "")
 	}
 
-	policyCR := policytemplate.PolicyToTksPolicyCR(policy)
+	policyCRs := policytemplate.PolicyToTksPolicyCRs(policy)
 
-	err = policytemplate.ApplyTksPolicyCR(ctx, organization.PrimaryClusterId, policyCR)
+	err = policytemplate.ApplyTksPolicyCRs(ctx, organization.PrimaryClusterId, policyCRs)
 
 	if err != nil {
 		log.Errorf(ctx, "failed to apply TksPolicyCR: %v", err)
@@ -490,6 +524,10 @@ func (u *PolicyUsecase) UpdatePolicyTargetClusters(ctx context.Context, organiza
 }
 
 func (u *PolicyUsecase) SetMandatoryPolicies(ctx context.Context, organizationId string, mandatoryPolicyIds []uuid.UUID, nonMandatoryPolicyIds []uuid.UUID) (err error) {
+	if err := checkPolicyApproval(ctx, u.organizationRepo, organizationId); err != nil {
+		return err
+	}
+
 	return u.repo.SetMandatoryPolicies(ctx, organizationId, mandatoryPolicyIds, nonMandatoryPolicyIds)
 }
 
@@ -587,7 +625,8 @@ func (u *PolicyUsecase) ListStackPolicyStatus(ctx context.Context, clusterId str
 			return nil, err
 		}
 
-		result[i].TemplateCurrentVersion = tksPolicyTemplateCR.Spec.Version
+		result[i].TemplateCurrentVersion, result[i].TemplatePinned =
+			u.stackTemplateVersion(ctx, clusterId, policy.TemplateId, tksPolicyTemplateCR.Spec.Version)
 
 		// version, ok := tksClusterCR.Status.Templates[policy.PolicyTemplate.Kind]
 
@@ -603,32 +642,107 @@ func (u *PolicyUsecase) ListStackPolicyStatus(ctx context.Context, clusterId str
 }
 
 func (u *PolicyUsecase) UpdateStackPolicyTemplateStatus(ctx context.Context, clusterId string, policyTemplateId uuid.UUID,
-	currentVersion string, targetVerson string) (err error) {
-	if currentVersion == targetVerson {
+	currentVersion string, targetVerson string, pinned *bool) (err error) {
+	stackVersion, err := u.stackTemplateRepo.GetVersion(ctx, domain.ClusterId(clusterId), policyTemplateId)
+	if err != nil {
+		return err
+	}
+
+	if stackVersion != nil && stackVersion.Pinned && pinned == nil && targetVerson != stackVersion.Version {
+		return httpErrors.NewBadRequestError(fmt.Errorf("template version is pinned to %s", stackVersion.Version), "P_PINNED_TEMPLATE_VERSION", "")
+	}
+
+	if currentVersion == targetVerson && pinned == nil {
 		// 버전 동일, 할일 없음
 		return nil
 	}
 
-	latestTemplate, err := u.templateRepo.GetByID(ctx, policyTemplateId)
-	if err != nil {
-		return err
+	pin := stackVersion != nil && stackVersion.Pinned
+	if pinned != nil {
+		pin = *pinned
 	}
 
-	if targetVerson != latestTemplate.Version {
-		return fmt.Errorf("targetVersion is not a latest version")
+	return u.changeStackPolicyTemplateVersion(ctx, clusterId, policyTemplateId, currentVersion, targetVerson, pin, StackPolicyTemplateActionUpdate)
+}
+
+func (u *PolicyUsecase) ListStackPolicyTemplateHistories(ctx context.Context, clusterId string, policyTemplateId uuid.UUID) ([]model.StackPolicyTemplateHistory, error) {
+	return u.stackTemplateRepo.ListHistories(ctx, domain.ClusterId(clusterId), policyTemplateId)
+}
+
+func (u *PolicyUsecase) changeStackPolicyTemplateVersion(ctx context.Context, clusterId string, policyTemplateId uuid.UUID,
+	currentVersion string, targetVersion string, pinned bool, action string) (err error) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return httpErrors.NewUnauthorizedError(fmt.Errorf("invalid token"), "A_INVALID_TOKEN", "")
 	}
 
-	currentTemplate, err := u.templateRepo.GetPolicyTemplateVersion(ctx, policyTemplateId, currentVersion)
+	cluster, err := u.clusterRepo.Get(ctx, domain.ClusterId(clusterId))
 	if err != nil {
+		return httpErrors.NewBadRequestError(fmt.Errorf("invalid clusterId"), "C_INVALID_CLUSTER_ID", "")
+	}
+	if err := checkPolicyApproval(ctx, u.organizationRepo, cluster.OrganizationId); err != nil {
 		return err
 	}
 
-	// 파라미터 호환성 검증, 파라미터 스키마가 동일하거나 추가된 필드만 있어야 하고 기존 필드는 이름, 타입이 유지되어야 함
-	_, err = extractNewTemplateParameter(currentTemplate.ParametersSchema, latestTemplate.ParametersSchema)
-	if err != nil {
-		return err
+	if currentVersion != targetVersion {
+		currentTemplate, err := u.templateRepo.GetPolicyTemplateVersion(ctx, policyTemplateId, currentVersion)
+		if err != nil {
+			return err
+		}
+
+		targetTemplate, err := u.templateRepo.GetPolicyTemplateVersion(ctx, policyTemplateId, targetVersion)
+		if err != nil {
+			return err
+		}
+
+		if currentTemplate == nil || targetTemplate == nil {
+			return httpErrors.NewBadRequestError(fmt.Errorf("version is not a supported version"), "P_INVALID_TEMPLATE_VERSION", "")
+		}
+
+		// policy operator 는 스택마다 다른 버전의 TKSPolicyTemplate 을 둘 수 없고 최신 버전으로의 업데이트만 지원한다.
+		latestVersion, err := u.templateRepo.GetLatestTemplateVersion(ctx, policyTemplateId)
+		if err != nil {
+			return err
+		}
+		if targetVersion != latestVersion {
+			return httpErrors.NewBadRequestError(fmt.Errorf("targetVersion is not a latest version"), "P_INVALID_TEMPLATE_VERSION", "")
+		}
+
+		// 파라미터 호환성 검증, 파라미터 스키마가 동일하거나 추가된 필드만 있어야 하고 기존 필드는 이름, 타입이 유지되어야 함
+		if _, err = extractNewTemplateParameter(currentTemplate.ParametersSchema, targetTemplate.ParametersSchema); err != nil {
+			return httpErrors.NewBadRequestError(err, "P_INCOMPATIBLE_TEMPLATE_VERSION", "")
+		}
+
+		if err = u.applyStackPolicyTemplateVersion(ctx, clusterId, targetTemplate); err != nil {
+			return err
+		}
 	}
 
+	userId := user.GetUserId()
+	stackVersion := model.StackPolicyTemplateVersion{
+		ClusterId:        domain.ClusterId(clusterId),
+		PolicyTemplateId: policyTemplateId,
+		Version:          targetVersion,
+		Pinned:           pinned,
+		UpdatorId:        &userId,
+	}
+	history := model.StackPolicyTemplateHistory{
+		ClusterId:        domain.ClusterId(clusterId),
+		PolicyTemplateId: policyTemplateId,
+		FromVersion:      currentVersion,
+		ToVersion:        targetVersion,
+		Action:           action,
+		Pinned:           pinned,
+		CreatorId:        &userId,
+	}
+
+	return u.stackTemplateRepo.SaveVersion(ctx, stackVersion, history)
+}
+
+// applyStackPolicyTemplateVersion 은 스택에 대상 버전의 템플릿이 적용되도록 한다.
+// policy operator 는 TKSPolicyTemplate CR 의 버전을 ToLatest 에 포함된 스택에만 적용하고 나머지 스택은 현재 버전을 유지하므로,
+// CR 을 최신 버전으로 갱신하고 해당 스택을 ToLatest 에 추가한다.
+func (u *PolicyUsecase) applyStackPolicyTemplateVersion(ctx context.Context, clusterId string, targetTemplate *model.PolicyTemplate) error {
 	cluster, err := u.clusterRepo.Get(ctx, domain.ClusterId(clusterId))
 	if err != nil {
 		return err
@@ -636,28 +750,38 @@ func (u *PolicyUsecase) UpdateStackPolicyTemplateStatus(ctx context.Context, clu
 
 	primaryClusterId := cluster.Organization.PrimaryClusterId
 
-	resourceName := strings.ToLower(latestTemplate.Kind)
-
-	tksPolicyTemplate, err := policytemplate.GetTksPolicyTemplateCR(ctx, primaryClusterId, resourceName)
-
+	tksPolicyTemplate, err := policytemplate.GetTksPolicyTemplateCR(ctx, primaryClusterId, targetTemplate.ResoureName())
 	if err != nil {
 		return err
 	}
 
-	if tksPolicyTemplate.Spec.ToLatest == nil {
-		tksPolicyTemplate.Spec.ToLatest = []string{}
+	toLatest := tksPolicyTemplate.Spec.ToLatest
+	if toLatest == nil {
+		toLatest = []string{}
 	}
-
-	latestTemplateCR := policytemplate.PolicyTemplateToTksPolicyTemplateCR(latestTemplate)
-	tksPolicyTemplate.Spec = latestTemplateCR.Spec
-
-	if !slices.Contains(tksPolicyTemplate.Spec.ToLatest, clusterId) {
-		tksPolicyTemplate.Spec.ToLatest = append(tksPolicyTemplate.Spec.ToLatest, clusterId)
+	if !slices.Contains(toLatest, clusterId) {
+		toLatest = append(toLatest, clusterId)
 	}
 
+	targetTemplateCR := policytemplate.PolicyTemplateToTksPolicyTemplateCR(targetTemplate)
+	tksPolicyTemplate.Spec = targetTemplateCR.Spec
+	tksPolicyTemplate.Spec.ToLatest = toLatest
+
 	return policytemplate.UpdateTksPolicyTemplateCR(ctx, primaryClusterId, tksPolicyTemplate)
 }
 
+// stackTemplateVersion 은 스택에 지정된 템플릿 버전과 고정 여부를 반환한다. 지정된 버전이 없으면 CR 의 버전을 따른다.
+func (u *PolicyUsecase) stackTemplateVersion(ctx context.Context, clusterId string, policyTemplateId uuid.UUID, crVersion string) (version string, pinned bool) {
+	stackVersion, err := u.stackTemplateRepo.GetVersion(ctx, domain.ClusterId(clusterId), policyTemplateId)
+	if err != nil {
+		log.Error(ctx, err)
+	}
+	if stackVersion == nil {
+		return crVersion, false
+	}
+	return stackVersion.Version, stackVersion.Pinned
+}
+
 func (u *PolicyUsecase) GetStackPolicyTemplateStatus(ctx context.Context, clusterId string, policyTemplateId uuid.UUID) (stackPolicyTemplateStatusResponse *domain.GetStackPolicyTemplateStatusResponse, err error) {
 	policies, err := u.repo.FetchByClusterIdAndTemplaeId(ctx, clusterId, policyTemplateId)
 
@@ -708,6 +832,8 @@ func (u *PolicyUsecase) GetStackPolicyTemplateStatus(ctx context.Context, cluste
 		version = tksPolicyTemplateCR.Spec.Version
 	}
 
+	version, pinned := u.stackTemplateVersion(ctx, clusterId, policyTemplateId, version)
+
 	currentTemplate, err := u.templateRepo.GetPolicyTemplateVersion(ctx, policyTemplateId, version)
 	if err != nil {
 		return nil, err
@@ -739,6 +865,7 @@ func (u *PolicyUsecase) GetStackPolicyTemplateStatus(ctx context.Context, cluste
 		TemplateDescription:              currentTemplate.Description,
 		TemplateLatestVersion:            latestTemplate.Version,
 		TemplateCurrentVersion:           currentTemplate.Version,
+		TemplatePinned:                   pinned,
 		TemplateLatestVersionReleaseDate: latestTemplate.CreatedAt,
 		AffectedPolicies:                 affectedPolicies,
 		UpdatedPolicyParameters:          updatedPolicyParameters,
@@ -804,10 +931,25 @@ func (u *PolicyUsecase) GetPolicyStatistics(ctx context.Context, organizationId
 		Total:           policyTotal,
 	}
 
+	mutationStatistics, err := u.mutationRepo.CountByKind(ctx, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Mutation = domain.MutationPolicyCount{ByKind: make(map[string]int64, len(mutationStatistics))}
+	for _, stat := range mutationStatistics {
+		result.Mutation.ByKind[string(stat.Kind)] = stat.Count
+		result.Mutation.Total += stat.Count
+	}
+
 	return &result, nil
 }
 
 func (u *PolicyUsecase) AddPoliciesForClusterID(ctx context.Context, organizationId string, clusterId domain.ClusterId, policyIds []uuid.UUID) (err error) {
+	if err := checkPolicyApproval(ctx, u.organizationRepo, organizationId); err != nil {
+		return err
+	}
+
 	organization, err := u.organizationRepo.Get(ctx, organizationId)
 
 	primaryClusterId := organization.PrimaryClusterId
@@ -818,56 +960,16 @@ func (u *PolicyUsecase) AddPoliciesForClusterID(ctx context.Context, organizatio
 		return httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
 	}
 
-	tkpolicies, err := policytemplate.GetTksPolicyCRs(ctx, primaryClusterId)
-	if err != nil {
-		log.Errorf(ctx, "error is :%s(%T)", err.Error(), err)
-
-		return err
-	}
-
-	ids := make([]string, len(policyIds))
-	for i, policyId := range policyIds {
-		ids[i] = policyId.String()
-	}
-
-	// mapset.NewSet([]string{})
-
-	for _, tkspolicy := range tkpolicies {
-		policyId := tkspolicy.GetPolicyID()
-
-		// 추가 대상
-		if slices.Contains(ids, policyId) {
-			// 현재 클러스터가 안 추가되어 있으면
-			if !slices.Contains(tkspolicy.Spec.Clusters, string(clusterId)) {
-				tkspolicy.Spec.Clusters = append(tkspolicy.Spec.Clusters, string(clusterId))
-
-				err := policytemplate.ApplyTksPolicyCR(ctx, primaryClusterId, &tkspolicy)
-				if err != nil {
-					log.Errorf(ctx, "error is :%s(%T)", err.Error(), err)
-				}
-			}
-
-		}
-	}
-
-	policies := []model.Policy{}
-
-	for _, policyId := range policyIds {
-		policy, err := u.repo.GetByID(ctx, organizationId, policyId)
-
-		if err != nil {
-			log.Errorf(ctx, "error is :%s(%T)", err.Error(), err)
-
-			continue
-		}
-
-		policies = append(policies, *policy)
-	}
+	policies := u.addClusterToPolicyCRs(ctx, organizationId, primaryClusterId, clusterId, policyIds)
 
 	return u.repo.AddPoliciesForClusterID(ctx, organizationId, clusterId, policies)
 }
 
 func (u *PolicyUsecase) UpdatePoliciesForClusterID(ctx context.Context, organizationId string, clusterId domain.ClusterId, policyIds []uuid.UUID) (err error) {
+	if err := checkPolicyApproval(ctx, u.organizationRepo, organizationId); err != nil {
+		return err
+	}
+
 	organization, err := u.organizationRepo.Get(ctx, organizationId)
 
 	primaryClusterId := organization.PrimaryClusterId
@@ -878,51 +980,65 @@ func (u *PolicyUsecase) UpdatePoliciesForClusterID(ctx context.Context, organiza
 		return httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
 	}
 
-	tkpolicies, err := policytemplate.GetTksPolicyCRs(ctx, primaryClusterId)
+	currentPolicyIds, err := u.repo.GetPolicyIDsByClusterID(ctx, clusterId)
 	if err != nil {
 		log.Errorf(ctx, "error is :%s(%T)", err.Error(), err)
 
 		return err
 	}
 
+	// 세팅 대상
+	policies := u.addClusterToPolicyCRs(ctx, organizationId, primaryClusterId, clusterId, policyIds)
+
+	// 클리어 대상
 	ids := make([]string, len(policyIds))
 	for i, policyId := range policyIds {
 		ids[i] = policyId.String()
 	}
 
-	// mapset.NewSet([]string{})
+	clearPolicyIds := []uuid.UUID{}
+	for _, policyId := range *currentPolicyIds {
+		if !slices.Contains(ids, policyId.String()) {
+			clearPolicyIds = append(clearPolicyIds, policyId)
+		}
+	}
+	u.removeClusterFromPolicyCRs(ctx, organizationId, primaryClusterId, clusterId, clearPolicyIds)
 
-	for _, tkspolicy := range tkpolicies {
-		policyId := tkspolicy.GetPolicyID()
+	return u.repo.UpdatePoliciesForClusterID(ctx, organizationId, clusterId, policies)
+}
 
-		// 세팅 대상
-		if slices.Contains(ids, policyId) {
-			// 현재 클러스터가 안 추가되어 있으면
-			if !slices.Contains(tkspolicy.Spec.Clusters, string(clusterId)) {
-				tkspolicy.Spec.Clusters = append(tkspolicy.Spec.Clusters, string(clusterId))
+func (u *PolicyUsecase) DeletePoliciesForClusterID(ctx context.Context, organizationId string, clusterId domain.ClusterId, policyIds []uuid.UUID) (err error) {
+	if err := checkPolicyApproval(ctx, u.organizationRepo, organizationId); err != nil {
+		return err
+	}
 
-				err := policytemplate.ApplyTksPolicyCR(ctx, primaryClusterId, &tkspolicy)
-				if err != nil {
-					log.Errorf(ctx, "error is :%s(%T)", err.Error(), err)
-				}
-			}
+	return u.deletePoliciesForClusterID(ctx, organizationId, clusterId, policyIds)
+}
 
-		} else { // 클리어 대상
-			// 현재 클러스터가 추가되어 있으면
-			if slices.Contains(tkspolicy.Spec.Clusters, string(clusterId)) {
-				newClusters := []string{}
+// DeletePoliciesForDeletedCluster 는 삭제되는 스택을 정책 적용 대상에서 제거한다.
+// 스택 삭제는 정책 변경 승인 대상이 아니므로 승인 모드와 관계없이 제거한다.
+func (u *PolicyUsecase) DeletePoliciesForDeletedCluster(ctx context.Context, organizationId string, clusterId domain.ClusterId, policyIds []uuid.UUID) (err error) {
+	return u.deletePoliciesForClusterID(ctx, organizationId, clusterId, policyIds)
+}
 
-				tkspolicy.Spec.Clusters = slices.Filter(newClusters, tkspolicy.Spec.Clusters,
-					func(s string) bool { return s != string(clusterId) })
+func (u *PolicyUsecase) deletePoliciesForClusterID(ctx context.Context, organizationId string, clusterId domain.ClusterId, policyIds []uuid.UUID) (err error) {
+	organization, err := u.organizationRepo.Get(ctx, organizationId)
 
-				err := policytemplate.ApplyTksPolicyCR(ctx, primaryClusterId, &tkspolicy)
-				if err != nil {
-					log.Errorf(ctx, "error is :%s(%T)", err.Error(), err)
-				}
-			}
-		}
+	primaryClusterId := organization.PrimaryClusterId
+
+	if err != nil {
+		log.Errorf(ctx, "error is :%s(%T)", err.Error(), err)
+
+		return httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
 	}
 
+	u.removeClusterFromPolicyCRs(ctx, organizationId, primaryClusterId, clusterId, policyIds)
+
+	return u.repo.DeletePoliciesForClusterID(ctx, organizationId, clusterId, policyIds)
+}
+
+// addClusterToPolicyCRs 는 정책의 TKSPolicy CR 대상에 클러스터를 추가하고 조회한 정책 목록을 반환한다.
+func (u *PolicyUsecase) addClusterToPolicyCRs(ctx context.Context, organizationId string, primaryClusterId string, clusterId domain.ClusterId, policyIds []uuid.UUID) []model.Policy {
 	policies := []model.Policy{}
 
 	for _, policyId := range policyIds {
@@ -935,54 +1051,43 @@ func (u *PolicyUsecase) UpdatePoliciesForClusterID(ctx context.Context, organiza
 		}
 
 		policies = append(policies, *policy)
-	}
-
-	return u.repo.UpdatePoliciesForClusterID(ctx, organizationId, clusterId, policies)
-}
-
-func (u *PolicyUsecase) DeletePoliciesForClusterID(ctx context.Context, organizationId string, clusterId domain.ClusterId, policyIds []uuid.UUID) (err error) {
-	organization, err := u.organizationRepo.Get(ctx, organizationId)
 
-	primaryClusterId := organization.PrimaryClusterId
-
-	if err != nil {
-		log.Errorf(ctx, "error is :%s(%T)", err.Error(), err)
+		// 현재 클러스터가 안 추가되어 있으면
+		if !slices.Contains(policy.TargetClusterIds, string(clusterId)) {
+			policy.TargetClusterIds = append(policy.TargetClusterIds, string(clusterId))
 
-		return httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
-	}
-
-	tkpolicies, err := policytemplate.GetTksPolicyCRs(ctx, primaryClusterId)
-	if err != nil {
-		log.Errorf(ctx, "error is :%s(%T)", err.Error(), err)
-
-		return err
+			err := policytemplate.ApplyTksPolicyCRs(ctx, primaryClusterId, policytemplate.PolicyToTksPolicyCRs(policy))
+			if err != nil {
+				log.Errorf(ctx, "error is :%s(%T)", err.Error(), err)
+			}
+		}
 	}
 
-	ids := make([]string, len(policyIds))
-	for i, policyId := range policyIds {
-		ids[i] = policyId.String()
-	}
+	return policies
+}
 
-	for _, tkspolicy := range tkpolicies {
-		policyId := tkspolicy.GetPolicyID()
+// removeClusterFromPolicyCRs 는 정책의 TKSPolicy CR 대상에서 클러스터를 제거하고, 해당 클러스터의 스택 전용 CR 도 삭제한다.
+func (u *PolicyUsecase) removeClusterFromPolicyCRs(ctx context.Context, organizationId string, primaryClusterId string, clusterId domain.ClusterId, policyIds []uuid.UUID) {
+	for _, policyId := range policyIds {
+		policy, err := u.repo.GetByID(ctx, organizationId, policyId)
+		if err != nil {
+			log.Errorf(ctx, "error is :%s(%T)", err.Error(), err)
+			continue
+		}
 
-		if slices.Contains(ids, policyId) {
-			// 현재 클러스터가 추가되어 있으면 제거
-			if slices.Contains(tkspolicy.Spec.Clusters, string(clusterId)) {
-				newClusters := []string{}
+		// 현재 클러스터가 추가되어 있으면 제거
+		if slices.Contains(policy.TargetClusterIds, string(clusterId)) {
+			newClusters := []string{}
 
-				tkspolicy.Spec.Clusters = slices.Filter(newClusters, tkspolicy.Spec.Clusters,
-					func(s string) bool { return s != string(clusterId) })
-				err := policytemplate.ApplyTksPolicyCR(ctx, primaryClusterId, &tkspolicy)
+			policy.TargetClusterIds = slices.Filter(newClusters, policy.TargetClusterIds,
+				func(s string) bool { return s != string(clusterId) })
 
-				if err != nil {
-					log.Errorf(ctx, "error is :%s(%T)", err.Error(), err)
-				}
+			err := policytemplate.ApplyTksPolicyCRs(ctx, primaryClusterId, policytemplate.PolicyToTksPolicyCRs(policy))
+			if err != nil {
+				log.Errorf(ctx, "error is :%s(%T)", err.Error(), err)
 			}
 		}
 	}
-
-	return u.repo.DeletePoliciesForClusterID(ctx, organizationId, clusterId, policyIds)
 }
 
 func (u *PolicyUsecase) GetStackPolicyStatistics(ctx context.Context, organizationId string, clusterId domain.ClusterId) (statistics *domain.StackPolicyStatistics, err error) {
@@ -1038,8 +1143,15 @@ func (u *PolicyUsecase) GetStackPolicyStatistics(ctx context.Context, organizati
 	}
 
 	outdatedPolicyCount := 0
+	tototalPolicyCount := 0
 
 	for _, policy := range policyList {
+		// 스택 전용 CR 은 기본 CR 과 같은 정책이므로 세지 않음
+		if policy.IsStackPolicy() {
+			continue
+		}
+		tototalPolicyCount++
+
 		templateId := policy.GetTemplateID()
 
 		if slices.Contains(outdatedTemplateIds, templateId) {
@@ -1047,8 +1159,6 @@ func (u *PolicyUsecase) GetStackPolicyStatistics(ctx context.Context, organizati
 		}
 	}
 
-	tototalPolicyCount := len(policyList)
-
 	uptodatePolicyCount := tototalPolicyCount - outdatedPolicyCount
 
 	result := domain.StackPolicyStatistics{
@@ -1067,6 +1177,8 @@ func (u *PolicyUsecase) GetPolicyIDsByClusterID(ctx context.Context, clusterId d
 	return u.repo.GetPolicyIDsByClusterID(ctx, clusterId)
 }
 
+const StackPolicyTemplateActionUpdate = "update"
+
 func extractNewTemplateParameter(paramdefs []*domain.ParameterDef, newParamDefs []*domain.ParameterDef) (policyParameters []domain.UpdatedPolicyTemplateParameter, err error) {
 	diffParamDef, err := policytemplate.GetNewParamDefs(paramdefs, newParamDefs)
 
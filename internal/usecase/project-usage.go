@@ -0,0 +1,225 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/openinfradev/tks-api/internal"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/spf13/viper"
+)
+
+const (
+	projectUsageDateFormat = "2006-01-02"
+	bytesPerGib            = 1024 * 1024 * 1024
+)
+
+// 네임스페이스별 자원 사용량 질의. 1시간 간격으로 조회하여 합산하면 하루 동안의 사용량(시간 단위)이 된다.
+var projectUsageQueries = map[string]string{
+	"cpu":     `sum(rate(container_cpu_usage_seconds_total{image!=""}[1h])) by (taco_cluster, namespace)`,
+	"memory":  `sum(container_memory_working_set_bytes{image!=""}) by (taco_cluster, namespace)`,
+	"storage": `sum(kubelet_volume_stats_used_bytes) by (taco_cluster, namespace)`,
+}
+
+type IProjectUsageUsecase interface {
+	Get(ctx context.Context, organizationId string, projectId string, from time.Time, to time.Time) (domain.GetProjectUsageResponse, error)
+	Rollup(ctx context.Context, organizationId string, date time.Time) error
+	RollupDailyUsages(ctx context.Context) error
+	WatchDailyUsages(ctx context.Context, interval time.Duration)
+}
+
+type ProjectUsageUsecase struct {
+	repo             repository.IProjectUsageRepository
+	projectRepo      repository.IProjectRepository
+	organizationRepo repository.IOrganizationRepository
+	dashboardUsecase IDashboardUsecase
+	jobLeaseRepo     repository.IJobLeaseRepository
+}
+
+func NewProjectUsageUsecase(r repository.Repository, dashboardUsecase IDashboardUsecase) IProjectUsageUsecase {
+	return &ProjectUsageUsecase{
+		repo:             r.ProjectUsage,
+		projectRepo:      r.Project,
+		organizationRepo: r.Organization,
+		dashboardUsecase: dashboardUsecase,
+		jobLeaseRepo:     r.JobLease,
+	}
+}
+
+// Get 은 from 부터 to 까지(양 끝 포함) 프로젝트의 일별 사용량과 단위 요금으로 계산한 비용을 반환한다.
+func (u *ProjectUsageUsecase) Get(ctx context.Context, organizationId string, projectId string, from time.Time, to time.Time) (out domain.GetProjectUsageResponse, err error) {
+	project, err := u.projectRepo.GetProjectById(ctx, organizationId, projectId)
+	if err != nil || project == nil {
+		return out, httpErrors.NewNotFoundError(fmt.Errorf("not found project"), "C_INVALID_PROJECT_ID", "")
+	}
+	if to.Before(from) || to.Sub(from) >= internal.MaxProjectUsagePeriodDays*24*time.Hour {
+		return out, httpErrors.NewBadRequestError(fmt.Errorf("invalid period"), "PU_INVALID_PERIOD", "")
+	}
+
+	usages, err := u.repo.FetchByProjectId(ctx, organizationId, projectId, from, to)
+	if err != nil {
+		return out, httpErrors.NewInternalServerError(err, "PU_FAILED_TO_GET_PROJECT_USAGE", "")
+	}
+
+	rates := projectUsageRates()
+	out = domain.GetProjectUsageResponse{
+		ProjectId: projectId,
+		From:      from.Format(projectUsageDateFormat),
+		To:        to.Format(projectUsageDateFormat),
+		Rates:     rates,
+		Usages:    make([]domain.ProjectUsageResponse, len(usages)),
+	}
+	for i, usage := range usages {
+		cost := usage.CpuCoreHours*rates.CpuCoreHourRate +
+			usage.MemoryGibHours*rates.MemoryGibHourRate +
+			usage.StorageGibHours*rates.StorageGibHourRate
+		out.Usages[i] = domain.ProjectUsageResponse{
+			Date:            usage.Date.Format(projectUsageDateFormat),
+			StackId:         usage.StackId,
+			Namespace:       usage.Namespace,
+			CpuCoreHours:    usage.CpuCoreHours,
+			MemoryGibHours:  usage.MemoryGibHours,
+			StorageGibHours: usage.StorageGibHours,
+			Cost:            cost,
+		}
+		out.CpuCoreHours += usage.CpuCoreHours
+		out.MemoryGibHours += usage.MemoryGibHours
+		out.StorageGibHours += usage.StorageGibHours
+		out.Cost += cost
+	}
+
+	return out, nil
+}
+
+// Rollup 은 조직의 프로젝트 네임스페이스별로 date(UTC) 하루 동안의 CPU, 메모리, 스토리지 사용량을 Thanos 에서 조회하여 저장한다.
+func (u *ProjectUsageUsecase) Rollup(ctx context.Context, organizationId string, date time.Time) error {
+	namespaces, err := u.projectRepo.GetProjectNamespacesByOrganizationId(ctx, organizationId)
+	if err != nil {
+		return err
+	}
+	if len(namespaces) == 0 {
+		return nil
+	}
+
+	thanosClient, err := u.dashboardUsecase.GetThanosClient(ctx, organizationId)
+	if err != nil {
+		return err
+	}
+
+	type namespaceKey struct {
+		stackId   string
+		namespace string
+	}
+	usages := map[namespaceKey]*model.ProjectUsage{}
+	for _, pn := range namespaces {
+		usages[namespaceKey{pn.StackId, pn.Namespace}] = &model.ProjectUsage{
+			OrganizationId: organizationId,
+			ProjectId:      pn.ProjectId,
+			StackId:        pn.StackId,
+			Namespace:      pn.Namespace,
+			Date:           date,
+		}
+	}
+
+	// rate(...[1h]) 는 직전 1시간의 평균이므로 01:00 부터 다음날 00:00 까지 조회한다
+	start := date.Add(time.Hour)
+	end := date.Add(24 * time.Hour)
+	for resource, query := range projectUsageQueries {
+		result, err := thanosClient.FetchRange(ctx, query, int(start.Unix()), int(end.Unix()), int(time.Hour.Seconds()))
+		if err != nil {
+			return err
+		}
+		for _, val := range result.Data.Result {
+			usage, ok := usages[namespaceKey{val.Metric.TacoCluster, val.Metric.Namespace}]
+			if !ok {
+				continue
+			}
+			hours := sumRangeValues(val.Values)
+			switch resource {
+			case "cpu":
+				usage.CpuCoreHours = hours
+			case "memory":
+				usage.MemoryGibHours = hours / bytesPerGib
+			case "storage":
+				usage.StorageGibHours = hours / bytesPerGib
+			}
+		}
+	}
+
+	out := make([]model.ProjectUsage, 0, len(usages))
+	for _, usage := range usages {
+		out = append(out, *usage)
+	}
+	return u.repo.Upsert(ctx, out)
+}
+
+// RollupDailyUsages 는 최근 ProjectUsageBackfillDays 일 중 집계되지 않은 날의 사용량을 조직별로 집계한다.
+// 오늘은 하루가 끝나지 않았으므로 집계하지 않는다.
+func (u *ProjectUsageUsecase) RollupDailyUsages(ctx context.Context) error {
+	organizations, err := u.organizationRepo.Fetch(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	for _, organization := range *organizations {
+		if organization.PrimaryClusterId == "" {
+			continue
+		}
+
+		for days := internal.ProjectUsageBackfillDays; days > 0; days-- {
+			date := today.AddDate(0, 0, -days)
+			exists, err := u.repo.ExistsByDate(ctx, organization.ID, date)
+			if err != nil {
+				return err
+			}
+			if exists {
+				continue
+			}
+
+			if err := u.Rollup(ctx, organization.ID, date); err != nil {
+				log.Errorf(ctx, "failed to rollup project usage of organization %s on %s: %v", organization.ID, date.Format(projectUsageDateFormat), err)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+func (u *ProjectUsageUsecase) WatchDailyUsages(ctx context.Context, interval time.Duration) {
+	watchExclusively(ctx, u.jobLeaseRepo, "project-usage-rollup", interval, u.RollupDailyUsages)
+}
+
+// sumRangeValues 는 range query 결과의 [timestamp, "value"] 값을 합산한다.
+func sumRangeValues(values []interface{}) (sum float64) {
+	for _, value := range values {
+		pair, ok := value.([]interface{})
+		if !ok || len(pair) != 2 {
+			continue
+		}
+		s, ok := pair[1].(string)
+		if !ok {
+			continue
+		}
+		if v, err := strconv.ParseFloat(s, 64); err == nil {
+			sum += v
+		}
+	}
+	return sum
+}
+
+func projectUsageRates() domain.ProjectUsageRates {
+	return domain.ProjectUsageRates{
+		Currency:           viper.GetString("project-usage-currency"),
+		CpuCoreHourRate:    viper.GetFloat64("project-usage-cpu-rate"),
+		MemoryGibHourRate:  viper.GetFloat64("project-usage-memory-rate"),
+		StorageGibHourRate: viper.GetFloat64("project-usage-storage-rate"),
+	}
+}
@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"github.com/Nerzal/gocloak/v13"
 	"github.com/openinfradev/tks-api/internal/keycloak"
+	"slices"
 	"sort"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	cloudprovider "github.com/openinfradev/tks-api/internal/cloud-provider"
 	"github.com/openinfradev/tks-api/internal/helper"
 	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
 	"github.com/openinfradev/tks-api/internal/model"
@@ -47,6 +49,7 @@ type StackUsecase struct {
 	organizationRepo  repository.IOrganizationRepository
 	stackTemplateRepo repository.IStackTemplateRepository
 	appServeAppRepo   repository.IAppServeAppRepository
+	policyBundleRepo  repository.IPolicyBundleRepository
 	argo              argowf.ArgoClient
 	dashbordUsecase   IDashboardUsecase
 	kc                keycloak.IKeycloak
@@ -60,6 +63,7 @@ func NewStackUsecase(r repository.Repository, argoClient argowf.ArgoClient, dash
 		organizationRepo:  r.Organization,
 		stackTemplateRepo: r.StackTemplate,
 		appServeAppRepo:   r.AppServeApp,
+		policyBundleRepo:  r.PolicyBundle,
 		argo:              argoClient,
 		dashbordUsecase:   dashbordUsecase,
 		kc:                kc,
@@ -101,9 +105,17 @@ func (u *StackUsecase) Create(ctx context.Context, dto model.Stack) (stackId dom
 			return "", httpErrors.NewBadRequestError(fmt.Errorf("Invalid clusterEndpoint"), "S_INVALID_ADMINCLUSTER_URL", "")
 		}
 	} else {
-		if _, err = u.cloudAccountRepo.Get(ctx, dto.CloudAccountId); err != nil {
+		cloudAccount, err := u.cloudAccountRepo.Get(ctx, dto.CloudAccountId)
+		if err != nil {
 			return "", httpErrors.NewInternalServerError(errors.Wrap(err, "Invalid cloudAccountId"), "S_INVALID_CLOUD_ACCOUNT", "")
 		}
+		provider, err := cloudprovider.New(cloudAccount.CloudService)
+		if err != nil {
+			return "", httpErrors.NewBadRequestError(err, "S_INVALID_CLOUD_SERVICE", "")
+		}
+		if dto.Conf.ClusterRegion, err = cloudprovider.ResolveRegion(provider, cloudAccount, dto.Conf.ClusterRegion); err != nil {
+			return "", httpErrors.NewBadRequestError(err, "S_INVALID_CLUSTER_REGION", "")
+		}
 	}
 
 	// Make stack nodes
@@ -125,6 +137,29 @@ func (u *StackUsecase) Create(ctx context.Context, dto model.Stack) (stackId dom
 		}
 	}
 
+	// 정책 번들에 포함된 정책도 스택 생성 시 함께 적용한다.
+	policyBundles := make([]model.PolicyBundle, len(dto.PolicyBundleIds))
+	policyBundleIds := make([]uuid.UUID, len(dto.PolicyBundleIds))
+	for i, policyBundleId := range dto.PolicyBundleIds {
+		policyBundleIds[i], err = uuid.Parse(policyBundleId)
+		if err != nil {
+			return "", httpErrors.NewBadRequestError(errors.Wrap(err, "Invalid policyBundleId"), "PB_INVALID_POLICY_BUNDLE_ID", "")
+		}
+		policyBundle, err := u.policyBundleRepo.Get(ctx, dto.OrganizationId, policyBundleIds[i])
+		if err != nil {
+			return "", httpErrors.NewBadRequestError(errors.Wrap(err, "Invalid policyBundleId"), "PB_NOT_FOUND_POLICY_BUNDLE", "")
+		}
+		policyBundles[i] = *policyBundle
+		for _, policyId := range policyBundle.PolicyIds() {
+			if !slices.Contains(dto.PolicyIds, policyId.String()) {
+				dto.PolicyIds = append(dto.PolicyIds, policyId.String())
+			}
+		}
+	}
+	if err := checkPresetConflicts(policyBundles); err != nil {
+		return "", err
+	}
+
 	var conf domain.StackConfResponse
 	if err := serializer.Map(ctx, dto.Conf, &conf); err != nil {
 		log.Error(ctx, err)
@@ -178,6 +213,12 @@ func (u *StackUsecase) Create(ctx context.Context, dto model.Stack) (stackId dom
 		}
 	}
 
+	for _, policyBundleId := range policyBundleIds {
+		if err := u.policyBundleRepo.AddCluster(ctx, policyBundleId, domain.ClusterId(dto.ID)); err != nil {
+			log.Errorf(ctx, "Failed to assign policy bundle %s to %s", policyBundleId, dto.ID)
+		}
+	}
+
 	// keycloak setting
 	log.Debugf(ctx, "Create keycloak client for %s", dto.ID)
 	// Create keycloak client
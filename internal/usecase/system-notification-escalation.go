@@ -0,0 +1,417 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type ISystemNotificationEscalationUsecase interface {
+	CreateOnCallSchedule(ctx context.Context, organizationId string, dto model.OnCallSchedule) (onCallScheduleId uuid.UUID, err error)
+	GetOnCallSchedule(ctx context.Context, organizationId string, onCallScheduleId uuid.UUID) (model.OnCallSchedule, error)
+	FetchOnCallSchedules(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.OnCallSchedule, error)
+	UpdateOnCallSchedule(ctx context.Context, organizationId string, onCallScheduleId uuid.UUID, input domain.UpdateOnCallScheduleRequest) error
+	DeleteOnCallSchedule(ctx context.Context, organizationId string, onCallScheduleId uuid.UUID) error
+
+	GetPolicy(ctx context.Context, organizationId string, systemNotificationRuleId uuid.UUID) (model.SystemNotificationEscalationPolicy, error)
+	SetPolicy(ctx context.Context, organizationId string, systemNotificationRuleId uuid.UUID, input domain.SetSystemNotificationEscalationPolicyRequest) error
+	DeletePolicy(ctx context.Context, organizationId string, systemNotificationRuleId uuid.UUID) error
+
+	Escalate(ctx context.Context) error
+	WatchEscalations(ctx context.Context, interval time.Duration)
+}
+
+type SystemNotificationEscalationUsecase struct {
+	repo                       repository.ISystemNotificationEscalationRepository
+	systemNotificationRuleRepo repository.ISystemNotificationRuleRepository
+	organizationRepo           repository.IOrganizationRepository
+	userRepo                   repository.IUserRepository
+	jobLeaseRepo               repository.IJobLeaseRepository
+}
+
+func NewSystemNotificationEscalationUsecase(r repository.Repository) ISystemNotificationEscalationUsecase {
+	return &SystemNotificationEscalationUsecase{
+		repo:                       r.SystemNotificationEscalation,
+		systemNotificationRuleRepo: r.SystemNotificationRule,
+		organizationRepo:           r.Organization,
+		userRepo:                   r.User,
+		jobLeaseRepo:               r.JobLease,
+	}
+}
+
+func (u *SystemNotificationEscalationUsecase) CreateOnCallSchedule(ctx context.Context, organizationId string, dto model.OnCallSchedule) (onCallScheduleId uuid.UUID, err error) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return uuid.Nil, httpErrors.NewUnauthorizedError(fmt.Errorf("invalid token"), "A_INVALID_TOKEN", "")
+	}
+
+	if err := u.validateParticipants(ctx, organizationId, dto.ParticipantIds); err != nil {
+		return uuid.Nil, err
+	}
+
+	userId := user.GetUserId()
+	dto.ID = uuid.New()
+	dto.OrganizationId = organizationId
+	dto.CreatorId = &userId
+
+	onCallScheduleId, err = u.repo.CreateOnCallSchedule(ctx, dto)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewInternalServerError(err, "", "")
+	}
+	return onCallScheduleId, nil
+}
+
+func (u *SystemNotificationEscalationUsecase) GetOnCallSchedule(ctx context.Context, organizationId string, onCallScheduleId uuid.UUID) (out model.OnCallSchedule, err error) {
+	out, err = u.repo.GetOnCallSchedule(ctx, organizationId, onCallScheduleId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return out, httpErrors.NewNotFoundError(err, "SNE_NOT_FOUND_ON_CALL_SCHEDULE", "")
+		}
+		return out, err
+	}
+	u.fillParticipantUsers(ctx, &out, map[uuid.UUID]model.User{})
+	return out, nil
+}
+
+func (u *SystemNotificationEscalationUsecase) FetchOnCallSchedules(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.OnCallSchedule, error) {
+	schedules, err := u.repo.FetchOnCallSchedules(ctx, organizationId, pg)
+	if err != nil {
+		return nil, err
+	}
+	users := map[uuid.UUID]model.User{}
+	for i := range schedules {
+		u.fillParticipantUsers(ctx, &schedules[i], users)
+	}
+	return schedules, nil
+}
+
+func (u *SystemNotificationEscalationUsecase) UpdateOnCallSchedule(ctx context.Context, organizationId string, onCallScheduleId uuid.UUID, input domain.UpdateOnCallScheduleRequest) error {
+	if _, err := u.GetOnCallSchedule(ctx, organizationId, onCallScheduleId); err != nil {
+		return err
+	}
+
+	updateMap := make(map[string]interface{})
+	if input.Name != nil {
+		updateMap["name"] = *input.Name
+	}
+	if input.Description != nil {
+		updateMap["description"] = *input.Description
+	}
+	if input.ParticipantIds != nil {
+		participantIds, err := parseParticipantIds(*input.ParticipantIds)
+		if err != nil {
+			return err
+		}
+		if err := u.validateParticipants(ctx, organizationId, participantIds); err != nil {
+			return err
+		}
+		participants, err := json.Marshal(participantIds)
+		if err != nil {
+			return httpErrors.NewBadRequestError(err, "SNE_INVALID_ON_CALL_SCHEDULE", "")
+		}
+		updateMap["participants"] = string(participants)
+	}
+	if input.RotationStartAt != nil {
+		updateMap["rotation_start_at"] = *input.RotationStartAt
+	}
+	if input.RotationHour != nil {
+		updateMap["rotation_hour"] = *input.RotationHour
+	}
+
+	if len(updateMap) == 0 {
+		return nil
+	}
+	return u.repo.UpdateOnCallSchedule(ctx, onCallScheduleId, updateMap)
+}
+
+// DeleteOnCallSchedule 은 당직 순번을 삭제한다. 에스컬레이션 정책에서 사용 중인 당직 순번은 삭제할 수 없다.
+func (u *SystemNotificationEscalationUsecase) DeleteOnCallSchedule(ctx context.Context, organizationId string, onCallScheduleId uuid.UUID) error {
+	if _, err := u.GetOnCallSchedule(ctx, organizationId, onCallScheduleId); err != nil {
+		return err
+	}
+
+	policies, err := u.repo.ListPolicies(ctx, organizationId)
+	if err != nil {
+		return err
+	}
+	for _, policy := range policies {
+		for _, step := range policy.StepsData {
+			if step.OnCallScheduleId == onCallScheduleId.String() {
+				return httpErrors.NewBadRequestError(fmt.Errorf("on-call schedule is used by escalation policy of rule %s", policy.SystemNotificationRuleId),
+					"SNE_ON_CALL_SCHEDULE_IN_USE", "")
+			}
+		}
+	}
+
+	return u.repo.DeleteOnCallSchedule(ctx, onCallScheduleId)
+}
+
+func (u *SystemNotificationEscalationUsecase) GetPolicy(ctx context.Context, organizationId string, systemNotificationRuleId uuid.UUID) (out model.SystemNotificationEscalationPolicy, err error) {
+	if err := u.checkRule(ctx, organizationId, systemNotificationRuleId); err != nil {
+		return out, err
+	}
+
+	out, err = u.repo.GetPolicy(ctx, organizationId, systemNotificationRuleId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return out, httpErrors.NewNotFoundError(err, "SNE_NOT_FOUND_ESCALATION_POLICY", "")
+		}
+		return out, err
+	}
+	return out, nil
+}
+
+// SetPolicy 는 알림 설정의 에스컬레이션 정책을 생성하거나 교체한다.
+func (u *SystemNotificationEscalationUsecase) SetPolicy(ctx context.Context, organizationId string, systemNotificationRuleId uuid.UUID, input domain.SetSystemNotificationEscalationPolicyRequest) error {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return httpErrors.NewUnauthorizedError(fmt.Errorf("invalid token"), "A_INVALID_TOKEN", "")
+	}
+
+	if err := u.checkRule(ctx, organizationId, systemNotificationRuleId); err != nil {
+		return err
+	}
+	if err := u.validateSteps(ctx, organizationId, input.Steps); err != nil {
+		return err
+	}
+
+	userId := user.GetUserId()
+	dto := model.SystemNotificationEscalationPolicy{
+		OrganizationId:           organizationId,
+		SystemNotificationRuleId: systemNotificationRuleId,
+		StepsData:                input.Steps,
+		Enabled:                  true,
+		CreatorId:                &userId,
+	}
+
+	existing, err := u.repo.GetPolicy(ctx, organizationId, systemNotificationRuleId)
+	if err == nil {
+		dto.ID = existing.ID
+		dto.CreatedAt = existing.CreatedAt
+		dto.CreatorId = existing.CreatorId
+		dto.Enabled = existing.Enabled
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	if input.Enabled != nil {
+		dto.Enabled = *input.Enabled
+	}
+
+	if err := u.repo.SavePolicy(ctx, dto); err != nil {
+		return httpErrors.NewInternalServerError(err, "", "")
+	}
+	return nil
+}
+
+func (u *SystemNotificationEscalationUsecase) DeletePolicy(ctx context.Context, organizationId string, systemNotificationRuleId uuid.UUID) error {
+	policy, err := u.GetPolicy(ctx, organizationId, systemNotificationRuleId)
+	if err != nil {
+		return err
+	}
+	return u.repo.DeletePolicy(ctx, policy.ID)
+}
+
+// Escalate 는 아무도 조치하지 않은 알림을 에스컬레이션 정책에 따라 다음 단계의 대상에게 발송한다.
+// 첫 단계는 알림 생성 시 발송하며, 발송에 실패한 경우에만 여기서 다시 발송한다.
+// 티커가 멈춰 있던 동안 여러 단계가 지났다면 지난 단계의 대상 모두에게 한 번에 발송한다.
+func (u *SystemNotificationEscalationUsecase) Escalate(ctx context.Context) error {
+	policies, err := u.repo.ListEnabledPolicies(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	since := now.Add(-internal.MaxSystemNotificationEscalationAge)
+	for _, policy := range policies {
+		notifications, err := u.repo.ListPendingNotifications(ctx, policy.SystemNotificationRuleId, len(policy.StepsData), since)
+		if err != nil {
+			log.Error(ctx, "Failed to get pending systemNotifications ", err)
+			continue
+		}
+
+		for _, notification := range notifications {
+			level := policy.DueLevel(notification.CreatedAt, now)
+			if level <= notification.EscalationLevel {
+				continue
+			}
+
+			// 다른 서버가 먼저 에스컬레이션한 경우에는 발송하지 않는다.
+			updated, err := u.repo.UpdateEscalationLevel(ctx, notification.ID, notification.EscalationLevel, level, &now)
+			if err != nil {
+				log.Error(ctx, "Failed to update escalation level ", err)
+				continue
+			}
+			if !updated {
+				continue
+			}
+
+			to := u.recipients(ctx, policy.OrganizationId, policy.StepsData[notification.EscalationLevel:level], now)
+			if len(to) == 0 {
+				log.Warn(ctx, fmt.Sprintf("no recipients for escalation level %d of systemNotification %s", level, notification.ID))
+				continue
+			}
+
+			title := fmt.Sprintf("[에스컬레이션 %d단계] %s", level, notification.MessageTitle)
+			if err := sendSystemNotificationMail(ctx, notification.OrganizationId, title, notification.MessageContent, to); err != nil {
+				// 발송하지 못한 단계는 다음 주기에 다시 발송하도록 단계를 되돌린다.
+				log.Error(ctx, fmt.Sprintf("Failed to send escalation level %d of systemNotification %s to %s. err : %s", level, notification.ID, to, err.Error()))
+				if _, err := u.repo.UpdateEscalationLevel(ctx, notification.ID, level, notification.EscalationLevel, notification.EscalatedAt); err != nil {
+					log.Error(ctx, "Failed to revert escalation level ", err)
+				}
+				continue
+			}
+		}
+	}
+	return nil
+}
+
+func (u *SystemNotificationEscalationUsecase) WatchEscalations(ctx context.Context, interval time.Duration) {
+	watchExclusively(ctx, u.jobLeaseRepo, "system-notification-escalation", interval, u.Escalate)
+}
+
+func (u *SystemNotificationEscalationUsecase) recipients(ctx context.Context, organizationId string, steps []domain.SystemNotificationEscalationStep, now time.Time) []string {
+	return escalationRecipients(ctx, u.repo, u.organizationRepo, u.userRepo, organizationId, steps, now)
+}
+
+// escalationRecipients 는 에스컬레이션 단계의 대상자 이메일 목록을 중복 없이 반환한다.
+// 알림 생성 시 첫 단계의 대상에게 발송할 때도 사용한다.
+func escalationRecipients(ctx context.Context, repo repository.ISystemNotificationEscalationRepository, organizationRepo repository.IOrganizationRepository,
+	userRepo repository.IUserRepository, organizationId string, steps []domain.SystemNotificationEscalationStep, now time.Time) []string {
+	userIds := []uuid.UUID{}
+	for _, step := range steps {
+		switch step.TargetType {
+		case domain.EscalationTarget_ON_CALL_PRIMARY, domain.EscalationTarget_ON_CALL_SECONDARY:
+			scheduleId, err := uuid.Parse(step.OnCallScheduleId)
+			if err != nil {
+				continue
+			}
+			schedule, err := repo.GetOnCallSchedule(ctx, organizationId, scheduleId)
+			if err != nil {
+				log.Error(ctx, "Failed to get onCallSchedule ", err)
+				continue
+			}
+			level := 0
+			if step.TargetType == domain.EscalationTarget_ON_CALL_SECONDARY {
+				level = 1
+			}
+			if userId, ok := schedule.OnCall(now, level); ok {
+				userIds = append(userIds, userId)
+			}
+		case domain.EscalationTarget_USER:
+			if userId, err := uuid.Parse(step.UserId); err == nil {
+				userIds = append(userIds, userId)
+			}
+		case domain.EscalationTarget_ORGANIZATION_ADMIN:
+			organization, err := organizationRepo.Get(ctx, organizationId)
+			if err != nil {
+				log.Error(ctx, "Failed to get organization ", err)
+				continue
+			}
+			if organization.AdminId != nil {
+				userIds = append(userIds, *organization.AdminId)
+			}
+		}
+	}
+
+	to := []string{}
+	for _, userId := range userIds {
+		user, err := userRepo.GetByUuid(ctx, userId)
+		if err != nil || user.OrganizationId != organizationId {
+			continue
+		}
+		if user.Email != "" && !slices.Contains(to, user.Email) {
+			to = append(to, user.Email)
+		}
+	}
+	return to
+}
+
+func (u *SystemNotificationEscalationUsecase) checkRule(ctx context.Context, organizationId string, systemNotificationRuleId uuid.UUID) error {
+	rule, err := u.systemNotificationRuleRepo.Get(ctx, systemNotificationRuleId)
+	if err != nil || rule.OrganizationId != organizationId {
+		return httpErrors.NewNotFoundError(fmt.Errorf("not found systemNotificationRule"), "SNR_NOT_EXISTED_SYSTEM_NOTIFICATION_RULE", "")
+	}
+	return nil
+}
+
+// validateSteps 는 단계의 대기 시간이 증가하는 순서인지, 대상 당직 순번과 사용자가 조직에 속해 있는지 확인한다.
+func (u *SystemNotificationEscalationUsecase) validateSteps(ctx context.Context, organizationId string, steps []domain.SystemNotificationEscalationStep) error {
+	for i, step := range steps {
+		if i > 0 && step.DelayMinute < steps[i-1].DelayMinute {
+			return httpErrors.NewBadRequestError(fmt.Errorf("delayMinute of step %d is less than the previous step", i+1), "SNE_INVALID_ESCALATION_POLICY", "")
+		}
+
+		switch step.TargetType {
+		case domain.EscalationTarget_ON_CALL_PRIMARY, domain.EscalationTarget_ON_CALL_SECONDARY:
+			scheduleId, err := uuid.Parse(step.OnCallScheduleId)
+			if err != nil {
+				return httpErrors.NewBadRequestError(fmt.Errorf("step %d requires onCallScheduleId", i+1), "SNE_INVALID_ON_CALL_SCHEDULE_ID", "")
+			}
+			if _, err := u.repo.GetOnCallSchedule(ctx, organizationId, scheduleId); err != nil {
+				return httpErrors.NewBadRequestError(fmt.Errorf("not found onCallSchedule %s", scheduleId), "SNE_NOT_FOUND_ON_CALL_SCHEDULE", "")
+			}
+		case domain.EscalationTarget_USER:
+			userId, err := uuid.Parse(step.UserId)
+			if err != nil {
+				return httpErrors.NewBadRequestError(fmt.Errorf("step %d requires userId", i+1), "SNE_INVALID_ESCALATION_POLICY", "")
+			}
+			if err := u.validateParticipants(ctx, organizationId, []uuid.UUID{userId}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (u *SystemNotificationEscalationUsecase) validateParticipants(ctx context.Context, organizationId string, userIds []uuid.UUID) error {
+	for _, userId := range userIds {
+		user, err := u.userRepo.GetByUuid(ctx, userId)
+		if err != nil || user.OrganizationId != organizationId {
+			return httpErrors.NewBadRequestError(fmt.Errorf("not found user %s", userId), "U_NO_USER", "")
+		}
+	}
+	return nil
+}
+
+// fillParticipantUsers 는 참여자 정보를 채운다. users 는 목록 조회 시 같은 사용자를 여러 번 조회하지 않기 위한 캐시이다.
+func (u *SystemNotificationEscalationUsecase) fillParticipantUsers(ctx context.Context, schedule *model.OnCallSchedule, users map[uuid.UUID]model.User) {
+	schedule.ParticipantUsers = make([]model.User, 0, len(schedule.ParticipantIds))
+	for _, userId := range schedule.ParticipantIds {
+		user, ok := users[userId]
+		if !ok {
+			var err error
+			if user, err = u.userRepo.GetByUuid(ctx, userId); err != nil {
+				log.Error(ctx, "Failed to get user ", err)
+				user = model.User{ID: userId}
+			}
+			users[userId] = user
+		}
+		schedule.ParticipantUsers = append(schedule.ParticipantUsers, user)
+	}
+}
+
+func parseParticipantIds(ids []string) ([]uuid.UUID, error) {
+	out := make([]uuid.UUID, len(ids))
+	for i, id := range ids {
+		userId, err := uuid.Parse(id)
+		if err != nil {
+			return nil, httpErrors.NewBadRequestError(fmt.Errorf("invalid participantId %s", id), "SNE_INVALID_ON_CALL_SCHEDULE", "")
+		}
+		out[i] = userId
+	}
+	return out, nil
+}
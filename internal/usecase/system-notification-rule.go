@@ -3,15 +3,21 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"math"
+	"sort"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal"
 	"github.com/openinfradev/tks-api/internal/helper"
 	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
 	"github.com/openinfradev/tks-api/internal/model"
 	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/promql"
 	"github.com/openinfradev/tks-api/internal/repository"
 	"github.com/openinfradev/tks-api/pkg/domain"
 	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
 )
@@ -24,21 +30,26 @@ type ISystemNotificationRuleUsecase interface {
 	Delete(ctx context.Context, systemNotificationRuleId uuid.UUID) (model.SystemNotificationRule, error)
 	GetByName(ctx context.Context, name string) (model.SystemNotificationRule, error)
 	MakeDefaultSystemNotificationRules(ctx context.Context, organizationId string, dto *model.Organization) error
+	Preview(ctx context.Context, organizationId string, input domain.PreviewSystemNotificationRuleRequest) (domain.PreviewSystemNotificationRuleResponse, error)
 }
 
 type SystemNotificationRuleUsecase struct {
 	repo                           repository.ISystemNotificationRuleRepository
 	organizationRepo               repository.IOrganizationRepository
 	userRepo                       repository.IUserRepository
+	clusterRepo                    repository.IClusterRepository
 	systemNotificationTemplateRepo repository.ISystemNotificationTemplateRepository
+	dashboardUsecase               IDashboardUsecase
 }
 
-func NewSystemNotificationRuleUsecase(r repository.Repository) ISystemNotificationRuleUsecase {
+func NewSystemNotificationRuleUsecase(r repository.Repository, dashboardUsecase IDashboardUsecase) ISystemNotificationRuleUsecase {
 	return &SystemNotificationRuleUsecase{
 		repo:                           r.SystemNotificationRule,
 		organizationRepo:               r.Organization,
 		userRepo:                       r.User,
+		clusterRepo:                    r.Cluster,
 		systemNotificationTemplateRepo: r.SystemNotificationTemplate,
+		dashboardUsecase:               dashboardUsecase,
 	}
 }
 
@@ -55,6 +66,10 @@ func (u *SystemNotificationRuleUsecase) Create(ctx context.Context, dto model.Sy
 		return uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("duplicate systemNotificationRule name"), "SNR_CREATE_ALREADY_EXISTED_NAME", "")
 	}
 
+	if _, err = u.renderRule(ctx, dto.SystemNotificationTemplateId, dto.SystemNotificationCondition.Duration, dto.SystemNotificationCondition.Parameters); err != nil {
+		return uuid.Nil, err
+	}
+
 	// Users
 	dto.TargetUsers = make([]model.User, 0)
 	for _, strId := range dto.TargetUserIds {
@@ -84,6 +99,10 @@ func (u *SystemNotificationRuleUsecase) Update(ctx context.Context, dto model.Sy
 		return httpErrors.NewBadRequestError(err, "SNR_NOT_EXISTED_STACK_TEMPLATE", "")
 	}
 
+	if _, err = u.renderRule(ctx, dto.SystemNotificationTemplateId, dto.SystemNotificationCondition.Duration, dto.SystemNotificationCondition.Parameters); err != nil {
+		return err
+	}
+
 	// Users
 	dto.TargetUsers = make([]model.User, 0)
 	for _, strId := range dto.TargetUserIds {
@@ -390,3 +409,128 @@ func (u *SystemNotificationRuleUsecase) MakeDefaultSystemNotificationRules(ctx c
 
 	return nil
 }
+
+// Preview 는 알림 설정을 적용하기 전에 최근 N 시간 동안 조직의 클러스터에서 알림이 언제 발생했을지 계산한다.
+// 템플릿의 메트릭 쿼리와 조건으로 만든 expr 을 Thanos 에서 range query 로 조회하고,
+// 조건을 만족한 구간이 지속시간(for) 이상 이어진 경우를 firing 으로 본다.
+func (u *SystemNotificationRuleUsecase) Preview(ctx context.Context, organizationId string, input domain.PreviewSystemNotificationRuleRequest) (out domain.PreviewSystemNotificationRuleResponse, err error) {
+	templateId, err := uuid.Parse(input.SystemNotificationTemplateId)
+	if err != nil {
+		return out, httpErrors.NewBadRequestError(err, "SNT_NOT_EXISTED_ALERT_TEMPLATE", "")
+	}
+	expr, err := u.renderRule(ctx, templateId, input.Duration, input.Parameters)
+	if err != nil {
+		return out, err
+	}
+	out.Expr = expr
+
+	var forSeconds int64
+	if input.Duration != "" {
+		if forSeconds, err = promql.ParseDuration(input.Duration); err != nil {
+			return out, httpErrors.NewBadRequestError(err, "SNR_INVALID_CONDITION", "")
+		}
+	}
+
+	hours := input.Hours
+	if hours == 0 {
+		hours = internal.DefaultSystemNotificationPreviewHours
+	}
+	// 알림 평가 주기에 가깝게 1분 간격으로 조회하되, 조회 구간이 길면 간격을 늘린다.
+	step := int(math.Max(60, math.Ceil(float64(hours*3600)/internal.MaxSystemNotificationPreviewPoints)))
+	end := time.Now().Truncate(time.Duration(step) * time.Second)
+	start := end.Add(-time.Duration(hours) * time.Hour)
+	out.StartAt, out.EndAt, out.Step = start, end, step
+	out.Series = []domain.SystemNotificationRulePreviewSeries{}
+
+	clusters, err := u.clusterRepo.FetchByOrganizationId(ctx, organizationId, uuid.Nil, nil)
+	if err != nil {
+		return out, httpErrors.NewInternalServerError(err, "S_FAILED_FETCH_CLUSTERS", "")
+	}
+	if len(clusters) == 0 {
+		return out, nil
+	}
+	clusterNames := make(map[string]string, len(clusters))
+	clusterIds := make([]string, len(clusters))
+	for i, cluster := range clusters {
+		clusterIds[i] = cluster.ID.String()
+		clusterNames[cluster.ID.String()] = cluster.Name
+	}
+
+	query, err := promql.ScopeQuery(expr, clusterIds)
+	if err != nil {
+		return out, httpErrors.NewBadRequestError(err, "SNT_INVALID_METRIC_QUERY", "")
+	}
+
+	thanosClient, err := u.dashboardUsecase.GetThanosClient(ctx, organizationId)
+	if err != nil {
+		return out, err
+	}
+	result, err := thanosClient.FetchRange(ctx, query, int(start.Unix()), int(end.Unix()), step)
+	if err != nil {
+		log.Error(ctx, err)
+		return out, httpErrors.NewInternalServerError(err, "SNR_FAILED_TO_PREVIEW", "")
+	}
+
+	for _, val := range result.Data.Result {
+		timestamps := make([]int64, 0, len(val.Values))
+		for _, value := range val.Values {
+			pair, ok := value.([]interface{})
+			if !ok || len(pair) != 2 {
+				continue
+			}
+			if ts, ok := pair[0].(float64); ok {
+				timestamps = append(timestamps, int64(math.Round(ts)))
+			}
+		}
+
+		periods := promql.FiringPeriods(timestamps, int64(step), forSeconds)
+		if len(periods) == 0 {
+			continue
+		}
+		series := domain.SystemNotificationRulePreviewSeries{
+			ClusterName:   clusterNames[val.Metric.TacoCluster],
+			Labels:        val.Metric.Labels,
+			FiringPeriods: make([]domain.SystemNotificationRuleFiringPeriod, len(periods)),
+		}
+		for i, period := range periods {
+			series.FiringPeriods[i] = domain.SystemNotificationRuleFiringPeriod{
+				StartsAt: time.Unix(period.StartsAt, 0),
+				EndsAt:   time.Unix(period.EndsAt, 0),
+			}
+		}
+		out.FiringCount += len(periods)
+		out.Series = append(out.Series, series)
+	}
+
+	return out, nil
+}
+
+// renderRule 은 알림 설정의 템플릿과 조건을 확인하고 알림 규칙의 expr 을 만든다.
+func (u *SystemNotificationRuleUsecase) renderRule(ctx context.Context, systemNotificationTemplateId uuid.UUID, duration string, parameters []domain.SystemNotificationParameter) (string, error) {
+	if duration != "" {
+		if err := promql.ValidateDuration(duration); err != nil {
+			return "", httpErrors.NewBadRequestError(err, "SNR_INVALID_CONDITION", "")
+		}
+	}
+
+	template, err := u.systemNotificationTemplateRepo.Get(ctx, systemNotificationTemplateId)
+	if err != nil {
+		return "", httpErrors.NewBadRequestError(err, "SNT_NOT_EXISTED_ALERT_TEMPLATE", "")
+	}
+	if err := promql.Validate(template.MetricQuery); err != nil {
+		return "", httpErrors.NewBadRequestError(err, "SNT_INVALID_METRIC_QUERY", "")
+	}
+
+	sorted := append([]domain.SystemNotificationParameter{}, parameters...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Order < sorted[j].Order })
+	conditions := make([]promql.Condition, len(sorted))
+	for i, parameter := range sorted {
+		conditions[i] = promql.Condition{Operator: parameter.Operator, Value: parameter.Value}
+	}
+
+	expr, err := promql.RenderRule(template.MetricQuery, conditions)
+	if err != nil {
+		return "", httpErrors.NewBadRequestError(err, "SNR_INVALID_CONDITION", "")
+	}
+	return expr, nil
+}
@@ -0,0 +1,220 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type ISystemNotificationSilenceUsecase interface {
+	Create(ctx context.Context, organizationId string, dto model.SystemNotificationSilence) (silenceId uuid.UUID, err error)
+	Get(ctx context.Context, organizationId string, silenceId uuid.UUID) (model.SystemNotificationSilence, error)
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.SystemNotificationSilence, error)
+	Expire(ctx context.Context, organizationId string, silenceId uuid.UUID) error
+
+	CreateMaintenanceWindow(ctx context.Context, organizationId string, dto model.StackMaintenanceWindow) (maintenanceWindowId uuid.UUID, err error)
+	ListMaintenanceWindows(ctx context.Context, organizationId string, stackId domain.StackId) ([]model.StackMaintenanceWindow, error)
+	UpdateMaintenanceWindow(ctx context.Context, organizationId string, stackId domain.StackId, maintenanceWindowId uuid.UUID, input domain.UpdateStackMaintenanceWindowRequest) error
+	DeleteMaintenanceWindow(ctx context.Context, organizationId string, stackId domain.StackId, maintenanceWindowId uuid.UUID) error
+}
+
+type SystemNotificationSilenceUsecase struct {
+	repo                       repository.ISystemNotificationSilenceRepository
+	clusterRepo                repository.IClusterRepository
+	systemNotificationRuleRepo repository.ISystemNotificationRuleRepository
+}
+
+func NewSystemNotificationSilenceUsecase(r repository.Repository) ISystemNotificationSilenceUsecase {
+	return &SystemNotificationSilenceUsecase{
+		repo:                       r.SystemNotificationSilence,
+		clusterRepo:                r.Cluster,
+		systemNotificationRuleRepo: r.SystemNotificationRule,
+	}
+}
+
+func (u *SystemNotificationSilenceUsecase) Create(ctx context.Context, organizationId string, dto model.SystemNotificationSilence) (silenceId uuid.UUID, err error) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return uuid.Nil, httpErrors.NewUnauthorizedError(fmt.Errorf("invalid token"), "A_INVALID_TOKEN", "")
+	}
+
+	now := time.Now()
+	if dto.StartsAt.IsZero() {
+		dto.StartsAt = now
+	}
+	if !dto.EndsAt.After(dto.StartsAt) || !dto.EndsAt.After(now) {
+		return uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("endsAt must be after startsAt and now"), "SNS_INVALID_PERIOD", "")
+	}
+
+	if !dto.HasMatcher() {
+		return uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("silence requires at least one matcher"), "SNS_NO_MATCHER", "")
+	}
+	if dto.ClusterId != "" {
+		if _, err := u.getCluster(ctx, organizationId, dto.ClusterId, "C_INVALID_CLUSTER_ID"); err != nil {
+			return uuid.Nil, err
+		}
+	}
+	if dto.SystemNotificationRuleId != nil {
+		rule, err := u.systemNotificationRuleRepo.Get(ctx, *dto.SystemNotificationRuleId)
+		if err != nil || rule.OrganizationId != organizationId {
+			return uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("not found systemNotificationRule"), "SNR_NOT_EXISTED_SYSTEM_NOTIFICATION_RULE", "")
+		}
+	}
+
+	userId := user.GetUserId()
+	dto.ID = uuid.New()
+	dto.OrganizationId = organizationId
+	dto.CreatorId = &userId
+
+	silenceId, err = u.repo.Create(ctx, dto)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewInternalServerError(err, "", "")
+	}
+	return silenceId, nil
+}
+
+func (u *SystemNotificationSilenceUsecase) Get(ctx context.Context, organizationId string, silenceId uuid.UUID) (out model.SystemNotificationSilence, err error) {
+	out, err = u.repo.Get(ctx, organizationId, silenceId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return out, httpErrors.NewNotFoundError(err, "SNS_NOT_FOUND_SILENCE", "")
+		}
+		return out, err
+	}
+	return
+}
+
+func (u *SystemNotificationSilenceUsecase) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.SystemNotificationSilence, error) {
+	return u.repo.Fetch(ctx, organizationId, pg)
+}
+
+// Expire 는 사일런스를 즉시 종료한다. 억제 이력을 남기기 위해 삭제하지 않고 종료 시각을 현재로 변경한다.
+// 아직 시작하지 않은 사일런스는 삭제한다.
+func (u *SystemNotificationSilenceUsecase) Expire(ctx context.Context, organizationId string, silenceId uuid.UUID) error {
+	silence, err := u.Get(ctx, organizationId, silenceId)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if now.Before(silence.StartsAt) {
+		return u.repo.Delete(ctx, silenceId)
+	}
+	if !silence.IsActive(now) {
+		return nil
+	}
+	return u.repo.Expire(ctx, silenceId, now)
+}
+
+func (u *SystemNotificationSilenceUsecase) CreateMaintenanceWindow(ctx context.Context, organizationId string, dto model.StackMaintenanceWindow) (maintenanceWindowId uuid.UUID, err error) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return uuid.Nil, httpErrors.NewUnauthorizedError(fmt.Errorf("invalid token"), "A_INVALID_TOKEN", "")
+	}
+
+	if _, err := u.getCluster(ctx, organizationId, domain.ClusterId(dto.StackId), "C_INVALID_STACK_ID"); err != nil {
+		return uuid.Nil, err
+	}
+	if dto.Timezone == "" {
+		dto.Timezone = internal.DefaultMaintenanceWindowTimezone
+	}
+
+	userId := user.GetUserId()
+	dto.ID = uuid.New()
+	dto.OrganizationId = organizationId
+	dto.Enabled = true
+	dto.CreatorId = &userId
+
+	maintenanceWindowId, err = u.repo.CreateMaintenanceWindow(ctx, dto)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewInternalServerError(err, "", "")
+	}
+	return maintenanceWindowId, nil
+}
+
+func (u *SystemNotificationSilenceUsecase) ListMaintenanceWindows(ctx context.Context, organizationId string, stackId domain.StackId) ([]model.StackMaintenanceWindow, error) {
+	if _, err := u.getCluster(ctx, organizationId, domain.ClusterId(stackId), "C_INVALID_STACK_ID"); err != nil {
+		return nil, err
+	}
+	return u.repo.ListMaintenanceWindows(ctx, organizationId, stackId)
+}
+
+func (u *SystemNotificationSilenceUsecase) UpdateMaintenanceWindow(ctx context.Context, organizationId string, stackId domain.StackId, maintenanceWindowId uuid.UUID, input domain.UpdateStackMaintenanceWindowRequest) error {
+	if _, err := u.getMaintenanceWindow(ctx, organizationId, stackId, maintenanceWindowId); err != nil {
+		return err
+	}
+
+	updateMap := make(map[string]interface{})
+	if input.Name != nil {
+		updateMap["name"] = *input.Name
+	}
+	if input.Description != nil {
+		updateMap["description"] = *input.Description
+	}
+	if input.Weekdays != nil {
+		weekdays, err := json.Marshal(*input.Weekdays)
+		if err != nil {
+			return httpErrors.NewBadRequestError(err, "SNS_INVALID_MAINTENANCE_WINDOW", "")
+		}
+		updateMap["weekdays"] = string(weekdays)
+	}
+	if input.StartTime != nil {
+		updateMap["start_time"] = *input.StartTime
+	}
+	if input.DurationMinute != nil {
+		updateMap["duration_minute"] = *input.DurationMinute
+	}
+	if input.Timezone != nil {
+		updateMap["timezone"] = *input.Timezone
+	}
+	if input.Enabled != nil {
+		updateMap["enabled"] = *input.Enabled
+	}
+
+	if len(updateMap) == 0 {
+		return nil
+	}
+	return u.repo.UpdateMaintenanceWindow(ctx, maintenanceWindowId, updateMap)
+}
+
+func (u *SystemNotificationSilenceUsecase) DeleteMaintenanceWindow(ctx context.Context, organizationId string, stackId domain.StackId, maintenanceWindowId uuid.UUID) error {
+	if _, err := u.getMaintenanceWindow(ctx, organizationId, stackId, maintenanceWindowId); err != nil {
+		return err
+	}
+	return u.repo.DeleteMaintenanceWindow(ctx, maintenanceWindowId)
+}
+
+func (u *SystemNotificationSilenceUsecase) getMaintenanceWindow(ctx context.Context, organizationId string, stackId domain.StackId, maintenanceWindowId uuid.UUID) (out model.StackMaintenanceWindow, err error) {
+	out, err = u.repo.GetMaintenanceWindow(ctx, organizationId, maintenanceWindowId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return out, httpErrors.NewNotFoundError(err, "SNS_NOT_FOUND_MAINTENANCE_WINDOW", "")
+		}
+		return out, err
+	}
+	if out.StackId != stackId {
+		return out, httpErrors.NewNotFoundError(fmt.Errorf("maintenance window is not of stack %s", stackId), "SNS_NOT_FOUND_MAINTENANCE_WINDOW", "")
+	}
+	return out, nil
+}
+
+// getCluster 는 클러스터(스택)가 조직에 속해 있는지 확인한다.
+func (u *SystemNotificationSilenceUsecase) getCluster(ctx context.Context, organizationId string, clusterId domain.ClusterId, code string) (model.Cluster, error) {
+	cluster, err := u.clusterRepo.Get(ctx, clusterId)
+	if err != nil || cluster.OrganizationId != organizationId {
+		return cluster, httpErrors.NewBadRequestError(fmt.Errorf("not found cluster %s", clusterId), code, "")
+	}
+	return cluster, nil
+}
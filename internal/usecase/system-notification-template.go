@@ -8,6 +8,7 @@ import (
 	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
 	"github.com/openinfradev/tks-api/internal/model"
 	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/promql"
 	"github.com/openinfradev/tks-api/internal/repository"
 	"github.com/openinfradev/tks-api/pkg/httpErrors"
 	"github.com/openinfradev/tks-api/pkg/log"
@@ -58,6 +59,10 @@ func (u *SystemNotificationTemplateUsecase) Create(ctx context.Context, dto mode
 		return uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("duplicate systemNotificationTemplate name"), "SNT_CREATE_ALREADY_EXISTED_NAME", "")
 	}
 
+	if err = validateSystemNotificationTemplate(dto); err != nil {
+		return uuid.Nil, err
+	}
+
 	systemNotificationTemplate, err = u.repo.Create(ctx, dto)
 	if err != nil {
 		return uuid.Nil, httpErrors.NewInternalServerError(err, "", "")
@@ -79,6 +84,10 @@ func (u *SystemNotificationTemplateUsecase) Update(ctx context.Context, dto mode
 		return httpErrors.NewBadRequestError(err, "SNT_NOT_EXISTED_ALERT_TEMPLATE", "")
 	}
 
+	if err = validateSystemNotificationTemplate(dto); err != nil {
+		return err
+	}
+
 	err = u.repo.Update(ctx, dto)
 	if err != nil {
 		return err
@@ -230,3 +239,23 @@ func (u *SystemNotificationTemplateUsecase) RemoveOrganizationSystemNotification
 
 	return nil
 }
+
+// validateSystemNotificationTemplate 은 메트릭 쿼리가 PromQL 로 해석되는지, 메트릭 파라미터가 라벨을 참조하는지 확인한다.
+func validateSystemNotificationTemplate(dto model.SystemNotificationTemplate) error {
+	if err := promql.Validate(dto.MetricQuery); err != nil {
+		return httpErrors.NewBadRequestError(err, "SNT_INVALID_METRIC_QUERY", "")
+	}
+
+	keys := make(map[string]bool, len(dto.MetricParameters))
+	for _, parameter := range dto.MetricParameters {
+		if keys[parameter.Key] {
+			return httpErrors.NewBadRequestError(fmt.Errorf("duplicate metric parameter key '%s'", parameter.Key), "SNT_INVALID_METRIC_PARAMETER", "")
+		}
+		keys[parameter.Key] = true
+
+		if err := promql.ValidateLabelReference(parameter.Value); err != nil {
+			return httpErrors.NewBadRequestError(err, "SNT_INVALID_METRIC_PARAMETER", "")
+		}
+	}
+	return nil
+}
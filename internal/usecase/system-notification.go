@@ -40,6 +40,10 @@ type SystemNotificationUsecase struct {
 	appGroupRepo               repository.IAppGroupRepository
 	systemNotificationRuleRepo repository.ISystemNotificationRuleRepository
 	userRepo                   repository.IUserRepository
+	policyRepo                 repository.IPolicyRepository
+	policyViolationRepo        repository.IPolicyViolationRepository
+	silenceRepo                repository.ISystemNotificationSilenceRepository
+	escalationRepo             repository.ISystemNotificationEscalationRepository
 }
 
 func NewSystemNotificationUsecase(r repository.Repository) ISystemNotificationUsecase {
@@ -50,6 +54,10 @@ func NewSystemNotificationUsecase(r repository.Repository) ISystemNotificationUs
 		organizationRepo:           r.Organization,
 		systemNotificationRuleRepo: r.SystemNotificationRule,
 		userRepo:                   r.User,
+		policyRepo:                 r.Policy,
+		policyViolationRepo:        r.PolicyViolation,
+		silenceRepo:                r.SystemNotificationSilence,
+		escalationRepo:             r.SystemNotificationEscalation,
 	}
 }
 
@@ -143,14 +151,55 @@ func (u *SystemNotificationUsecase) Create(ctx context.Context, input domain.Cre
 			} else {
 				dto.MessageActionProposal = ""
 			}
+
+			if systemNotification.Labels.ViolatingKind != "" {
+				u.recordPolicyViolation(ctx, organizationId, systemNotification)
+			}
+		}
+
+		u.suppress(ctx, &dto)
+
+		// 에스컬레이션 정책이 있는 알림은 첫 단계의 대상에게 발송하고, 이후 단계는 에스컬레이션에서 발송한다.
+		var escalationPolicy *model.SystemNotificationEscalationPolicy
+		if systemNotificationRuleId != nil && !dto.Suppressed {
+			policy, err := u.escalationRepo.GetPolicy(ctx, organizationId, *systemNotificationRuleId)
+			if err == nil && policy.Enabled && len(policy.StepsData) > 0 {
+				escalationPolicy = &policy
+				dto.EscalationLevel = 1
+			} else if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				log.Error(ctx, "Failed to get systemNotificationEscalationPolicy ", err)
+			}
 		}
 
-		_, err = u.repo.Create(ctx, dto)
+		systemNotificationId, err := u.repo.Create(ctx, dto)
 		if err != nil {
 			log.Error(ctx, "Failed to create systemNotification ", err)
 			continue
 		}
 
+		// 억제된 알림은 기록만 하고 발송하지 않는다.
+		if dto.Suppressed {
+			log.Info(ctx, fmt.Sprintf("systemNotification %s on cluster %s is suppressed", dto.Name, dto.ClusterId))
+			continue
+		}
+
+		if escalationPolicy != nil {
+			now := time.Now()
+			to := escalationRecipients(ctx, u.escalationRepo, u.organizationRepo, u.userRepo, organizationId, escalationPolicy.StepsData[:1], now)
+			if len(to) == 0 {
+				log.Warn(ctx, fmt.Sprintf("no recipients for the first escalation step of systemNotification %s", systemNotificationId))
+				continue
+			}
+			if err := sendSystemNotificationMail(ctx, organizationId, dto.MessageTitle, dto.MessageContent, to); err != nil {
+				// 첫 단계의 지연 시간이 지나면 에스컬레이션에서 다시 발송하도록 단계를 되돌린다.
+				log.Error(ctx, fmt.Sprintf("Failed to send email to %s. err : %s", to, err.Error()))
+				if _, err := u.escalationRepo.UpdateEscalationLevel(ctx, systemNotificationId, 1, 0, nil); err != nil {
+					log.Error(ctx, "Failed to revert escalation level ", err)
+				}
+			}
+			continue
+		}
+
 		if systemNotificationRuleId != nil {
 			rule, err := u.systemNotificationRuleRepo.Get(ctx, *systemNotificationRuleId)
 			if err != nil {
@@ -177,13 +226,7 @@ func (u *SystemNotificationUsecase) Create(ctx context.Context, input domain.Cre
 					}
 				}
 
-				message, err := mail.MakeSystemNotificationMessage(ctx, organizationId, systemNotification.Annotations.Message, systemNotification.Annotations.Description, to)
-				if err != nil {
-					log.Error(ctx, fmt.Sprintf("Failed to make email content. err : %s", err.Error()))
-					continue
-				}
-				mailer := mail.New(message)
-				if err := mailer.SendMail(ctx); err != nil {
+				if err := sendSystemNotificationMail(ctx, organizationId, systemNotification.Annotations.Message, systemNotification.Annotations.Description, to); err != nil {
 					log.Error(ctx, fmt.Sprintf("Failed to send email to %s. err : %s", to, err.Error()))
 					continue
 				}
@@ -195,6 +238,74 @@ func (u *SystemNotificationUsecase) Create(ctx context.Context, input domain.Cre
 	return nil
 }
 
+// sendSystemNotificationMail 은 시스템 알림 메일을 to 에게 발송한다.
+func sendSystemNotificationMail(ctx context.Context, organizationId string, title string, content string, to []string) error {
+	message, err := mail.MakeSystemNotificationMessage(ctx, organizationId, title, content, to)
+	if err != nil {
+		return errors.Wrap(err, "failed to make email content")
+	}
+	return mail.New(message).SendMail(ctx)
+}
+
+// suppress 는 알림이 조직의 사일런스나 스택의 점검 시간에 해당하면 억제된 알림으로 표시한다.
+func (u *SystemNotificationUsecase) suppress(ctx context.Context, dto *model.SystemNotification) {
+	now := time.Now()
+
+	silences, err := u.silenceRepo.ListActive(ctx, dto.OrganizationId, now)
+	if err != nil {
+		log.Error(ctx, "Failed to get systemNotificationSilences ", err)
+	}
+	for _, silence := range silences {
+		if silence.Matches(*dto) {
+			dto.Suppressed = true
+			dto.SystemNotificationSilenceId = &silence.ID
+			return
+		}
+	}
+
+	windows, err := u.silenceRepo.ListMaintenanceWindows(ctx, dto.OrganizationId, domain.StackId(dto.ClusterId))
+	if err != nil {
+		log.Error(ctx, "Failed to get stackMaintenanceWindows ", err)
+	}
+	for _, window := range windows {
+		if window.IsActive(now) {
+			dto.Suppressed = true
+			dto.StackMaintenanceWindowId = &window.ID
+			return
+		}
+	}
+}
+
+func (u *SystemNotificationUsecase) recordPolicyViolation(ctx context.Context, organizationId string, systemNotification domain.SystemNotificationRequest) {
+	labels := systemNotification.Labels
+
+	policy, err := u.policyRepo.GetByResourceName(ctx, organizationId, labels.Name)
+	if err != nil {
+		policy = nil
+	}
+
+	lastSeenAt := systemNotification.StartsAt
+	if lastSeenAt.IsZero() {
+		lastSeenAt = time.Now()
+	}
+
+	dto := newPolicyViolation(policy, model.PolicyViolation{
+		OrganizationId:     organizationId,
+		ClusterId:          domain.ClusterId(labels.TacoCluster),
+		PolicyName:         labels.Name,
+		PolicyTemplateKind: labels.Kind,
+		Namespace:          labels.ViolatingNamespace,
+		Kind:               labels.ViolatingKind,
+		Name:               labels.ViolatingName,
+		Message:            labels.ViolationMsg,
+		EnforcementAction:  labels.ViolationEnforcement,
+		LastSeenAt:         lastSeenAt,
+	})
+	if err := u.policyViolationRepo.Upsert(ctx, dto); err != nil {
+		log.Error(ctx, "Failed to record policy violation ", err)
+	}
+}
+
 func (u *SystemNotificationUsecase) Update(ctx context.Context, dto model.SystemNotification) error {
 	return nil
 }
@@ -1,23 +1,37 @@
 package usecase
 
 type Usecase struct {
-	Auth                       IAuthUsecase
-	User                       IUserUsecase
-	Cluster                    IClusterUsecase
-	Organization               IOrganizationUsecase
-	AppGroup                   IAppGroupUsecase
-	AppServeApp                IAppServeAppUsecase
-	CloudAccount               ICloudAccountUsecase
-	StackTemplate              IStackTemplateUsecase
-	Dashboard                  IDashboardUsecase
-	SystemNotification         ISystemNotificationUsecase
-	SystemNotificationTemplate ISystemNotificationTemplateUsecase
-	SystemNotificationRule     ISystemNotificationRuleUsecase
-	Stack                      IStackUsecase
-	Project                    IProjectUsecase
-	Role                       IRoleUsecase
-	Permission                 IPermissionUsecase
-	Audit                      IAuditUsecase
-	PolicyTemplate             IPolicyTemplateUsecase
-	Policy                     IPolicyUsecase
+	Auth                         IAuthUsecase
+	User                         IUserUsecase
+	Cluster                      IClusterUsecase
+	Organization                 IOrganizationUsecase
+	AppGroup                     IAppGroupUsecase
+	AppServeApp                  IAppServeAppUsecase
+	CloudAccount                 ICloudAccountUsecase
+	StackTemplate                IStackTemplateUsecase
+	Dashboard                    IDashboardUsecase
+	SystemNotification           ISystemNotificationUsecase
+	SystemNotificationTemplate   ISystemNotificationTemplateUsecase
+	SystemNotificationRule       ISystemNotificationRuleUsecase
+	Stack                        IStackUsecase
+	Project                      IProjectUsecase
+	Role                         IRoleUsecase
+	Permission                   IPermissionUsecase
+	Audit                        IAuditUsecase
+	PolicyTemplate               IPolicyTemplateUsecase
+	Policy                       IPolicyUsecase
+	AccessToken                  IAccessTokenUsecase
+	Mfa                          IMfaUsecase
+	PolicyException              IPolicyExceptionUsecase
+	PolicyViolation              IPolicyViolationUsecase
+	PolicyBundle                 IPolicyBundleUsecase
+	PolicyRollout                IPolicyRolloutUsecase
+	ComplianceReport             IComplianceReportUsecase
+	MutationPolicy               IMutationPolicyUsecase
+	PolicyChangeRequest          IPolicyChangeRequestUsecase
+	Cost                         ICostUsecase
+	ProjectUsage                 IProjectUsageUsecase
+	DashboardWidget              IDashboardWidgetUsecase
+	SystemNotificationSilence    ISystemNotificationSilenceUsecase
+	SystemNotificationEscalation ISystemNotificationEscalationUsecase
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	netmail "net/mail"
+	"strings"
 
 	"github.com/Nerzal/gocloak/v13"
 	"github.com/google/uuid"
@@ -13,6 +15,7 @@ import (
 	"github.com/openinfradev/tks-api/internal/model"
 	"github.com/openinfradev/tks-api/internal/pagination"
 	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
 	"github.com/openinfradev/tks-api/pkg/httpErrors"
 	"github.com/openinfradev/tks-api/pkg/log"
 	"github.com/pkg/errors"
@@ -29,7 +32,8 @@ type IUserUsecase interface {
 	Update(ctx context.Context, user *model.User) (*model.User, error)
 	ResetPassword(ctx context.Context, userId uuid.UUID) error
 	ResetPasswordByAccountId(ctx context.Context, accountId string, organizationId string) error
-	GenerateRandomPassword(ctx context.Context) string
+	UnlockByAccountId(ctx context.Context, accountId string, organizationId string) error
+	GenerateRandomPassword(ctx context.Context, organizationId string) string
 	Delete(ctx context.Context, userId uuid.UUID, organizationId string) error
 	GetByAccountId(ctx context.Context, accountId string, organizationId string) (*model.User, error)
 	GetByEmail(ctx context.Context, email string, organizationId string) (*model.User, error)
@@ -46,6 +50,10 @@ type IUserUsecase interface {
 	UpdateByAccountIdByAdmin(ctx context.Context, user *model.User) (*model.User, error)
 
 	ListUsersByRole(ctx context.Context, organizationId string, roleId string, pg *pagination.Pagination) (*[]model.User, error)
+
+	ImportUsers(ctx context.Context, organizationId string, rows []domain.ImportUserRow, dryRun bool) (domain.ImportUsersResponse, []model.User, error)
+	RollbackImportedUsers(ctx context.Context, organizationId string, users []model.User)
+	SendImportedUserPasswords(ctx context.Context, organizationId string, out *domain.ImportUsersResponse, users []model.User)
 }
 
 type UserUsecase struct {
@@ -100,7 +108,7 @@ func (u *UserUsecase) ResetPassword(ctx context.Context, userId uuid.UUID) error
 		return httpErrors.NewInternalServerError(err, "", "")
 	}
 
-	randomPassword := helper.GenerateRandomString(passwordLength)
+	randomPassword := user.Organization.PasswordPolicy.GenerateRandomPassword()
 	userInKeycloak.Credentials = &[]gocloak.CredentialRepresentation{
 		{
 			Type:      gocloak.StringP("password"),
@@ -115,6 +123,7 @@ func (u *UserUsecase) ResetPassword(ctx context.Context, userId uuid.UUID) error
 	if err = u.userRepository.UpdatePasswordAt(ctx, userId, user.Organization.ID, true); err != nil {
 		return httpErrors.NewInternalServerError(err, "", "")
 	}
+	recordPasswordHistory(ctx, u.userRepository, userId, randomPassword)
 
 	message, err := mail.MakeTemporaryPasswordMessage(ctx, user.Email, user.Organization.ID, user.AccountId, randomPassword)
 	if err != nil {
@@ -142,8 +151,30 @@ func (u *UserUsecase) ResetPasswordByAccountId(ctx context.Context, accountId st
 	return u.ResetPassword(ctx, user.ID)
 }
 
-func (u *UserUsecase) GenerateRandomPassword(ctx context.Context) string {
-	return helper.GenerateRandomString(passwordLength)
+func (u *UserUsecase) UnlockByAccountId(ctx context.Context, accountId string, organizationId string) error {
+	user, err := u.userRepository.Get(ctx, accountId, organizationId)
+	if err != nil {
+		if _, status := httpErrors.ErrorResponse(err); status == http.StatusNotFound {
+			return httpErrors.NewBadRequestError(fmt.Errorf("user not found"), "U_NO_USER", "")
+		}
+		return httpErrors.NewInternalServerError(err, "", "")
+	}
+
+	if err := u.userRepository.UpdateLockedUntil(ctx, user.ID, nil); err != nil {
+		return httpErrors.NewInternalServerError(err, "", "")
+	}
+	if err := u.authRepository.DeleteAuthAttemptsByAccount(ctx, authAttemptLogin, organizationId, accountId); err != nil {
+		return httpErrors.NewInternalServerError(err, "", "")
+	}
+	return nil
+}
+
+func (u *UserUsecase) GenerateRandomPassword(ctx context.Context, organizationId string) string {
+	organization, err := u.organizationRepository.Get(ctx, organizationId)
+	if err != nil {
+		return model.DefaultPasswordPolicy().GenerateRandomPassword()
+	}
+	return organization.PasswordPolicy.GenerateRandomPassword()
 }
 
 func (u *UserUsecase) ValidateAccount(ctx context.Context, userId uuid.UUID, password string, organizationId string) error {
@@ -201,7 +232,7 @@ func (u *UserUsecase) DeleteAdmin(ctx context.Context, organizationId string) er
 
 func (u *UserUsecase) CreateAdmin(ctx context.Context, user *model.User) (*model.User, error) {
 	// Generate Admin user object
-	randomPassword := helper.GenerateRandomString(passwordLength)
+	randomPassword := u.GenerateRandomPassword(ctx, user.Organization.ID)
 	user.Password = randomPassword
 
 	// Create Admin user in keycloak & DB
@@ -256,6 +287,14 @@ func (u *UserUsecase) UpdatePasswordByAccountId(ctx context.Context, accountId s
 	if _, err := u.kc.Login(ctx, accountId, originPassword, organizationId); err != nil {
 		return httpErrors.NewBadRequestError(fmt.Errorf("invalid origin password"), "A_INVALID_PASSWORD", "")
 	}
+	user, err := u.userRepository.Get(ctx, accountId, organizationId)
+	if err != nil {
+		return errors.Wrap(err, "getting user from repository failed")
+	}
+	if err = checkPasswordPolicy(ctx, u.userRepository, user.ID, user.Organization.PasswordPolicy, newPassword); err != nil {
+		return err
+	}
+
 	originUser, err := u.kc.GetUser(ctx, organizationId, accountId)
 	if err != nil {
 		return err
@@ -274,15 +313,11 @@ func (u *UserUsecase) UpdatePasswordByAccountId(ctx context.Context, accountId s
 	}
 
 	// update password UpdateAt in DB
-	user, err := u.userRepository.Get(ctx, accountId, organizationId)
-	if err != nil {
-		return errors.Wrap(err, "getting user from repository failed")
-	}
-
 	err = u.userRepository.UpdatePasswordAt(ctx, user.ID, organizationId, false)
 	if err != nil {
 		return errors.Wrap(err, "updating user in repository failed")
 	}
+	recordPasswordHistory(ctx, u.userRepository, user.ID, newPassword)
 
 	return nil
 }
@@ -422,6 +457,14 @@ func (u *UserUsecase) DeleteByAccountId(ctx context.Context, accountId string, o
 }
 
 func (u *UserUsecase) Create(ctx context.Context, user *model.User) (*model.User, error) {
+	organization, err := u.organizationRepository.Get(ctx, user.Organization.ID)
+	if err != nil {
+		return nil, httpErrors.NewBadRequestError(err, "C_INVALID_ORGANIZATION_ID", "")
+	}
+	if err = organization.PasswordPolicy.Check(user.Password); err != nil {
+		return nil, httpErrors.NewBadRequestError(err, "A_PASSWORD_POLICY_VIOLATION", "")
+	}
+
 	// Create user in keycloak
 	var groups []string
 	for _, role := range user.Roles {
@@ -446,6 +489,7 @@ func (u *UserUsecase) Create(ctx context.Context, user *model.User) (*model.User
 	}
 
 	if user.ID, err = uuid.Parse(userUuidStr); err != nil {
+		u.deleteKeycloakUser(ctx, user.Organization.ID, user.AccountId)
 		return nil, err
 	}
 
@@ -454,8 +498,11 @@ func (u *UserUsecase) Create(ctx context.Context, user *model.User) (*model.User
 	//resUser, err := u.userRepository.Create(ctx, userUuid, user.AccountId, user.Name, user.Email,
 	//	user.Department, user.Description, user.Organization.ID, roleUuid)
 	if err != nil {
+		// DB 에 저장하지 못하면 keycloak 에 만든 사용자도 삭제하여 사용자가 반쯤 생성된 상태로 남지 않도록 한다.
+		u.deleteKeycloakUser(ctx, user.Organization.ID, user.AccountId)
 		return nil, err
 	}
+	recordPasswordHistory(ctx, u.userRepository, resUser.ID, user.Password)
 
 	return resUser, nil
 }
@@ -528,6 +575,146 @@ func (u *UserUsecase) ListUsersByRole(ctx context.Context, organizationId string
 
 }
 
+// ImportUsers 는 CSV 로 전달된 사용자들을 일괄 생성한다.
+// 모든 행이 유효한 경우에만 생성하며, 생성 도중 실패하면 이번 가져오기에서 생성한 사용자만 삭제한다.
+// 반환하는 사용자에는 임시 비밀번호가 들어 있으며, 이후 처리까지 성공하면 SendImportedUserPasswords 로 발송한다.
+func (u *UserUsecase) ImportUsers(ctx context.Context, organizationId string, rows []domain.ImportUserRow, dryRun bool) (out domain.ImportUsersResponse, created []model.User, err error) {
+	out.DryRun = dryRun
+	out.Total = len(rows)
+	out.Results = make([]domain.ImportUserResult, len(rows))
+
+	users := make([]model.User, len(rows))
+	accountIdLines := make(map[string]int)
+	emailLines := make(map[string]int)
+	for i, row := range rows {
+		result := &out.Results[i]
+		result.Line = row.Line
+		result.AccountId = row.AccountId
+		result.Email = row.Email
+
+		result.Errors, err = u.validateImportUserRow(ctx, organizationId, row)
+		if err != nil {
+			return out, nil, err
+		}
+		if line, ok := accountIdLines[row.AccountId]; ok && row.AccountId != "" {
+			result.Errors = append(result.Errors, fmt.Sprintf("accountId is duplicated with line %d", line))
+		}
+		if line, ok := emailLines[row.Email]; ok && row.Email != "" {
+			result.Errors = append(result.Errors, fmt.Sprintf("email is duplicated with line %d", line))
+		}
+		accountIdLines[row.AccountId] = row.Line
+		emailLines[row.Email] = row.Line
+
+		users[i] = model.User{
+			AccountId:    row.AccountId,
+			Name:         row.Name,
+			Email:        row.Email,
+			Department:   row.Department,
+			Organization: model.Organization{ID: organizationId},
+		}
+		for _, roleName := range row.Roles {
+			role, err := u.roleRepository.GetTksRoleByRoleName(ctx, organizationId, roleName)
+			if err != nil {
+				result.Errors = append(result.Errors, fmt.Sprintf("role '%s' does not exist", roleName))
+				continue
+			}
+			users[i].Roles = append(users[i].Roles, *role)
+		}
+
+		if len(result.Errors) > 0 {
+			out.Invalid++
+		}
+	}
+	if dryRun || out.Invalid > 0 {
+		return out, nil, nil
+	}
+
+	created = make([]model.User, 0, len(users))
+	for i := range users {
+		users[i].Password = u.GenerateRandomPassword(ctx, organizationId)
+		// Create 는 실패하면 만든 것을 남기지 않으므로, 실패한 행은 되돌릴 대상에 넣지 않는다.
+		// 검증 이후 다른 요청이 같은 계정을 만든 경우에도 그 사용자를 삭제하지 않기 위해서이다.
+		resUser, err := u.Create(ctx, &users[i])
+		if err == nil {
+			resUser.Password = users[i].Password
+			created = append(created, *resUser)
+			// 최초 로그인 시 비밀번호를 변경하도록 임시 비밀번호로 설정한다.
+			err = u.userRepository.UpdatePasswordAt(ctx, resUser.ID, organizationId, true)
+		}
+		if err != nil {
+			log.Errorf(ctx, "failed to import user(line %d). err : %s", rows[i].Line, err)
+			u.RollbackImportedUsers(ctx, organizationId, created)
+			return out, nil, httpErrors.NewInternalServerError(errors.Wrapf(err, "failed to create user of line %d", rows[i].Line), "U_FAILED_TO_IMPORT_USERS", "")
+		}
+	}
+
+	for i := range created {
+		out.Results[i].Created = true
+		out.Created++
+	}
+
+	return out, created, nil
+}
+
+// SendImportedUserPasswords 는 ImportUsers 로 생성한 사용자에게 임시 비밀번호를 발송한다.
+func (u *UserUsecase) SendImportedUserPasswords(ctx context.Context, organizationId string, out *domain.ImportUsersResponse, users []model.User) {
+	for i, user := range users {
+		if err := u.SendEmailForTemporaryPassword(ctx, user.AccountId, organizationId, user.Password); err != nil {
+			log.Errorf(ctx, "failed to send temporary password to %s. err : %s", user.AccountId, err)
+			continue
+		}
+		out.Results[i].EmailSent = true
+	}
+}
+
+func (u *UserUsecase) validateImportUserRow(ctx context.Context, organizationId string, row domain.ImportUserRow) (errs []string, err error) {
+	errs = make([]string, 0)
+	if row.AccountId == "" {
+		errs = append(errs, "accountId is required")
+	} else if _, err := u.GetByAccountId(ctx, row.AccountId, organizationId); err == nil {
+		errs = append(errs, "accountId already exists")
+	} else if _, status := httpErrors.ErrorResponse(err); status != http.StatusNotFound {
+		return nil, err
+	}
+
+	if row.Email == "" {
+		errs = append(errs, "email is required")
+	} else if address, err := netmail.ParseAddress(row.Email); err != nil || address.Address != row.Email {
+		errs = append(errs, "email is invalid")
+	} else if _, err := u.GetByEmail(ctx, row.Email, organizationId); err == nil {
+		errs = append(errs, "email already exists")
+	} else if _, status := httpErrors.ErrorResponse(err); status != http.StatusNotFound {
+		return nil, err
+	}
+
+	if strings.TrimSpace(row.Name) == "" {
+		errs = append(errs, "name is required")
+	}
+	if len([]rune(row.Department)) > 50 {
+		errs = append(errs, "department must be at most 50 characters")
+	}
+	if len(row.Roles) == 0 {
+		errs = append(errs, "role is required")
+	}
+	return errs, nil
+}
+
+// RollbackImportedUsers 는 ImportUsers 가 생성한 사용자를 DB 와 keycloak 에서 삭제한다.
+func (u *UserUsecase) RollbackImportedUsers(ctx context.Context, organizationId string, users []model.User) {
+	for _, user := range users {
+		if err := u.userRepository.DeleteWithUuid(ctx, user.ID); err != nil {
+			log.Errorf(ctx, "failed to rollback user %s in DB. err : %s", user.AccountId, err)
+		}
+		u.deleteKeycloakUser(ctx, organizationId, user.AccountId)
+	}
+}
+
+func (u *UserUsecase) deleteKeycloakUser(ctx context.Context, organizationId string, accountId string) {
+	if err := u.kc.DeleteUser(ctx, organizationId, accountId); err != nil {
+		log.Errorf(ctx, "failed to delete user %s in keycloak. err : %s", accountId, err)
+	}
+}
+
 func NewUserUsecase(r repository.Repository, kc keycloak.IKeycloak) IUserUsecase {
 	return &UserUsecase{
 		authRepository:         r.Auth,
@@ -537,3 +724,36 @@ func NewUserUsecase(r repository.Repository, kc keycloak.IKeycloak) IUserUsecase
 		organizationRepository: r.Organization,
 	}
 }
+
+// checkPasswordPolicy validates the new password against the organization password policy and the password history.
+func checkPasswordPolicy(ctx context.Context, repo repository.IUserRepository, userId uuid.UUID, policy model.PasswordPolicy, password string) error {
+	if err := policy.Check(password); err != nil {
+		return httpErrors.NewBadRequestError(err, "A_PASSWORD_POLICY_VIOLATION", "")
+	}
+	if !policy.ReuseBanned || policy.HistoryDepth <= 0 {
+		return nil
+	}
+
+	histories, err := repo.ListPasswordHistories(ctx, userId, policy.HistoryDepth)
+	if err != nil {
+		return httpErrors.NewInternalServerError(err, "", "")
+	}
+	for _, history := range histories {
+		if helper.CheckPasswordHash(history.PasswordHash, password) {
+			return httpErrors.NewBadRequestError(fmt.Errorf("password was used recently"), "A_REUSED_PASSWORD", "")
+		}
+	}
+	return nil
+}
+
+// recordPasswordHistory 는 비밀번호 변경 이력을 남긴다. 이력 저장 실패가 비밀번호 변경을 막지는 않는다.
+func recordPasswordHistory(ctx context.Context, repo repository.IUserRepository, userId uuid.UUID, password string) {
+	hashed, err := helper.HashPassword(password)
+	if err != nil {
+		log.Error(ctx, "failed to hash password. err : ", err)
+		return
+	}
+	if err = repo.CreatePasswordHistory(ctx, userId, hashed); err != nil {
+		log.Error(ctx, "failed to create password history. err : ", err)
+	}
+}
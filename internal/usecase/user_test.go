@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/Nerzal/gocloak/v13"
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/keycloak"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+)
+
+// fakeUserRepository 는 사용자 가져오기에 필요한 메소드만 구현한다.
+// AccountIdFilter, EmailFilter 는 다음 List 호출의 조회 조건을 기록한다.
+type fakeUserRepository struct {
+	repository.IUserRepository
+	users     map[string]model.User
+	accountId string
+	email     string
+	deleted   []uuid.UUID
+}
+
+func (r *fakeUserRepository) OrganizationFilter(organization string) repository.FilterFunc {
+	return nil
+}
+
+func (r *fakeUserRepository) AccountIdFilter(accountId string) repository.FilterFunc {
+	r.accountId, r.email = accountId, ""
+	return nil
+}
+
+func (r *fakeUserRepository) EmailFilter(email string) repository.FilterFunc {
+	r.accountId, r.email = "", email
+	return nil
+}
+
+func (r *fakeUserRepository) List(ctx context.Context, filters ...repository.FilterFunc) (*[]model.User, error) {
+	for _, user := range r.users {
+		if (r.accountId != "" && user.AccountId == r.accountId) || (r.email != "" && user.Email == r.email) {
+			return &[]model.User{user}, nil
+		}
+	}
+	return nil, httpErrors.NewNotFoundError(httpErrors.NotFound, "", "")
+}
+
+func (r *fakeUserRepository) Create(ctx context.Context, user *model.User) (*model.User, error) {
+	r.users[user.AccountId] = *user
+	return user, nil
+}
+
+func (r *fakeUserRepository) UpdatePasswordAt(ctx context.Context, userId uuid.UUID, organizationId string, isTemporary bool) error {
+	return nil
+}
+
+func (r *fakeUserRepository) CreatePasswordHistory(ctx context.Context, userId uuid.UUID, passwordHash string) error {
+	return nil
+}
+
+func (r *fakeUserRepository) DeleteWithUuid(ctx context.Context, userId uuid.UUID) error {
+	r.deleted = append(r.deleted, userId)
+	return nil
+}
+
+type fakeRoleRepository struct {
+	repository.IRoleRepository
+}
+
+func (r *fakeRoleRepository) GetTksRoleByRoleName(ctx context.Context, organizationId string, roleName string) (*model.Role, error) {
+	if roleName != "admin" && roleName != "user" {
+		return nil, fmt.Errorf("not found")
+	}
+	return &model.Role{ID: roleName, Name: roleName}, nil
+}
+
+// fakeKeycloak 은 existing 에 있는 계정의 생성을 실패시킨다.
+type fakeKeycloak struct {
+	keycloak.IKeycloak
+	existing []string
+	deleted  []string
+}
+
+func (k *fakeKeycloak) CreateUser(ctx context.Context, organizationId string, user *gocloak.User) (string, error) {
+	if slices.Contains(k.existing, *user.Username) {
+		return "", fmt.Errorf("user exists with same username")
+	}
+	return uuid.New().String(), nil
+}
+
+func (k *fakeKeycloak) DeleteUser(ctx context.Context, organizationId string, userAccountId string) error {
+	k.deleted = append(k.deleted, userAccountId)
+	return nil
+}
+
+func newImportUserUsecase(users map[string]model.User, kc *fakeKeycloak) (*UserUsecase, *fakeUserRepository) {
+	userRepo := &fakeUserRepository{users: users}
+	return &UserUsecase{
+		userRepository: userRepo,
+		roleRepository: &fakeRoleRepository{},
+		organizationRepository: &fakeOrganizationRepository{organization: model.Organization{
+			ID:             "org1",
+			PasswordPolicy: model.DefaultPasswordPolicy(),
+		}},
+		kc: kc,
+	}, userRepo
+}
+
+func TestImportUsersDryRun(t *testing.T) {
+	kc := &fakeKeycloak{}
+	u, _ := newImportUserUsecase(map[string]model.User{
+		"existing": {AccountId: "existing", Email: "existing@example.com"},
+	}, kc)
+
+	rows := []domain.ImportUserRow{
+		{Line: 2, AccountId: "alice", Name: "Alice", Email: "alice@example.com", Roles: []string{"user"}},
+		{Line: 3, AccountId: "existing", Name: "Existing", Email: "existing@example.com", Roles: []string{"user"}},
+		{Line: 4, AccountId: "alice", Name: "", Email: "Alice <alice@example.com>", Roles: []string{"unknown"}},
+		{Line: 5, AccountId: "", Name: "Bob", Email: "", Roles: []string{}},
+	}
+
+	out, created, err := u.ImportUsers(context.Background(), "org1", rows, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(created) != 0 || !out.DryRun || out.Total != 4 || out.Invalid != 3 {
+		t.Fatalf("unexpected result: created=%d out=%+v", len(created), out)
+	}
+
+	want := [][]string{
+		nil,
+		{"accountId already exists", "email already exists"},
+		{"email is invalid", "accountId is duplicated with line 2", "name is required", "role 'unknown' does not exist"},
+		{"accountId is required", "email is required", "role is required"},
+	}
+	for i, result := range out.Results {
+		got := slices.Clone(result.Errors)
+		slices.Sort(got)
+		expected := slices.Clone(want[i])
+		slices.Sort(expected)
+		if !slices.Equal(got, expected) {
+			t.Errorf("line %d: errors = %v, want %v", result.Line, result.Errors, want[i])
+		}
+	}
+}
+
+func TestImportUsersRollbackOnlyCreatedUsers(t *testing.T) {
+	// 검증 이후 다른 요청이 bob 을 keycloak 에 먼저 만든 경우
+	kc := &fakeKeycloak{existing: []string{"bob"}}
+	u, userRepo := newImportUserUsecase(map[string]model.User{}, kc)
+
+	rows := []domain.ImportUserRow{
+		{Line: 2, AccountId: "alice", Name: "Alice", Email: "alice@example.com", Roles: []string{"user"}},
+		{Line: 3, AccountId: "bob", Name: "Bob", Email: "bob@example.com", Roles: []string{"user"}},
+		{Line: 4, AccountId: "carol", Name: "Carol", Email: "carol@example.com", Roles: []string{"user"}},
+	}
+
+	_, created, err := u.ImportUsers(context.Background(), "org1", rows, false)
+	if err == nil {
+		t.Fatal("expected import to fail")
+	}
+	if len(created) != 0 {
+		t.Errorf("no user must be reported as created, got %d", len(created))
+	}
+	if !slices.Equal(kc.deleted, []string{"alice"}) {
+		t.Errorf("only the user created by the import must be deleted from keycloak, got %v", kc.deleted)
+	}
+	if len(userRepo.deleted) != 1 || userRepo.deleted[0] != userRepo.users["alice"].ID {
+		t.Errorf("only the user created by the import must be deleted from DB, got %v", userRepo.deleted)
+	}
+}
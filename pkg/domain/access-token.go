@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"time"
+)
+
+// enum
+type AccessTokenType int32
+
+const (
+	AccessTokenType_PERSONAL AccessTokenType = iota
+	AccessTokenType_SERVICE_ACCOUNT
+)
+
+var accessTokenType = [...]string{
+	"PERSONAL",
+	"SERVICE_ACCOUNT",
+}
+
+var accessTokenTypeMap = map[string]AccessTokenType{
+	"PERSONAL":        AccessTokenType_PERSONAL,
+	"SERVICE_ACCOUNT": AccessTokenType_SERVICE_ACCOUNT,
+}
+
+func (m AccessTokenType) String() string { return accessTokenType[(m)] }
+func (m AccessTokenType) FromString(s string) AccessTokenType {
+	if v, ok := accessTokenTypeMap[s]; ok {
+		return v
+	}
+	return AccessTokenType_PERSONAL
+}
+
+type AccessTokenResponse struct {
+	ID             string             `json:"id"`
+	OrganizationId string             `json:"organizationId"`
+	Name           string             `json:"name"`
+	Description    string             `json:"description"`
+	TokenType      string             `json:"tokenType" enum:"PERSONAL,SERVICE_ACCOUNT"`
+	TokenPrefix    string             `json:"tokenPrefix"`
+	Scopes         []string           `json:"scopes" example:"GetStacks,Policy"`
+	ExpiredAt      *time.Time         `json:"expiredAt"`
+	LastUsedAt     *time.Time         `json:"lastUsedAt"`
+	RevokedAt      *time.Time         `json:"revokedAt"`
+	Creator        SimpleUserResponse `json:"creator"`
+	CreatedAt      time.Time          `json:"createdAt"`
+	UpdatedAt      time.Time          `json:"updatedAt"`
+}
+
+type CreateAccessTokenRequest struct {
+	Name        string   `json:"name" validate:"required,name"`
+	Description string   `json:"description" validate:"min=0,max=100"`
+	Scopes      []string `json:"scopes" validate:"required,min=1" example:"GetStacks,Policy"`
+	ExpiresIn   int      `json:"expiresIn" validate:"min=0,max=365" example:"90"` // days. 0 means the token never expires.
+}
+
+type CreateAccessTokenResponse struct {
+	ID        string     `json:"id"`
+	Token     string     `json:"token"` // plain token is returned only once on creation
+	ExpiredAt *time.Time `json:"expiredAt"`
+}
+
+type GetAccessTokensResponse struct {
+	AccessTokens []AccessTokenResponse `json:"accessTokens"`
+	Pagination   PaginationResponse    `json:"pagination"`
+}
+
+type ServiceAccountResponse struct {
+	ID             string             `json:"id"`
+	OrganizationId string             `json:"organizationId"`
+	Name           string             `json:"name"`
+	Description    string             `json:"description"`
+	Role           SimpleRoleResponse `json:"role"`
+	Creator        SimpleUserResponse `json:"creator"`
+	CreatedAt      time.Time          `json:"createdAt"`
+	UpdatedAt      time.Time          `json:"updatedAt"`
+}
+
+type CreateServiceAccountRequest struct {
+	Name        string `json:"name" validate:"required,name"`
+	Description string `json:"description" validate:"min=0,max=100"`
+	RoleId      string `json:"roleId" validate:"required"`
+}
+
+type CreateServiceAccountResponse struct {
+	ID string `json:"id"`
+}
+
+type GetServiceAccountResponse struct {
+	ServiceAccount ServiceAccountResponse `json:"serviceAccount"`
+}
+
+type GetServiceAccountsResponse struct {
+	ServiceAccounts []ServiceAccountResponse `json:"serviceAccounts"`
+	Pagination      PaginationResponse       `json:"pagination"`
+}
@@ -4,6 +4,7 @@ type LoginRequest struct {
 	AccountId      string `json:"accountId" validate:"required"`
 	Password       string `json:"password" validate:"required"`
 	OrganizationId string `json:"organizationId" validate:"required"`
+	OtpCode        string `json:"otpCode"`
 }
 
 type LoginResponse struct {
@@ -15,6 +16,8 @@ type LoginResponse struct {
 		Department      string               `json:"department"`
 		Organization    OrganizationResponse `json:"organization"`
 		PasswordExpired bool                 `json:"passwordExpired"`
+
+		MfaEnrollmentRequired bool `json:"mfaEnrollmentRequired"`
 	} `json:"user"`
 }
 
@@ -11,6 +11,7 @@ const (
 	CloudService_AWS       = "AWS"
 	CloudService_AZURE     = "AZZURE"
 	CloudService_GCP       = "GCP"
+	CloudService_OPENSTACK = "OPENSTACK"
 	CloudService_BYOH      = "BYOH"
 )
 
@@ -47,6 +48,15 @@ func (m CloudAccountStatus) FromString(s string) CloudAccountStatus {
 	return CloudAccountStatus_PENDING
 }
 
+// CloudAccountCredentialStatus 는 TKS 가 계정에 생성한 IAM 을 사용할 수 있는지 주기적으로 확인한 결과이다.
+type CloudAccountCredentialStatus string
+
+const (
+	CloudAccountCredentialStatus_UNKNOWN   CloudAccountCredentialStatus = "UNKNOWN"
+	CloudAccountCredentialStatus_HEALTHY   CloudAccountCredentialStatus = "HEALTHY"
+	CloudAccountCredentialStatus_UNHEALTHY CloudAccountCredentialStatus = "UNHEALTHY"
+)
+
 type ResourceQuotaAttr struct {
 	Type     string `json:"type"`
 	Usage    int    `json:"usage"`
@@ -59,31 +69,43 @@ type ResourceQuota struct {
 }
 
 type CloudAccountResponse struct {
-	ID             string             `json:"id"`
-	OrganizationId string             `json:"organizationId"`
-	Name           string             `json:"name"`
-	Description    string             `json:"description"`
-	CloudService   string             `json:"cloudService"`
-	Resource       string             `json:"resource"`
-	Clusters       int                `json:"clusters"`
-	Status         string             `json:"status"`
-	AwsAccountId   string             `json:"awsAccountId"`
-	CreatedIAM     bool               `json:"createdIAM"`
-	Creator        SimpleUserResponse `json:"creator"`
-	Updator        SimpleUserResponse `json:"updator"`
-	CreatedAt      time.Time          `json:"createdAt"`
-	UpdatedAt      time.Time          `json:"updatedAt"`
+	ID                  string                       `json:"id"`
+	OrganizationId      string                       `json:"organizationId"`
+	Name                string                       `json:"name"`
+	Description         string                       `json:"description"`
+	CloudService        string                       `json:"cloudService"`
+	Resource            string                       `json:"resource"`
+	Clusters            int                          `json:"clusters"`
+	Status              string                       `json:"status"`
+	AwsAccountId        string                       `json:"awsAccountId"`
+	AccountId           string                       `json:"accountId"`
+	TenantId            string                       `json:"tenantId"`
+	Endpoint            string                       `json:"endpoint"`
+	AllowedRegions      []string                     `json:"allowedRegions"`
+	CredentialStatus    CloudAccountCredentialStatus `json:"credentialStatus"`
+	CredentialCheckedAt *time.Time                   `json:"credentialCheckedAt,omitempty"`
+	CredentialError     string                       `json:"credentialError,omitempty"`
+	CreatedIAM          bool                         `json:"createdIAM"`
+	Creator             SimpleUserResponse           `json:"creator"`
+	Updator             SimpleUserResponse           `json:"updator"`
+	CreatedAt           time.Time                    `json:"createdAt"`
+	UpdatedAt           time.Time                    `json:"updatedAt"`
 }
 
 type SimpleCloudAccountResponse struct {
-	ID             string `json:"id"`
-	OrganizationId string `json:"organizationId"`
-	Name           string `json:"name"`
-	Description    string `json:"description"`
-	CloudService   string `json:"cloudService"`
-	AwsAccountId   string `json:"awsAccountId"`
-	CreatedIAM     bool   `json:"createdIAM"`
-	Clusters       int    `json:"clusters"`
+	ID               string                       `json:"id"`
+	OrganizationId   string                       `json:"organizationId"`
+	Name             string                       `json:"name"`
+	Description      string                       `json:"description"`
+	CloudService     string                       `json:"cloudService"`
+	AwsAccountId     string                       `json:"awsAccountId"`
+	AccountId        string                       `json:"accountId"`
+	TenantId         string                       `json:"tenantId"`
+	Endpoint         string                       `json:"endpoint"`
+	AllowedRegions   []string                     `json:"allowedRegions"`
+	CredentialStatus CloudAccountCredentialStatus `json:"credentialStatus"`
+	CreatedIAM       bool                         `json:"createdIAM"`
+	Clusters         int                          `json:"clusters"`
 }
 
 type GetCloudAccountsResponse struct {
@@ -95,14 +117,25 @@ type GetCloudAccountResponse struct {
 	CloudAccount CloudAccountResponse `json:"cloudAccount"`
 }
 
+// CreateCloudAccountRequest 의 accountId 는 Azure subscription id, GCP project id, OpenStack project id 이며
+// AWS 는 awsAccountId 를 사용한다. 인증 정보는 cloudService 에 맞는 항목만 입력한다.
 type CreateCloudAccountRequest struct {
-	Name            string `json:"name" validate:"required,name"`
-	Description     string `json:"description"`
-	CloudService    string `json:"cloudService" validate:"oneof=AWS AZZURE GCP"`
-	AwsAccountId    string `json:"awsAccountId" validate:"required,min=12,max=12"`
-	AccessKeyId     string `json:"accessKeyId" validate:"required,min=16,max=128"`
-	SecretAccessKey string `json:"secretAccessKey" validate:"required,min=16,max=128"`
-	SessionToken    string `json:"sessionToken" validate:"max=2000"`
+	Name              string   `json:"name" validate:"required,name"`
+	Description       string   `json:"description"`
+	CloudService      string   `json:"cloudService" validate:"oneof=AWS AZZURE GCP OPENSTACK"`
+	AwsAccountId      string   `json:"awsAccountId" validate:"required_if=CloudService AWS,omitempty,min=12,max=12"`
+	AccountId         string   `json:"accountId" validate:"max=128"`
+	TenantId          string   `json:"tenantId" validate:"max=128"`
+	Endpoint          string   `json:"endpoint" validate:"omitempty,url"`
+	AllowedRegions    []string `json:"allowedRegions" validate:"max=20,dive,required,max=64"`
+	AccessKeyId       string   `json:"accessKeyId" validate:"required_if=CloudService AWS,omitempty,min=16,max=128"`
+	SecretAccessKey   string   `json:"secretAccessKey" validate:"required_if=CloudService AWS,omitempty,min=16,max=128"`
+	SessionToken      string   `json:"sessionToken" validate:"max=2000"`
+	ClientId          string   `json:"clientId" validate:"max=128"`
+	ClientSecret      string   `json:"clientSecret" validate:"max=256"`
+	ServiceAccountKey string   `json:"serviceAccountKey" validate:"max=8192"`
+	Username          string   `json:"username" validate:"max=128"`
+	Password          string   `json:"password" validate:"max=256"`
 }
 
 type CreateCloudAccountResponse struct {
@@ -110,13 +143,37 @@ type CreateCloudAccountResponse struct {
 }
 
 type UpdateCloudAccountRequest struct {
-	Description string `json:"description"`
+	Description    string   `json:"description"`
+	AllowedRegions []string `json:"allowedRegions,omitempty" validate:"omitempty,max=20,dive,required,max=64"`
 }
 
 type DeleteCloudAccountRequest struct {
-	AccessKeyId     string `json:"accessKeyId" validate:"required,min=16,max=128"`
-	SecretAccessKey string `json:"secretAccessKey" validate:"required,min=16,max=128"`
-	SessionToken    string `json:"sessionToken" validate:"max=2000"`
+	AccessKeyId       string `json:"accessKeyId" validate:"omitempty,min=16,max=128"`
+	SecretAccessKey   string `json:"secretAccessKey" validate:"omitempty,min=16,max=128"`
+	SessionToken      string `json:"sessionToken" validate:"max=2000"`
+	ClientId          string `json:"clientId" validate:"max=128"`
+	ClientSecret      string `json:"clientSecret" validate:"max=256"`
+	ServiceAccountKey string `json:"serviceAccountKey" validate:"max=8192"`
+	Username          string `json:"username" validate:"max=128"`
+	Password          string `json:"password" validate:"max=256"`
+}
+
+// RotateCloudAccountCredentialRequest 는 새 인증 정보로 IAM 생성 워크플로우를 다시 실행한다. cloudService 에 맞는 항목만 입력한다.
+type RotateCloudAccountCredentialRequest struct {
+	AccessKeyId       string `json:"accessKeyId" validate:"omitempty,min=16,max=128"`
+	SecretAccessKey   string `json:"secretAccessKey" validate:"omitempty,min=16,max=128"`
+	SessionToken      string `json:"sessionToken" validate:"max=2000"`
+	ClientId          string `json:"clientId" validate:"max=128"`
+	ClientSecret      string `json:"clientSecret" validate:"max=256"`
+	ServiceAccountKey string `json:"serviceAccountKey" validate:"max=8192"`
+	Username          string `json:"username" validate:"max=128"`
+	Password          string `json:"password" validate:"max=256"`
+}
+
+type CloudAccountCredentialStatusResponse struct {
+	CredentialStatus    CloudAccountCredentialStatus `json:"credentialStatus"`
+	CredentialCheckedAt *time.Time                   `json:"credentialCheckedAt,omitempty"`
+	CredentialError     string                       `json:"credentialError,omitempty"`
 }
 
 type CheckCloudAccountNameResponse struct {
@@ -127,9 +184,20 @@ type CheckCloudAccountAwsAccountIdResponse struct {
 	Existed bool `json:"existed"`
 }
 
-type GetCloudAccountResourceQuotaResponse struct {
+// RegionResourceQuota 는 리전별 쿼터 조회 결과이다. 조회에 실패한 리전은 error 에 사유를 담는다.
+type RegionResourceQuota struct {
+	Region        string        `json:"region"`
 	Available     bool          `json:"available"`
 	ResourceQuota ResourceQuota `json:"resourceQuota"`
+	Error         string        `json:"error,omitempty"`
+}
+
+// GetCloudAccountResourceQuotaResponse 의 available 은 하나 이상의 리전에 스택을 생성할 수 있는지 여부이며,
+// resourceQuota 는 첫번째 허용 리전의 쿼터이다.
+type GetCloudAccountResourceQuotaResponse struct {
+	Available     bool                  `json:"available"`
+	ResourceQuota ResourceQuota         `json:"resourceQuota"`
+	Regions       []RegionResourceQuota `json:"regions"`
 }
 
 type DeleteCloudAccountResponse struct {
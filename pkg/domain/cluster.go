@@ -160,6 +160,7 @@ type CreateClusterRequest struct {
 	TksUserNode            int      `json:"tksUserNode"`
 	TksUserNodeMax         int      `json:"tksUserNodeMax,omitempty"`
 	TksUserNodeType        string   `json:"tksUserNodeType,omitempty"`
+	ClusterRegion          string   `json:"clusterRegion,omitempty"`
 }
 
 type ImportClusterRequest struct {
@@ -203,6 +204,7 @@ type ClusterResponse struct {
 	Status                 string                      `json:"status"`
 	StatusDesc             string                      `json:"statusDesc"`
 	Conf                   ClusterConfResponse         `json:"conf"`
+	ClusterRegion          string                      `json:"clusterRegion,omitempty"`
 	ClusterType            string                      `json:"clusterType"`
 	Creator                SimpleUserResponse          `json:"creator"`
 	Updator                SimpleUserResponse          `json:"updator"`
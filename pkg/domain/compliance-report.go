@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"time"
+)
+
+type ComplianceReportFormat string
+
+const (
+	ComplianceReportFormatJson ComplianceReportFormat = "json"
+	ComplianceReportFormatHtml ComplianceReportFormat = "html"
+	ComplianceReportFormatPdf  ComplianceReportFormat = "pdf"
+)
+
+// ComplianceReportContent 는 보고서 생성 시점의 정책 준수 현황 스냅샷이다.
+type ComplianceReportContent struct {
+	OrganizationId   string                  `json:"organizationId" example:"oh4z7m9kz"`
+	OrganizationName string                  `json:"organizationName" example:"tks"`
+	PeriodStart      time.Time               `json:"periodStart" format:"date-time"`
+	PeriodEnd        time.Time               `json:"periodEnd" format:"date-time"`
+	GeneratedAt      time.Time               `json:"generatedAt" format:"date-time"`
+	Stacks           []ComplianceReportStack `json:"stacks"`
+}
+
+type ComplianceReportStack struct {
+	StackId            string                   `json:"stackId" example:"cmsai5k5l"`
+	StackName          string                   `json:"stackName" example:"production"`
+	ViolationCount     int                      `json:"violationCount"`
+	OpenExceptionCount int                      `json:"openExceptionCount"`
+	Policies           []ComplianceReportPolicy `json:"policies"`
+}
+
+type ComplianceReportPolicy struct {
+	PolicyId          string                      `json:"policyId" example:"0091fe9b-e44b-423d-9562-ac2b73089593"`
+	PolicyName        string                      `json:"policyName" example:"label 정책"`
+	Mandatory         bool                        `json:"mandatory"`
+	TemplateName      string                      `json:"templateName" example:"필수 Label 검사"`
+	TemplateKind      string                      `json:"templateKind" example:"K8sRequiredLabels"`
+	TemplateVersion   string                      `json:"templateVersion" example:"v1.0.1"`
+	VersionPinned     bool                        `json:"versionPinned"`
+	EnforcementAction string                      `json:"enforcementAction" enum:"warn,deny,dryrun" example:"deny"`
+	ViolationCount    int                         `json:"violationCount"`
+	OpenExceptions    []ComplianceReportException `json:"openExceptions"`
+}
+
+type ComplianceReportException struct {
+	ExceptionId   string    `json:"exceptionId" example:"0091fe9b-e44b-423d-9562-ac2b73089593"`
+	Namespace     string    `json:"namespace" example:"default"`
+	Justification string    `json:"justification"`
+	ExpiredAt     time.Time `json:"expiredAt" format:"date-time"`
+}
+
+type CreateComplianceReportRequest struct {
+	StackId     string    `json:"stackId,omitempty" example:"cmsai5k5l"`
+	PeriodStart time.Time `json:"periodStart" validate:"required" format:"date-time"`
+	PeriodEnd   time.Time `json:"periodEnd" validate:"required,gtfield=PeriodStart" format:"date-time"`
+}
+
+type CreateComplianceReportResponse struct {
+	ID string `json:"id"`
+}
+
+type ComplianceReportResponse struct {
+	ID          string             `json:"id" example:"d98ef5f1-4a68-4047-a446-2207787ce3ff"`
+	StackId     string             `json:"stackId,omitempty" example:"cmsai5k5l"`
+	PeriodStart time.Time          `json:"periodStart" format:"date-time"`
+	PeriodEnd   time.Time          `json:"periodEnd" format:"date-time"`
+	Scheduled   bool               `json:"scheduled"`
+	Creator     SimpleUserResponse `json:"creator"`
+	CreatedAt   time.Time          `json:"createdAt" format:"date-time"`
+}
+
+type ListComplianceReportsResponse struct {
+	ComplianceReports []ComplianceReportResponse `json:"complianceReports"`
+	Pagination        PaginationResponse         `json:"pagination"`
+}
+
+type GetComplianceReportResponse struct {
+	ComplianceReport ComplianceReportResponse `json:"complianceReport"`
+	Content          ComplianceReportContent  `json:"content"`
+}
@@ -0,0 +1,94 @@
+package domain
+
+// NodeCost 는 노드 역할별 인스턴스 타입, 노드 수와 월 비용이다.
+// 가격표에 없는 인스턴스 타입은 priced 가 false 이며 비용 합계에서 제외된다.
+type NodeCost struct {
+	Type         string  `json:"type"`
+	InstanceType string  `json:"instanceType"`
+	Count        int     `json:"count"`
+	HourlyPrice  float64 `json:"hourlyPrice"`
+	MonthlyCost  float64 `json:"monthlyCost"`
+	Priced       bool    `json:"priced"`
+}
+
+// CostStatus 는 비용을 계산한 가격 정보의 상태이다.
+// 가격표가 없는 리전은 비용을 0 으로 표시하지 않고 NO_PRICE_CATALOG 로 구분한다.
+type CostStatus string
+
+const (
+	CostStatus_PRICED           CostStatus = "PRICED"
+	CostStatus_PARTIALLY_PRICED CostStatus = "PARTIALLY_PRICED"
+	CostStatus_NO_PRICE_CATALOG CostStatus = "NO_PRICE_CATALOG"
+)
+
+type CostEstimate struct {
+	CloudService string     `json:"cloudService"`
+	Region       string     `json:"region"`
+	Status       CostStatus `json:"status"`
+	Currency     string     `json:"currency"`
+	Nodes        []NodeCost `json:"nodes"`
+	MonthlyCost  float64    `json:"monthlyCost"`
+	Warnings     []string   `json:"warnings,omitempty"`
+}
+
+type StackCost struct {
+	StackId   StackId `json:"stackId"`
+	StackName string  `json:"stackName"`
+	CostEstimate
+}
+
+// ProjectStackCost 는 프로젝트가 사용하는 스택의 비용이다.
+// 여러 프로젝트가 공유하는 스택의 비용은 프로젝트 수로 나누어 배분한다.
+type ProjectStackCost struct {
+	StackId          StackId `json:"stackId"`
+	StackName        string  `json:"stackName"`
+	ProjectCount     int     `json:"projectCount"`
+	StackMonthlyCost float64 `json:"stackMonthlyCost"`
+	MonthlyCost      float64 `json:"monthlyCost"`
+}
+
+type ProjectCost struct {
+	ProjectId   string             `json:"projectId"`
+	ProjectName string             `json:"projectName"`
+	Currency    string             `json:"currency"`
+	Stacks      []ProjectStackCost `json:"stacks"`
+	MonthlyCost float64            `json:"monthlyCost"`
+	Warnings    []string           `json:"warnings,omitempty"`
+}
+
+type DashboardCost struct {
+	Currency    string        `json:"currency"`
+	MonthlyCost float64       `json:"monthlyCost"`
+	Stacks      []StackCost   `json:"stacks"`
+	Projects    []ProjectCost `json:"projects"`
+	Warnings    []string      `json:"warnings,omitempty"`
+}
+
+type EstimateStackCostRequest struct {
+	CloudService     string `json:"cloudService" validate:"required,oneof=AWS BYOH"`
+	StackTemplateId  string `json:"stackTemplateId"`
+	CloudAccountId   string `json:"cloudAccountId"`
+	TksCpNode        int    `json:"tksCpNode"`
+	TksCpNodeType    string `json:"tksCpNodeType,omitempty"`
+	TksInfraNode     int    `json:"tksInfraNode"`
+	TksInfraNodeType string `json:"tksInfraNodeType,omitempty"`
+	TksUserNode      int    `json:"tksUserNode"`
+	TksUserNodeType  string `json:"tksUserNodeType,omitempty"`
+	ClusterRegion    string `json:"clusterRegion,omitempty"`
+}
+
+type EstimateStackCostResponse struct {
+	Estimate CostEstimate `json:"estimate"`
+}
+
+type GetStackCostResponse struct {
+	Cost StackCost `json:"cost"`
+}
+
+type GetProjectCostResponse struct {
+	Cost ProjectCost `json:"cost"`
+}
+
+type GetDashboardCostResponse struct {
+	Cost DashboardCost `json:"cost"`
+}
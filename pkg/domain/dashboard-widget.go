@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"time"
+)
+
+// DashboardWidgetChartType 은 사용자 정의 위젯의 차트 유형이다.
+type DashboardWidgetChartType string
+
+const (
+	DashboardWidgetChartTypeLine  DashboardWidgetChartType = "line"
+	DashboardWidgetChartTypeArea  DashboardWidgetChartType = "area"
+	DashboardWidgetChartTypeBar   DashboardWidgetChartType = "bar"
+	DashboardWidgetChartTypeGauge DashboardWidgetChartType = "gauge"
+	DashboardWidgetChartTypeStat  DashboardWidgetChartType = "stat"
+)
+
+// DashboardWidgetThreshold 는 값이 Value 이상일 때 Color 로 표시하는 임계치이다.
+type DashboardWidgetThreshold struct {
+	Value float64 `json:"value" example:"0.8"`
+	Color string  `json:"color" example:"#FF0000"`
+	Label string  `json:"label,omitempty" example:"warning"`
+}
+
+type DashboardWidgetResponse struct {
+	ID          string                     `json:"id" example:"d98ef5f1-4a68-4047-a446-2207787ce3ff"`
+	Name        string                     `json:"name" example:"namespace 별 CPU 사용량"`
+	Description string                     `json:"description"`
+	Query       string                     `json:"query" example:"sum(rate(container_cpu_usage_seconds_total[5m])) by (namespace)"`
+	ChartType   string                     `json:"chartType" enums:"line,area,bar,gauge,stat" example:"line"`
+	Unit        string                     `json:"unit" example:"cores"`
+	Thresholds  []DashboardWidgetThreshold `json:"thresholds"`
+	Shared      bool                       `json:"shared"`
+	Creator     SimpleUserResponse         `json:"creator,omitempty"`
+	CreatedAt   time.Time                  `json:"createdAt" format:"date-time"`
+	UpdatedAt   time.Time                  `json:"updatedAt" format:"date-time"`
+}
+
+type CreateDashboardWidgetRequest struct {
+	Name        string                     `json:"name" validate:"required,name" example:"namespace 별 CPU 사용량"`
+	Description string                     `json:"description"`
+	Query       string                     `json:"query" validate:"required" example:"sum(rate(container_cpu_usage_seconds_total[5m])) by (namespace)"`
+	ChartType   string                     `json:"chartType" validate:"required,oneof=line area bar gauge stat" enums:"line,area,bar,gauge,stat" example:"line"`
+	Unit        string                     `json:"unit" example:"cores"`
+	Thresholds  []DashboardWidgetThreshold `json:"thresholds,omitempty"`
+	Shared      bool                       `json:"shared"`
+}
+
+type CreateDashboardWidgetResponse struct {
+	ID string `json:"id"`
+}
+
+type UpdateDashboardWidgetRequest struct {
+	Name        *string                     `json:"name,omitempty" validate:"omitempty,name" example:"namespace 별 CPU 사용량"`
+	Description *string                     `json:"description,omitempty"`
+	Query       *string                     `json:"query,omitempty" example:"sum(rate(container_cpu_usage_seconds_total[5m])) by (namespace)"`
+	ChartType   *string                     `json:"chartType,omitempty" validate:"omitempty,oneof=line area bar gauge stat" enums:"line,area,bar,gauge,stat" example:"line"`
+	Unit        *string                     `json:"unit,omitempty" example:"cores"`
+	Thresholds  *[]DashboardWidgetThreshold `json:"thresholds,omitempty"`
+	Shared      *bool                       `json:"shared,omitempty"`
+}
+
+type GetDashboardWidgetResponse struct {
+	DashboardWidget DashboardWidgetResponse `json:"dashboardWidget"`
+}
+
+type ListDashboardWidgetResponse struct {
+	DashboardWidgets []DashboardWidgetResponse `json:"dashboardWidgets"`
+	Pagination       PaginationResponse        `json:"pagination"`
+}
+
+// DashboardWidgetData 는 위젯 질의 결과이다. 시계열마다 하나의 series 가 만들어지며 x 축은 unix timestamp 이다.
+type DashboardWidgetData struct {
+	Query     string    `json:"query"`
+	Duration  string    `json:"duration"`
+	Interval  string    `json:"interval"`
+	ChartData ChartData `json:"chartData"`
+	UpdatedAt time.Time `json:"updatedAt" format:"date-time"`
+}
+
+type GetDashboardWidgetDataResponse struct {
+	DashboardWidget DashboardWidgetResponse `json:"dashboardWidget"`
+	Data            DashboardWidgetData     `json:"data"`
+}
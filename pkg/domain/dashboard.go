@@ -180,6 +180,21 @@ type UpdateDashboardRequest struct {
 	DashboardContents
 }
 
+type SetDefaultDashboardRequest struct {
+	Contents []DashboardContents `json:"contents" validate:"required"`
+}
+
+// DashboardLayoutVersion 은 대시보드 레이아웃 import/export 형식의 버전이다.
+const DashboardLayoutVersion = "v1"
+
+// DashboardLayout 은 조직 간에 대시보드를 공유하기 위한 import/export 형식이다.
+type DashboardLayout struct {
+	Version      string              `json:"version" validate:"required,oneof=v1" example:"v1"`
+	DashboardKey string              `json:"dashboardKey" example:"main"`
+	Contents     []DashboardContents `json:"contents" validate:"required"`
+	ExportedAt   time.Time           `json:"exportedAt" format:"date-time"`
+}
+
 type CommonDashboardResponse struct {
 	Result string `json:"result"`
 }
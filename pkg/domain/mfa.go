@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"time"
+)
+
+type GetMyMfaResponse struct {
+	Enabled               bool       `json:"enabled"`
+	EnabledAt             *time.Time `json:"enabledAt"`
+	RemainingRecoveryCode int        `json:"remainingRecoveryCode"`
+}
+
+type EnrollMfaResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningUri string `json:"provisioningUri"`
+}
+
+type MfaCodeRequest struct {
+	Code string `json:"code" validate:"required"`
+}
+
+type MfaRecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+type VerifyMfaResponse struct {
+	ExpiredAt time.Time `json:"expiredAt"`
+}
+
+type MfaPolicyResponse struct {
+	Required  bool                 `json:"required"`
+	Roles     []SimpleRoleResponse `json:"roles"`
+	Updator   SimpleUserResponse   `json:"updator"`
+	UpdatedAt time.Time            `json:"updatedAt"`
+}
+
+type GetMfaPolicyResponse struct {
+	MfaPolicy MfaPolicyResponse `json:"mfaPolicy"`
+}
+
+type UpdateMfaPolicyRequest struct {
+	Required bool     `json:"required"`
+	RoleIds  []string `json:"roleIds"`
+}
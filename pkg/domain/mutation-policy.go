@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"time"
+)
+
+// MutationPolicyKind 는 뮤테이션 정책 템플릿 유형이다.
+type MutationPolicyKind string
+
+const (
+	MutationPolicyKindDefaultLabels    MutationPolicyKind = "DefaultLabels"
+	MutationPolicyKindImagePullPolicy  MutationPolicyKind = "ImagePullPolicy"
+	MutationPolicyKindResourceDefaults MutationPolicyKind = "ResourceDefaults"
+	MutationPolicyKindSecurityContext  MutationPolicyKind = "SecurityContext"
+)
+
+type MutationPolicyTemplateResponse struct {
+	Kind             string          `json:"kind" example:"DefaultLabels"`
+	Name             string          `json:"name" example:"기본 Label 추가"`
+	Description      string          `json:"description"`
+	MutatorKinds     []string        `json:"mutatorKinds" example:"AssignMetadata"`
+	ParametersSchema []*ParameterDef `json:"parametersSchema"`
+}
+
+type ListMutationPolicyTemplatesResponse struct {
+	MutationPolicyTemplates []MutationPolicyTemplateResponse `json:"mutationPolicyTemplates"`
+}
+
+type MutationPolicyResponse struct {
+	ID        string             `json:"id" example:"d98ef5f1-4a68-4047-a446-2207787ce3ff"`
+	Creator   SimpleUserResponse `json:"creator,omitempty"`
+	Updator   SimpleUserResponse `json:"updator,omitempty"`
+	CreatedAt time.Time          `json:"createdAt" format:"date-time"`
+	UpdatedAt time.Time          `json:"updatedAt" format:"date-time"`
+
+	TargetClusters []SimpleClusterResponse `json:"targetClusters"`
+
+	PolicyName         string `json:"policyName" example:"기본 label 정책"`
+	PolicyResourceName string `json:"policyResourceName" example:"defaultlabels"`
+	Description        string `json:"description"`
+	Kind               string `json:"kind" enums:"DefaultLabels,ImagePullPolicy,ResourceDefaults,SecurityContext" example:"DefaultLabels"`
+	Parameters         string `json:"parameters" example:"{\"labels\":[{\"key\":\"owner\",\"value\":\"tks\"}]}"`
+	Match              *Match `json:"match,omitempty"`
+}
+
+type CreateMutationPolicyRequest struct {
+	TargetClusterIds []string `json:"targetClusterIds" example:"83bf8081-f0c5-4b31-826d-23f6f366ec90"`
+
+	PolicyName         string `json:"policyName" validate:"required,name" example:"기본 label 정책"`
+	PolicyResourceName string `json:"policyResourceName,omitempty" validate:"resourcename" example:"defaultlabels"`
+	Description        string `json:"description"`
+	Kind               string `json:"kind" validate:"required,oneof=DefaultLabels ImagePullPolicy ResourceDefaults SecurityContext" enums:"DefaultLabels,ImagePullPolicy,ResourceDefaults,SecurityContext" example:"DefaultLabels"`
+	Parameters         string `json:"parameters" validate:"required" example:"{\"labels\":[{\"key\":\"owner\",\"value\":\"tks\"}]}"`
+	Match              *Match `json:"match,omitempty"`
+}
+
+type CreateMutationPolicyResponse struct {
+	ID string `json:"id"`
+}
+
+type UpdateMutationPolicyRequest struct {
+	TargetClusterIds *[]string `json:"targetClusterIds,omitempty" example:"83bf8081-f0c5-4b31-826d-23f6f366ec90"`
+
+	PolicyName  *string `json:"policyName,omitempty" validate:"omitempty,name" example:"기본 label 정책"`
+	Description *string `json:"description,omitempty"`
+	Parameters  *string `json:"parameters,omitempty" example:"{\"labels\":[{\"key\":\"owner\",\"value\":\"tks\"}]}"`
+	Match       *Match  `json:"match,omitempty"`
+}
+
+type GetMutationPolicyResponse struct {
+	MutationPolicy MutationPolicyResponse `json:"mutationPolicy"`
+}
+
+type ListMutationPolicyResponse struct {
+	MutationPolicies []MutationPolicyResponse `json:"mutationPolicies"`
+	Pagination       PaginationResponse       `json:"pagination"`
+}
+
+type MutationPolicyCount struct {
+	Total  int64            `json:"total"`
+	ByKind map[string]int64 `json:"byKind"`
+}
@@ -110,6 +110,32 @@ type UpdateOrganizationTemplatesRequest struct {
 	SystemNotificationTemplateIds *[]string `json:"systemNotificationTemplateIds,omitempty"`
 }
 
+type PasswordPolicyResponse struct {
+	MinLength        int  `json:"minLength"`
+	RequireUppercase bool `json:"requireUppercase"`
+	RequireLowercase bool `json:"requireLowercase"`
+	RequireDigit     bool `json:"requireDigit"`
+	RequireSpecial   bool `json:"requireSpecial"`
+	HistoryDepth     int  `json:"historyDepth"`
+	ExpiryDays       int  `json:"expiryDays"`
+	ReuseBanned      bool `json:"reuseBanned"`
+}
+
+type GetPasswordPolicyResponse struct {
+	PasswordPolicy PasswordPolicyResponse `json:"passwordPolicy"`
+}
+
+type UpdatePasswordPolicyRequest struct {
+	MinLength        int  `json:"minLength" validate:"required,min=8,max=128"`
+	RequireUppercase bool `json:"requireUppercase"`
+	RequireLowercase bool `json:"requireLowercase"`
+	RequireDigit     bool `json:"requireDigit"`
+	RequireSpecial   bool `json:"requireSpecial"`
+	HistoryDepth     int  `json:"historyDepth" validate:"min=0,max=24"`
+	ExpiryDays       int  `json:"expiryDays" validate:"min=0,max=365"`
+	ReuseBanned      bool `json:"reuseBanned"`
+}
+
 type DeleteOrganizationResponse struct {
 	ID string `json:"id"`
 }
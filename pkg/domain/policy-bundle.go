@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"time"
+)
+
+// PolicyBundleItemRequest 의 enforcementAction, parameters 가 비어 있으면 정책에 설정된 값을 그대로 사용한다.
+type PolicyBundleItemRequest struct {
+	PolicyId          string `json:"policyId" validate:"required,uuid" example:"d98ef5f1-4a68-4047-a446-2207787ce3ff"`
+	EnforcementAction string `json:"enforcementAction,omitempty" validate:"omitempty,oneof=deny dryrun warn" enum:"warn,deny,dryrun" example:"deny"`
+	Parameters        string `json:"parameters,omitempty" example:"{\"key\":\"value\"}"`
+}
+
+type PolicyBundleItemResponse struct {
+	PolicyId          string `json:"policyId" example:"d98ef5f1-4a68-4047-a446-2207787ce3ff"`
+	PolicyName        string `json:"policyName" example:"label 정책"`
+	TemplateName      string `json:"templateName" example:"필수 Label 검사"`
+	EnforcementAction string `json:"enforcementAction" enum:"warn,deny,dryrun" example:"deny"`
+	Parameters        string `json:"parameters" example:"{\"key\":\"value\"}"`
+}
+
+type PolicyBundleResponse struct {
+	ID          string                     `json:"id" example:"d98ef5f1-4a68-4047-a446-2207787ce3ff"`
+	Name        string                     `json:"name" example:"PCI baseline"`
+	Description string                     `json:"description"`
+	Items       []PolicyBundleItemResponse `json:"items"`
+	Stacks      []SimpleClusterResponse    `json:"stacks"`
+	Creator     SimpleUserResponse         `json:"creator,omitempty"`
+	Updator     SimpleUserResponse         `json:"updator,omitempty"`
+	CreatedAt   time.Time                  `json:"createdAt" format:"date-time"`
+	UpdatedAt   time.Time                  `json:"updatedAt" format:"date-time"`
+}
+
+type CreatePolicyBundleRequest struct {
+	Name        string                    `json:"name" validate:"required,name" example:"PCI baseline"`
+	Description string                    `json:"description"`
+	Items       []PolicyBundleItemRequest `json:"items" validate:"required,min=1,dive"`
+}
+
+type CreatePolicyBundleResponse struct {
+	ID string `json:"id"`
+}
+
+// UpdatePolicyBundleRequest 의 items 는 번들의 항목 전체를 대체한다.
+type UpdatePolicyBundleRequest struct {
+	Name        string                    `json:"name" validate:"required,name" example:"PCI baseline"`
+	Description string                    `json:"description"`
+	Items       []PolicyBundleItemRequest `json:"items" validate:"required,min=1,dive"`
+}
+
+type GetPolicyBundleResponse struct {
+	PolicyBundle PolicyBundleResponse `json:"policyBundle"`
+}
+
+type ListPolicyBundleResponse struct {
+	PolicyBundles []PolicyBundleResponse `json:"policyBundles"`
+	Pagination    PaginationResponse     `json:"pagination"`
+}
+
+// PolicyBundleAffectedClusterResponse 는 번들 변경 시 클러스터에 추가, 제거되거나 파라미터/적용 액션이 변경되는 정책을 나타낸다.
+type PolicyBundleAffectedClusterResponse struct {
+	Cluster          SimpleClusterResponse `json:"cluster"`
+	AddedPolicyIds   []string              `json:"addedPolicyIds"`
+	RemovedPolicyIds []string              `json:"removedPolicyIds"`
+	UpdatedPolicyIds []string              `json:"updatedPolicyIds"`
+}
+
+type PreviewPolicyBundleUpdateResponse struct {
+	AffectedClusters []PolicyBundleAffectedClusterResponse `json:"affectedClusters"`
+}
@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"time"
+)
+
+type PolicyChangeType string
+
+const (
+	PolicyChangeTypeUpdate               PolicyChangeType = "update"
+	PolicyChangeTypeDelete               PolicyChangeType = "delete"
+	PolicyChangeTypeUpdateTargetClusters PolicyChangeType = "update_target_clusters"
+)
+
+type PolicyChangeRequestStatus string
+
+const (
+	PolicyChangeRequestStatusPending  PolicyChangeRequestStatus = "pending"
+	PolicyChangeRequestStatusApproved PolicyChangeRequestStatus = "approved"
+	PolicyChangeRequestStatusRejected PolicyChangeRequestStatus = "rejected"
+	PolicyChangeRequestStatusExpired  PolicyChangeRequestStatus = "expired"
+)
+
+// PolicyChangeDiff 는 변경 요청으로 바뀌는 정책 필드의 변경 전후 값이다.
+type PolicyChangeDiff struct {
+	Field  string `json:"field" example:"enforcementAction"`
+	Before string `json:"before" example:"dryrun"`
+	After  string `json:"after" example:"deny"`
+}
+
+type PolicyApprovalSettingResponse struct {
+	Enabled     bool `json:"enabled"`
+	ExpiryHours int  `json:"expiryHours" example:"72"`
+}
+
+type GetPolicyApprovalSettingResponse struct {
+	PolicyApprovalSetting PolicyApprovalSettingResponse `json:"policyApprovalSetting"`
+}
+
+type UpdatePolicyApprovalSettingRequest struct {
+	Enabled     bool `json:"enabled"`
+	ExpiryHours int  `json:"expiryHours" validate:"required,min=1,max=720" example:"72"`
+}
+
+type PolicyChangeRequestResponse struct {
+	ID            string             `json:"id" example:"d98ef5f1-4a68-4047-a446-2207787ce3ff"`
+	PolicyId      string             `json:"policyId" example:"d98ef5f1-4a68-4047-a446-2207787ce3ff"`
+	PolicyName    string             `json:"policyName" example:"label 정책"`
+	ChangeType    string             `json:"changeType" enums:"update,delete,update_target_clusters" example:"update"`
+	Diff          []PolicyChangeDiff `json:"diff"`
+	Status        string             `json:"status" enums:"pending,approved,rejected,expired" example:"pending"`
+	Requester     SimpleUserResponse `json:"requester"`
+	Reviewer      SimpleUserResponse `json:"reviewer,omitempty"`
+	ReviewComment string             `json:"reviewComment,omitempty"`
+	ReviewedAt    *time.Time         `json:"reviewedAt,omitempty" format:"date-time"`
+	ExpiresAt     time.Time          `json:"expiresAt" format:"date-time"`
+	CreatedAt     time.Time          `json:"createdAt" format:"date-time"`
+}
+
+type CreatePolicyChangeRequestResponse struct {
+	ID string `json:"id"`
+}
+
+type GetPolicyChangeRequestResponse struct {
+	PolicyChangeRequest PolicyChangeRequestResponse `json:"policyChangeRequest"`
+}
+
+type ListPolicyChangeRequestResponse struct {
+	PolicyChangeRequests []PolicyChangeRequestResponse `json:"policyChangeRequests"`
+	Pagination           PaginationResponse            `json:"pagination"`
+}
+
+type ApprovePolicyChangeRequestRequest struct {
+	Comment string `json:"comment" validate:"omitempty,max=500"`
+}
+
+type RejectPolicyChangeRequestRequest struct {
+	Comment string `json:"comment" validate:"required,max=500"`
+}
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"time"
+)
+
+type PolicyExceptionResponse struct {
+	ID            string             `json:"id" example:"d98ef5f1-4a68-4047-a446-2207787ce3ff"`
+	PolicyId      string             `json:"policyId" example:"d98ef5f1-4a68-4047-a446-2207787ce3ff"`
+	ClusterId     string             `json:"clusterId" example:"cmsai5k5l"`
+	Namespace     string             `json:"namespace" example:"legacy-app"`
+	Justification string             `json:"justification"`
+	Approver      SimpleUserResponse `json:"approver"`
+	Creator       SimpleUserResponse `json:"creator"`
+	ExpiredAt     time.Time          `json:"expiredAt" format:"date-time"`
+	Expired       bool               `json:"expired"`
+	CreatedAt     time.Time          `json:"createdAt" format:"date-time"`
+}
+
+type CreatePolicyExceptionRequest struct {
+	ClusterId     string    `json:"clusterId" validate:"required" example:"cmsai5k5l"`
+	Namespace     string    `json:"namespace" validate:"required,resourcename" example:"legacy-app"`
+	Justification string    `json:"justification" validate:"required,max=500"`
+	ApproverId    string    `json:"approverId" validate:"required,uuid" example:"d98ef5f1-4a68-4047-a446-2207787ce3ff"`
+	ExpiredAt     time.Time `json:"expiredAt" validate:"required" format:"date-time"`
+}
+
+type CreatePolicyExceptionResponse struct {
+	ID string `json:"id"`
+}
+
+type ListPolicyExceptionResponse struct {
+	Exceptions []PolicyExceptionResponse `json:"exceptions"`
+}
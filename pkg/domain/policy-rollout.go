@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"time"
+)
+
+type PolicyRolloutStatus string
+
+const (
+	PolicyRolloutStatusInProgress PolicyRolloutStatus = "in_progress"
+	PolicyRolloutStatusCompleted  PolicyRolloutStatus = "completed"
+	PolicyRolloutStatusCancelled  PolicyRolloutStatus = "cancelled"
+)
+
+type StartPolicyRolloutRequest struct {
+	InitialEnforcementAction string `json:"initialEnforcementAction" validate:"required,oneof=dryrun warn" enum:"warn,dryrun" example:"dryrun"`
+	ObservationHours         int    `json:"observationHours" validate:"required,min=1,max=720" example:"72"`
+	ViolationThreshold       int    `json:"violationThreshold" validate:"min=0" example:"0"`
+}
+
+type StartPolicyRolloutResponse struct {
+	ID string `json:"id"`
+}
+
+type PolicyRolloutStackResponse struct {
+	ClusterId          string     `json:"clusterId" example:"cmsai5k5l"`
+	EnforcementAction  string     `json:"enforcementAction" enum:"warn,deny,dryrun" example:"dryrun"`
+	StageStartedAt     time.Time  `json:"stageStartedAt" format:"date-time"`
+	LastViolationCount int        `json:"lastViolationCount"`
+	PromotedAt         *time.Time `json:"promotedAt,omitempty" format:"date-time"`
+}
+
+type PolicyRolloutResponse struct {
+	ID                       string                       `json:"id" example:"d98ef5f1-4a68-4047-a446-2207787ce3ff"`
+	PolicyId                 string                       `json:"policyId" example:"d98ef5f1-4a68-4047-a446-2207787ce3ff"`
+	InitialEnforcementAction string                       `json:"initialEnforcementAction" enum:"warn,dryrun" example:"dryrun"`
+	BaseEnforcementAction    string                       `json:"baseEnforcementAction" enum:"warn,deny,dryrun" example:"warn"`
+	ObservationHours         int                          `json:"observationHours" example:"72"`
+	ViolationThreshold       int                          `json:"violationThreshold" example:"0"`
+	Status                   string                       `json:"status" enum:"in_progress,completed,cancelled" example:"in_progress"`
+	Stacks                   []PolicyRolloutStackResponse `json:"stacks"`
+	Creator                  SimpleUserResponse           `json:"creator"`
+	CreatedAt                time.Time                    `json:"createdAt" format:"date-time"`
+	CompletedAt              *time.Time                   `json:"completedAt,omitempty" format:"date-time"`
+}
+
+type GetPolicyRolloutResponse struct {
+	PolicyRollout PolicyRolloutResponse `json:"policyRollout"`
+}
@@ -114,6 +114,28 @@ type CreatePolicyTemplateVersionResponse struct {
 	Version string `json:"version" example:"v1.1.1"`
 }
 
+type ImportPolicyTemplateRequest struct {
+	// ConstraintTemplate YAML 또는 JSON. SourceUrl 과 둘 중 하나를 지정한다.
+	ConstraintTemplate string `json:"constraintTemplate,omitempty" validate:"required_without=SourceUrl"`
+	// git 저장소의 ConstraintTemplate 파일 경로(https)
+	SourceUrl string `json:"sourceUrl,omitempty" validate:"required_without=ConstraintTemplate,omitempty,url" example:"https://github.com/open-policy-agent/gatekeeper-library/blob/master/library/general/requiredlabels/template.yaml"`
+
+	// 지정하면 해당 템플릿의 새 버전으로 가져오고, 지정하지 않으면 새 템플릿을 생성한다.
+	PolicyTemplateId string `json:"policyTemplateId,omitempty" example:"d98ef5f1-4a68-4047-a446-2207787ce3ff"`
+	VersionUpType    string `json:"versionUpType,omitempty" validate:"omitempty,oneof=major minor patch" enums:"major,minor,patch" example:"minor"`
+
+	// 새 템플릿 생성 시 사용하며, 지정하지 않으면 ConstraintTemplate 의 annotation 과 kind 를 사용한다.
+	TemplateName             string   `json:"templateName,omitempty" validate:"omitempty,name" example:"필수 Label 검사"`
+	Description              string   `json:"description,omitempty" example:"이 정책은 ..."`
+	Severity                 string   `json:"severity,omitempty" validate:"omitempty,oneof=low medium high" enums:"low,medium,high" example:"medium"`
+	PermittedOrganizationIds []string `json:"permittedOrganizationIds,omitempty"`
+}
+
+type ImportPolicyTemplateResponse struct {
+	ID      string `json:"id" example:"d98ef5f1-4a68-4047-a446-2207787ce3ff"`
+	Version string `json:"version" example:"v1.1.0"`
+}
+
 type GetPolicyTemplateResponse struct {
 	PolicyTemplate PolicyTemplateResponse `json:"policyTemplate"`
 }
@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"time"
+)
+
+type PolicyViolationStatus string
+
+const (
+	PolicyViolationStatusOpen         PolicyViolationStatus = "open"
+	PolicyViolationStatusAcknowledged PolicyViolationStatus = "acknowledged"
+	PolicyViolationStatusFixed        PolicyViolationStatus = "fixed"
+	PolicyViolationStatusExcepted     PolicyViolationStatus = "excepted"
+)
+
+type PolicyViolationResponse struct {
+	ID                 string             `json:"id" example:"d98ef5f1-4a68-4047-a446-2207787ce3ff"`
+	ClusterId          string             `json:"clusterId" example:"cmsai5k5l"`
+	PolicyId           string             `json:"policyId" example:"d98ef5f1-4a68-4047-a446-2207787ce3ff"`
+	PolicyName         string             `json:"policyName" example:"label-require"`
+	PolicyTemplateKind string             `json:"policyTemplateKind" example:"K8sRequiredLabels"`
+	Namespace          string             `json:"namespace" example:"default"`
+	Kind               string             `json:"kind" example:"Pod"`
+	Name               string             `json:"name" example:"nginx"`
+	Message            string             `json:"message"`
+	EnforcementAction  string             `json:"enforcementAction" enums:"warn,deny,dryrun"`
+	Status             string             `json:"status" enums:"open,acknowledged,fixed,excepted"`
+	FirstSeenAt        time.Time          `json:"firstSeenAt" format:"date-time"`
+	LastSeenAt         time.Time          `json:"lastSeenAt" format:"date-time"`
+	Updator            SimpleUserResponse `json:"updator"`
+	UpdatedAt          time.Time          `json:"updatedAt" format:"date-time"`
+}
+
+type ListPolicyViolationResponse struct {
+	PolicyViolations []PolicyViolationResponse `json:"policyViolations"`
+	Pagination       PaginationResponse        `json:"pagination"`
+}
+
+type GetPolicyViolationResponse struct {
+	PolicyViolation PolicyViolationResponse `json:"policyViolation"`
+}
+
+type UpdatePolicyViolationStatusRequest struct {
+	Status string `json:"status" validate:"required,oneof=open acknowledged fixed excepted" enums:"open,acknowledged,fixed,excepted"`
+}
@@ -48,6 +48,7 @@ type PolicyResponse struct {
 	Match              *Match          `json:"match,omitempty"`
 	MatchYaml          *string         `json:"matchYaml,omitempty" example:"namespaces:\r\n- testns1"`
 	//Tags              []string         `json:"tags,omitempty" example:"k8s,label"`
+	Exceptions []PolicyExceptionResponse `json:"exceptions"`
 }
 
 type CreatePolicyRequest struct {
@@ -135,6 +136,7 @@ type StackPolicyStatusResponse struct {
 	TemplateDescription    string `json:"templateDescription" example:"파라미터로 설정된 레이블 검사"`
 	TemplateCurrentVersion string `json:"templateCurrentVersion" example:"v1.0.1"`
 	TemplateLatestVersion  string `json:"templateLatestVersion" example:"v1.0.3"`
+	TemplatePinned         bool   `json:"templatePinned"`
 }
 
 type ListStackPolicyStatusResponse struct {
@@ -148,6 +150,7 @@ type GetStackPolicyTemplateStatusResponse struct {
 	TemplateMandatory                bool                             `json:"templateMandatory"`
 	TemplateCurrentVersion           string                           `json:"templateCurrentVersion" example:"v1.0.1"`
 	TemplateLatestVersion            string                           `json:"templateLatestVersion" example:"v1.0.3"`
+	TemplatePinned                   bool                             `json:"templatePinned"`
 	TemplateLatestVersionReleaseDate time.Time                        `json:"templateLatestVersionReleaseDate" format:"date-time"`
 	UpdatedPolicyParameters          []UpdatedPolicyTemplateParameter `json:"updatedPolicyParameters"`
 	AffectedPolicies                 []PolicyStatus                   `json:"affectedPolicies"`
@@ -185,9 +188,25 @@ type PolicyUpdate struct {
 type UpdateStackPolicyTemplateStatusRequest struct {
 	TemplateCurrentVersion string `json:"templateCurrentVersion" validate:"version" example:"v1.0.1"`
 	TemplateTargetVerson   string `json:"templateTargetVerson" validate:"version" example:"v1.0.3"`
+	// 지정하면 버전 고정 여부를 변경한다. 고정된 스택은 고정을 해제하거나 다시 고정하지 않으면 버전을 변경할 수 없다.
+	Pinned *bool `json:"pinned,omitempty"`
 	// PolicyUpdate           []PolicyUpdate `json:"policyUpdate"`
 }
 
+type StackPolicyTemplateHistoryResponse struct {
+	ID          string             `json:"id"`
+	FromVersion string             `json:"fromVersion" example:"v1.0.3"`
+	ToVersion   string             `json:"toVersion" example:"v1.0.1"`
+	Action      string             `json:"action" enums:"update"`
+	Pinned      bool               `json:"pinned"`
+	Creator     SimpleUserResponse `json:"creator"`
+	CreatedAt   time.Time          `json:"createdAt" format:"date-time"`
+}
+
+type ListStackPolicyTemplateHistoryResponse struct {
+	Histories []StackPolicyTemplateHistoryResponse `json:"histories"`
+}
+
 type TemplateCount struct {
 	TksTemplate          int64 `json:"tksTemplate"`
 	OrganizationTemplate int64 `json:"organizationTemplate"`
@@ -204,8 +223,9 @@ type PolicyCount struct {
 }
 
 type PolicyStatisticsResponse struct {
-	Template TemplateCount `json:"templateCount"`
-	Policy   PolicyCount   `json:"policyCount"`
+	Template TemplateCount       `json:"templateCount"`
+	Policy   PolicyCount         `json:"policyCount"`
+	Mutation MutationPolicyCount `json:"mutationPolicyCount"`
 }
 
 type StackPolicyStatistics struct {
@@ -0,0 +1,32 @@
+package domain
+
+// ProjectUsageRates 는 사용량 비용 산정에 사용하는 단위 요금이다.
+type ProjectUsageRates struct {
+	Currency           string  `json:"currency"`
+	CpuCoreHourRate    float64 `json:"cpuCoreHourRate"`
+	MemoryGibHourRate  float64 `json:"memoryGibHourRate"`
+	StorageGibHourRate float64 `json:"storageGibHourRate"`
+}
+
+type ProjectUsageResponse struct {
+	Date            string  `json:"date"`
+	StackId         string  `json:"stackId"`
+	Namespace       string  `json:"namespace"`
+	CpuCoreHours    float64 `json:"cpuCoreHours"`
+	MemoryGibHours  float64 `json:"memoryGibHours"`
+	StorageGibHours float64 `json:"storageGibHours"`
+	Cost            float64 `json:"cost"`
+}
+
+// GetProjectUsageResponse 는 기간 동안 프로젝트의 모든 스택의 네임스페이스 사용량 합계와 일별 사용량이다.
+type GetProjectUsageResponse struct {
+	ProjectId       string                 `json:"projectId"`
+	From            string                 `json:"from"`
+	To              string                 `json:"to"`
+	Rates           ProjectUsageRates      `json:"rates"`
+	CpuCoreHours    float64                `json:"cpuCoreHours"`
+	MemoryGibHours  float64                `json:"memoryGibHours"`
+	StorageGibHours float64                `json:"storageGibHours"`
+	Cost            float64                `json:"cost"`
+	Usages          []ProjectUsageResponse `json:"usages"`
+}
@@ -89,6 +89,7 @@ type CreateStackRequest struct {
 	CloudAccountId   string   `json:"cloudAccountId"`
 	ClusterEndpoint  string   `json:"userClusterEndpoint,omitempty"`
 	PolicyIds        []string `json:"policyIds,omitempty"`
+	PolicyBundleIds  []string `json:"policyBundleIds,omitempty"`
 	TksCpNode        int      `json:"tksCpNode"`
 	TksCpNodeMax     int      `json:"tksCpNodeMax,omitempty"`
 	TksCpNodeType    string   `json:"tksCpNodeType,omitempty"`
@@ -98,6 +99,7 @@ type CreateStackRequest struct {
 	TksUserNode      int      `json:"tksUserNode"`
 	TksUserNodeMax   int      `json:"tksUserNodeMax,omitempty"`
 	TksUserNodeType  string   `json:"tksUserNodeType,omitempty"`
+	ClusterRegion    string   `json:"clusterRegion,omitempty"`
 }
 
 type CreateStackResponse struct {
@@ -114,6 +116,7 @@ type StackConfResponse struct {
 	TksUserNode      int    `json:"tksUserNode" validate:"required,min=0,max=100"`
 	TksUserNodeMax   int    `json:"tksUserNodeMax,omitempty"`
 	TksUserNodeType  string `json:"tksUserNodeType,omitempty"`
+	ClusterRegion    string `json:"clusterRegion,omitempty"`
 }
 
 type StackResponse struct {
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"time"
+)
+
+// 에스컬레이션 단계의 알림 대상
+const (
+	EscalationTarget_ON_CALL_PRIMARY    = "ON_CALL_PRIMARY"
+	EscalationTarget_ON_CALL_SECONDARY  = "ON_CALL_SECONDARY"
+	EscalationTarget_USER               = "USER"
+	EscalationTarget_ORGANIZATION_ADMIN = "ORGANIZATION_ADMIN"
+)
+
+type SystemNotificationEscalationStep struct {
+	TargetType       string `json:"targetType" validate:"required,oneof=ON_CALL_PRIMARY ON_CALL_SECONDARY USER ORGANIZATION_ADMIN" enums:"ON_CALL_PRIMARY,ON_CALL_SECONDARY,USER,ORGANIZATION_ADMIN" example:"ON_CALL_PRIMARY"`
+	OnCallScheduleId string `json:"onCallScheduleId,omitempty" validate:"omitempty,uuid"`
+	UserId           string `json:"userId,omitempty" validate:"omitempty,uuid"`
+	DelayMinute      int    `json:"delayMinute" validate:"min=0,max=1440" example:"10"`
+}
+
+type OnCallScheduleResponse struct {
+	ID              string               `json:"id" example:"d98ef5f1-4a68-4047-a446-2207787ce3ff"`
+	OrganizationId  string               `json:"organizationId"`
+	Name            string               `json:"name"`
+	Description     string               `json:"description"`
+	Participants    []SimpleUserResponse `json:"participants"`
+	RotationStartAt time.Time            `json:"rotationStartAt" format:"date-time"`
+	RotationHour    int                  `json:"rotationHour" example:"168"`
+	Primary         *SimpleUserResponse  `json:"primary,omitempty"`
+	Secondary       *SimpleUserResponse  `json:"secondary,omitempty"`
+	NextHandoffAt   time.Time            `json:"nextHandoffAt" format:"date-time"`
+	Creator         SimpleUserResponse   `json:"creator"`
+	CreatedAt       time.Time            `json:"createdAt" format:"date-time"`
+	UpdatedAt       time.Time            `json:"updatedAt" format:"date-time"`
+}
+
+type CreateOnCallScheduleRequest struct {
+	Name            string    `json:"name" validate:"required,max=100"`
+	Description     string    `json:"description"`
+	ParticipantIds  []string  `json:"participantIds" validate:"required,min=1,dive,uuid"`
+	RotationStartAt time.Time `json:"rotationStartAt" validate:"required" format:"date-time"`
+	RotationHour    int       `json:"rotationHour" validate:"required,min=1,max=8760" example:"168"`
+}
+
+type CreateOnCallScheduleResponse struct {
+	ID string `json:"id"`
+}
+
+type UpdateOnCallScheduleRequest struct {
+	Name            *string    `json:"name,omitempty" validate:"omitempty,max=100"`
+	Description     *string    `json:"description,omitempty"`
+	ParticipantIds  *[]string  `json:"participantIds,omitempty" validate:"omitempty,min=1,dive,uuid"`
+	RotationStartAt *time.Time `json:"rotationStartAt,omitempty" format:"date-time"`
+	RotationHour    *int       `json:"rotationHour,omitempty" validate:"omitempty,min=1,max=8760"`
+}
+
+type GetOnCallSchedulesResponse struct {
+	OnCallSchedules []OnCallScheduleResponse `json:"onCallSchedules"`
+	Pagination      PaginationResponse       `json:"pagination"`
+}
+
+type SystemNotificationEscalationPolicyResponse struct {
+	ID                       string                             `json:"id" example:"d98ef5f1-4a68-4047-a446-2207787ce3ff"`
+	SystemNotificationRuleId string                             `json:"systemNotificationRuleId"`
+	Steps                    []SystemNotificationEscalationStep `json:"steps"`
+	Enabled                  bool                               `json:"enabled"`
+	Creator                  SimpleUserResponse                 `json:"creator"`
+	CreatedAt                time.Time                          `json:"createdAt" format:"date-time"`
+	UpdatedAt                time.Time                          `json:"updatedAt" format:"date-time"`
+}
+
+type SetSystemNotificationEscalationPolicyRequest struct {
+	Steps   []SystemNotificationEscalationStep `json:"steps" validate:"required,min=1,max=10,dive"`
+	Enabled *bool                              `json:"enabled,omitempty"`
+}
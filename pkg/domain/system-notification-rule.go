@@ -126,3 +126,30 @@ type DeleteSystemNotificationRuleResponse struct {
 	ID   string `json:"id"`
 	Name string `json:"name"`
 }
+
+type PreviewSystemNotificationRuleRequest struct {
+	SystemNotificationTemplateId string                        `json:"systemNotificationTemplateId" validate:"required"`
+	Duration                     string                        `json:"duration"`
+	Parameters                   []SystemNotificationParameter `json:"parameters" validate:"required"`
+	Hours                        int                           `json:"hours" validate:"min=0,max=168"`
+}
+
+type SystemNotificationRuleFiringPeriod struct {
+	StartsAt time.Time `json:"startsAt"`
+	EndsAt   time.Time `json:"endsAt"`
+}
+
+type SystemNotificationRulePreviewSeries struct {
+	ClusterName   string                               `json:"clusterName"`
+	Labels        map[string]string                    `json:"labels"`
+	FiringPeriods []SystemNotificationRuleFiringPeriod `json:"firingPeriods"`
+}
+
+type PreviewSystemNotificationRuleResponse struct {
+	Expr        string                                `json:"expr"`
+	StartAt     time.Time                             `json:"startAt"`
+	EndAt       time.Time                             `json:"endAt"`
+	Step        int                                   `json:"step"`
+	FiringCount int                                   `json:"firingCount"`
+	Series      []SystemNotificationRulePreviewSeries `json:"series"`
+}
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"time"
+)
+
+type SystemNotificationSilenceResponse struct {
+	ID                       string             `json:"id" example:"d98ef5f1-4a68-4047-a446-2207787ce3ff"`
+	OrganizationId           string             `json:"organizationId"`
+	ClusterId                string             `json:"clusterId" example:"cmsai5k5l"`
+	Node                     string             `json:"node"`
+	AlertName                string             `json:"alertName" example:"node-cpu-high-load"`
+	SystemNotificationRuleId string             `json:"systemNotificationRuleId"`
+	StartsAt                 time.Time          `json:"startsAt" format:"date-time"`
+	EndsAt                   time.Time          `json:"endsAt" format:"date-time"`
+	Comment                  string             `json:"comment"`
+	Active                   bool               `json:"active"`
+	Creator                  SimpleUserResponse `json:"creator"`
+	CreatedAt                time.Time          `json:"createdAt" format:"date-time"`
+}
+
+type CreateSystemNotificationSilenceRequest struct {
+	ClusterId                string    `json:"clusterId" example:"cmsai5k5l"`
+	Node                     string    `json:"node"`
+	AlertName                string    `json:"alertName" example:"node-cpu-high-load"`
+	SystemNotificationRuleId string    `json:"systemNotificationRuleId" validate:"omitempty,uuid"`
+	StartsAt                 time.Time `json:"startsAt" format:"date-time"`
+	EndsAt                   time.Time `json:"endsAt" validate:"required" format:"date-time"`
+	Comment                  string    `json:"comment" validate:"required,max=500"`
+}
+
+type CreateSystemNotificationSilenceResponse struct {
+	ID string `json:"id"`
+}
+
+type GetSystemNotificationSilencesResponse struct {
+	SystemNotificationSilences []SystemNotificationSilenceResponse `json:"systemNotificationSilences"`
+	Pagination                 PaginationResponse                  `json:"pagination"`
+}
+
+type StackMaintenanceWindowResponse struct {
+	ID             string             `json:"id" example:"d98ef5f1-4a68-4047-a446-2207787ce3ff"`
+	StackId        string             `json:"stackId" example:"cmsai5k5l"`
+	Name           string             `json:"name"`
+	Description    string             `json:"description"`
+	Weekdays       []time.Weekday     `json:"weekdays" example:"0,6"`
+	StartTime      string             `json:"startTime" example:"02:00"`
+	DurationMinute int                `json:"durationMinute" example:"120"`
+	Timezone       string             `json:"timezone" example:"Asia/Seoul"`
+	Enabled        bool               `json:"enabled"`
+	Active         bool               `json:"active"`
+	Creator        SimpleUserResponse `json:"creator"`
+	CreatedAt      time.Time          `json:"createdAt" format:"date-time"`
+	UpdatedAt      time.Time          `json:"updatedAt" format:"date-time"`
+}
+
+type CreateStackMaintenanceWindowRequest struct {
+	Name           string         `json:"name" validate:"required,max=100"`
+	Description    string         `json:"description"`
+	Weekdays       []time.Weekday `json:"weekdays" validate:"required,min=1,dive,min=0,max=6" example:"0,6"`
+	StartTime      string         `json:"startTime" validate:"required,datetime=15:04" example:"02:00"`
+	DurationMinute int            `json:"durationMinute" validate:"required,min=1,max=1440" example:"120"`
+	Timezone       string         `json:"timezone" validate:"omitempty,timezone" example:"Asia/Seoul"`
+}
+
+type CreateStackMaintenanceWindowResponse struct {
+	ID string `json:"id"`
+}
+
+type UpdateStackMaintenanceWindowRequest struct {
+	Name           *string         `json:"name,omitempty" validate:"omitempty,max=100"`
+	Description    *string         `json:"description,omitempty"`
+	Weekdays       *[]time.Weekday `json:"weekdays,omitempty" validate:"omitempty,min=1,dive,min=0,max=6"`
+	StartTime      *string         `json:"startTime,omitempty" validate:"omitempty,datetime=15:04"`
+	DurationMinute *int            `json:"durationMinute,omitempty" validate:"omitempty,min=1,max=1440"`
+	Timezone       *string         `json:"timezone,omitempty" validate:"omitempty,timezone"`
+	Enabled        *bool           `json:"enabled,omitempty"`
+}
+
+type GetStackMaintenanceWindowsResponse struct {
+	StackMaintenanceWindows []StackMaintenanceWindowResponse `json:"stackMaintenanceWindows"`
+}
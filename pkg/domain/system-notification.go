@@ -51,6 +51,14 @@ type SystemNotificationRequest struct {
 		Severity    string `json:"severity"`
 		Instance    string `json:"instance"`
 		TacoCluster string `json:"taco_cluster"`
+		// 정책 위반 알림(POLICY_NOTIFICATION) 레이블
+		Kind                 string `json:"kind"`
+		Name                 string `json:"name"`
+		ViolatingKind        string `json:"violating_kind"`
+		ViolatingName        string `json:"violating_name"`
+		ViolatingNamespace   string `json:"violating_namespace"`
+		ViolationMsg         string `json:"violation_msg"`
+		ViolationEnforcement string `json:"violation_enforcement"`
 	} `json:"labels"`
 	Annotations struct {
 		Message                  string `json:"message"`
@@ -105,6 +113,9 @@ type SystemNotificationResponse struct {
 	CreatedAt                 time.Time                          `json:"createdAt"`
 	UpdatedAt                 time.Time                          `json:"updatedAt"`
 	PolicyName                string                             `json:"policyName"`
+	Suppressed                bool                               `json:"suppressed"`
+	EscalationLevel           int                                `json:"escalationLevel"`
+	EscalatedAt               *time.Time                         `json:"escalatedAt"`
 }
 
 type SystemNotificationActionResponse struct {
@@ -21,6 +21,7 @@ type UserResponse struct {
 	UpdatedAt         time.Time            `json:"updatedAt"`
 	PasswordUpdatedAt time.Time            `json:"passwordUpdatedAt"`
 	PasswordExpired   bool                 `json:"passwordExpired"`
+	LockedUntil       *time.Time           `json:"lockedUntil"`
 
 	Email       string `json:"email"`
 	Department  string `json:"department"`
@@ -84,6 +85,7 @@ type GetUserResponse struct {
 		Creator      string               `json:"creator"`
 		CreatedAt    time.Time            `json:"createdAt"`
 		UpdatedAt    time.Time            `json:"updatedAt"`
+		LockedUntil  *time.Time           `json:"lockedUntil"`
 	} `json:"user"`
 }
 
@@ -103,6 +105,7 @@ type ListUserBody struct {
 	Creator      string               `json:"creator"`
 	CreatedAt    time.Time            `json:"createdAt"`
 	UpdatedAt    time.Time            `json:"updatedAt"`
+	LockedUntil  *time.Time           `json:"lockedUntil"`
 }
 
 type UpdateUserRequest struct {
@@ -178,6 +181,33 @@ type CheckExistedResponse struct {
 	Existed bool `json:"existed"`
 }
 
+// ImportUserRow 는 사용자 CSV 파일의 한 행이다. Roles 는 역할 이름이며 CSV 에서는 ';' 로 구분한다.
+type ImportUserRow struct {
+	Line       int
+	AccountId  string
+	Name       string
+	Email      string
+	Department string
+	Roles      []string
+}
+
+type ImportUserResult struct {
+	Line      int      `json:"line"`
+	AccountId string   `json:"accountId"`
+	Email     string   `json:"email"`
+	Errors    []string `json:"errors,omitempty"`
+	Created   bool     `json:"created"`
+	EmailSent bool     `json:"emailSent"`
+}
+
+type ImportUsersResponse struct {
+	DryRun  bool               `json:"dryRun"`
+	Total   int                `json:"total"`
+	Invalid int                `json:"invalid"`
+	Created int                `json:"created"`
+	Results []ImportUserResult `json:"results"`
+}
+
 type Admin_CreateUserRequest struct {
 	AccountId     string             `json:"accountId" validate:"required"`
 	Name          string             `json:"name" validate:"name"`
@@ -21,20 +21,47 @@ var errorMap = map[ErrorCode]string{
 	"C_INVALID_AUDIT_ID":                        "유효하지 않은 로그 아이디입니다. 로그 아이디를 확인하세요.",
 	"C_INVALID_POLICY_TEMPLATE_ID":              "유효하지 않은 정책 템플릿 아이디입니다. 정책 템플릿 아이디를 확인하세요.",
 	"C_INVALID_POLICY_ID":                       "유효하지 않은 정책 아이디입니다. 정책 아이디를 확인하세요.",
+	"C_INVALID_SERVICE_ACCOUNT_ID":              "유효하지 않은 서비스계정 아이디입니다. 서비스계정 아이디를 확인하세요.",
+	"C_INVALID_ACCESS_TOKEN_ID":                 "유효하지 않은 액세스 토큰 아이디입니다. 액세스 토큰 아이디를 확인하세요.",
 	"C_FAILED_TO_CALL_WORKFLOW":                 "워크플로우 호출에 실패했습니다.",
 
 	// Auth
-	"A_INVALID_ID":              "아이디가 존재하지 않습니다.",
-	"A_INVALID_PASSWORD":        "비밀번호가 일치하지 않습니다.",
-	"A_SAME_OLD_PASSWORD":       "기존 비밀번호와 동일합니다.",
-	"A_INVALID_TOKEN":           "사용자 토큰 오류",
-	"A_EXPIRED_TOKEN":           "사용자 토큰 만료",
-	"A_INVALID_USER_CREDENTIAL": "비밀번호가 일치하지 않습니다.",
-	"A_INVALID_ORIGIN_PASSWORD": "기존 비밀번호가 일치하지 않습니다.",
-	"A_INVALID_CODE":            "인증번호가 일치하지 않습니다.",
-	"A_NO_SESSION":              "세션 정보를 찾을 수 없습니다.",
-	"A_EXPIRED_CODE":            "인증번호가 만료되었습니다.",
-	"A_UNUSABLE_TOKEN":          "사용할 수 없는 토큰입니다.",
+	"A_INVALID_ID":                "아이디가 존재하지 않습니다.",
+	"A_INVALID_PASSWORD":          "비밀번호가 일치하지 않습니다.",
+	"A_SAME_OLD_PASSWORD":         "기존 비밀번호와 동일합니다.",
+	"A_PASSWORD_POLICY_VIOLATION": "비밀번호가 조직의 비밀번호 정책을 만족하지 않습니다.",
+	"A_REUSED_PASSWORD":           "최근에 사용한 비밀번호는 다시 사용할 수 없습니다.",
+	"A_INVALID_TOKEN":             "사용자 토큰 오류",
+	"A_EXPIRED_TOKEN":             "사용자 토큰 만료",
+	"A_INVALID_USER_CREDENTIAL":   "비밀번호가 일치하지 않습니다.",
+	"A_INVALID_ORIGIN_PASSWORD":   "기존 비밀번호가 일치하지 않습니다.",
+	"A_INVALID_CODE":              "인증번호가 일치하지 않습니다.",
+	"A_NO_SESSION":                "세션 정보를 찾을 수 없습니다.",
+	"A_EXPIRED_CODE":              "인증번호가 만료되었습니다.",
+	"A_UNUSABLE_TOKEN":            "사용할 수 없는 토큰입니다.",
+	"A_OUT_OF_SCOPE":              "토큰에 허용되지 않은 API 입니다.",
+	"A_LOCKED_ACCOUNT":            "로그인 실패 횟수를 초과하여 계정이 잠겼습니다. 잠시 후 다시 시도하거나 관리자에게 문의하세요.",
+	"A_TOO_MANY_ATTEMPTS":         "요청 횟수를 초과했습니다. 잠시 후 다시 시도하세요.",
+	"A_MFA_CODE_REQUIRED":         "2차 인증 코드를 입력하세요.",
+	"A_INVALID_MFA_CODE":          "2차 인증 코드가 일치하지 않습니다.",
+	"A_LOCKED_MFA":                "2차 인증 실패 횟수를 초과하여 잠시 후 다시 시도해야 합니다.",
+	"A_MFA_ENROLLMENT_REQUIRED":   "조직 정책에 따라 2차 인증 등록이 필요합니다.",
+	"A_MFA_STEP_UP_REQUIRED":      "2차 인증 후 다시 시도하세요.",
+
+	// MFA
+	"MFA_ALREADY_ENABLED":    "이미 2차 인증이 활성화되어 있습니다.",
+	"MFA_NOT_ENROLLED":       "2차 인증이 등록되지 않았습니다.",
+	"MFA_REQUIRED_BY_POLICY": "조직 정책에 따라 2차 인증을 해제할 수 없습니다.",
+	"MFA_INVALID_ROLE":       "유효하지 않은 역할입니다. 역할을 확인하세요.",
+
+	// AccessToken
+	"AT_CREATE_ALREADY_EXISTED_NAME":   "서비스계정에 이미 존재하는 이름입니다.",
+	"AT_INVALID_ROLE":                  "유효하지 않은 역할입니다. 역할을 확인하세요.",
+	"AT_INVALID_SCOPE":                 "유효하지 않은 스코프입니다. 스코프를 확인하세요.",
+	"AT_NOT_FOUND_SERVICE_ACCOUNT":     "서비스계정이 존재하지 않습니다.",
+	"AT_NOT_FOUND_ACCESS_TOKEN":        "액세스 토큰이 존재하지 않습니다.",
+	"AT_NOT_PERMITTED_ON_ACCESS_TOKEN": "액세스 토큰으로는 토큰을 발급할 수 없습니다.",
+	"AT_NOT_PERMITTED_ROLE":            "자신이 가지지 않은 역할의 서비스계정은 생성하거나 키를 발급할 수 없습니다.",
 
 	// Organization
 	"O_INVALID_ORGANIZATION_NAME":                   "조직에 이미 존재하는 이름입니다.",
@@ -42,19 +69,29 @@ var errorMap = map[ErrorCode]string{
 	"O_FAILED_UPDATE_STACK_TEMPLATES":               "조직에 스택템플릿을 설정하는데 실패했습니다",
 	"O_FAILED_UPDATE_POLICY_TEMPLATES":              "조직에 정책템플릿을 설정하는데 실패했습니다",
 	"O_FAILED_UPDATE_SYSTEM_NOTIFICATION_TEMPLATES": "조직에 알림템플릿을 설정하는데 실패했습니다",
+	"O_INVALID_PASSWORD_POLICY":                     "유효하지 않은 비밀번호 정책입니다. 재사용 금지 시 이력 개수를 1 이상으로 설정하세요.",
 
 	// User
-	"U_NO_USER": "해당 사용자 정보를 찾을 수 없습니다.",
+	"U_NO_USER":                "해당 사용자 정보를 찾을 수 없습니다.",
+	"U_INVALID_CSV":            "유효하지 않은 사용자 CSV 파일입니다. 파일 형식을 확인하세요.",
+	"U_FAILED_TO_IMPORT_USERS": "사용자 일괄 등록에 실패했습니다. 생성된 사용자는 모두 삭제되었습니다.",
 
 	// CloudAccount
-	"CA_INVALID_CLIENT_TOKEN_ID":    "유효하지 않은 토큰입니다. AccessKeyId, SecretAccessKey, SessionToken 을 확인후 다시 입력하세요.",
-	"CA_INVALID_CLOUD_ACCOUNT_NAME": "유효하지 않은 클라우드계정 이름입니다. 클라우드계정 이름을 확인하세요.",
+	"CA_INVALID_CLIENT_TOKEN_ID":     "유효하지 않은 토큰입니다. AccessKeyId, SecretAccessKey, SessionToken 을 확인후 다시 입력하세요.",
+	"CA_INVALID_CLOUD_ACCOUNT_NAME":  "유효하지 않은 클라우드계정 이름입니다. 클라우드계정 이름을 확인하세요.",
+	"CA_INVALID_ACCOUNT":             "클라우드계정 정보가 올바르지 않습니다. 클라우드서비스별 필수 항목을 확인하세요.",
+	"CA_INVALID_REGION":              "유효하지 않은 리전입니다. 리전 이름을 확인하세요.",
+	"CA_REGION_IN_USE":               "사용 중인 클러스터가 있는 리전은 허용 리전에서 제외할 수 없습니다.",
+	"CA_INVALID_STATUS_FOR_ROTATION": "생성이 완료된 클라우드계정만 인증 정보를 교체할 수 있습니다.",
+	"CA_INVALID_CREDENTIAL":          "입력한 인증 정보로 클라우드계정에 접근할 수 없습니다. 인증 정보를 확인후 다시 입력하세요.",
 
 	// Dashboard
-	"D_INVALID_CHART_TYPE":    "유효하지 않은 차트타입입니다.",
-	"D_INVALID_PRIMARY_STACK": "프라이머리 스택이 정상적으로 설치되지 않았습니다. 스택을 확인하세요.",
-	"D_NOT_FOUND_CHART":       "요청한 차트를 불러올 수 없습니다.",
-	"D_NO_STACK":              "",
+	"D_INVALID_CHART_TYPE":          "유효하지 않은 차트타입입니다.",
+	"D_INVALID_PRIMARY_STACK":       "프라이머리 스택이 정상적으로 설치되지 않았습니다. 스택을 확인하세요.",
+	"D_NOT_FOUND_CHART":             "요청한 차트를 불러올 수 없습니다.",
+	"D_NO_STACK":                    "",
+	"D_NOT_FOUND_DEFAULT_DASHBOARD": "조직의 기본 대시보드가 존재하지 않습니다.",
+	"D_INVALID_DASHBOARD_LAYOUT":    "유효하지 않은 대시보드 레이아웃입니다. 파일 형식과 버전을 확인하세요.",
 
 	// AppServeApp
 	"D_NO_ASA": "요청한 앱아이디에 해당하는 어플리케이션이 없습니다.",
@@ -62,6 +99,7 @@ var errorMap = map[ErrorCode]string{
 	// Cluster
 	"CL_INVALID_BYOH_CLUSTER_ENDPOINT": "BYOH 타입의 클러스터 생성을 위한 cluster endpoint 가 유효하지 않습니다.",
 	"CL_INVALID_CLUSTER_TYPE_AWS":      "클러스터 타입이 유효하지 않습니다.",
+	"CL_INVALID_CLUSTER_REGION":        "클라우드 계정에 허용되지 않은 리전입니다.",
 
 	// Stack
 	"S_INVALID_STACK_TEMPLATE":      "스택 템플릿을 가져올 수 없습니다.",
@@ -79,6 +117,7 @@ var errorMap = map[ErrorCode]string{
 	"S_INVALID_CLUSTER_URL":         "BYOH 타입의 클러스터 생성은 반드시 userClusterEndpoint 값이 필요합니다.",
 	"S_INVALID_CLUSTER_ID":          "BYOH 타입의 클러스터 생성은 반드시 clusterId 값이 필요합니다.",
 	"S_INVALID_CLOUD_SERVICE":       "클라우드 서비스 타입이 잘못되었습니다.",
+	"S_INVALID_CLUSTER_REGION":      "클라우드 계정에 허용되지 않은 리전입니다.",
 	"S_FAILED_DELETE_POLICIES":      "스택의 폴리시들을 삭제하는 실패하였습니다",
 
 	// Alert
@@ -91,6 +130,8 @@ var errorMap = map[ErrorCode]string{
 	"SNT_NOT_EXISTED_ALERT_TEMPLATE":    "업데이트할 알림템플릿이 존재하지 않습니다.",
 	"SNT_FAILED_DELETE_EXIST_RULES":     "알림템플릿을 사용하고 있는 알림 설정이 있습니다. 알림 설정을 삭제하세요.",
 	"SNT_CANNOT_DELETE_SYSTEM_TEMPLATE": "시스템 알림템플릿은 삭제 할 수 없습니다.",
+	"SNT_INVALID_METRIC_QUERY":          "알림템플릿의 메트릭 쿼리가 올바른 PromQL 이 아닙니다.",
+	"SNT_INVALID_METRIC_PARAMETER":      "알림템플릿의 메트릭 파라미터가 올바르지 않습니다. 값은 $labels.<라벨 이름> 형식이어야 합니다.",
 
 	// SystemNotificationRule
 	"SNR_CREATE_ALREADY_EXISTED_NAME":           "알림 설정에 이미 존재하는 이름입니다.",
@@ -99,6 +140,25 @@ var errorMap = map[ErrorCode]string{
 	"SNR_NOT_EXISTED_SYSTEM_NOTIFICATION_RULE":  "업데이트할 알림 설정이 존재하지 않습니다.",
 	"SNR_INVALID_ENABLE_PORTAL":                 "알림 방법의 포탈은 설정을 변경할 수 없습니다.",
 	"SNR_CANNOT_DELETE_SYSTEM_RULE":             "시스템 알림 설정은 삭제 할 수 없습니다.",
+	"SNR_INVALID_CONDITION":                     "알림 조건이 올바르지 않습니다. 연산자, 비교값, 지속시간을 확인하세요.",
+	"SNR_FAILED_TO_PREVIEW":                     "알림 미리보기 쿼리를 실행하는데 실패했습니다.",
+
+	// SystemNotificationSilence
+	"SNS_INVALID_SILENCE_ID":            "유효하지 않은 알림 사일런스 아이디입니다.",
+	"SNS_NOT_FOUND_SILENCE":             "알림 사일런스가 존재하지 않습니다.",
+	"SNS_INVALID_PERIOD":                "사일런스 종료 시각은 시작 시각과 현재 시각 이후여야 합니다.",
+	"SNS_NO_MATCHER":                    "사일런스에는 클러스터, 노드, 알림 이름, 알림 설정 중 하나 이상의 조건이 필요합니다.",
+	"SNS_INVALID_MAINTENANCE_WINDOW_ID": "유효하지 않은 점검 시간 아이디입니다.",
+	"SNS_NOT_FOUND_MAINTENANCE_WINDOW":  "스택의 점검 시간이 존재하지 않습니다.",
+	"SNS_INVALID_MAINTENANCE_WINDOW":    "점검 시간 설정이 올바르지 않습니다.",
+
+	// SystemNotificationEscalation
+	"SNE_INVALID_ON_CALL_SCHEDULE_ID": "유효하지 않은 당직 순번 아이디입니다.",
+	"SNE_NOT_FOUND_ON_CALL_SCHEDULE":  "당직 순번이 존재하지 않습니다.",
+	"SNE_INVALID_ON_CALL_SCHEDULE":    "당직 순번 설정이 올바르지 않습니다.",
+	"SNE_ON_CALL_SCHEDULE_IN_USE":     "에스컬레이션 정책에서 사용 중인 당직 순번은 삭제할 수 없습니다.",
+	"SNE_NOT_FOUND_ESCALATION_POLICY": "알림 설정의 에스컬레이션 정책이 존재하지 않습니다.",
+	"SNE_INVALID_ESCALATION_POLICY":   "에스컬레이션 정책 설정이 올바르지 않습니다.",
 
 	// AppGroup
 	"AG_NOT_FOUND_CLUSTER":         "지장한 클러스터가 존재하지 않습니다.",
@@ -134,19 +194,95 @@ var errorMap = map[ErrorCode]string{
 	"PT_NOT_PERMITTED_ON_TKS_POLICY_TEMPLATE": "tks 템플릿에 대해 해당 동작을 수행할 수 없습니다.",
 	"PT_INVALID_PARAMETER_SCHEMA":             "파라미터 스키마에 잘못된 타입이 지정되었습니다.",
 	"PT_INVALID_SYNC":                         "잘못된 데이터 동기화 설정입니다. 데이터 동기화 설정을 확인하세요.",
+	"PT_POLICY_TEMPLATE_VERSION_IN_USE":       "스택에 지정된 정책 템플릿 버전은 삭제할 수 없습니다.",
+	"PT_INVALID_CONSTRAINT_TEMPLATE":          "유효하지 않은 ConstraintTemplate 입니다. ConstraintTemplate 을 확인하세요.",
+	"PT_FAILED_TO_FETCH_CONSTRAINT_TEMPLATE":  "ConstraintTemplate 파일을 가져오는데 실패했습니다. 경로를 확인하세요.",
+	"PT_CONSTRAINT_TEMPLATE_KIND_MISMATCH":    "ConstraintTemplate 의 유형이 정책 템플릿 유형과 다릅니다.",
 
 	// Policy
-	"P_CREATE_ALREADY_EXISTED_NAME":  "정첵에 이미 존재하는 이름입니다.",
-	"P_NOT_FOUND_POLICY":             "정책이 존재하지 않습니다.",
-	"P_INVALID_POLICY_NAME":          "유효하지 않은 정책 이름입니다. 정책 이름을 확인하세요.",
-	"P_INVALID_POLICY_RESOURCE_NAME": "유효하지 않은 정책 자원 이름(k8s 자원 이름)입니다. 정책 자원 이름을 확인하세요.",
-	"P_INVALID_MATCH":                "유효하지 않은 match 설정입니다. match 설정을 확인하세요.",
-	"P_FAILED_FETCH_POLICY":          "정책 ID에 해당하는 정책을 가져오는데 실패했습니다.",
-	"P_FAILED_FETCH_CLUSTER":         "정책의 클러스터 정보를 가져오는데 실패했습니다.",
-	"P_FAILED_FETCH_TEMPLATE":        "정책의 템플릿 정보를 가져오는데 실패했습니다.",
-	"P_CALL_TO_APPLY_KUBERNETES":     "쿠버네티스 클러스터 호출에 실패했습니다.",
-	"P_FAILED_TO_APPLY_KUBERNETES":   "쿠버네티스 클러스터 변경사항 적용에 실패했습니다.",
-	"P_INVALID_POLICY_PARAMETER":     "정책 파라미터가 템플릿의 파라미터 스키마에 유효하지 않습니다. 파라미터를 확인하세요.",
+	"P_CREATE_ALREADY_EXISTED_NAME":   "정첵에 이미 존재하는 이름입니다.",
+	"P_NOT_FOUND_POLICY":              "정책이 존재하지 않습니다.",
+	"P_INVALID_POLICY_NAME":           "유효하지 않은 정책 이름입니다. 정책 이름을 확인하세요.",
+	"P_INVALID_POLICY_RESOURCE_NAME":  "유효하지 않은 정책 자원 이름(k8s 자원 이름)입니다. 정책 자원 이름을 확인하세요.",
+	"P_INVALID_MATCH":                 "유효하지 않은 match 설정입니다. match 설정을 확인하세요.",
+	"P_FAILED_FETCH_POLICY":           "정책 ID에 해당하는 정책을 가져오는데 실패했습니다.",
+	"P_FAILED_FETCH_CLUSTER":          "정책의 클러스터 정보를 가져오는데 실패했습니다.",
+	"P_FAILED_FETCH_TEMPLATE":         "정책의 템플릿 정보를 가져오는데 실패했습니다.",
+	"P_CALL_TO_APPLY_KUBERNETES":      "쿠버네티스 클러스터 호출에 실패했습니다.",
+	"P_FAILED_TO_APPLY_KUBERNETES":    "쿠버네티스 클러스터 변경사항 적용에 실패했습니다.",
+	"P_INVALID_POLICY_PARAMETER":      "정책 파라미터가 템플릿의 파라미터 스키마에 유효하지 않습니다. 파라미터를 확인하세요.",
+	"P_INVALID_TEMPLATE_VERSION":      "템플릿이 지원하지 않는 버전입니다. 버전을 확인하세요.",
+	"P_INCOMPATIBLE_TEMPLATE_VERSION": "템플릿 버전 간 파라미터가 호환되지 않습니다.",
+	"P_PINNED_TEMPLATE_VERSION":       "스택에 고정된 템플릿 버전입니다. 고정을 해제한 후 변경하세요.",
+
+	// PolicyException
+	"PE_INVALID_POLICY_EXCEPTION_ID": "유효하지 않은 정책 예외 아이디입니다. 정책 예외 아이디를 확인하세요.",
+	"PE_NOT_FOUND_POLICY_EXCEPTION":  "정책 예외가 존재하지 않습니다.",
+	"PE_INVALID_CLUSTER":             "정책이 적용된 클러스터가 아닙니다. 클러스터를 확인하세요.",
+	"PE_INVALID_EXPIRED_AT":          "유효하지 않은 만료 시각입니다. 만료 시각은 최대 90일 이내여야 합니다.",
+	"PE_INVALID_APPROVER":            "유효하지 않은 승인자입니다. 승인자는 요청자가 아닌 같은 조직의 사용자여야 합니다.",
+
+	// PolicyViolation
+	"PV_INVALID_POLICY_VIOLATION_ID": "유효하지 않은 정책 위반 아이디입니다. 정책 위반 아이디를 확인하세요.",
+	"PV_NOT_FOUND_POLICY_VIOLATION":  "정책 위반 기록이 존재하지 않습니다.",
+
+	// PolicyRollout
+	"PR_POLICY_ROLLOUT_IN_PROGRESS": "정책 단계적 적용이 진행 중입니다. 단계적 적용을 취소한 후 적용 액션을 변경하세요.",
+	"PR_NOT_FOUND_POLICY_ROLLOUT":   "정책 단계적 적용 이력이 존재하지 않습니다.",
+	"PR_NOT_IN_PROGRESS":            "진행 중인 정책 단계적 적용이 없습니다.",
+	"PR_NO_TARGET_STACK":            "정책이 적용된 스택이 없습니다.",
+
+	// PolicyBundle
+	"PB_INVALID_POLICY_BUNDLE_ID":    "유효하지 않은 정책 번들 아이디입니다. 정책 번들 아이디를 확인하세요.",
+	"PB_NOT_FOUND_POLICY_BUNDLE":     "정책 번들이 존재하지 않습니다.",
+	"PB_CREATE_ALREADY_EXISTED_NAME": "정책 번들 이름이 이미 존재합니다.",
+	"PB_DUPLICATED_POLICY":           "번들에 같은 정책이 중복되어 있습니다.",
+	"PB_POLICY_BUNDLE_IN_USE":        "스택에 할당된 정책 번들입니다. 할당을 해제한 후 삭제하세요.",
+	"PB_NOT_ASSIGNED_POLICY_BUNDLE":  "스택에 할당되지 않은 정책 번들입니다.",
+	"PB_CONFLICTING_PRESET":          "스택에 할당된 다른 정책 번들에 같은 정책의 다른 프리셋이 있습니다.",
+
+	// ComplianceReport
+	"CR_INVALID_COMPLIANCE_REPORT_ID":         "유효하지 않은 준수 보고서 아이디입니다. 준수 보고서 아이디를 확인하세요.",
+	"CR_NOT_FOUND_COMPLIANCE_REPORT":          "준수 보고서가 존재하지 않습니다.",
+	"CR_FAILED_TO_GENERATE_COMPLIANCE_REPORT": "준수 보고서 생성에 실패했습니다.",
+	"CR_UNSUPPORTED_FORMAT":                   "지원하지 않는 보고서 형식입니다. json 또는 html 을 사용하세요.",
+
+	// MutationPolicy
+	"MP_INVALID_MUTATION_POLICY_ID":   "유효하지 않은 뮤테이션 정책 아이디입니다. 뮤테이션 정책 아이디를 확인하세요.",
+	"MP_NOT_FOUND_MUTATION_POLICY":    "뮤테이션 정책이 존재하지 않습니다.",
+	"MP_CREATE_ALREADY_EXISTED_NAME":  "이미 존재하는 뮤테이션 정책 이름입니다.",
+	"MP_INVALID_POLICY_RESOURCE_NAME": "이미 존재하는 뮤테이션 정책 자원 이름입니다. 정책 자원 이름을 확인하세요.",
+	"MP_INVALID_PARAMETER":            "뮤테이션 정책 파라미터가 유효하지 않습니다. 파라미터를 확인하세요.",
+
+	// PolicyChangeRequest
+	"PCR_INVALID_POLICY_CHANGE_REQUEST_ID": "유효하지 않은 정책 변경 요청 아이디입니다. 정책 변경 요청 아이디를 확인하세요.",
+	"PCR_NOT_FOUND_POLICY_CHANGE_REQUEST":  "정책 변경 요청이 존재하지 않습니다.",
+	"PCR_ALREADY_PENDING":                  "승인 대기 중인 변경 요청이 있는 정책입니다. 기존 요청이 처리된 후 다시 요청하세요.",
+	"PCR_INVALID_PAYLOAD":                  "유효하지 않은 정책 변경 요청입니다. 변경 내용을 확인하세요.",
+	"PCR_NOT_PENDING":                      "승인 대기 중인 변경 요청이 아닙니다.",
+	"PCR_EXPIRED":                          "만료된 정책 변경 요청입니다.",
+	"PCR_SELF_REVIEW":                      "본인이 요청한 정책 변경은 승인하거나 반려할 수 없습니다.",
+	"PCR_APPROVAL_REQUIRED":                "정책 변경 승인 모드에서는 승인된 변경 요청으로만 정책을 변경할 수 있습니다.",
+
+	// Cost
+	"CO_INVALID_STACK_TEMPLATE": "유효하지 않은 스택 템플릿입니다. 스택 템플릿을 확인하세요.",
+	"CO_INVALID_CLOUD_ACCOUNT":  "유효하지 않은 클라우드 계정입니다. 클라우드 계정을 확인하세요.",
+	"CO_INVALID_CLUSTER_REGION": "클라우드 계정에서 허용되지 않은 리전입니다. 리전을 확인하세요.",
+	"CO_NOT_FOUND_STACK":        "스택이 존재하지 않습니다.",
+	"CO_NOT_FOUND_PROJECT":      "프로젝트가 존재하지 않습니다.",
+	"CO_FAILED_TO_GET_COST":     "비용 조회에 실패했습니다.",
+
+	// ProjectUsage
+	"PU_INVALID_PERIOD":              "유효하지 않은 조회 기간입니다. from, to 는 YYYY-MM-DD 형식이며 최대 366일까지 조회할 수 있습니다.",
+	"PU_FAILED_TO_GET_PROJECT_USAGE": "프로젝트 사용량 조회에 실패했습니다.",
+
+	// DashboardWidget
+	"DW_INVALID_DASHBOARD_WIDGET_ID": "유효하지 않은 위젯 아이디입니다. 위젯 아이디를 확인하세요.",
+	"DW_NOT_FOUND_DASHBOARD_WIDGET":  "위젯이 존재하지 않습니다.",
+	"DW_NOT_PERMITTED":               "위젯을 생성한 사용자만 수정하거나 삭제할 수 있습니다.",
+	"DW_INVALID_QUERY":               "유효하지 않은 PromQL 입니다. 쿼리를 확인하세요.",
+	"DW_INVALID_THRESHOLDS":          "유효하지 않은 임계치입니다. 임계치를 확인하세요.",
+	"DW_FAILED_TO_QUERY":             "위젯 데이터 조회에 실패했습니다.",
 }
 
 func (m ErrorCode) GetText() string {
@@ -123,6 +123,26 @@ func GetAwsAccountIdSecret(ctx context.Context) (awsAccountId string, err error)
 	return
 }
 
+// GetCloudSecret 은 argo 네임스페이스에 저장된 클라우드 인증 정보 secret 의 데이터를 반환한다.
+func GetCloudSecret(ctx context.Context, name string) (map[string]string, error) {
+	clientset, err := GetClientAdminCluster(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets, err := clientset.CoreV1().Secrets("argo").Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		log.Error(ctx, err)
+		return nil, err
+	}
+
+	data := make(map[string]string, len(secrets.Data))
+	for key, value := range secrets.Data {
+		data[key] = string(value)
+	}
+	return data, nil
+}
+
 func GetKubeConfig(ctx context.Context, clusterId string, configType KubeConfigType) ([]byte, error) {
 	clientset, err := GetClientAdminCluster(ctx)
 	if err != nil {
@@ -169,6 +189,26 @@ func GetClientFromClusterId(ctx context.Context, clusterId string) (*kubernetes.
 	return clientset_user, nil
 }
 
+// 사용자 클러스터에 Gatekeeper mutation 리소스 등을 직접 적용하기 위한 dynamic client 생성
+func GetDynamicClientFromClusterId(ctx context.Context, clusterId string) (*dynamic.DynamicClient, error) {
+	kubeconfig, err := GetKubeConfig(ctx, clusterId, KubeconfigForAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		log.Error(ctx, err)
+		return nil, err
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return dynamicClient, nil
+}
+
 func GetKubernetesVserionByClusterId(ctx context.Context, clusterId string) (string, error) {
 	clientset, err := GetClientAdminCluster(ctx)
 	if err != nil {
@@ -0,0 +1,78 @@
+package thanos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gcache "github.com/patrickmn/go-cache"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/sync/singleflight"
+)
+
+// 합쳐진 요청을 대신 조회할 때의 최대 시간
+const queryCacheFetchTimeout = 30 * time.Second
+
+var queryCacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "tks_thanos_query_cache_requests_total",
+	Help: "Number of thanos range queries by cache result (hit, miss, coalesced).",
+}, []string{"result"})
+
+// NewCached 는 range query 결과를 ttl 동안 캐시하는 클라이언트를 생성한다.
+// start, end 를 step 단위로 정렬하여 캐시 키로 사용하므로 같은 step 구간 안의 요청은 같은 결과를 받는다.
+// 진행 중인 동일한 query 는 하나의 요청으로 합쳐진다.
+func NewCached(host string, port int, ssl bool, token string, ttl time.Duration) (ThanosClient, error) {
+	client, err := New(host, port, ssl, token)
+	if err != nil {
+		return nil, err
+	}
+
+	impl := client.(*ThanosClientImpl)
+	impl.cache = gcache.New(ttl, 2*ttl)
+	impl.flights = &singleflight.Group{}
+	return impl, nil
+}
+
+func (c *ThanosClientImpl) cachedFetchRange(ctx context.Context, query string, start int, end int, step int) ([]byte, error) {
+	if step > 0 {
+		start -= start % step
+		end -= end % step
+	}
+	key := fmt.Sprintf("%s|%d|%d|%d", query, start, end, step)
+
+	if body, found := c.cache.Get(key); found {
+		queryCacheRequests.WithLabelValues("hit").Inc()
+		return body.([]byte), nil
+	}
+
+	// 먼저 요청한 호출자가 취소되어도 함께 기다리는 요청이 실패하지 않도록 호출자의 ctx 와 분리하여 조회한다.
+	leader := false
+	ch := c.flights.DoChan(key, func() (interface{}, error) {
+		leader = true
+		fetchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), queryCacheFetchTimeout)
+		defer cancel()
+
+		body, err := c.doFetchRange(fetchCtx, query, start, end, step)
+		if err != nil {
+			return nil, err
+		}
+		c.cache.SetDefault(key, body)
+		return body, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if leader {
+			queryCacheRequests.WithLabelValues("miss").Inc()
+		} else {
+			queryCacheRequests.WithLabelValues("coalesced").Inc()
+		}
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.([]byte), nil
+	}
+}
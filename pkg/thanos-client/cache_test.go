@@ -0,0 +1,107 @@
+package thanos
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCachedFetchRange(t *testing.T) {
+	var calls int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"matrix","result":[{"metric":{"taco_cluster":"c1"},"values":[[3600,"1"]]}]}}`))
+	}))
+	defer server.Close()
+
+	idx := strings.LastIndex(server.URL, ":")
+	port, _ := strconv.Atoi(server.URL[idx+1:])
+	client, err := NewCached(server.URL[:idx], port, false, "", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 같은 step 구간 안의 동시 요청은 하나로 합쳐진다
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			out, err := client.FetchRange(context.Background(), "up", 3600+i, 7200+i, 3600)
+			if err != nil || len(out.Data.Result) != 1 {
+				t.Errorf("unexpected result %v %v", out, err)
+			}
+		}(i)
+	}
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if _, err := client.FetchRange(context.Background(), "up", 3600, 7200, 3600); err != nil {
+		t.Fatal(err)
+	}
+	if calls := atomic.LoadInt32(&calls); calls != 1 {
+		t.Errorf("expected 1 call to thanos, got %d", calls)
+	}
+
+	if _, err := client.FetchRange(context.Background(), "up", 7200, 10800, 3600); err != nil {
+		t.Fatal(err)
+	}
+	if calls := atomic.LoadInt32(&calls); calls != 2 {
+		t.Errorf("expected 2 calls to thanos, got %d", calls)
+	}
+}
+
+func TestCachedFetchRangeLeaderCanceled(t *testing.T) {
+	var calls int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"matrix","result":[]}}`))
+	}))
+	defer server.Close()
+
+	idx := strings.LastIndex(server.URL, ":")
+	port, _ := strconv.Atoi(server.URL[idx+1:])
+	client, err := NewCached(server.URL[:idx], port, false, "", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 먼저 요청한 호출자가 취소되어도 기다리던 요청은 결과를 받는다
+	ctx, cancel := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := client.FetchRange(ctx, "up", 3600, 7200, 3600)
+		leaderErr <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	followerErr := make(chan error, 1)
+	go func() {
+		_, err := client.FetchRange(context.Background(), "up", 3600, 7200, 3600)
+		followerErr <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	cancel()
+	if err := <-leaderErr; err != context.Canceled {
+		t.Errorf("expected canceled leader, got %v", err)
+	}
+	close(release)
+	if err := <-followerErr; err != nil {
+		t.Errorf("expected follower to succeed, got %v", err)
+	}
+	if calls := atomic.LoadInt32(&calls); calls != 1 {
+		t.Errorf("expected 1 call to thanos, got %d", calls)
+	}
+}
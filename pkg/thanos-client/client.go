@@ -11,6 +11,8 @@ import (
 
 	"github.com/openinfradev/tks-api/internal/helper"
 	"github.com/openinfradev/tks-api/pkg/log"
+	gcache "github.com/patrickmn/go-cache"
+	"golang.org/x/sync/singleflight"
 )
 
 type ThanosClient interface {
@@ -25,6 +27,10 @@ type ThanosClient interface {
 type ThanosClientImpl struct {
 	client *http.Client
 	url    string
+
+	// NewCached 로 생성한 경우에만 설정된다
+	cache   *gcache.Cache
+	flights *singleflight.Group
 }
 
 // New function
@@ -185,11 +191,22 @@ func (c *ThanosClientImpl) FetchPolicyViolationCountRange(ctx context.Context, q
 }
 
 func (c *ThanosClientImpl) fetchRange(ctx context.Context, query string, start int, end int, step int) ([]byte, error) {
+	if c.cache != nil {
+		return c.cachedFetchRange(ctx, query, start, end, step)
+	}
+	return c.doFetchRange(ctx, query, start, end, step)
+}
+
+func (c *ThanosClientImpl) doFetchRange(ctx context.Context, query string, start int, end int, step int) ([]byte, error) {
 	rangeParam := fmt.Sprintf("&dedup=true&partial_response=false&start=%d&end=%d&step=%d&max_source_resolution=0s", start, end, step)
 	query = url.QueryEscape(query) + rangeParam
 	requestUrl := c.url + "/api/v1/query_range?query=" + query
 
-	res, err := c.client.Get(requestUrl)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.client.Do(req)
 	if err != nil {
 		return nil, err
 	}
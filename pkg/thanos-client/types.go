@@ -1,5 +1,7 @@
 package thanos
 
+import "encoding/json"
+
 type Metric struct {
 	Data   MetricData `json:"data"`
 	Status string     `json:"status"`
@@ -17,9 +19,23 @@ type MetricDataResult struct {
 }
 
 type MetricDataResultMetric struct {
-	Name        string `json:"__name__"`
-	Namespace   string `json:"namespace,omitempty"`
-	TacoCluster string `json:"taco_cluster"`
+	Name        string            `json:"__name__"`
+	Namespace   string            `json:"namespace,omitempty"`
+	TacoCluster string            `json:"taco_cluster"`
+	Labels      map[string]string `json:"-"`
+}
+
+// UnmarshalJSON 은 자주 쓰는 라벨 필드와 함께 시계열의 전체 라벨을 Labels 에 담는다.
+func (m *MetricDataResultMetric) UnmarshalJSON(data []byte) error {
+	labels := map[string]string{}
+	if err := json.Unmarshal(data, &labels); err != nil {
+		return err
+	}
+	m.Name = labels["__name__"]
+	m.Namespace = labels["namespace"]
+	m.TacoCluster = labels["taco_cluster"]
+	m.Labels = labels
+	return nil
 }
 
 // PolicyMetric dedicated policy metric struct